@@ -0,0 +1,708 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/botobag/artemis/graphql"
+	"github.com/botobag/artemis/graphql/ast"
+	"github.com/botobag/artemis/graphql/parser"
+	"github.com/botobag/artemis/graphql/token"
+	"github.com/botobag/artemis/graphql/util/sdl"
+	"github.com/botobag/artemis/iterator"
+)
+
+// generateConfig specifies the input for generate.
+type generateConfig struct {
+	// Name of the package for the generated code
+	PackageName string
+
+	// Sources of the SDL files
+	Sources []*token.Source
+}
+
+// generator generates Go code for a schema.
+type generator struct {
+	// The schema built from SDL
+	schema graphql.Schema
+
+	// SDL text to be embedded in the generated code
+	source string
+
+	// Types defined in SDL sorted by names
+	types []graphql.TypeWithName
+
+	// Root operation types
+	rootTypes map[graphql.Object]bool
+
+	// Interfaces and Unions that the Object with the given name belongs to
+	abstractTypes map[string][]graphql.TypeWithName
+
+	// Buffer for the generated code
+	buf bytes.Buffer
+}
+
+// generate parses SDL from the sources and generates the Go code.
+func generate(config *generateConfig) ([]byte, error) {
+	if len(config.Sources) == 0 {
+		return nil, fmt.Errorf("no SDL source was given")
+	}
+
+	var (
+		document ast.Document
+		sources  []string
+	)
+	for _, source := range config.Sources {
+		doc, err := parser.Parse(source)
+		if err != nil {
+			return nil, err
+		}
+		document.Definitions = append(document.Definitions, doc.Definitions...)
+		sources = append(sources, string(source.Body()))
+	}
+
+	// Custom Scalars require coercers to build the schema. The generated code doesn't run the schema
+	// so placeholders are provided.
+	resolvers := &sdl.Resolvers{
+		Scalars: map[string]sdl.ScalarCoercers{},
+	}
+	for _, definition := range document.Definitions {
+		if definition, ok := definition.(*ast.ScalarTypeDefinition); ok {
+			resolvers.Scalars[definition.Name.Value()] = sdl.ScalarCoercers{
+				ResultCoercer: graphql.ScalarResultCoercerFunc(func(value interface{}) (interface{}, error) {
+					return value, nil
+				}),
+			}
+		}
+	}
+
+	schema, errs := sdl.BuildASTSchema(document, resolvers)
+	if errs.HaveOccurred() {
+		messages := make([]string, len(errs.Errors))
+		for i, err := range errs.Errors {
+			messages[i] = err.Error()
+		}
+		return nil, fmt.Errorf("%s", strings.Join(messages, "\n"))
+	}
+
+	g := &generator{
+		schema:        schema,
+		source:        strings.Join(sources, "\n"),
+		rootTypes:     map[graphql.Object]bool{},
+		abstractTypes: map[string][]graphql.TypeWithName{},
+	}
+	if err := g.init(); err != nil {
+		return nil, err
+	}
+
+	g.printf("// Code generated by artemis-gen. DO NOT EDIT.\n\n")
+	g.printf("package %s\n\n", config.PackageName)
+	g.printf("import (\n")
+	g.printf("\t%q\n\n", "context")
+	g.printf("\t%q\n", "github.com/botobag/artemis/graphql")
+	g.printf("\t%q\n", "github.com/botobag/artemis/graphql/token")
+	g.printf("\t%q\n", "github.com/botobag/artemis/graphql/util/sdl")
+	g.printf(")\n")
+
+	for _, t := range g.types {
+		switch t := t.(type) {
+		case graphql.Enum:
+			g.generateEnum(t)
+		case graphql.InputObject:
+			g.generateInputObject(t)
+		case graphql.Object:
+			g.generateObject(t)
+		case graphql.Interface:
+			g.generateAbstractType(t, t.Description())
+		case graphql.Union:
+			g.generateAbstractType(t, t.Description())
+		}
+	}
+
+	g.generateResolvers()
+	g.generateNewSchema()
+
+	code, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format generated code: %s\n%s", err, g.buf.String())
+	}
+	return code, nil
+}
+
+// init collects the information about the types in schema.
+func (g *generator) init() error {
+	for _, rootType := range []graphql.Object{g.schema.Query(), g.schema.Mutation(), g.schema.Subscription()} {
+		if rootType != nil {
+			g.rootTypes[rootType] = true
+		}
+	}
+
+	iter := g.schema.TypeMap().Iterator()
+	for {
+		t, err := iter.Next()
+		if err == iterator.Done {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		namedType := t.(graphql.TypeWithName)
+		if isDefinedType(namedType) {
+			g.types = append(g.types, namedType)
+		}
+	}
+	sort.Slice(g.types, func(i, j int) bool {
+		return g.types[i].Name() < g.types[j].Name()
+	})
+
+	for _, t := range g.types {
+		abstractType, ok := t.(graphql.AbstractType)
+		if !ok {
+			continue
+		}
+		for _, object := range g.possibleTypes(abstractType) {
+			g.abstractTypes[object.Name()] = append(g.abstractTypes[object.Name()], t)
+		}
+	}
+
+	return nil
+}
+
+// isDefinedType returns true if the type is neither a built-in scalar or an introspection type.
+func isDefinedType(t graphql.TypeWithName) bool {
+	if strings.HasPrefix(t.Name(), "__") {
+		return false
+	}
+
+	switch t.Name() {
+	case "Int", "Float", "String", "Boolean", "ID":
+		return false
+	}
+
+	return true
+}
+
+// possibleTypes returns the Object types that implement the Interface or belong to the Union in the
+// order of their names.
+func (g *generator) possibleTypes(t graphql.AbstractType) []graphql.Object {
+	var objects []graphql.Object
+	iter := g.schema.PossibleTypes(t).Iterator()
+	for {
+		object, err := iter.Next()
+		if err != nil {
+			break
+		}
+		objects = append(objects, object.(graphql.Object))
+	}
+	sort.Slice(objects, func(i, j int) bool {
+		return objects[i].Name() < objects[j].Name()
+	})
+	return objects
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+// printComment prints the description and the deprecation as Go comments.
+func (g *generator) printComment(indent string, description string, deprecation *graphql.Deprecation) {
+	if len(description) > 0 {
+		for _, line := range strings.Split(description, "\n") {
+			g.printf("%s// %s\n", indent, strings.TrimRight(line, " \t"))
+		}
+	}
+
+	if deprecation != nil {
+		if len(description) > 0 {
+			g.printf("%s//\n", indent)
+		}
+		g.printf("%s// Deprecated: %s\n", indent, deprecation.Reason)
+	}
+}
+
+// sortedFields returns the fields in the map in the order of their names.
+func sortedFields(fieldMap graphql.FieldMap) []graphql.Field {
+	fields := make([]graphql.Field, 0, len(fieldMap))
+	for _, field := range fieldMap {
+		fields = append(fields, field)
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Name() < fields[j].Name()
+	})
+	return fields
+}
+
+// sortedArgs returns the arguments of the field in the order of their names.
+func sortedArgs(field graphql.Field) []*graphql.Argument {
+	fieldArgs := field.Args()
+	args := make([]*graphql.Argument, 0, len(fieldArgs))
+	for i := range fieldArgs {
+		args = append(args, &fieldArgs[i])
+	}
+	sort.Slice(args, func(i, j int) bool {
+		return args[i].Name() < args[j].Name()
+	})
+	return args
+}
+
+// goType returns the Go type for a GraphQL input or output type. Nullable types are represented by
+// pointers except for lists, Interfaces, Unions and custom Scalars whose Go types can be nil.
+// Objects are always referenced by pointers.
+func goType(t graphql.Type) string {
+	nonNull := false
+	if nonNullType, ok := t.(graphql.NonNull); ok {
+		nonNull = true
+		t = nonNullType.InnerType()
+	}
+
+	switch t := t.(type) {
+	case graphql.List:
+		return "[]" + goType(t.ElementType())
+
+	case graphql.Scalar:
+		var name string
+		switch t.Name() {
+		case "Int":
+			name = "int"
+		case "Float":
+			name = "float64"
+		case "String", "ID":
+			name = "string"
+		case "Boolean":
+			name = "bool"
+		default:
+			return "interface{}"
+		}
+		if nonNull {
+			return name
+		}
+		return "*" + name
+
+	case graphql.Enum:
+		if nonNull {
+			return goName(t.Name())
+		}
+		return "*" + goName(t.Name())
+
+	case graphql.InputObject:
+		if nonNull {
+			return goName(t.Name())
+		}
+		return "*" + goName(t.Name())
+
+	case graphql.Object:
+		return "*" + goName(t.Name())
+
+	case graphql.Interface:
+		return goName(t.Name())
+
+	case graphql.Union:
+		return goName(t.Name())
+	}
+
+	panic(fmt.Sprintf("unexpected type %s", graphql.Inspect(t)))
+}
+
+// nilAsNull returns true if the nil value of the Go type for t should be resolved as null. Nil
+// slice for non-null List is resolved as an empty list.
+func nilAsNull(t graphql.Type) bool {
+	goType := goType(t)
+	if strings.HasPrefix(goType, "[]") {
+		return graphql.IsNullableType(t)
+	}
+	return strings.HasPrefix(goType, "*") ||
+		goType == "interface{}" ||
+		graphql.IsAbstractType(graphql.NullableTypeOf(t))
+}
+
+// generateEnum generates a Go string type and the constants for the enum values.
+func (g *generator) generateEnum(t graphql.Enum) {
+	name := goName(t.Name())
+
+	g.printf("\n")
+	if len(t.Description()) > 0 {
+		g.printComment("", t.Description(), nil)
+	} else {
+		g.printf("// %s is generated from the Enum type %s.\n", name, t.Name())
+	}
+	g.printf("type %s string\n\n", name)
+
+	g.printf("// Enum values of %s\n", name)
+	g.printf("const (\n")
+	for _, value := range sortedEnumValues(t) {
+		g.printComment("\t", value.Description(), value.Deprecation())
+		g.printf("\t%s %s = %q\n", name+goName(value.Name()), name, value.Name())
+	}
+	g.printf(")\n")
+}
+
+// sortedEnumValues returns the values of the enum in the order of their names.
+func sortedEnumValues(t graphql.Enum) []graphql.EnumValue {
+	enumValues := t.Values()
+	values := make([]graphql.EnumValue, 0, len(enumValues))
+	for _, value := range enumValues {
+		values = append(values, value)
+	}
+	sort.Slice(values, func(i, j int) bool {
+		return values[i].Name() < values[j].Name()
+	})
+	return values
+}
+
+// generateInputObject generates a Go struct for the InputObject.
+func (g *generator) generateInputObject(t graphql.InputObject) {
+	name := goName(t.Name())
+
+	g.printf("\n")
+	if len(t.Description()) > 0 {
+		g.printComment("", t.Description(), nil)
+	} else {
+		g.printf("// %s is generated from the InputObject type %s.\n", name, t.Name())
+	}
+	g.printf("type %s struct {\n", name)
+
+	fieldMap := t.Fields()
+	names := make([]string, 0, len(fieldMap))
+	for name := range fieldMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		field := fieldMap[name]
+		g.printComment("\t", field.Description(), field.Deprecation())
+		g.printf("\t%s %s `graphql:%q`\n", goName(name), goType(field.Type()), name)
+	}
+	g.printf("}\n")
+}
+
+// isResolvedField returns true if the field is resolved by the resolver interface of its Object.
+// These includes all fields in root operation types and fields that take arguments.
+func (g *generator) isResolvedField(object graphql.Object, field graphql.Field) bool {
+	return g.rootTypes[object] || len(field.Args()) > 0
+}
+
+// resolverName returns the name of the resolver interface for the Object.
+func resolverName(object graphql.Object) string {
+	return goName(object.Name()) + "Resolver"
+}
+
+// argsName returns the name of the argument struct for the field.
+func argsName(object graphql.Object, field graphql.Field) string {
+	return goName(object.Name()) + goName(field.Name()) + "Args"
+}
+
+// markerName returns the name of the unexported method that marks the Go types of the possible
+// types for an Interface or a Union.
+func markerName(t graphql.TypeWithName) string {
+	return "is" + goName(t.Name())
+}
+
+// generateObject generates a Go struct to hold the values for the fields that are not resolved by
+// resolvers (called "model") and a resolver interface for the rest fields.
+func (g *generator) generateObject(t graphql.Object) {
+	var (
+		name           = goName(t.Name())
+		fields         = sortedFields(t.Fields())
+		modelFields    []graphql.Field
+		resolvedFields []graphql.Field
+	)
+	for _, field := range fields {
+		if g.isResolvedField(t, field) {
+			resolvedFields = append(resolvedFields, field)
+		} else {
+			modelFields = append(modelFields, field)
+		}
+	}
+
+	if !g.rootTypes[t] {
+		g.printf("\n")
+		if len(t.Description()) > 0 {
+			g.printComment("", t.Description(), nil)
+		} else {
+			g.printf("// %s is generated from the Object type %s.\n", name, t.Name())
+		}
+		g.printf("type %s struct {\n", name)
+		for _, field := range modelFields {
+			g.printComment("\t", field.Description(), field.Deprecation())
+			g.printf("\t%s %s `graphql:%q`\n", goName(field.Name()), goType(field.Type()), field.Name())
+		}
+		g.printf("}\n")
+
+		for _, abstractType := range g.abstractTypes[t.Name()] {
+			g.printf("\nfunc (*%s) %s() {}\n", name, markerName(abstractType))
+		}
+	}
+
+	if len(resolvedFields) == 0 {
+		return
+	}
+
+	g.printf("\n// %s resolves the fields in %s", resolverName(t), t.Name())
+	if !g.rootTypes[t] {
+		g.printf(" that take arguments")
+	}
+	g.printf(".\n")
+	g.printf("type %s interface {\n", resolverName(t))
+	for i, field := range resolvedFields {
+		if i > 0 {
+			g.printf("\n")
+		}
+		g.printComment("\t", field.Description(), field.Deprecation())
+		g.printf("\t%s(ctx context.Context", goName(field.Name()))
+		if !g.rootTypes[t] {
+			g.printf(", obj *%s", name)
+		}
+		if len(field.Args()) > 0 {
+			g.printf(", args %s", argsName(t, field))
+		}
+		g.printf(") (%s, error)\n", goType(field.Type()))
+	}
+	g.printf("}\n")
+
+	for _, field := range resolvedFields {
+		args := sortedArgs(field)
+		if len(args) == 0 {
+			continue
+		}
+
+		g.printf("\n// %s contains the arguments for %s.%s.\n", argsName(t, field), t.Name(), field.Name())
+		g.printf("type %s struct {\n", argsName(t, field))
+		for _, arg := range args {
+			g.printComment("\t", arg.Description(), arg.Deprecation())
+			g.printf("\t%s %s `graphql:%q`\n", goName(arg.Name()), goType(arg.Type()), arg.Name())
+		}
+		g.printf("}\n")
+	}
+}
+
+// generateAbstractType generates a Go interface for an Interface or a Union. The Go types of its
+// possible types implement the interface with an unexported marker method.
+func (g *generator) generateAbstractType(t graphql.TypeWithName, description string) {
+	name := goName(t.Name())
+
+	g.printf("\n")
+	if len(description) > 0 {
+		g.printComment("", description, nil)
+	} else {
+		g.printf("// %s is generated from the %s type %s.\n", name, abstractKindOf(t), t.Name())
+	}
+	g.printf("type %s interface {\n", name)
+	g.printf("\t%s()\n", markerName(t))
+	g.printf("}\n")
+}
+
+func abstractKindOf(t graphql.TypeWithName) string {
+	if _, ok := t.(graphql.Union); ok {
+		return "Union"
+	}
+	return "Interface"
+}
+
+// customScalars returns the custom Scalars defined in schema.
+func (g *generator) customScalars() []graphql.Scalar {
+	var scalars []graphql.Scalar
+	for _, t := range g.types {
+		if scalar, ok := t.(graphql.Scalar); ok {
+			scalars = append(scalars, scalar)
+		}
+	}
+	return scalars
+}
+
+// resolvedObjects returns the Object types that have resolver interfaces.
+func (g *generator) resolvedObjects() []graphql.Object {
+	var objects []graphql.Object
+	for _, t := range g.types {
+		object, ok := t.(graphql.Object)
+		if !ok {
+			continue
+		}
+		for _, field := range object.Fields() {
+			if g.isResolvedField(object, field) {
+				objects = append(objects, object)
+				break
+			}
+		}
+	}
+	return objects
+}
+
+// generateResolvers generates the Resolvers interface that provides resolver for each Object and
+// coercers for each custom Scalar.
+func (g *generator) generateResolvers() {
+	g.printf("\n// Resolvers provides the resolvers for Object types and the coercers for custom Scalar\n")
+	g.printf("// types to NewSchema.\n")
+	g.printf("type Resolvers interface {\n")
+	for _, object := range g.resolvedObjects() {
+		g.printf("\t%s() %s\n", goName(object.Name()), resolverName(object))
+	}
+	for _, scalar := range g.customScalars() {
+		g.printf("\t%sScalar() sdl.ScalarCoercers\n", goName(scalar.Name()))
+	}
+	g.printf("}\n")
+}
+
+// quoteSource quotes the SDL text into a Go string literal.
+func quoteSource(source string) string {
+	if strings.Contains(source, "\r") {
+		return strconv.Quote(source)
+	}
+	return "`" + strings.Replace(source, "`", "` + \"`\" + `", -1) + "`"
+}
+
+// resolverVarName returns the name of the local variable in NewSchema that holds the resolver for
+// the Object.
+func resolverVarName(object graphql.Object) string {
+	return "resolver" + goName(object.Name())
+}
+
+// generateNewSchema generates NewSchema function which builds the schema and wires the resolvers.
+func (g *generator) generateNewSchema() {
+	g.printf("\n// schemaSource contains the SDL which the code in this file is generated from.\n")
+	g.printf("const schemaSource = %s\n", quoteSource(g.source))
+
+	g.printf("\n// NewSchema builds the schema with the given resolvers.\n")
+	g.printf("func NewSchema(resolvers Resolvers, opts ...sdl.BuildOption) (graphql.Schema, graphql.Errors) {\n")
+
+	resolvedObjects := g.resolvedObjects()
+	if len(resolvedObjects) > 0 {
+		g.printf("var (\n")
+		for _, object := range resolvedObjects {
+			g.printf("%s = resolvers.%s()\n", resolverVarName(object), goName(object.Name()))
+		}
+		g.printf(")\n\n")
+	}
+
+	g.printf("return sdl.BuildSchema(token.NewSource(schemaSource), &sdl.Resolvers{\n")
+
+	// Field resolvers
+	g.printf("Fields: map[string]sdl.FieldResolvers{\n")
+	for _, t := range g.types {
+		object, ok := t.(graphql.Object)
+		if !ok {
+			continue
+		}
+		g.printf("%q: {\n", object.Name())
+		for _, field := range sortedFields(object.Fields()) {
+			g.printf("%q: graphql.FieldResolverFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {\n", field.Name())
+			g.generateFieldResolverBody(object, field)
+			g.printf("}),\n")
+		}
+		g.printf("},\n")
+	}
+	g.printf("},\n")
+
+	// Type resolvers
+	g.printf("Types: map[string]graphql.TypeResolver{\n")
+	for _, t := range g.types {
+		abstractType, ok := t.(graphql.AbstractType)
+		if !ok {
+			continue
+		}
+		g.printf("%q: graphql.TypeResolverFunc(func(ctx context.Context, value interface{}, info graphql.ResolveInfo) (graphql.Object, error) {\n", t.Name())
+		possibleTypes := g.possibleTypes(abstractType)
+		if len(possibleTypes) > 0 {
+			g.printf("switch value.(type) {\n")
+			for _, object := range possibleTypes {
+				g.printf("case *%s:\n", goName(object.Name()))
+				g.printf("return info.Schema().TypeMap().Lookup(%q).(graphql.Object), nil\n", object.Name())
+			}
+			g.printf("}\n")
+		}
+		g.printf("return nil, nil\n")
+		g.printf("}),\n")
+	}
+	g.printf("},\n")
+
+	// Scalar coercers
+	g.printf("Scalars: map[string]sdl.ScalarCoercers{\n")
+	for _, scalar := range g.customScalars() {
+		g.printf("%q: resolvers.%sScalar(),\n", scalar.Name(), goName(scalar.Name()))
+	}
+	g.printf("},\n")
+
+	// Enum values
+	g.printf("Enums: map[string]sdl.EnumValues{\n")
+	for _, t := range g.types {
+		enum, ok := t.(graphql.Enum)
+		if !ok {
+			continue
+		}
+		g.printf("%q: {\n", enum.Name())
+		for _, value := range sortedEnumValues(enum) {
+			g.printf("%q: %s,\n", value.Name(), goName(enum.Name())+goName(value.Name()))
+		}
+		g.printf("},\n")
+	}
+	g.printf("},\n")
+
+	g.printf("}, opts...)\n")
+	g.printf("}\n")
+}
+
+// generateFieldResolverBody generates the code to resolve the field value either from the model
+// struct or by calling the method in resolver interface.
+func (g *generator) generateFieldResolverBody(object graphql.Object, field graphql.Field) {
+	var value string
+	if g.isResolvedField(object, field) {
+		if len(field.Args()) > 0 {
+			g.printf("var args %s\n", argsName(object, field))
+			g.printf("if err := info.Args().Decode(&args); err != nil {\n")
+			g.printf("return nil, err\n")
+			g.printf("}\n")
+		}
+
+		g.printf("result, err := %s.%s(ctx", resolverVarName(object), goName(field.Name()))
+		if !g.rootTypes[object] {
+			g.printf(", source.(*%s)", goName(object.Name()))
+		}
+		if len(field.Args()) > 0 {
+			g.printf(", args")
+		}
+		g.printf(")\n")
+		g.printf("if err != nil {\n")
+		g.printf("return nil, err\n")
+		g.printf("}\n")
+		value = "result"
+	} else {
+		value = fmt.Sprintf("source.(*%s).%s", goName(object.Name()), goName(field.Name()))
+	}
+
+	if nilAsNull(field.Type()) {
+		// Return untyped nil for null.
+		if !g.isResolvedField(object, field) {
+			g.printf("result := %s\n", value)
+			value = "result"
+		}
+		g.printf("if %s == nil {\n", value)
+		g.printf("return nil, nil\n")
+		g.printf("}\n")
+
+		// Dereference the pointer to leaf value for result coercion.
+		if graphql.IsLeafType(field.Type()) && strings.HasPrefix(goType(field.Type()), "*") {
+			value = "*" + value
+		}
+	}
+	g.printf("return %s, nil\n", value)
+}
@@ -0,0 +1,145 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package main
+
+import (
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/botobag/artemis/graphql/token"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("goName", func() {
+	It("converts GraphQL names into exported Go identifiers", func() {
+		for name, expected := range map[string]string{
+			"id":           "ID",
+			"name":         "Name",
+			"firstName":    "FirstName",
+			"first_name":   "FirstName",
+			"userID":       "UserID",
+			"userId":       "UserID",
+			"homeURL":      "HomeURL",
+			"HTTPServer":   "HTTPServer",
+			"NEW_HOPE":     "NewHope",
+			"JEDI":         "Jedi",
+			"__typename":   "Typename",
+			"item2":        "Item2",
+			"base64Value":  "Base64Value",
+			"_":            "X",
+			"_1st":         "X1st",
+			"Query":        "Query",
+			"SearchResult": "SearchResult",
+		} {
+			Expect(goName(name)).Should(Equal(expected), "name: %s", name)
+		}
+	})
+})
+
+var updateGolden = flag.Bool("update", false, "update the golden files in testdata")
+
+// expectGolden compares the generated code with the golden file in testdata. Run "go test" with
+// -update to rewrite the golden file.
+func expectGolden(code []byte, name string) {
+	golden := filepath.Join("testdata", name)
+	if *updateGolden {
+		Expect(ioutil.WriteFile(golden, code, 0644)).Should(Succeed())
+	}
+
+	expected, err := ioutil.ReadFile(golden)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(string(code)).Should(Equal(string(expected)),
+		`%s is out of date; run "go test -update" to update it`, golden)
+}
+
+// multipleSourcesConfig returns a config that defines the schema in multiple sources.
+func multipleSourcesConfig() *generateConfig {
+	return &generateConfig{
+		PackageName: "multiple",
+		Sources: []*token.Source{
+			token.NewSource(`
+type Query {
+  user(id: ID!): User
+  users(offset: Int, limit: Int, after: String, before: String): [User!]!
+}`),
+			token.NewSource(`
+type User {
+  id: ID!
+  friends(first: Int, after: String): [User!]!
+}`),
+		},
+	}
+}
+
+var _ = Describe("generate", func() {
+	It("generates the code for the example", func() {
+		const dir = "internal/example"
+
+		source, err := ioutil.ReadFile(filepath.Join(dir, "schema.graphql"))
+		Expect(err).ShouldNot(HaveOccurred())
+
+		expected, err := ioutil.ReadFile(filepath.Join(dir, "schema.generated.go"))
+		Expect(err).ShouldNot(HaveOccurred())
+
+		code, err := generate(&generateConfig{
+			PackageName: "example",
+			Sources:     []*token.Source{token.NewSourceFromBytes(source)},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(code)).Should(Equal(string(expected)),
+			`schema.generated.go is out of date; run "go generate" in %s`, dir)
+	})
+
+	It("merges types from multiple sources", func() {
+		code, err := generate(multipleSourcesConfig())
+		Expect(err).ShouldNot(HaveOccurred())
+		expectGolden(code, "multiple.golden")
+	})
+
+	It("generates the same code every time", func() {
+		expected, err := generate(multipleSourcesConfig())
+		Expect(err).ShouldNot(HaveOccurred())
+
+		// Arguments and fields are stored in maps. Run a few times to exercise the iteration order.
+		for i := 0; i < 10; i++ {
+			code, err := generate(multipleSourcesConfig())
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(code)).Should(Equal(string(expected)))
+		}
+	})
+
+	It("rejects empty sources", func() {
+		_, err := generate(&generateConfig{
+			PackageName: "empty",
+		})
+		Expect(err).Should(MatchError("no SDL source was given"))
+	})
+
+	It("rejects invalid schema", func() {
+		_, err := generate(&generateConfig{
+			PackageName: "invalid",
+			Sources: []*token.Source{
+				token.NewSource(`type Query { user: User }`),
+			},
+		})
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).Should(ContainSubstring(`"User"`))
+	})
+})
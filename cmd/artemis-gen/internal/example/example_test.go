@@ -0,0 +1,284 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package example_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/botobag/artemis/cmd/artemis-gen/internal/example"
+	"github.com/botobag/artemis/graphql"
+	"github.com/botobag/artemis/graphql/executor"
+	"github.com/botobag/artemis/graphql/parser"
+	"github.com/botobag/artemis/graphql/token"
+	"github.com/botobag/artemis/graphql/util/sdl"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+type resolvers struct {
+	humans []*example.Human
+	droids []*example.Droid
+	height map[string]float64
+}
+
+var _ example.Resolvers = (*resolvers)(nil)
+
+func (r *resolvers) Query() example.QueryResolver       { return queryResolver{r} }
+func (r *resolvers) Mutation() example.MutationResolver { return mutationResolver{} }
+func (r *resolvers) Human() example.HumanResolver       { return humanResolver{r} }
+
+func (r *resolvers) DateTimeScalar() sdl.ScalarCoercers {
+	return sdl.ScalarCoercers{
+		ResultCoercer: graphql.ScalarResultCoercerFunc(func(value interface{}) (interface{}, error) {
+			if value, ok := value.(time.Time); ok {
+				return value.Format(time.RFC3339), nil
+			}
+			return nil, graphql.NewCoercionError("invalid DateTime: %v", value)
+		}),
+	}
+}
+
+type queryResolver struct {
+	*resolvers
+}
+
+func (r queryResolver) Hero(ctx context.Context, args example.QueryHeroArgs) (example.Character, error) {
+	if args.Episode != nil && *args.Episode == example.EpisodeEmpire {
+		return r.humans[0], nil
+	}
+	return r.droids[0], nil
+}
+
+func (r queryResolver) Human(ctx context.Context, args example.QueryHumanArgs) (*example.Human, error) {
+	for _, human := range r.humans {
+		if human.ID == args.ID {
+			return human, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r queryResolver) Search(ctx context.Context, args example.QuerySearchArgs) ([]example.SearchResult, error) {
+	var results []example.SearchResult
+	for _, human := range r.humans {
+		if strings.Contains(*human.Name, args.Text) {
+			results = append(results, human)
+		}
+	}
+	for _, droid := range r.droids {
+		if strings.Contains(*droid.Name, args.Text) {
+			results = append(results, droid)
+		}
+	}
+	return results, nil
+}
+
+type mutationResolver struct{}
+
+func (mutationResolver) CreateReview(ctx context.Context, args example.MutationCreateReviewArgs) (*example.Review, error) {
+	if args.Review.Stars < 0 || args.Review.Stars > 5 {
+		return nil, fmt.Errorf("invalid stars: %d", args.Review.Stars)
+	}
+	return &example.Review{
+		Episode:    &args.Episode,
+		Stars:      args.Review.Stars,
+		Commentary: args.Review.Commentary,
+	}, nil
+}
+
+type humanResolver struct {
+	*resolvers
+}
+
+func (r humanResolver) Height(ctx context.Context, obj *example.Human, args example.HumanHeightArgs) (*float64, error) {
+	height, exists := r.height[obj.ID]
+	if !exists {
+		return nil, nil
+	}
+	if args.Unit != nil && *args.Unit == example.LengthUnitFoot {
+		height *= 3.28084
+	}
+	return &height, nil
+}
+
+func executeQuery(schema graphql.Schema, query string) string {
+	document := parser.MustParse(token.NewSource(query))
+
+	operation, errs := executor.Prepare(schema, document)
+	Expect(errs).Should(Equal(graphql.NoErrors()))
+
+	result := operation.Execute(context.Background())
+	resultJSON, err := json.Marshal(result)
+	Expect(err).ShouldNot(HaveOccurred())
+	return string(resultJSON)
+}
+
+var _ = Describe("Generated code", func() {
+	var schema graphql.Schema
+
+	BeforeEach(func() {
+		luke := &example.Human{
+			ID:        "1000",
+			Name:      stringPtr("Luke Skywalker"),
+			AppearsIn: []example.Episode{example.EpisodeNewHope, example.EpisodeEmpire, example.EpisodeJedi},
+		}
+		r2d2 := &example.Droid{
+			ID:              "2001",
+			Name:            stringPtr("R2-D2"),
+			AppearsIn:       []example.Episode{example.EpisodeNewHope},
+			PrimaryFunction: stringPtr("Astromech"),
+			ManufacturedAt:  time.Date(1977, time.May, 25, 0, 0, 0, 0, time.UTC),
+			Friends:         []example.Character{luke},
+		}
+		luke.Friends = []example.Character{r2d2, nil}
+
+		var errs graphql.Errors
+		schema, errs = example.NewSchema(&resolvers{
+			humans: []*example.Human{luke},
+			droids: []*example.Droid{r2d2},
+			height: map[string]float64{
+				"1000": 1.72,
+			},
+		})
+		Expect(errs).Should(Equal(graphql.NoErrors()))
+	})
+
+	It("resolves fields from models and resolvers", func() {
+		Expect(executeQuery(schema, `{
+      hero {
+        __typename
+        id
+        name
+        appearsIn
+        ... on Droid {
+          primaryFunction
+          manufacturedAt
+        }
+        friends {
+          name
+          ... on Human {
+            height(unit: FOOT)
+          }
+        }
+      }
+      human(id: "1000") {
+        height
+        homePlanet
+      }
+      missing: human(id: "3000") {
+        name
+      }
+    }`)).Should(MatchJSON(`{
+			"data": {
+				"hero": {
+					"__typename": "Droid",
+					"id": "2001",
+					"name": "R2-D2",
+					"appearsIn": ["NEW_HOPE"],
+					"primaryFunction": "Astromech",
+					"manufacturedAt": "1977-05-25T00:00:00Z",
+					"friends": [{
+						"name": "Luke Skywalker",
+						"height": 5.6430448
+					}]
+				},
+				"human": {
+					"height": 1.72,
+					"homePlanet": null
+				},
+				"missing": null
+			}
+		}`))
+	})
+
+	It("decodes enum arguments", func() {
+		Expect(executeQuery(schema, `{
+      hero(episode: EMPIRE) {
+        __typename
+        friends {
+          __typename
+        }
+      }
+    }`)).Should(MatchJSON(`{
+			"data": {
+				"hero": {
+					"__typename": "Human",
+					"friends": [{ "__typename": "Droid" }, null]
+				}
+			}
+		}`))
+	})
+
+	It("resolves unions", func() {
+		Expect(executeQuery(schema, `{
+      search(text: "2") {
+        __typename
+        ... on Droid {
+          name
+        }
+      }
+    }`)).Should(MatchJSON(`{
+			"data": {
+				"search": [{ "__typename": "Droid", "name": "R2-D2" }]
+			}
+		}`))
+	})
+
+	It("decodes input objects", func() {
+		Expect(executeQuery(schema, `mutation {
+      createReview(episode: JEDI, review: { stars: 5, commentary: "Great!" }) {
+        episode
+        stars
+        commentary
+      }
+    }`)).Should(MatchJSON(`{
+			"data": {
+				"createReview": {
+					"episode": "JEDI",
+					"stars": 5,
+					"commentary": "Great!"
+				}
+			}
+		}`))
+	})
+
+	It("reports errors from resolvers", func() {
+		Expect(executeQuery(schema, `mutation {
+      createReview(episode: JEDI, review: { stars: 6 }) {
+        stars
+      }
+    }`)).Should(MatchJSON(`{
+			"errors": [{
+				"message": "invalid stars: 6",
+				"locations": [{ "line": 2, "column": 7 }],
+				"path": ["createReview"]
+			}],
+			"data": {
+				"createReview": null
+			}
+		}`))
+	})
+})
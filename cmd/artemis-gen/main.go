@@ -0,0 +1,95 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+// Command artemis-gen generates Go code from GraphQL schema written in SDL for building the schema
+// with type-safe resolvers. It is intended to be run with "go generate":
+//
+//	//go:generate go run github.com/botobag/artemis/cmd/artemis-gen -o schema.generated.go schema.graphql
+//
+// The following code is generated:
+//
+//   - A Go string type and constants for each Enum type.
+//   - A Go struct for each InputObject type.
+//   - A Go struct (called "model") for each Object type except root operation types. The model
+//     contains the fields that don't take arguments.
+//   - A resolver interface for each Object type whose fields take arguments and each root operation
+//     type. A method in the interface resolves a field that is not in the model and takes a typed
+//     struct for the arguments (if any).
+//   - A Go interface for each Interface and Union type. The models of the possible types implement
+//     the interface with an unexported marker method. Values of Interfaces and Unions must be
+//     pointers to the models.
+//   - A Resolvers interface that provides the resolver interfaces and the coercers for custom
+//     Scalars, and a NewSchema function that builds the schema and wires the resolvers.
+//
+// Nullable types are mapped to pointers except for lists, Interfaces, Unions and custom Scalars
+// whose Go types can be nil. Objects are always referenced by pointers. Values of custom Scalars are
+// represented by interface{}.
+//
+// Because every field is wired to an explicit resolver, an unimplemented resolver becomes a compile
+// error instead of a runtime error reported by the default field resolver.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/botobag/artemis/graphql/token"
+)
+
+func main() {
+	var (
+		packageName = flag.String("package", os.Getenv("GOPACKAGE"),
+			"name of the package for the generated code (default: $GOPACKAGE set by go generate)")
+		output = flag.String("o", "schema.generated.go", "output file")
+	)
+
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] file.graphql...\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if err := run(*packageName, *output, flag.Args()); err != nil {
+		fmt.Fprintf(os.Stderr, "artemis-gen: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(packageName string, output string, filenames []string) error {
+	if len(packageName) == 0 {
+		return fmt.Errorf("package name is not specified")
+	}
+
+	config := &generateConfig{
+		PackageName: packageName,
+	}
+	for _, filename := range filenames {
+		body, err := ioutil.ReadFile(filename)
+		if err != nil {
+			return err
+		}
+		config.Sources = append(config.Sources, token.NewSourceFromBytes(body, token.SourceName(filename)))
+	}
+
+	code, err := generate(config)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(output, code, 0644)
+}
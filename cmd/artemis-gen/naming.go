@@ -0,0 +1,141 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package main
+
+import (
+	"strings"
+
+	"github.com/botobag/artemis/internal/util"
+)
+
+// commonInitialisms contains the words that are kept in upper case in Go identifiers as suggested
+// by Go Code Review Comments [0].
+//
+// [0]: https://github.com/golang/go/wiki/CodeReviewComments#initialisms
+var commonInitialisms = map[string]bool{
+	"ACL":   true,
+	"API":   true,
+	"ASCII": true,
+	"CPU":   true,
+	"CSS":   true,
+	"DNS":   true,
+	"EOF":   true,
+	"GUID":  true,
+	"HTML":  true,
+	"HTTP":  true,
+	"HTTPS": true,
+	"ID":    true,
+	"IP":    true,
+	"JSON":  true,
+	"LHS":   true,
+	"QPS":   true,
+	"RAM":   true,
+	"RHS":   true,
+	"RPC":   true,
+	"SLA":   true,
+	"SMTP":  true,
+	"SQL":   true,
+	"SSH":   true,
+	"TCP":   true,
+	"TLS":   true,
+	"TTL":   true,
+	"UDP":   true,
+	"UI":    true,
+	"UID":   true,
+	"URI":   true,
+	"URL":   true,
+	"UTF8":  true,
+	"UUID":  true,
+	"VM":    true,
+	"XML":   true,
+	"XMPP":  true,
+	"XSRF":  true,
+	"XSS":   true,
+}
+
+func isUpper(c byte) bool {
+	return c >= 'A' && c <= 'Z'
+}
+
+func isLower(c byte) bool {
+	return c >= 'a' && c <= 'z'
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// splitWords splits a GraphQL name into words at underscores and case boundaries. For example,
+// "firstName" is split into "first" and "Name", "NEW_HOPE" into "NEW" and "HOPE", and "HTTPServer"
+// into "HTTP" and "Server".
+func splitWords(name string) []string {
+	var (
+		words []string
+		start = 0
+	)
+
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		if c == '_' {
+			if start < i {
+				words = append(words, name[start:i])
+			}
+			start = i + 1
+			continue
+		}
+
+		if i > start && isUpper(c) {
+			prev := name[i-1]
+			if isLower(prev) || isDigit(prev) || (isUpper(prev) && i+1 < len(name) && isLower(name[i+1])) {
+				words = append(words, name[start:i])
+				start = i
+			}
+		}
+	}
+
+	if start < len(name) {
+		words = append(words, name[start:])
+	}
+
+	return words
+}
+
+// goName converts a GraphQL name into an exported Go identifier. For example, it returns "ID" for
+// "id", "FirstName" for "first_name" and "firstName", and "NewHope" for "NEW_HOPE".
+func goName(name string) string {
+	var b util.StringBuilder
+	for _, word := range splitWords(name) {
+		upper := strings.ToUpper(word)
+		if commonInitialisms[upper] {
+			b.WriteString(upper)
+		} else if upper == word {
+			// Word in all capitals (e.g., "NEW" in "NEW_HOPE").
+			b.WriteString(word[:1])
+			b.WriteString(strings.ToLower(word[1:]))
+		} else {
+			b.WriteString(strings.ToUpper(word[:1]))
+			b.WriteString(word[1:])
+		}
+	}
+
+	s := b.String()
+	if len(s) == 0 || isDigit(s[0]) {
+		// Name consists of underscores only or starts with a digit after removing underscores.
+		s = "X" + s
+	}
+	return s
+}
@@ -0,0 +1,52 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package graphql
+
+// AppliedDirective represents a directive applied to an element in type system (e.g., a type, a
+// field, an argument or an enum value) such as `@auth(requires: ADMIN)` in
+//
+//	type Query {
+//		secret: String @auth(requires: ADMIN)
+//	}
+//
+// Applied directives provide metadata for the elements which are not used by GraphQL itself. The
+// directive must be defined in the schema and accept the location where it is applied. This is
+// checked when creating the schema.
+//
+// Reference: https://graphql.github.io/graphql-spec/June2018/#sec-Type-System.Directives
+type AppliedDirective struct {
+	// Name of the directive being applied
+	Name string
+
+	// Args maps argument name to the value given to the directive. Values are the internal values
+	// for the argument types like the DefaultValue in ArgumentConfig.
+	Args map[string]interface{}
+}
+
+// AppliedDirectives is a list of directives applied to an element in type system in the order they
+// appear.
+type AppliedDirectives []*AppliedDirective
+
+// Lookup finds the first applied directive with the given name. Return nil if not found.
+func (directives AppliedDirectives) Lookup(name string) *AppliedDirective {
+	for _, directive := range directives {
+		if directive.Name == name {
+			return directive
+		}
+	}
+	return nil
+}
@@ -741,6 +741,12 @@ func (value StringValue) TokenRange() token.Range {
 	}
 }
 
+// IsNil returns true if the value is undefined. This is used for checking whether an optional
+// description is specified in type system definitions.
+func (value StringValue) IsNil() bool {
+	return value.Token == nil
+}
+
 // IsBlockString returns true if the value is provided in a block string (""").
 func (value StringValue) IsBlockString() bool {
 	return value.Token.Kind == token.KindBlockString
@@ -1286,3 +1292,1242 @@ func (node *Directive) TokenRange() token.Range {
 func (node *Directive) GetArguments() Arguments {
 	return node.Arguments
 }
+
+//===----------------------------------------------------------------------------------------====//
+// 3 Type System
+//===----------------------------------------------------------------------------------------====//
+// The GraphQL Type system describes the capabilities of a GraphQL server and is used to determine
+// if a query is valid. The type system also describes the input types of query variables to
+// determine if values provided at runtime are valid.
+//
+// Reference: https://graphql.github.io/graphql-spec/June2018/#sec-Type-System
+
+// TypeSystemDefinition represents a definition that describes a GraphQL type system.
+//
+//	TypeSystemDefinition ::
+//		SchemaDefinition
+//		TypeDefinition
+//		DirectiveDefinition
+//
+// Reference: https://graphql.github.io/graphql-spec/June2018/#TypeSystemDefinition
+type TypeSystemDefinition interface {
+	Definition
+
+	// typeSystemDefinitionNode is a special mark to indicate a TypeSystemDefinition node. It makes
+	// sure that only type system definition node can be assigned to TypeSystemDefinition.
+	typeSystemDefinitionNode()
+}
+
+var (
+	_ TypeSystemDefinition = (*SchemaDefinition)(nil)
+	_ TypeSystemDefinition = (*DirectiveDefinition)(nil)
+)
+
+// The following implement TypeDefinition interface.
+var (
+	_ TypeDefinition = (*ScalarTypeDefinition)(nil)
+	_ TypeDefinition = (*ObjectTypeDefinition)(nil)
+	_ TypeDefinition = (*InterfaceTypeDefinition)(nil)
+	_ TypeDefinition = (*UnionTypeDefinition)(nil)
+	_ TypeDefinition = (*EnumTypeDefinition)(nil)
+	_ TypeDefinition = (*InputObjectTypeDefinition)(nil)
+)
+
+//===----------------------------------------------------------------------------------------====//
+// 3.1 Type System Extensions
+//===----------------------------------------------------------------------------------------====//
+// Type system extensions are used to represent a GraphQL type system which has been extended from
+// some original type system.
+//
+// Reference: https://graphql.github.io/graphql-spec/June2018/#sec-Type-System-Extensions
+
+// TypeSystemExtension represents an extension to a GraphQL type system.
+//
+//	TypeSystemExtension ::
+//		SchemaExtension
+//		TypeExtension
+//
+// Reference: https://graphql.github.io/graphql-spec/June2018/#TypeSystemExtension
+type TypeSystemExtension interface {
+	Definition
+
+	// typeSystemExtensionNode is a special mark to indicate a TypeSystemExtension node. It makes sure
+	// that only type system extension node can be assigned to TypeSystemExtension.
+	typeSystemExtensionNode()
+}
+
+var _ TypeSystemExtension = (*SchemaExtension)(nil)
+
+// The following implement TypeExtension interface.
+var (
+	_ TypeExtension = (*ScalarTypeExtension)(nil)
+	_ TypeExtension = (*ObjectTypeExtension)(nil)
+	_ TypeExtension = (*InterfaceTypeExtension)(nil)
+	_ TypeExtension = (*UnionTypeExtension)(nil)
+	_ TypeExtension = (*EnumTypeExtension)(nil)
+	_ TypeExtension = (*InputObjectTypeExtension)(nil)
+)
+
+// SchemaExtension extends a schema defined in the original schema.
+//
+// Reference: https://graphql.github.io/graphql-spec/June2018/#SchemaExtension
+type SchemaExtension struct {
+	// Directives applied to the schema
+	Directives Directives `ast:"optional"`
+
+	// OperationTypes specifies the additional root operation types.
+	OperationTypes OperationTypeDefinitions `ast:"optional"`
+}
+
+// TokenRange implements Node.
+func (extension *SchemaExtension) TokenRange() token.Range {
+	var firstToken, lastToken *token.Token
+	if len(extension.Directives) > 0 {
+		firstToken = extension.Directives.FirstToken()
+	} else {
+		firstToken = extension.OperationTypes.FirstToken()
+	}
+
+	if len(extension.OperationTypes) > 0 {
+		lastToken = extension.OperationTypes.LastToken()
+	} else {
+		lastToken = extension.Directives.LastToken()
+	}
+
+	return token.Range{
+		First: firstToken.Prev.Prev, // "extend" keyword
+		Last:  lastToken,
+	}
+}
+
+// GetDirectives implements Definition.
+func (extension *SchemaExtension) GetDirectives() Directives {
+	return extension.Directives
+}
+
+// typeSystemExtensionNode implements TypeSystemExtension.
+func (*SchemaExtension) typeSystemExtensionNode() {}
+
+// TypeExtension represents an extension to a named type.
+//
+//	TypeExtension ::
+//		ScalarTypeExtension
+//		ObjectTypeExtension
+//		InterfaceTypeExtension
+//		UnionTypeExtension
+//		EnumTypeExtension
+//		InputObjectTypeExtension
+//
+// Reference: https://graphql.github.io/graphql-spec/June2018/#TypeExtension
+type TypeExtension interface {
+	TypeSystemExtension
+
+	// GetName returns the name of the type being extended. (Prepend "Get" to avoid name collision
+	// with the fields in derived class.)
+	GetName() Name
+
+	// typeExtensionNode is a special mark to indicate a TypeExtension node. It makes sure that only
+	// type extension node can be assigned to TypeExtension.
+	typeExtensionNode()
+}
+
+// ScalarTypeExtension extends a Scalar type defined in the original schema.
+//
+// Reference: https://graphql.github.io/graphql-spec/June2018/#ScalarTypeExtension
+type ScalarTypeExtension struct {
+	// Name of the extending type
+	Name Name
+
+	// Directives applied to the type
+	Directives Directives
+}
+
+// TokenRange implements Node.
+func (extension *ScalarTypeExtension) TokenRange() token.Range {
+	return token.Range{
+		First: extension.Name.Token.Prev.Prev, // "extend" keyword
+		Last:  extension.Directives.LastToken(),
+	}
+}
+
+// GetDirectives implements Definition.
+func (extension *ScalarTypeExtension) GetDirectives() Directives {
+	return extension.Directives
+}
+
+// GetName implements TypeExtension.
+func (extension *ScalarTypeExtension) GetName() Name {
+	return extension.Name
+}
+
+// typeSystemExtensionNode implements TypeSystemExtension.
+func (*ScalarTypeExtension) typeSystemExtensionNode() {}
+
+// typeExtensionNode implements TypeExtension.
+func (*ScalarTypeExtension) typeExtensionNode() {}
+
+// ObjectTypeExtension extends an Object type defined in the original schema.
+//
+// Reference: https://graphql.github.io/graphql-spec/June2018/#ObjectTypeExtension
+type ObjectTypeExtension struct {
+	// Name of the extending type
+	Name Name
+
+	// Interfaces that are additionally implemented by the type
+	Interfaces NamedTypes `ast:"optional"`
+
+	// Directives applied to the type
+	Directives Directives `ast:"optional"`
+
+	// Fields that are added to the type
+	Fields FieldDefinitions `ast:"optional"`
+}
+
+// TokenRange implements Node.
+func (extension *ObjectTypeExtension) TokenRange() token.Range {
+	var lastToken *token.Token
+	if len(extension.Fields) > 0 {
+		lastToken = extension.Fields.LastToken()
+	} else if len(extension.Directives) > 0 {
+		lastToken = extension.Directives.LastToken()
+	} else {
+		lastToken = extension.Interfaces.LastToken()
+	}
+
+	return token.Range{
+		First: extension.Name.Token.Prev.Prev, // "extend" keyword
+		Last:  lastToken,
+	}
+}
+
+// GetDirectives implements Definition.
+func (extension *ObjectTypeExtension) GetDirectives() Directives {
+	return extension.Directives
+}
+
+// GetName implements TypeExtension.
+func (extension *ObjectTypeExtension) GetName() Name {
+	return extension.Name
+}
+
+// typeSystemExtensionNode implements TypeSystemExtension.
+func (*ObjectTypeExtension) typeSystemExtensionNode() {}
+
+// typeExtensionNode implements TypeExtension.
+func (*ObjectTypeExtension) typeExtensionNode() {}
+
+// InterfaceTypeExtension extends an Interface type defined in the original schema.
+//
+// Reference: https://graphql.github.io/graphql-spec/June2018/#InterfaceTypeExtension
+type InterfaceTypeExtension struct {
+	// Name of the extending type
+	Name Name
+
+	// Interfaces that are additionally implemented by the type
+	Interfaces NamedTypes `ast:"optional"`
+
+	// Directives applied to the type
+	Directives Directives `ast:"optional"`
+
+	// Fields that are added to the type
+	Fields FieldDefinitions `ast:"optional"`
+}
+
+// TokenRange implements Node.
+func (extension *InterfaceTypeExtension) TokenRange() token.Range {
+	var lastToken *token.Token
+	if len(extension.Fields) > 0 {
+		lastToken = extension.Fields.LastToken()
+	} else if len(extension.Directives) > 0 {
+		lastToken = extension.Directives.LastToken()
+	} else {
+		lastToken = extension.Interfaces.LastToken()
+	}
+
+	return token.Range{
+		First: extension.Name.Token.Prev.Prev, // "extend" keyword
+		Last:  lastToken,
+	}
+}
+
+// GetDirectives implements Definition.
+func (extension *InterfaceTypeExtension) GetDirectives() Directives {
+	return extension.Directives
+}
+
+// GetName implements TypeExtension.
+func (extension *InterfaceTypeExtension) GetName() Name {
+	return extension.Name
+}
+
+// typeSystemExtensionNode implements TypeSystemExtension.
+func (*InterfaceTypeExtension) typeSystemExtensionNode() {}
+
+// typeExtensionNode implements TypeExtension.
+func (*InterfaceTypeExtension) typeExtensionNode() {}
+
+// UnionTypeExtension extends an Union type defined in the original schema.
+//
+// Reference: https://graphql.github.io/graphql-spec/June2018/#UnionTypeExtension
+type UnionTypeExtension struct {
+	// Name of the extending type
+	Name Name
+
+	// Directives applied to the type
+	Directives Directives `ast:"optional"`
+
+	// Types specifies the additional member types of the union.
+	Types NamedTypes `ast:"optional"`
+}
+
+// TokenRange implements Node.
+func (extension *UnionTypeExtension) TokenRange() token.Range {
+	var lastToken *token.Token
+	if len(extension.Types) > 0 {
+		lastToken = extension.Types.LastToken()
+	} else {
+		lastToken = extension.Directives.LastToken()
+	}
+
+	return token.Range{
+		First: extension.Name.Token.Prev.Prev, // "extend" keyword
+		Last:  lastToken,
+	}
+}
+
+// GetDirectives implements Definition.
+func (extension *UnionTypeExtension) GetDirectives() Directives {
+	return extension.Directives
+}
+
+// GetName implements TypeExtension.
+func (extension *UnionTypeExtension) GetName() Name {
+	return extension.Name
+}
+
+// typeSystemExtensionNode implements TypeSystemExtension.
+func (*UnionTypeExtension) typeSystemExtensionNode() {}
+
+// typeExtensionNode implements TypeExtension.
+func (*UnionTypeExtension) typeExtensionNode() {}
+
+// EnumTypeExtension extends an Enum type defined in the original schema.
+//
+// Reference: https://graphql.github.io/graphql-spec/June2018/#EnumTypeExtension
+type EnumTypeExtension struct {
+	// Name of the extending type
+	Name Name
+
+	// Directives applied to the type
+	Directives Directives `ast:"optional"`
+
+	// Values that are added to the type
+	Values EnumValueDefinitions `ast:"optional"`
+}
+
+// TokenRange implements Node.
+func (extension *EnumTypeExtension) TokenRange() token.Range {
+	var lastToken *token.Token
+	if len(extension.Values) > 0 {
+		lastToken = extension.Values.LastToken()
+	} else {
+		lastToken = extension.Directives.LastToken()
+	}
+
+	return token.Range{
+		First: extension.Name.Token.Prev.Prev, // "extend" keyword
+		Last:  lastToken,
+	}
+}
+
+// GetDirectives implements Definition.
+func (extension *EnumTypeExtension) GetDirectives() Directives {
+	return extension.Directives
+}
+
+// GetName implements TypeExtension.
+func (extension *EnumTypeExtension) GetName() Name {
+	return extension.Name
+}
+
+// typeSystemExtensionNode implements TypeSystemExtension.
+func (*EnumTypeExtension) typeSystemExtensionNode() {}
+
+// typeExtensionNode implements TypeExtension.
+func (*EnumTypeExtension) typeExtensionNode() {}
+
+// InputObjectTypeExtension extends an Input Object type defined in the original schema.
+//
+// Reference: https://graphql.github.io/graphql-spec/June2018/#InputObjectTypeExtension
+type InputObjectTypeExtension struct {
+	// Name of the extending type
+	Name Name
+
+	// Directives applied to the type
+	Directives Directives `ast:"optional"`
+
+	// Fields that are added to the type
+	Fields InputValueDefinitions `ast:"optional"`
+}
+
+// TokenRange implements Node.
+func (extension *InputObjectTypeExtension) TokenRange() token.Range {
+	var lastToken *token.Token
+	if len(extension.Fields) > 0 {
+		lastToken = extension.Fields.LastToken()
+	} else {
+		lastToken = extension.Directives.LastToken()
+	}
+
+	return token.Range{
+		First: extension.Name.Token.Prev.Prev, // "extend" keyword
+		Last:  lastToken,
+	}
+}
+
+// GetDirectives implements Definition.
+func (extension *InputObjectTypeExtension) GetDirectives() Directives {
+	return extension.Directives
+}
+
+// GetName implements TypeExtension.
+func (extension *InputObjectTypeExtension) GetName() Name {
+	return extension.Name
+}
+
+// typeSystemExtensionNode implements TypeSystemExtension.
+func (*InputObjectTypeExtension) typeSystemExtensionNode() {}
+
+// typeExtensionNode implements TypeExtension.
+func (*InputObjectTypeExtension) typeExtensionNode() {}
+
+//===----------------------------------------------------------------------------------------====//
+// 3.2 Schema
+//===----------------------------------------------------------------------------------------====//
+// A GraphQL service’s collective type system capabilities are referred to as that service’s
+// “schema”. A schema is defined in terms of the types and directives it supports as well as the
+// root operation types for each kind of operation: query, mutation, and subscription.
+//
+// Reference: https://graphql.github.io/graphql-spec/June2018/#sec-Schema
+
+// OperationTypeDefinitions specifies a list of OperationTypeDefinition's in a SchemaDefinition.
+type OperationTypeDefinitions []*OperationTypeDefinition
+
+var _ Node = OperationTypeDefinitions{}
+
+// FirstToken returns the first token in the sequence of operation type definitions.
+func (nodes OperationTypeDefinitions) FirstToken() *token.Token {
+	if len(nodes) == 0 {
+		return nil
+	}
+	// Find left brace "{" token in prior to the first OperationTypeDefinition.
+	return nodes[0].TokenRange().First.Prev
+}
+
+// LastToken returns the last token in the sequence of operation type definitions.
+func (nodes OperationTypeDefinitions) LastToken() *token.Token {
+	if len(nodes) == 0 {
+		return nil
+	}
+	// Find right brace "}" token after the last OperationTypeDefinition.
+	return nodes[len(nodes)-1].TokenRange().Last.Next
+}
+
+// TokenRange implements Node.
+func (nodes OperationTypeDefinitions) TokenRange() token.Range {
+	return token.Range{
+		First: nodes.FirstToken(),
+		Last:  nodes.LastToken(),
+	}
+}
+
+// SchemaDefinition defines the root operation types in a schema.
+//
+// Reference: https://graphql.github.io/graphql-spec/June2018/#SchemaDefinition
+type SchemaDefinition struct {
+	// Description of the schema
+	Description StringValue `ast:"optional"`
+
+	// Directives applied to the schema
+	Directives Directives `ast:"optional"`
+
+	// OperationTypes specifies the root operation types.
+	OperationTypes OperationTypeDefinitions
+}
+
+// TokenRange implements Node.
+func (definition *SchemaDefinition) TokenRange() token.Range {
+	var firstToken *token.Token
+	if len(definition.Directives) > 0 {
+		firstToken = definition.Directives.FirstToken()
+	} else {
+		firstToken = definition.OperationTypes.FirstToken()
+	}
+
+	return token.Range{
+		First: firstTokenWithDescription(definition.Description, firstToken.Prev), // "schema" keyword
+		Last:  definition.OperationTypes.LastToken(),
+	}
+}
+
+// GetDirectives implements Definition.
+func (definition *SchemaDefinition) GetDirectives() Directives {
+	return definition.Directives
+}
+
+// typeSystemDefinitionNode implements TypeSystemDefinition.
+func (*SchemaDefinition) typeSystemDefinitionNode() {}
+
+// OperationTypeDefinition specifies the root type for an operation type.
+//
+// Reference: https://graphql.github.io/graphql-spec/June2018/#RootOperationTypeDefinition
+type OperationTypeDefinition struct {
+	// Operation is a Name token that contains operation type.
+	Operation *token.Token
+
+	// Type is the Object type for the operation.
+	Type NamedType
+}
+
+var _ Node = (*OperationTypeDefinition)(nil)
+
+// TokenRange implements Node.
+func (definition *OperationTypeDefinition) TokenRange() token.Range {
+	return token.Range{
+		First: definition.Operation,
+		Last:  definition.Type.Name.Token,
+	}
+}
+
+// OperationType returns the type of operation.
+func (definition *OperationTypeDefinition) OperationType() OperationType {
+	return OperationType(definition.Operation.Value)
+}
+
+//===----------------------------------------------------------------------------------------====//
+// 3.3 Descriptions
+//===----------------------------------------------------------------------------------------====//
+// Documentation is a first-class feature of GraphQL type systems. GraphQL descriptions are provided
+// as StringValue's that precedes the definitions.
+//
+// Reference: https://graphql.github.io/graphql-spec/June2018/#sec-Descriptions
+
+// firstTokenWithDescription returns the description token if it presents. Otherwise, return the
+// given token.
+func firstTokenWithDescription(description StringValue, t *token.Token) *token.Token {
+	if !description.IsNil() {
+		return description.Token
+	}
+	return t
+}
+
+//===----------------------------------------------------------------------------------------====//
+// 3.4 Types
+//===----------------------------------------------------------------------------------------====//
+// The fundamental unit of any GraphQL Schema is the type.
+//
+// Reference: https://graphql.github.io/graphql-spec/June2018/#sec-Types
+
+// TypeDefinition represents definition for a named type.
+//
+//	TypeDefinition ::
+//		ScalarTypeDefinition
+//		ObjectTypeDefinition
+//		InterfaceTypeDefinition
+//		UnionTypeDefinition
+//		EnumTypeDefinition
+//		InputObjectTypeDefinition
+//
+// Reference: https://graphql.github.io/graphql-spec/June2018/#TypeDefinition
+type TypeDefinition interface {
+	TypeSystemDefinition
+
+	// GetDescription returns the description of the defining type. (Prepend "Get" to avoid name
+	// collision with the fields in derived class.)
+	GetDescription() StringValue
+
+	// GetName returns the name of the defining type. (Prepend "Get" to avoid name collision with the
+	// fields in derived class.)
+	GetName() Name
+
+	// typeDefinitionNode is a special mark to indicate a TypeDefinition node. It makes sure that only
+	// type definition node can be assigned to TypeDefinition.
+	typeDefinitionNode()
+}
+
+// NamedTypes specifies a list of NamedType's. It is used for specifying the interfaces implemented
+// by an Object type and the member types of an Union type.
+type NamedTypes []NamedType
+
+var _ Node = NamedTypes{}
+
+// FirstToken returns the first token in the sequence of named types.
+func (nodes NamedTypes) FirstToken() *token.Token {
+	if len(nodes) == 0 {
+		return nil
+	}
+	return nodes[0].Name.Token
+}
+
+// LastToken returns the last token in the sequence of named types.
+func (nodes NamedTypes) LastToken() *token.Token {
+	if len(nodes) == 0 {
+		return nil
+	}
+	return nodes[len(nodes)-1].Name.Token
+}
+
+// TokenRange implements Node.
+func (nodes NamedTypes) TokenRange() token.Range {
+	return token.Range{
+		First: nodes.FirstToken(),
+		Last:  nodes.LastToken(),
+	}
+}
+
+//===----------------------------------------------------------------------------------------====//
+// 3.5 Scalars
+//===----------------------------------------------------------------------------------------====//
+
+// ScalarTypeDefinition defines a Scalar type.
+//
+// Reference: https://graphql.github.io/graphql-spec/June2018/#ScalarTypeDefinition
+type ScalarTypeDefinition struct {
+	// Description of the defining type
+	Description StringValue `ast:"optional"`
+
+	// Name of the defining type
+	Name Name
+
+	// Directives applied to the type
+	Directives Directives `ast:"optional"`
+}
+
+// TokenRange implements Node.
+func (definition *ScalarTypeDefinition) TokenRange() token.Range {
+	var lastToken *token.Token
+	if len(definition.Directives) > 0 {
+		lastToken = definition.Directives.LastToken()
+	} else {
+		lastToken = definition.Name.Token
+	}
+
+	return token.Range{
+		First: firstTokenWithDescription(definition.Description, definition.Name.Token.Prev),
+		Last:  lastToken,
+	}
+}
+
+// GetDirectives implements Definition.
+func (definition *ScalarTypeDefinition) GetDirectives() Directives {
+	return definition.Directives
+}
+
+// GetDescription implements TypeDefinition.
+func (definition *ScalarTypeDefinition) GetDescription() StringValue {
+	return definition.Description
+}
+
+// GetName implements TypeDefinition.
+func (definition *ScalarTypeDefinition) GetName() Name {
+	return definition.Name
+}
+
+// typeSystemDefinitionNode implements TypeSystemDefinition.
+func (*ScalarTypeDefinition) typeSystemDefinitionNode() {}
+
+// typeDefinitionNode implements TypeDefinition.
+func (*ScalarTypeDefinition) typeDefinitionNode() {}
+
+//===----------------------------------------------------------------------------------------====//
+// 3.6 Objects
+//===----------------------------------------------------------------------------------------====//
+
+// ObjectTypeDefinition defines an Object type.
+//
+// Reference: https://graphql.github.io/graphql-spec/June2018/#ObjectTypeDefinition
+type ObjectTypeDefinition struct {
+	// Description of the defining type
+	Description StringValue `ast:"optional"`
+
+	// Name of the defining type
+	Name Name
+
+	// Interfaces implemented by the defining type
+	Interfaces NamedTypes `ast:"optional"`
+
+	// Directives applied to the type
+	Directives Directives `ast:"optional"`
+
+	// Fields in the defining type
+	Fields FieldDefinitions `ast:"optional"`
+}
+
+// TokenRange implements Node.
+func (definition *ObjectTypeDefinition) TokenRange() token.Range {
+	var lastToken *token.Token
+	if len(definition.Fields) > 0 {
+		lastToken = definition.Fields.LastToken()
+	} else if len(definition.Directives) > 0 {
+		lastToken = definition.Directives.LastToken()
+	} else if len(definition.Interfaces) > 0 {
+		lastToken = definition.Interfaces.LastToken()
+	} else {
+		lastToken = definition.Name.Token
+	}
+
+	return token.Range{
+		First: firstTokenWithDescription(definition.Description, definition.Name.Token.Prev),
+		Last:  lastToken,
+	}
+}
+
+// GetDirectives implements Definition.
+func (definition *ObjectTypeDefinition) GetDirectives() Directives {
+	return definition.Directives
+}
+
+// GetDescription implements TypeDefinition.
+func (definition *ObjectTypeDefinition) GetDescription() StringValue {
+	return definition.Description
+}
+
+// GetName implements TypeDefinition.
+func (definition *ObjectTypeDefinition) GetName() Name {
+	return definition.Name
+}
+
+// typeSystemDefinitionNode implements TypeSystemDefinition.
+func (*ObjectTypeDefinition) typeSystemDefinitionNode() {}
+
+// typeDefinitionNode implements TypeDefinition.
+func (*ObjectTypeDefinition) typeDefinitionNode() {}
+
+// FieldDefinitions specifies a list of FieldDefinition's.
+//
+// Reference: https://graphql.github.io/graphql-spec/June2018/#FieldsDefinition
+type FieldDefinitions []*FieldDefinition
+
+var _ Node = FieldDefinitions{}
+
+// FirstToken returns the first token in the sequence of field definitions.
+func (nodes FieldDefinitions) FirstToken() *token.Token {
+	if len(nodes) == 0 {
+		return nil
+	}
+	// Find left brace "{" token in prior to the first FieldDefinition.
+	return nodes[0].TokenRange().First.Prev
+}
+
+// LastToken returns the last token in the sequence of field definitions.
+func (nodes FieldDefinitions) LastToken() *token.Token {
+	if len(nodes) == 0 {
+		return nil
+	}
+	// Find right brace "}" token after the last FieldDefinition.
+	return nodes[len(nodes)-1].TokenRange().Last.Next
+}
+
+// TokenRange implements Node.
+func (nodes FieldDefinitions) TokenRange() token.Range {
+	return token.Range{
+		First: nodes.FirstToken(),
+		Last:  nodes.LastToken(),
+	}
+}
+
+// FieldDefinition defines a field in an Object or an Interface type.
+//
+// Reference: https://graphql.github.io/graphql-spec/June2018/#FieldDefinition
+type FieldDefinition struct {
+	// Description of the defining field
+	Description StringValue `ast:"optional"`
+
+	// Name of the defining field
+	Name Name
+
+	// Arguments taken by the defining field
+	Arguments InputValueDefinitions `ast:"optional"`
+
+	// Type of the field value
+	Type Type
+
+	// Directives applied to the field
+	Directives Directives `ast:"optional"`
+}
+
+var _ Node = (*FieldDefinition)(nil)
+
+// TokenRange implements Node.
+func (definition *FieldDefinition) TokenRange() token.Range {
+	var lastToken *token.Token
+	if len(definition.Directives) > 0 {
+		lastToken = definition.Directives.LastToken()
+	} else {
+		lastToken = definition.Type.TokenRange().Last
+	}
+
+	return token.Range{
+		First: firstTokenWithDescription(definition.Description, definition.Name.Token),
+		Last:  lastToken,
+	}
+}
+
+// InputValueDefinitions specifies a list of InputValueDefinition's.
+//
+// Reference: https://graphql.github.io/graphql-spec/June2018/#ArgumentsDefinition
+type InputValueDefinitions []*InputValueDefinition
+
+var _ Node = InputValueDefinitions{}
+
+// FirstToken returns the first token in the sequence of input value definitions.
+func (nodes InputValueDefinitions) FirstToken() *token.Token {
+	if len(nodes) == 0 {
+		return nil
+	}
+	// Find left paren "(" (for arguments) or left brace "{" (for input fields) token in prior to the
+	// first InputValueDefinition.
+	return nodes[0].TokenRange().First.Prev
+}
+
+// LastToken returns the last token in the sequence of input value definitions.
+func (nodes InputValueDefinitions) LastToken() *token.Token {
+	if len(nodes) == 0 {
+		return nil
+	}
+	// Find right paren ")" (for arguments) or right brace "}" (for input fields) token after the last
+	// InputValueDefinition.
+	return nodes[len(nodes)-1].TokenRange().Last.Next
+}
+
+// TokenRange implements Node.
+func (nodes InputValueDefinitions) TokenRange() token.Range {
+	return token.Range{
+		First: nodes.FirstToken(),
+		Last:  nodes.LastToken(),
+	}
+}
+
+// InputValueDefinition defines an argument for a field or a directive, or a field in an Input
+// Object type.
+//
+// Reference: https://graphql.github.io/graphql-spec/June2018/#InputValueDefinition
+type InputValueDefinition struct {
+	// Description of the defining input value
+	Description StringValue `ast:"optional"`
+
+	// Name of the defining input value
+	Name Name
+
+	// Type of the input value
+	Type Type
+
+	// DefaultValue describes the value to be used when no input value is supplied.
+	DefaultValue Value `ast:"optional"`
+
+	// Directives applied to the input value
+	Directives Directives `ast:"optional"`
+}
+
+var _ Node = (*InputValueDefinition)(nil)
+
+// TokenRange implements Node.
+func (definition *InputValueDefinition) TokenRange() token.Range {
+	var lastToken *token.Token
+	if len(definition.Directives) > 0 {
+		lastToken = definition.Directives.LastToken()
+	} else if definition.DefaultValue != nil {
+		lastToken = definition.DefaultValue.TokenRange().Last
+	} else {
+		lastToken = definition.Type.TokenRange().Last
+	}
+
+	return token.Range{
+		First: firstTokenWithDescription(definition.Description, definition.Name.Token),
+		Last:  lastToken,
+	}
+}
+
+//===----------------------------------------------------------------------------------------====//
+// 3.7 Interfaces
+//===----------------------------------------------------------------------------------------====//
+
+// InterfaceTypeDefinition defines an Interface type.
+//
+// Reference: https://graphql.github.io/graphql-spec/June2018/#InterfaceTypeDefinition
+type InterfaceTypeDefinition struct {
+	// Description of the defining type
+	Description StringValue `ast:"optional"`
+
+	// Name of the defining type
+	Name Name
+
+	// Interfaces implemented by the defining type
+	Interfaces NamedTypes `ast:"optional"`
+
+	// Directives applied to the type
+	Directives Directives `ast:"optional"`
+
+	// Fields in the defining type
+	Fields FieldDefinitions `ast:"optional"`
+}
+
+// TokenRange implements Node.
+func (definition *InterfaceTypeDefinition) TokenRange() token.Range {
+	var lastToken *token.Token
+	if len(definition.Fields) > 0 {
+		lastToken = definition.Fields.LastToken()
+	} else if len(definition.Directives) > 0 {
+		lastToken = definition.Directives.LastToken()
+	} else if len(definition.Interfaces) > 0 {
+		lastToken = definition.Interfaces.LastToken()
+	} else {
+		lastToken = definition.Name.Token
+	}
+
+	return token.Range{
+		First: firstTokenWithDescription(definition.Description, definition.Name.Token.Prev),
+		Last:  lastToken,
+	}
+}
+
+// GetDirectives implements Definition.
+func (definition *InterfaceTypeDefinition) GetDirectives() Directives {
+	return definition.Directives
+}
+
+// GetDescription implements TypeDefinition.
+func (definition *InterfaceTypeDefinition) GetDescription() StringValue {
+	return definition.Description
+}
+
+// GetName implements TypeDefinition.
+func (definition *InterfaceTypeDefinition) GetName() Name {
+	return definition.Name
+}
+
+// typeSystemDefinitionNode implements TypeSystemDefinition.
+func (*InterfaceTypeDefinition) typeSystemDefinitionNode() {}
+
+// typeDefinitionNode implements TypeDefinition.
+func (*InterfaceTypeDefinition) typeDefinitionNode() {}
+
+//===----------------------------------------------------------------------------------------====//
+// 3.8 Unions
+//===----------------------------------------------------------------------------------------====//
+
+// UnionTypeDefinition defines an Union type.
+//
+// Reference: https://graphql.github.io/graphql-spec/June2018/#UnionTypeDefinition
+type UnionTypeDefinition struct {
+	// Description of the defining type
+	Description StringValue `ast:"optional"`
+
+	// Name of the defining type
+	Name Name
+
+	// Directives applied to the type
+	Directives Directives `ast:"optional"`
+
+	// Types specifies the member types of the union.
+	Types NamedTypes `ast:"optional"`
+}
+
+// TokenRange implements Node.
+func (definition *UnionTypeDefinition) TokenRange() token.Range {
+	var lastToken *token.Token
+	if len(definition.Types) > 0 {
+		lastToken = definition.Types.LastToken()
+	} else if len(definition.Directives) > 0 {
+		lastToken = definition.Directives.LastToken()
+	} else {
+		lastToken = definition.Name.Token
+	}
+
+	return token.Range{
+		First: firstTokenWithDescription(definition.Description, definition.Name.Token.Prev),
+		Last:  lastToken,
+	}
+}
+
+// GetDirectives implements Definition.
+func (definition *UnionTypeDefinition) GetDirectives() Directives {
+	return definition.Directives
+}
+
+// GetDescription implements TypeDefinition.
+func (definition *UnionTypeDefinition) GetDescription() StringValue {
+	return definition.Description
+}
+
+// GetName implements TypeDefinition.
+func (definition *UnionTypeDefinition) GetName() Name {
+	return definition.Name
+}
+
+// typeSystemDefinitionNode implements TypeSystemDefinition.
+func (*UnionTypeDefinition) typeSystemDefinitionNode() {}
+
+// typeDefinitionNode implements TypeDefinition.
+func (*UnionTypeDefinition) typeDefinitionNode() {}
+
+//===----------------------------------------------------------------------------------------====//
+// 3.9 Enums
+//===----------------------------------------------------------------------------------------====//
+
+// EnumTypeDefinition defines an Enum type.
+//
+// Reference: https://graphql.github.io/graphql-spec/June2018/#EnumTypeDefinition
+type EnumTypeDefinition struct {
+	// Description of the defining type
+	Description StringValue `ast:"optional"`
+
+	// Name of the defining type
+	Name Name
+
+	// Directives applied to the type
+	Directives Directives `ast:"optional"`
+
+	// Values defined in the Enum type
+	Values EnumValueDefinitions `ast:"optional"`
+}
+
+// TokenRange implements Node.
+func (definition *EnumTypeDefinition) TokenRange() token.Range {
+	var lastToken *token.Token
+	if len(definition.Values) > 0 {
+		lastToken = definition.Values.LastToken()
+	} else if len(definition.Directives) > 0 {
+		lastToken = definition.Directives.LastToken()
+	} else {
+		lastToken = definition.Name.Token
+	}
+
+	return token.Range{
+		First: firstTokenWithDescription(definition.Description, definition.Name.Token.Prev),
+		Last:  lastToken,
+	}
+}
+
+// GetDirectives implements Definition.
+func (definition *EnumTypeDefinition) GetDirectives() Directives {
+	return definition.Directives
+}
+
+// GetDescription implements TypeDefinition.
+func (definition *EnumTypeDefinition) GetDescription() StringValue {
+	return definition.Description
+}
+
+// GetName implements TypeDefinition.
+func (definition *EnumTypeDefinition) GetName() Name {
+	return definition.Name
+}
+
+// typeSystemDefinitionNode implements TypeSystemDefinition.
+func (*EnumTypeDefinition) typeSystemDefinitionNode() {}
+
+// typeDefinitionNode implements TypeDefinition.
+func (*EnumTypeDefinition) typeDefinitionNode() {}
+
+// EnumValueDefinitions specifies a list of EnumValueDefinition's.
+//
+// Reference: https://graphql.github.io/graphql-spec/June2018/#EnumValuesDefinition
+type EnumValueDefinitions []*EnumValueDefinition
+
+var _ Node = EnumValueDefinitions{}
+
+// FirstToken returns the first token in the sequence of enum value definitions.
+func (nodes EnumValueDefinitions) FirstToken() *token.Token {
+	if len(nodes) == 0 {
+		return nil
+	}
+	// Find left brace "{" token in prior to the first EnumValueDefinition.
+	return nodes[0].TokenRange().First.Prev
+}
+
+// LastToken returns the last token in the sequence of enum value definitions.
+func (nodes EnumValueDefinitions) LastToken() *token.Token {
+	if len(nodes) == 0 {
+		return nil
+	}
+	// Find right brace "}" token after the last EnumValueDefinition.
+	return nodes[len(nodes)-1].TokenRange().Last.Next
+}
+
+// TokenRange implements Node.
+func (nodes EnumValueDefinitions) TokenRange() token.Range {
+	return token.Range{
+		First: nodes.FirstToken(),
+		Last:  nodes.LastToken(),
+	}
+}
+
+// EnumValueDefinition defines a value in an Enum type.
+//
+// Reference: https://graphql.github.io/graphql-spec/June2018/#EnumValueDefinition
+type EnumValueDefinition struct {
+	// Description of the defining value
+	Description StringValue `ast:"optional"`
+
+	// Name of the defining value
+	Name Name
+
+	// Directives applied to the value
+	Directives Directives `ast:"optional"`
+}
+
+var _ Node = (*EnumValueDefinition)(nil)
+
+// TokenRange implements Node.
+func (definition *EnumValueDefinition) TokenRange() token.Range {
+	var lastToken *token.Token
+	if len(definition.Directives) > 0 {
+		lastToken = definition.Directives.LastToken()
+	} else {
+		lastToken = definition.Name.Token
+	}
+
+	return token.Range{
+		First: firstTokenWithDescription(definition.Description, definition.Name.Token),
+		Last:  lastToken,
+	}
+}
+
+//===----------------------------------------------------------------------------------------====//
+// 3.10 Input Objects
+//===----------------------------------------------------------------------------------------====//
+
+// InputObjectTypeDefinition defines an Input Object type.
+//
+// Reference: https://graphql.github.io/graphql-spec/June2018/#InputObjectTypeDefinition
+type InputObjectTypeDefinition struct {
+	// Description of the defining type
+	Description StringValue `ast:"optional"`
+
+	// Name of the defining type
+	Name Name
+
+	// Directives applied to the type
+	Directives Directives `ast:"optional"`
+
+	// Fields in the defining type
+	Fields InputValueDefinitions `ast:"optional"`
+}
+
+// TokenRange implements Node.
+func (definition *InputObjectTypeDefinition) TokenRange() token.Range {
+	var lastToken *token.Token
+	if len(definition.Fields) > 0 {
+		lastToken = definition.Fields.LastToken()
+	} else if len(definition.Directives) > 0 {
+		lastToken = definition.Directives.LastToken()
+	} else {
+		lastToken = definition.Name.Token
+	}
+
+	return token.Range{
+		First: firstTokenWithDescription(definition.Description, definition.Name.Token.Prev),
+		Last:  lastToken,
+	}
+}
+
+// GetDirectives implements Definition.
+func (definition *InputObjectTypeDefinition) GetDirectives() Directives {
+	return definition.Directives
+}
+
+// GetDescription implements TypeDefinition.
+func (definition *InputObjectTypeDefinition) GetDescription() StringValue {
+	return definition.Description
+}
+
+// GetName implements TypeDefinition.
+func (definition *InputObjectTypeDefinition) GetName() Name {
+	return definition.Name
+}
+
+// typeSystemDefinitionNode implements TypeSystemDefinition.
+func (*InputObjectTypeDefinition) typeSystemDefinitionNode() {}
+
+// typeDefinitionNode implements TypeDefinition.
+func (*InputObjectTypeDefinition) typeDefinitionNode() {}
+
+//===----------------------------------------------------------------------------------------====//
+// 3.13 Directives
+//===----------------------------------------------------------------------------------------====//
+
+// DirectiveLocations specifies a list of locations where a directive can be applied.
+//
+// Reference: https://graphql.github.io/graphql-spec/June2018/#DirectiveLocations
+type DirectiveLocations []Name
+
+var _ Node = DirectiveLocations{}
+
+// FirstToken returns the first token in the sequence of directive locations.
+func (nodes DirectiveLocations) FirstToken() *token.Token {
+	if len(nodes) == 0 {
+		return nil
+	}
+	return nodes[0].Token
+}
+
+// LastToken returns the last token in the sequence of directive locations.
+func (nodes DirectiveLocations) LastToken() *token.Token {
+	if len(nodes) == 0 {
+		return nil
+	}
+	return nodes[len(nodes)-1].Token
+}
+
+// TokenRange implements Node.
+func (nodes DirectiveLocations) TokenRange() token.Range {
+	return token.Range{
+		First: nodes.FirstToken(),
+		Last:  nodes.LastToken(),
+	}
+}
+
+// DirectiveDefinition defines a directive.
+//
+// Reference: https://graphql.github.io/graphql-spec/June2018/#DirectiveDefinition
+type DirectiveDefinition struct {
+	// Description of the defining directive
+	Description StringValue `ast:"optional"`
+
+	// Name of the defining directive
+	Name Name
+
+	// Arguments taken by the directive
+	Arguments InputValueDefinitions `ast:"optional"`
+
+	// Repeatable is the Name token of the "repeatable" keyword or nil if the directive is not
+	// repeatable.
+	Repeatable *token.Token
+
+	// Locations specifies where the directive can be applied.
+	Locations DirectiveLocations
+}
+
+// TokenRange implements Node.
+func (definition *DirectiveDefinition) TokenRange() token.Range {
+	return token.Range{
+		// ".Prev.Prev" to move to the "directive" keyword (skipping "@").
+		First: firstTokenWithDescription(definition.Description, definition.Name.Token.Prev.Prev),
+		Last:  definition.Locations.LastToken(),
+	}
+}
+
+// IsRepeatable returns true if the directive can be applied multiple times at a single location.
+func (definition *DirectiveDefinition) IsRepeatable() bool {
+	return definition.Repeatable != nil
+}
+
+// GetDirectives implements Definition. Directive definition cannot be annotated with directives so
+// it always returns nil.
+func (definition *DirectiveDefinition) GetDirectives() Directives {
+	return nil
+}
+
+// typeSystemDefinitionNode implements TypeSystemDefinition.
+func (*DirectiveDefinition) typeSystemDefinitionNode() {}
@@ -87,10 +87,26 @@ func (p *printer) printNode(node Node) {
 		p.printDirectives(node)
 	case Document:
 		p.printDocument(node)
+	case *EnumValueDefinition:
+		p.printEnumValueDefinition(node)
+	case EnumValueDefinitions:
+		p.printEnumValueDefinitions(node)
+	case *FieldDefinition:
+		p.printFieldDefinition(node)
+	case FieldDefinitions:
+		p.printFieldDefinitions(node)
+	case *InputValueDefinition:
+		p.printInputValueDefinition(node)
+	case InputValueDefinitions:
+		p.printArgumentsDefinition(node)
 	case Name:
 		p.printName(node)
 	case *ObjectField:
 		p.printObjectField(node)
+	case *OperationTypeDefinition:
+		p.printOperationTypeDefinition(node)
+	case OperationTypeDefinitions:
+		p.printOperationTypeDefinitions(node)
 	case SelectionSet:
 		p.printSelectionSet(node)
 	case *VariableDefinition:
@@ -141,6 +157,10 @@ func (p *printer) printDefinition(node Definition) {
 		p.printOperationDefinition(node)
 	case Selection:
 		p.printSelection(node)
+	case TypeSystemDefinition:
+		p.printTypeSystemDefinition(node)
+	case TypeSystemExtension:
+		p.printTypeSystemExtension(node)
 	default:
 		panic(fmt.Sprintf("unexpected node type %T when printing Definition", node))
 	}
@@ -453,8 +473,8 @@ func (p *printer) printBlockString(value string, indentation string) {
 
 	// Replace """ with \""".
 	value = strings.Replace(value, `"""`, `\"""`, -1)
-	if len(indentation) > 0 {
-		value = strings.Replace(value, "\n", "\n"+p.indentation()+indentation, -1)
+	if indentation = p.indentation() + indentation; len(indentation) > 0 {
+		value = strings.Replace(value, "\n", "\n"+indentation, -1)
 	}
 	p.WriteString(value)
 
@@ -521,3 +541,365 @@ func (p *printer) printDirective(directive *Directive) {
 	p.printName(directive.Name)
 	p.printArguments("(", directive.Arguments, ", ", ")")
 }
+
+//===----------------------------------------------------------------------------------------====//
+// Type System Definition
+//===----------------------------------------------------------------------------------------====//
+
+func (p *printer) printTypeSystemDefinition(node TypeSystemDefinition) {
+	switch node := node.(type) {
+	case *SchemaDefinition:
+		p.printSchemaDefinition(node)
+	case *DirectiveDefinition:
+		p.printDirectiveDefinition(node)
+	case TypeDefinition:
+		p.printTypeDefinition(node)
+	default:
+		panic(fmt.Sprintf("unexpected node type %T when printing TypeSystemDefinition", node))
+	}
+}
+
+func (p *printer) printSchemaDefinition(schema *SchemaDefinition) {
+	p.printDescription(schema.Description)
+	p.WriteString("schema")
+
+	if len(schema.Directives) > 0 {
+		p.WriteString(" ")
+		p.printDirectives(schema.Directives)
+	}
+
+	if len(schema.OperationTypes) > 0 {
+		p.WriteString(" ")
+		p.printOperationTypeDefinitions(schema.OperationTypes)
+	}
+}
+
+func (p *printer) printOperationTypeDefinitions(operationTypes OperationTypeDefinitions) {
+	if len(operationTypes) > 0 {
+		p.beginBlock()
+		p.writeIndent()
+		p.printOperationTypeDefinition(operationTypes[0])
+		for _, operationType := range operationTypes[1:] {
+			p.writeNewLineWithIndent()
+			p.printOperationTypeDefinition(operationType)
+		}
+		p.endBlock()
+	}
+}
+
+func (p *printer) printOperationTypeDefinition(operationType *OperationTypeDefinition) {
+	p.WriteString(operationType.Operation.Value)
+	p.WriteString(": ")
+	p.printNamedType(operationType.Type)
+}
+
+// Print description (if any) that precedes a definition. A new line is started after description.
+func (p *printer) printDescription(description StringValue) {
+	if !description.IsNil() {
+		p.printStringValue(description, "")
+		p.writeNewLineWithIndent()
+	}
+}
+
+func (p *printer) printTypeDefinition(node TypeDefinition) {
+	p.printDescription(node.GetDescription())
+
+	switch node := node.(type) {
+	case *ScalarTypeDefinition:
+		p.printScalarTypeDefinition(node)
+	case *ObjectTypeDefinition:
+		p.printObjectTypeDefinition(node)
+	case *InterfaceTypeDefinition:
+		p.printInterfaceTypeDefinition(node)
+	case *UnionTypeDefinition:
+		p.printUnionTypeDefinition(node)
+	case *EnumTypeDefinition:
+		p.printEnumTypeDefinition(node)
+	case *InputObjectTypeDefinition:
+		p.printInputObjectTypeDefinition(node)
+	default:
+		panic(fmt.Sprintf("unexpected node type %T when printing TypeDefinition", node))
+	}
+}
+
+// Print the keyword and the name that begin a type definition followed by directives if there's any.
+func (p *printer) printTypeDefinitionHead(keyword string, name Name, interfaces NamedTypes, directives Directives) {
+	p.WriteString(keyword)
+	p.WriteString(" ")
+	p.printName(name)
+
+	if len(interfaces) > 0 {
+		p.WriteString(" implements ")
+		p.printNamedType(interfaces[0])
+		for _, iface := range interfaces[1:] {
+			p.WriteString(" & ")
+			p.printNamedType(iface)
+		}
+	}
+
+	if len(directives) > 0 {
+		p.WriteString(" ")
+		p.printDirectives(directives)
+	}
+}
+
+func (p *printer) printScalarTypeDefinition(scalar *ScalarTypeDefinition) {
+	p.printTypeDefinitionHead("scalar", scalar.Name, nil, scalar.Directives)
+}
+
+func (p *printer) printObjectTypeDefinition(object *ObjectTypeDefinition) {
+	p.printTypeDefinitionHead("type", object.Name, object.Interfaces, object.Directives)
+	if len(object.Fields) > 0 {
+		p.WriteString(" ")
+		p.printFieldDefinitions(object.Fields)
+	}
+}
+
+func (p *printer) printFieldDefinitions(fields FieldDefinitions) {
+	if len(fields) > 0 {
+		p.beginBlock()
+		p.writeIndent()
+		p.printFieldDefinition(fields[0])
+		for _, field := range fields[1:] {
+			p.writeNewLineWithIndent()
+			p.printFieldDefinition(field)
+		}
+		p.endBlock()
+	}
+}
+
+func (p *printer) printFieldDefinition(field *FieldDefinition) {
+	p.printDescription(field.Description)
+	p.printName(field.Name)
+	p.printArgumentsDefinition(field.Arguments)
+	p.WriteString(": ")
+	p.printType(field.Type)
+
+	if len(field.Directives) > 0 {
+		p.WriteString(" ")
+		p.printDirectives(field.Directives)
+	}
+}
+
+// Print arguments in a single line if none of them spans multiple lines (e.g., it has description).
+// Otherwise, print one argument per line.
+func (p *printer) printArgumentsDefinition(args InputValueDefinitions) {
+	if len(args) == 0 {
+		return
+	}
+
+	isSingleLine := true
+	for _, arg := range args {
+		if strings.ContainsRune(Print(arg), '\n') {
+			isSingleLine = false
+			break
+		}
+	}
+
+	if isSingleLine {
+		p.WriteString("(")
+		p.printInputValueDefinition(args[0])
+		for _, arg := range args[1:] {
+			p.WriteString(", ")
+			p.printInputValueDefinition(arg)
+		}
+		p.WriteString(")")
+	} else {
+		p.WriteString("(")
+		p.indentLevel++
+		for _, arg := range args {
+			p.writeNewLineWithIndent()
+			p.printInputValueDefinition(arg)
+		}
+		p.indentLevel--
+		p.writeNewLineWithIndent()
+		p.WriteString(")")
+	}
+}
+
+func (p *printer) printInputValueDefinition(value *InputValueDefinition) {
+	p.printDescription(value.Description)
+	p.printName(value.Name)
+	p.WriteString(": ")
+	p.printType(value.Type)
+
+	if value.DefaultValue != nil {
+		p.WriteString(" = ")
+		p.printValue(value.DefaultValue)
+	}
+
+	if len(value.Directives) > 0 {
+		p.WriteString(" ")
+		p.printDirectives(value.Directives)
+	}
+}
+
+func (p *printer) printInterfaceTypeDefinition(iface *InterfaceTypeDefinition) {
+	p.printTypeDefinitionHead("interface", iface.Name, iface.Interfaces, iface.Directives)
+	if len(iface.Fields) > 0 {
+		p.WriteString(" ")
+		p.printFieldDefinitions(iface.Fields)
+	}
+}
+
+func (p *printer) printUnionTypeDefinition(union *UnionTypeDefinition) {
+	p.printTypeDefinitionHead("union", union.Name, nil, union.Directives)
+	p.printUnionMemberTypes(union.Types)
+}
+
+func (p *printer) printUnionMemberTypes(types NamedTypes) {
+	if len(types) > 0 {
+		p.WriteString(" = ")
+		p.printNamedType(types[0])
+		for _, t := range types[1:] {
+			p.WriteString(" | ")
+			p.printNamedType(t)
+		}
+	}
+}
+
+func (p *printer) printEnumTypeDefinition(enum *EnumTypeDefinition) {
+	p.printTypeDefinitionHead("enum", enum.Name, nil, enum.Directives)
+	if len(enum.Values) > 0 {
+		p.WriteString(" ")
+		p.printEnumValueDefinitions(enum.Values)
+	}
+}
+
+func (p *printer) printEnumValueDefinitions(values EnumValueDefinitions) {
+	if len(values) > 0 {
+		p.beginBlock()
+		p.writeIndent()
+		p.printEnumValueDefinition(values[0])
+		for _, value := range values[1:] {
+			p.writeNewLineWithIndent()
+			p.printEnumValueDefinition(value)
+		}
+		p.endBlock()
+	}
+}
+
+func (p *printer) printEnumValueDefinition(value *EnumValueDefinition) {
+	p.printDescription(value.Description)
+	p.printName(value.Name)
+
+	if len(value.Directives) > 0 {
+		p.WriteString(" ")
+		p.printDirectives(value.Directives)
+	}
+}
+
+func (p *printer) printInputObjectTypeDefinition(inputObject *InputObjectTypeDefinition) {
+	p.printTypeDefinitionHead("input", inputObject.Name, nil, inputObject.Directives)
+	if len(inputObject.Fields) > 0 {
+		p.WriteString(" ")
+		p.printInputFieldsDefinition(inputObject.Fields)
+	}
+}
+
+func (p *printer) printInputFieldsDefinition(fields InputValueDefinitions) {
+	if len(fields) > 0 {
+		p.beginBlock()
+		p.writeIndent()
+		p.printInputValueDefinition(fields[0])
+		for _, field := range fields[1:] {
+			p.writeNewLineWithIndent()
+			p.printInputValueDefinition(field)
+		}
+		p.endBlock()
+	}
+}
+
+func (p *printer) printDirectiveDefinition(directive *DirectiveDefinition) {
+	p.printDescription(directive.Description)
+	p.WriteString("directive @")
+	p.printName(directive.Name)
+	p.printArgumentsDefinition(directive.Arguments)
+	if directive.IsRepeatable() {
+		p.WriteString(" repeatable")
+	}
+	p.WriteString(" on ")
+
+	locations := directive.Locations
+	if len(locations) > 0 {
+		p.printName(locations[0])
+		for _, location := range locations[1:] {
+			p.WriteString(" | ")
+			p.printName(location)
+		}
+	}
+}
+
+//===----------------------------------------------------------------------------------------====//
+// Type System Extension
+//===----------------------------------------------------------------------------------------====//
+
+func (p *printer) printTypeSystemExtension(node TypeSystemExtension) {
+	p.WriteString("extend ")
+
+	switch node := node.(type) {
+	case *SchemaExtension:
+		p.printSchemaExtension(node)
+	case *ScalarTypeExtension:
+		p.printTypeDefinitionHead("scalar", node.Name, nil, node.Directives)
+	case *ObjectTypeExtension:
+		p.printObjectTypeExtension(node)
+	case *InterfaceTypeExtension:
+		p.printInterfaceTypeExtension(node)
+	case *UnionTypeExtension:
+		p.printTypeDefinitionHead("union", node.Name, nil, node.Directives)
+		p.printUnionMemberTypes(node.Types)
+	case *EnumTypeExtension:
+		p.printEnumTypeExtension(node)
+	case *InputObjectTypeExtension:
+		p.printInputObjectTypeExtension(node)
+	default:
+		panic(fmt.Sprintf("unexpected node type %T when printing TypeSystemExtension", node))
+	}
+}
+
+func (p *printer) printSchemaExtension(schema *SchemaExtension) {
+	p.WriteString("schema")
+
+	if len(schema.Directives) > 0 {
+		p.WriteString(" ")
+		p.printDirectives(schema.Directives)
+	}
+
+	if len(schema.OperationTypes) > 0 {
+		p.WriteString(" ")
+		p.printOperationTypeDefinitions(schema.OperationTypes)
+	}
+}
+
+func (p *printer) printObjectTypeExtension(object *ObjectTypeExtension) {
+	p.printTypeDefinitionHead("type", object.Name, object.Interfaces, object.Directives)
+	if len(object.Fields) > 0 {
+		p.WriteString(" ")
+		p.printFieldDefinitions(object.Fields)
+	}
+}
+
+func (p *printer) printInterfaceTypeExtension(iface *InterfaceTypeExtension) {
+	p.printTypeDefinitionHead("interface", iface.Name, iface.Interfaces, iface.Directives)
+	if len(iface.Fields) > 0 {
+		p.WriteString(" ")
+		p.printFieldDefinitions(iface.Fields)
+	}
+}
+
+func (p *printer) printEnumTypeExtension(enum *EnumTypeExtension) {
+	p.printTypeDefinitionHead("enum", enum.Name, nil, enum.Directives)
+	if len(enum.Values) > 0 {
+		p.WriteString(" ")
+		p.printEnumValueDefinitions(enum.Values)
+	}
+}
+
+func (p *printer) printInputObjectTypeExtension(inputObject *InputObjectTypeExtension) {
+	p.printTypeDefinitionHead("input", inputObject.Name, nil, inputObject.Directives)
+	if len(inputObject.Fields) > 0 {
+		p.WriteString(" ")
+		p.printInputFieldsDefinition(inputObject.Fields)
+	}
+}
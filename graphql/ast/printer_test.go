@@ -38,6 +38,12 @@ func kitchenSinkAST() ast.Node {
 	return parse(string(kitchenSink))
 }
 
+func schemaKitchenSinkAST() ast.Node {
+	kitchenSink, err := ioutil.ReadFile("../parser/schema-kitchen-sink.graphql")
+	Expect(err).ShouldNot(HaveOccurred())
+	return parse(string(kitchenSink))
+}
+
 var _ = Describe("Printer: Query document", func() {
 	// graphql-js/src/language/__tests__/printer-test.js@8c96dc8
 	It("does not alter ast", func() {
@@ -181,3 +187,214 @@ var _ = Describe("Printer: Query document", func() {
 		`)))
 	})
 })
+
+var _ = Describe("Printer: SDL document", func() {
+	// graphql-js/src/language/__tests__/schema-printer-test.js@f529809
+	It("prints minimal ast", func() {
+		astNode := &ast.ScalarTypeDefinition{
+			Name: ast.Name{
+				Token: &token.Token{
+					Kind:  token.KindName,
+					Value: "foo",
+				},
+			},
+		}
+		Expect(ast.Print(astNode)).Should(Equal("scalar foo"))
+	})
+
+	It("does not alter ast", func() {
+		kitchenSink := schemaKitchenSinkAST()
+		_ = ast.Print(kitchenSink)
+		Expect(kitchenSink).Should(Equal(schemaKitchenSinkAST()))
+	})
+
+	It("prints descriptions", func() {
+		printed := ast.Print(parse(`
+"""Schema description"""
+schema {
+  query: Foo
+}
+
+"Object description"
+type Foo {
+  """
+  Multi-line
+  field description
+  """
+  field(
+    "Argument description"
+    arg: String = """
+    Multi-line
+    default value
+    """
+  ): String
+  other(arg: Int): Int
+}
+
+enum Bar {
+  "Enum value description"
+  BAZ
+}`))
+
+		Expect(printed).Should(Equal(util.Dedent(`
+			"""Schema description"""
+			schema {
+			  query: Foo
+			}
+
+			"Object description"
+			type Foo {
+			  """
+			  Multi-line
+			  field description
+			  """
+			  field(
+			    "Argument description"
+			    arg: String = """
+			      Multi-line
+			      default value
+			    """
+			  ): String
+			  other(arg: Int): Int
+			}
+
+			enum Bar {
+			  "Enum value description"
+			  BAZ
+			}
+		`)))
+	})
+
+	It("prints kitchen sink", func() {
+		printed := ast.Print(schemaKitchenSinkAST())
+
+		Expect(printed).Should(Equal(util.Dedent(`
+			schema {
+			  query: QueryType
+			  mutation: MutationType
+			}
+
+			"""
+			This is a description
+			of the ` + "`Foo`" + ` type.
+			"""
+			type Foo implements Bar & Baz {
+			  one: Type
+			  """This is a description of the ` + "`two`" + ` field."""
+			  two(
+			    """This is a description of the ` + "`argument`" + ` argument."""
+			    argument: InputType!
+			  ): Type
+			  three(argument: InputType, other: String): Int
+			  four(argument: String = "string"): String
+			  five(argument: [String] = ["string", "string"]): String
+			  six(argument: InputType = {key: "value"}): Type
+			  seven(argument: Int = null): Type
+			}
+
+			type AnnotatedObject @onObject(arg: "value") {
+			  annotatedField(arg: Type = "default" @onArg): Type @onField
+			}
+
+			type UndefinedType
+
+			extend type Foo {
+			  seven(argument: [String]): Type
+			}
+
+			extend type Foo @onType
+
+			interface Bar {
+			  one: Type
+			  four(argument: String = "string"): String
+			}
+
+			interface AnnotatedInterface @onInterface {
+			  annotatedField(arg: Type @onArg): Type @onField
+			}
+
+			interface UndefinedInterface
+
+			extend interface Bar {
+			  two(argument: InputType!): Type
+			}
+
+			extend interface Bar @onInterface
+
+			interface Baz implements Bar & Two {
+			  one: Type
+			  two(argument: InputType!): Type
+			  four(argument: String = "string"): String
+			}
+
+			extend interface Baz implements Three
+
+			union Feed = Story | Article | Advert
+
+			union AnnotatedUnion @onUnion = A | B
+
+			union AnnotatedUnionTwo @onUnion = A | B
+
+			union UndefinedUnion
+
+			extend union Feed = Photo | Video
+
+			extend union Feed @onUnion
+
+			scalar CustomScalar
+
+			scalar AnnotatedScalar @onScalar
+
+			extend scalar CustomScalar @onScalar
+
+			enum Site {
+			  DESKTOP
+			  MOBILE
+			}
+
+			enum AnnotatedEnum @onEnum {
+			  ANNOTATED_VALUE @onEnumValue
+			  OTHER_VALUE
+			}
+
+			enum UndefinedEnum
+
+			extend enum Site {
+			  VR
+			}
+
+			extend enum Site @onEnum
+
+			input InputType {
+			  key: String!
+			  answer: Int = 42
+			}
+
+			input AnnotatedInput @onInputObject {
+			  annotatedField: Type @onField
+			}
+
+			input UndefinedInput
+
+			extend input InputType {
+			  other: Float = 1.23e4
+			}
+
+			extend input InputType @onInputObject
+
+			directive @skip(if: Boolean!) on FIELD | FRAGMENT_SPREAD | INLINE_FRAGMENT
+
+			directive @include(if: Boolean!) on FIELD | FRAGMENT_SPREAD | INLINE_FRAGMENT
+
+			directive @include2(if: Boolean!) on FIELD | FRAGMENT_SPREAD | INLINE_FRAGMENT
+
+			directive @myRepeatableDir(name: String!) repeatable on OBJECT | INTERFACE
+
+			extend schema @onSchema
+
+			extend schema @onSchema {
+			  subscription: SubscriptionType
+			}
+		`)))
+	})
+})
@@ -19,12 +19,14 @@
 package main
 
 import (
+	"bytes"
 	"fmt"
+	"go/format"
 	"go/importer"
 	"go/types"
 	"io"
+	"io/ioutil"
 	"log"
-	"os"
 	"reflect"
 	"strings"
 	"text/template"
@@ -42,6 +44,10 @@ var abstractASTNodeNames = []string{
 	"Value",
 	"Definition",
 	"Selection",
+	"TypeSystemDefinition",
+	"TypeDefinition",
+	"TypeSystemExtension",
+	"TypeExtension",
 }
 
 // ASTNodeTypeInfo contains information for an AST node.
@@ -112,7 +118,7 @@ func (ctx *ASTNodeTypeInfo) NilCheck(field string) string {
 		return "len(" + field + ") != 0"
 	} else {
 		switch ctx.Name {
-		case "Name":
+		case "Name", "StringValue":
 			return "!" + field + ".IsNil()"
 		case "NamedType":
 			return "!" + field + ".Name.IsNil()"
@@ -262,19 +268,26 @@ func discoverASTNodeTypes() error {
 			// ast.Value which is an abstract that implements ast.Node as example, the following add
 			// ast.Value to ast.Node's children (i.e., possible nodes) and removes the children in
 			// ast.Value from ast.Node.
+			//
+			// An abstract may be included by multiple abstracts transitively (e.g., ast.TypeDefinition
+			// is an ast.TypeSystemDefinition which is an ast.Definition which is an ast.Node). We only
+			// add it to the abstract that still directly contains its possible nodes. This requires
+			// abstractASTNodeNames to list the more general abstract first.
 			for _, abstractASTNode := range abstractASTNodes {
 				if node != abstractASTNode {
 					if types.Implements(typ, abstractASTNode.Type.(*types.Interface)) {
 						// Construct new possible nodes set of abstractASTNode which excludes nodes that
 						// implements node.Type.
-						possibleNodes := make([]*ASTNodeTypeInfo, 0, len(abstractASTNode.Children)-len(node.Children)+1)
+						possibleNodes := make([]*ASTNodeTypeInfo, 0, len(abstractASTNode.Children)+1)
 						for _, possibleNode := range abstractASTNode.Children {
 							if !types.Implements(possibleNode.Type, node.Type.(*types.Interface)) {
 								possibleNodes = append(possibleNodes, possibleNode)
 							}
 						}
-						possibleNodes = append(possibleNodes, node)
-						abstractASTNode.Children = possibleNodes
+						if len(possibleNodes) != len(abstractASTNode.Children) {
+							possibleNodes = append(possibleNodes, node)
+							abstractASTNode.Children = possibleNodes
+						}
 					}
 				}
 			}
@@ -610,20 +623,25 @@ func walk%s(node %s, ctx interface{}, v *Visitor) bool {`, node.Name, node.TypeE
 }
 
 func main() {
-	w, err := os.Create(filename)
-	if err != nil {
+	if err := discoverASTNodeTypes(); err != nil {
 		log.Fatalln(err)
 	}
-	defer w.Close()
 
-	if err := discoverASTNodeTypes(); err != nil {
+	var w bytes.Buffer
+	genHeader(&w)
+	genImports(&w)
+	genVisitActionInterfaces(&w)
+	genVisitor(&w)
+	genNewVisitor(&w)
+	genWalk(&w, true)
+
+	// Run gofmt on the generated code.
+	source, err := format.Source(w.Bytes())
+	if err != nil {
 		log.Fatalln(err)
 	}
 
-	genHeader(w)
-	genImports(w)
-	genVisitActionInterfaces(w)
-	genVisitor(w)
-	genNewVisitor(w)
-	genWalk(w, true)
+	if err := ioutil.WriteFile(filename, source, 0644); err != nil {
+		log.Fatalln(err)
+	}
 }
@@ -104,6 +104,70 @@ func (f SelectionVisitActionFunc) VisitSelection(node ast.Selection, ctx interfa
 	return f(node, ctx)
 }
 
+// TypeSystemDefinitionVisitAction implements visiting function for TypeSystemDefinition.
+type TypeSystemDefinitionVisitAction interface {
+	VisitTypeSystemDefinition(node ast.TypeSystemDefinition, ctx interface{}) Result
+}
+
+// TypeSystemDefinitionVisitActionFunc is an adapter to help define a TypeSystemDefinitionVisitAction from a function
+// which specifies action when traversing a node.
+type TypeSystemDefinitionVisitActionFunc func(node ast.TypeSystemDefinition, ctx interface{}) Result
+
+var _ TypeSystemDefinitionVisitAction = (TypeSystemDefinitionVisitActionFunc)(nil)
+
+// VisitTypeSystemDefinition implements TypeSystemDefinitionVisitAction by calling f(node, ctx).
+func (f TypeSystemDefinitionVisitActionFunc) VisitTypeSystemDefinition(node ast.TypeSystemDefinition, ctx interface{}) Result {
+	return f(node, ctx)
+}
+
+// TypeDefinitionVisitAction implements visiting function for TypeDefinition.
+type TypeDefinitionVisitAction interface {
+	VisitTypeDefinition(node ast.TypeDefinition, ctx interface{}) Result
+}
+
+// TypeDefinitionVisitActionFunc is an adapter to help define a TypeDefinitionVisitAction from a function
+// which specifies action when traversing a node.
+type TypeDefinitionVisitActionFunc func(node ast.TypeDefinition, ctx interface{}) Result
+
+var _ TypeDefinitionVisitAction = (TypeDefinitionVisitActionFunc)(nil)
+
+// VisitTypeDefinition implements TypeDefinitionVisitAction by calling f(node, ctx).
+func (f TypeDefinitionVisitActionFunc) VisitTypeDefinition(node ast.TypeDefinition, ctx interface{}) Result {
+	return f(node, ctx)
+}
+
+// TypeSystemExtensionVisitAction implements visiting function for TypeSystemExtension.
+type TypeSystemExtensionVisitAction interface {
+	VisitTypeSystemExtension(node ast.TypeSystemExtension, ctx interface{}) Result
+}
+
+// TypeSystemExtensionVisitActionFunc is an adapter to help define a TypeSystemExtensionVisitAction from a function
+// which specifies action when traversing a node.
+type TypeSystemExtensionVisitActionFunc func(node ast.TypeSystemExtension, ctx interface{}) Result
+
+var _ TypeSystemExtensionVisitAction = (TypeSystemExtensionVisitActionFunc)(nil)
+
+// VisitTypeSystemExtension implements TypeSystemExtensionVisitAction by calling f(node, ctx).
+func (f TypeSystemExtensionVisitActionFunc) VisitTypeSystemExtension(node ast.TypeSystemExtension, ctx interface{}) Result {
+	return f(node, ctx)
+}
+
+// TypeExtensionVisitAction implements visiting function for TypeExtension.
+type TypeExtensionVisitAction interface {
+	VisitTypeExtension(node ast.TypeExtension, ctx interface{}) Result
+}
+
+// TypeExtensionVisitActionFunc is an adapter to help define a TypeExtensionVisitAction from a function
+// which specifies action when traversing a node.
+type TypeExtensionVisitActionFunc func(node ast.TypeExtension, ctx interface{}) Result
+
+var _ TypeExtensionVisitAction = (TypeExtensionVisitActionFunc)(nil)
+
+// VisitTypeExtension implements TypeExtensionVisitAction by calling f(node, ctx).
+func (f TypeExtensionVisitActionFunc) VisitTypeExtension(node ast.TypeExtension, ctx interface{}) Result {
+	return f(node, ctx)
+}
+
 // ArgumentVisitAction implements visiting function for Argument.
 type ArgumentVisitAction interface {
 	VisitArgument(node *ast.Argument, ctx interface{}) Result
@@ -184,6 +248,38 @@ func (f DirectiveVisitActionFunc) VisitDirective(node *ast.Directive, ctx interf
 	return f(node, ctx)
 }
 
+// DirectiveDefinitionVisitAction implements visiting function for DirectiveDefinition.
+type DirectiveDefinitionVisitAction interface {
+	VisitDirectiveDefinition(node *ast.DirectiveDefinition, ctx interface{}) Result
+}
+
+// DirectiveDefinitionVisitActionFunc is an adapter to help define a DirectiveDefinitionVisitAction from a function
+// which specifies action when traversing a node.
+type DirectiveDefinitionVisitActionFunc func(node *ast.DirectiveDefinition, ctx interface{}) Result
+
+var _ DirectiveDefinitionVisitAction = (DirectiveDefinitionVisitActionFunc)(nil)
+
+// VisitDirectiveDefinition implements DirectiveDefinitionVisitAction by calling f(node, ctx).
+func (f DirectiveDefinitionVisitActionFunc) VisitDirectiveDefinition(node *ast.DirectiveDefinition, ctx interface{}) Result {
+	return f(node, ctx)
+}
+
+// DirectiveLocationsVisitAction implements visiting function for DirectiveLocations.
+type DirectiveLocationsVisitAction interface {
+	VisitDirectiveLocations(node ast.DirectiveLocations, ctx interface{}) Result
+}
+
+// DirectiveLocationsVisitActionFunc is an adapter to help define a DirectiveLocationsVisitAction from a function
+// which specifies action when traversing a node.
+type DirectiveLocationsVisitActionFunc func(node ast.DirectiveLocations, ctx interface{}) Result
+
+var _ DirectiveLocationsVisitAction = (DirectiveLocationsVisitActionFunc)(nil)
+
+// VisitDirectiveLocations implements DirectiveLocationsVisitAction by calling f(node, ctx).
+func (f DirectiveLocationsVisitActionFunc) VisitDirectiveLocations(node ast.DirectiveLocations, ctx interface{}) Result {
+	return f(node, ctx)
+}
+
 // DirectivesVisitAction implements visiting function for Directives.
 type DirectivesVisitAction interface {
 	VisitDirectives(node ast.Directives, ctx interface{}) Result
@@ -216,6 +312,38 @@ func (f DocumentVisitActionFunc) VisitDocument(node ast.Document, ctx interface{
 	return f(node, ctx)
 }
 
+// EnumTypeDefinitionVisitAction implements visiting function for EnumTypeDefinition.
+type EnumTypeDefinitionVisitAction interface {
+	VisitEnumTypeDefinition(node *ast.EnumTypeDefinition, ctx interface{}) Result
+}
+
+// EnumTypeDefinitionVisitActionFunc is an adapter to help define a EnumTypeDefinitionVisitAction from a function
+// which specifies action when traversing a node.
+type EnumTypeDefinitionVisitActionFunc func(node *ast.EnumTypeDefinition, ctx interface{}) Result
+
+var _ EnumTypeDefinitionVisitAction = (EnumTypeDefinitionVisitActionFunc)(nil)
+
+// VisitEnumTypeDefinition implements EnumTypeDefinitionVisitAction by calling f(node, ctx).
+func (f EnumTypeDefinitionVisitActionFunc) VisitEnumTypeDefinition(node *ast.EnumTypeDefinition, ctx interface{}) Result {
+	return f(node, ctx)
+}
+
+// EnumTypeExtensionVisitAction implements visiting function for EnumTypeExtension.
+type EnumTypeExtensionVisitAction interface {
+	VisitEnumTypeExtension(node *ast.EnumTypeExtension, ctx interface{}) Result
+}
+
+// EnumTypeExtensionVisitActionFunc is an adapter to help define a EnumTypeExtensionVisitAction from a function
+// which specifies action when traversing a node.
+type EnumTypeExtensionVisitActionFunc func(node *ast.EnumTypeExtension, ctx interface{}) Result
+
+var _ EnumTypeExtensionVisitAction = (EnumTypeExtensionVisitActionFunc)(nil)
+
+// VisitEnumTypeExtension implements EnumTypeExtensionVisitAction by calling f(node, ctx).
+func (f EnumTypeExtensionVisitActionFunc) VisitEnumTypeExtension(node *ast.EnumTypeExtension, ctx interface{}) Result {
+	return f(node, ctx)
+}
+
 // EnumValueVisitAction implements visiting function for EnumValue.
 type EnumValueVisitAction interface {
 	VisitEnumValue(node ast.EnumValue, ctx interface{}) Result
@@ -232,6 +360,38 @@ func (f EnumValueVisitActionFunc) VisitEnumValue(node ast.EnumValue, ctx interfa
 	return f(node, ctx)
 }
 
+// EnumValueDefinitionVisitAction implements visiting function for EnumValueDefinition.
+type EnumValueDefinitionVisitAction interface {
+	VisitEnumValueDefinition(node *ast.EnumValueDefinition, ctx interface{}) Result
+}
+
+// EnumValueDefinitionVisitActionFunc is an adapter to help define a EnumValueDefinitionVisitAction from a function
+// which specifies action when traversing a node.
+type EnumValueDefinitionVisitActionFunc func(node *ast.EnumValueDefinition, ctx interface{}) Result
+
+var _ EnumValueDefinitionVisitAction = (EnumValueDefinitionVisitActionFunc)(nil)
+
+// VisitEnumValueDefinition implements EnumValueDefinitionVisitAction by calling f(node, ctx).
+func (f EnumValueDefinitionVisitActionFunc) VisitEnumValueDefinition(node *ast.EnumValueDefinition, ctx interface{}) Result {
+	return f(node, ctx)
+}
+
+// EnumValueDefinitionsVisitAction implements visiting function for EnumValueDefinitions.
+type EnumValueDefinitionsVisitAction interface {
+	VisitEnumValueDefinitions(node ast.EnumValueDefinitions, ctx interface{}) Result
+}
+
+// EnumValueDefinitionsVisitActionFunc is an adapter to help define a EnumValueDefinitionsVisitAction from a function
+// which specifies action when traversing a node.
+type EnumValueDefinitionsVisitActionFunc func(node ast.EnumValueDefinitions, ctx interface{}) Result
+
+var _ EnumValueDefinitionsVisitAction = (EnumValueDefinitionsVisitActionFunc)(nil)
+
+// VisitEnumValueDefinitions implements EnumValueDefinitionsVisitAction by calling f(node, ctx).
+func (f EnumValueDefinitionsVisitActionFunc) VisitEnumValueDefinitions(node ast.EnumValueDefinitions, ctx interface{}) Result {
+	return f(node, ctx)
+}
+
 // FieldVisitAction implements visiting function for Field.
 type FieldVisitAction interface {
 	VisitField(node *ast.Field, ctx interface{}) Result
@@ -248,6 +408,38 @@ func (f FieldVisitActionFunc) VisitField(node *ast.Field, ctx interface{}) Resul
 	return f(node, ctx)
 }
 
+// FieldDefinitionVisitAction implements visiting function for FieldDefinition.
+type FieldDefinitionVisitAction interface {
+	VisitFieldDefinition(node *ast.FieldDefinition, ctx interface{}) Result
+}
+
+// FieldDefinitionVisitActionFunc is an adapter to help define a FieldDefinitionVisitAction from a function
+// which specifies action when traversing a node.
+type FieldDefinitionVisitActionFunc func(node *ast.FieldDefinition, ctx interface{}) Result
+
+var _ FieldDefinitionVisitAction = (FieldDefinitionVisitActionFunc)(nil)
+
+// VisitFieldDefinition implements FieldDefinitionVisitAction by calling f(node, ctx).
+func (f FieldDefinitionVisitActionFunc) VisitFieldDefinition(node *ast.FieldDefinition, ctx interface{}) Result {
+	return f(node, ctx)
+}
+
+// FieldDefinitionsVisitAction implements visiting function for FieldDefinitions.
+type FieldDefinitionsVisitAction interface {
+	VisitFieldDefinitions(node ast.FieldDefinitions, ctx interface{}) Result
+}
+
+// FieldDefinitionsVisitActionFunc is an adapter to help define a FieldDefinitionsVisitAction from a function
+// which specifies action when traversing a node.
+type FieldDefinitionsVisitActionFunc func(node ast.FieldDefinitions, ctx interface{}) Result
+
+var _ FieldDefinitionsVisitAction = (FieldDefinitionsVisitActionFunc)(nil)
+
+// VisitFieldDefinitions implements FieldDefinitionsVisitAction by calling f(node, ctx).
+func (f FieldDefinitionsVisitActionFunc) VisitFieldDefinitions(node ast.FieldDefinitions, ctx interface{}) Result {
+	return f(node, ctx)
+}
+
 // FloatValueVisitAction implements visiting function for FloatValue.
 type FloatValueVisitAction interface {
 	VisitFloatValue(node ast.FloatValue, ctx interface{}) Result
@@ -312,6 +504,70 @@ func (f InlineFragmentVisitActionFunc) VisitInlineFragment(node *ast.InlineFragm
 	return f(node, ctx)
 }
 
+// InputObjectTypeDefinitionVisitAction implements visiting function for InputObjectTypeDefinition.
+type InputObjectTypeDefinitionVisitAction interface {
+	VisitInputObjectTypeDefinition(node *ast.InputObjectTypeDefinition, ctx interface{}) Result
+}
+
+// InputObjectTypeDefinitionVisitActionFunc is an adapter to help define a InputObjectTypeDefinitionVisitAction from a function
+// which specifies action when traversing a node.
+type InputObjectTypeDefinitionVisitActionFunc func(node *ast.InputObjectTypeDefinition, ctx interface{}) Result
+
+var _ InputObjectTypeDefinitionVisitAction = (InputObjectTypeDefinitionVisitActionFunc)(nil)
+
+// VisitInputObjectTypeDefinition implements InputObjectTypeDefinitionVisitAction by calling f(node, ctx).
+func (f InputObjectTypeDefinitionVisitActionFunc) VisitInputObjectTypeDefinition(node *ast.InputObjectTypeDefinition, ctx interface{}) Result {
+	return f(node, ctx)
+}
+
+// InputObjectTypeExtensionVisitAction implements visiting function for InputObjectTypeExtension.
+type InputObjectTypeExtensionVisitAction interface {
+	VisitInputObjectTypeExtension(node *ast.InputObjectTypeExtension, ctx interface{}) Result
+}
+
+// InputObjectTypeExtensionVisitActionFunc is an adapter to help define a InputObjectTypeExtensionVisitAction from a function
+// which specifies action when traversing a node.
+type InputObjectTypeExtensionVisitActionFunc func(node *ast.InputObjectTypeExtension, ctx interface{}) Result
+
+var _ InputObjectTypeExtensionVisitAction = (InputObjectTypeExtensionVisitActionFunc)(nil)
+
+// VisitInputObjectTypeExtension implements InputObjectTypeExtensionVisitAction by calling f(node, ctx).
+func (f InputObjectTypeExtensionVisitActionFunc) VisitInputObjectTypeExtension(node *ast.InputObjectTypeExtension, ctx interface{}) Result {
+	return f(node, ctx)
+}
+
+// InputValueDefinitionVisitAction implements visiting function for InputValueDefinition.
+type InputValueDefinitionVisitAction interface {
+	VisitInputValueDefinition(node *ast.InputValueDefinition, ctx interface{}) Result
+}
+
+// InputValueDefinitionVisitActionFunc is an adapter to help define a InputValueDefinitionVisitAction from a function
+// which specifies action when traversing a node.
+type InputValueDefinitionVisitActionFunc func(node *ast.InputValueDefinition, ctx interface{}) Result
+
+var _ InputValueDefinitionVisitAction = (InputValueDefinitionVisitActionFunc)(nil)
+
+// VisitInputValueDefinition implements InputValueDefinitionVisitAction by calling f(node, ctx).
+func (f InputValueDefinitionVisitActionFunc) VisitInputValueDefinition(node *ast.InputValueDefinition, ctx interface{}) Result {
+	return f(node, ctx)
+}
+
+// InputValueDefinitionsVisitAction implements visiting function for InputValueDefinitions.
+type InputValueDefinitionsVisitAction interface {
+	VisitInputValueDefinitions(node ast.InputValueDefinitions, ctx interface{}) Result
+}
+
+// InputValueDefinitionsVisitActionFunc is an adapter to help define a InputValueDefinitionsVisitAction from a function
+// which specifies action when traversing a node.
+type InputValueDefinitionsVisitActionFunc func(node ast.InputValueDefinitions, ctx interface{}) Result
+
+var _ InputValueDefinitionsVisitAction = (InputValueDefinitionsVisitActionFunc)(nil)
+
+// VisitInputValueDefinitions implements InputValueDefinitionsVisitAction by calling f(node, ctx).
+func (f InputValueDefinitionsVisitActionFunc) VisitInputValueDefinitions(node ast.InputValueDefinitions, ctx interface{}) Result {
+	return f(node, ctx)
+}
+
 // IntValueVisitAction implements visiting function for IntValue.
 type IntValueVisitAction interface {
 	VisitIntValue(node ast.IntValue, ctx interface{}) Result
@@ -328,6 +584,38 @@ func (f IntValueVisitActionFunc) VisitIntValue(node ast.IntValue, ctx interface{
 	return f(node, ctx)
 }
 
+// InterfaceTypeDefinitionVisitAction implements visiting function for InterfaceTypeDefinition.
+type InterfaceTypeDefinitionVisitAction interface {
+	VisitInterfaceTypeDefinition(node *ast.InterfaceTypeDefinition, ctx interface{}) Result
+}
+
+// InterfaceTypeDefinitionVisitActionFunc is an adapter to help define a InterfaceTypeDefinitionVisitAction from a function
+// which specifies action when traversing a node.
+type InterfaceTypeDefinitionVisitActionFunc func(node *ast.InterfaceTypeDefinition, ctx interface{}) Result
+
+var _ InterfaceTypeDefinitionVisitAction = (InterfaceTypeDefinitionVisitActionFunc)(nil)
+
+// VisitInterfaceTypeDefinition implements InterfaceTypeDefinitionVisitAction by calling f(node, ctx).
+func (f InterfaceTypeDefinitionVisitActionFunc) VisitInterfaceTypeDefinition(node *ast.InterfaceTypeDefinition, ctx interface{}) Result {
+	return f(node, ctx)
+}
+
+// InterfaceTypeExtensionVisitAction implements visiting function for InterfaceTypeExtension.
+type InterfaceTypeExtensionVisitAction interface {
+	VisitInterfaceTypeExtension(node *ast.InterfaceTypeExtension, ctx interface{}) Result
+}
+
+// InterfaceTypeExtensionVisitActionFunc is an adapter to help define a InterfaceTypeExtensionVisitAction from a function
+// which specifies action when traversing a node.
+type InterfaceTypeExtensionVisitActionFunc func(node *ast.InterfaceTypeExtension, ctx interface{}) Result
+
+var _ InterfaceTypeExtensionVisitAction = (InterfaceTypeExtensionVisitActionFunc)(nil)
+
+// VisitInterfaceTypeExtension implements InterfaceTypeExtensionVisitAction by calling f(node, ctx).
+func (f InterfaceTypeExtensionVisitActionFunc) VisitInterfaceTypeExtension(node *ast.InterfaceTypeExtension, ctx interface{}) Result {
+	return f(node, ctx)
+}
+
 // ListTypeVisitAction implements visiting function for ListType.
 type ListTypeVisitAction interface {
 	VisitListType(node ast.ListType, ctx interface{}) Result
@@ -392,6 +680,22 @@ func (f NamedTypeVisitActionFunc) VisitNamedType(node ast.NamedType, ctx interfa
 	return f(node, ctx)
 }
 
+// NamedTypesVisitAction implements visiting function for NamedTypes.
+type NamedTypesVisitAction interface {
+	VisitNamedTypes(node ast.NamedTypes, ctx interface{}) Result
+}
+
+// NamedTypesVisitActionFunc is an adapter to help define a NamedTypesVisitAction from a function
+// which specifies action when traversing a node.
+type NamedTypesVisitActionFunc func(node ast.NamedTypes, ctx interface{}) Result
+
+var _ NamedTypesVisitAction = (NamedTypesVisitActionFunc)(nil)
+
+// VisitNamedTypes implements NamedTypesVisitAction by calling f(node, ctx).
+func (f NamedTypesVisitActionFunc) VisitNamedTypes(node ast.NamedTypes, ctx interface{}) Result {
+	return f(node, ctx)
+}
+
 // NonNullTypeVisitAction implements visiting function for NonNullType.
 type NonNullTypeVisitAction interface {
 	VisitNonNullType(node ast.NonNullType, ctx interface{}) Result
@@ -440,6 +744,38 @@ func (f ObjectFieldVisitActionFunc) VisitObjectField(node *ast.ObjectField, ctx
 	return f(node, ctx)
 }
 
+// ObjectTypeDefinitionVisitAction implements visiting function for ObjectTypeDefinition.
+type ObjectTypeDefinitionVisitAction interface {
+	VisitObjectTypeDefinition(node *ast.ObjectTypeDefinition, ctx interface{}) Result
+}
+
+// ObjectTypeDefinitionVisitActionFunc is an adapter to help define a ObjectTypeDefinitionVisitAction from a function
+// which specifies action when traversing a node.
+type ObjectTypeDefinitionVisitActionFunc func(node *ast.ObjectTypeDefinition, ctx interface{}) Result
+
+var _ ObjectTypeDefinitionVisitAction = (ObjectTypeDefinitionVisitActionFunc)(nil)
+
+// VisitObjectTypeDefinition implements ObjectTypeDefinitionVisitAction by calling f(node, ctx).
+func (f ObjectTypeDefinitionVisitActionFunc) VisitObjectTypeDefinition(node *ast.ObjectTypeDefinition, ctx interface{}) Result {
+	return f(node, ctx)
+}
+
+// ObjectTypeExtensionVisitAction implements visiting function for ObjectTypeExtension.
+type ObjectTypeExtensionVisitAction interface {
+	VisitObjectTypeExtension(node *ast.ObjectTypeExtension, ctx interface{}) Result
+}
+
+// ObjectTypeExtensionVisitActionFunc is an adapter to help define a ObjectTypeExtensionVisitAction from a function
+// which specifies action when traversing a node.
+type ObjectTypeExtensionVisitActionFunc func(node *ast.ObjectTypeExtension, ctx interface{}) Result
+
+var _ ObjectTypeExtensionVisitAction = (ObjectTypeExtensionVisitActionFunc)(nil)
+
+// VisitObjectTypeExtension implements ObjectTypeExtensionVisitAction by calling f(node, ctx).
+func (f ObjectTypeExtensionVisitActionFunc) VisitObjectTypeExtension(node *ast.ObjectTypeExtension, ctx interface{}) Result {
+	return f(node, ctx)
+}
+
 // ObjectValueVisitAction implements visiting function for ObjectValue.
 type ObjectValueVisitAction interface {
 	VisitObjectValue(node ast.ObjectValue, ctx interface{}) Result
@@ -472,6 +808,102 @@ func (f OperationDefinitionVisitActionFunc) VisitOperationDefinition(node *ast.O
 	return f(node, ctx)
 }
 
+// OperationTypeDefinitionVisitAction implements visiting function for OperationTypeDefinition.
+type OperationTypeDefinitionVisitAction interface {
+	VisitOperationTypeDefinition(node *ast.OperationTypeDefinition, ctx interface{}) Result
+}
+
+// OperationTypeDefinitionVisitActionFunc is an adapter to help define a OperationTypeDefinitionVisitAction from a function
+// which specifies action when traversing a node.
+type OperationTypeDefinitionVisitActionFunc func(node *ast.OperationTypeDefinition, ctx interface{}) Result
+
+var _ OperationTypeDefinitionVisitAction = (OperationTypeDefinitionVisitActionFunc)(nil)
+
+// VisitOperationTypeDefinition implements OperationTypeDefinitionVisitAction by calling f(node, ctx).
+func (f OperationTypeDefinitionVisitActionFunc) VisitOperationTypeDefinition(node *ast.OperationTypeDefinition, ctx interface{}) Result {
+	return f(node, ctx)
+}
+
+// OperationTypeDefinitionsVisitAction implements visiting function for OperationTypeDefinitions.
+type OperationTypeDefinitionsVisitAction interface {
+	VisitOperationTypeDefinitions(node ast.OperationTypeDefinitions, ctx interface{}) Result
+}
+
+// OperationTypeDefinitionsVisitActionFunc is an adapter to help define a OperationTypeDefinitionsVisitAction from a function
+// which specifies action when traversing a node.
+type OperationTypeDefinitionsVisitActionFunc func(node ast.OperationTypeDefinitions, ctx interface{}) Result
+
+var _ OperationTypeDefinitionsVisitAction = (OperationTypeDefinitionsVisitActionFunc)(nil)
+
+// VisitOperationTypeDefinitions implements OperationTypeDefinitionsVisitAction by calling f(node, ctx).
+func (f OperationTypeDefinitionsVisitActionFunc) VisitOperationTypeDefinitions(node ast.OperationTypeDefinitions, ctx interface{}) Result {
+	return f(node, ctx)
+}
+
+// ScalarTypeDefinitionVisitAction implements visiting function for ScalarTypeDefinition.
+type ScalarTypeDefinitionVisitAction interface {
+	VisitScalarTypeDefinition(node *ast.ScalarTypeDefinition, ctx interface{}) Result
+}
+
+// ScalarTypeDefinitionVisitActionFunc is an adapter to help define a ScalarTypeDefinitionVisitAction from a function
+// which specifies action when traversing a node.
+type ScalarTypeDefinitionVisitActionFunc func(node *ast.ScalarTypeDefinition, ctx interface{}) Result
+
+var _ ScalarTypeDefinitionVisitAction = (ScalarTypeDefinitionVisitActionFunc)(nil)
+
+// VisitScalarTypeDefinition implements ScalarTypeDefinitionVisitAction by calling f(node, ctx).
+func (f ScalarTypeDefinitionVisitActionFunc) VisitScalarTypeDefinition(node *ast.ScalarTypeDefinition, ctx interface{}) Result {
+	return f(node, ctx)
+}
+
+// ScalarTypeExtensionVisitAction implements visiting function for ScalarTypeExtension.
+type ScalarTypeExtensionVisitAction interface {
+	VisitScalarTypeExtension(node *ast.ScalarTypeExtension, ctx interface{}) Result
+}
+
+// ScalarTypeExtensionVisitActionFunc is an adapter to help define a ScalarTypeExtensionVisitAction from a function
+// which specifies action when traversing a node.
+type ScalarTypeExtensionVisitActionFunc func(node *ast.ScalarTypeExtension, ctx interface{}) Result
+
+var _ ScalarTypeExtensionVisitAction = (ScalarTypeExtensionVisitActionFunc)(nil)
+
+// VisitScalarTypeExtension implements ScalarTypeExtensionVisitAction by calling f(node, ctx).
+func (f ScalarTypeExtensionVisitActionFunc) VisitScalarTypeExtension(node *ast.ScalarTypeExtension, ctx interface{}) Result {
+	return f(node, ctx)
+}
+
+// SchemaDefinitionVisitAction implements visiting function for SchemaDefinition.
+type SchemaDefinitionVisitAction interface {
+	VisitSchemaDefinition(node *ast.SchemaDefinition, ctx interface{}) Result
+}
+
+// SchemaDefinitionVisitActionFunc is an adapter to help define a SchemaDefinitionVisitAction from a function
+// which specifies action when traversing a node.
+type SchemaDefinitionVisitActionFunc func(node *ast.SchemaDefinition, ctx interface{}) Result
+
+var _ SchemaDefinitionVisitAction = (SchemaDefinitionVisitActionFunc)(nil)
+
+// VisitSchemaDefinition implements SchemaDefinitionVisitAction by calling f(node, ctx).
+func (f SchemaDefinitionVisitActionFunc) VisitSchemaDefinition(node *ast.SchemaDefinition, ctx interface{}) Result {
+	return f(node, ctx)
+}
+
+// SchemaExtensionVisitAction implements visiting function for SchemaExtension.
+type SchemaExtensionVisitAction interface {
+	VisitSchemaExtension(node *ast.SchemaExtension, ctx interface{}) Result
+}
+
+// SchemaExtensionVisitActionFunc is an adapter to help define a SchemaExtensionVisitAction from a function
+// which specifies action when traversing a node.
+type SchemaExtensionVisitActionFunc func(node *ast.SchemaExtension, ctx interface{}) Result
+
+var _ SchemaExtensionVisitAction = (SchemaExtensionVisitActionFunc)(nil)
+
+// VisitSchemaExtension implements SchemaExtensionVisitAction by calling f(node, ctx).
+func (f SchemaExtensionVisitActionFunc) VisitSchemaExtension(node *ast.SchemaExtension, ctx interface{}) Result {
+	return f(node, ctx)
+}
+
 // SelectionSetVisitAction implements visiting function for SelectionSet.
 type SelectionSetVisitAction interface {
 	VisitSelectionSet(node ast.SelectionSet, ctx interface{}) Result
@@ -504,6 +936,38 @@ func (f StringValueVisitActionFunc) VisitStringValue(node ast.StringValue, ctx i
 	return f(node, ctx)
 }
 
+// UnionTypeDefinitionVisitAction implements visiting function for UnionTypeDefinition.
+type UnionTypeDefinitionVisitAction interface {
+	VisitUnionTypeDefinition(node *ast.UnionTypeDefinition, ctx interface{}) Result
+}
+
+// UnionTypeDefinitionVisitActionFunc is an adapter to help define a UnionTypeDefinitionVisitAction from a function
+// which specifies action when traversing a node.
+type UnionTypeDefinitionVisitActionFunc func(node *ast.UnionTypeDefinition, ctx interface{}) Result
+
+var _ UnionTypeDefinitionVisitAction = (UnionTypeDefinitionVisitActionFunc)(nil)
+
+// VisitUnionTypeDefinition implements UnionTypeDefinitionVisitAction by calling f(node, ctx).
+func (f UnionTypeDefinitionVisitActionFunc) VisitUnionTypeDefinition(node *ast.UnionTypeDefinition, ctx interface{}) Result {
+	return f(node, ctx)
+}
+
+// UnionTypeExtensionVisitAction implements visiting function for UnionTypeExtension.
+type UnionTypeExtensionVisitAction interface {
+	VisitUnionTypeExtension(node *ast.UnionTypeExtension, ctx interface{}) Result
+}
+
+// UnionTypeExtensionVisitActionFunc is an adapter to help define a UnionTypeExtensionVisitAction from a function
+// which specifies action when traversing a node.
+type UnionTypeExtensionVisitActionFunc func(node *ast.UnionTypeExtension, ctx interface{}) Result
+
+var _ UnionTypeExtensionVisitAction = (UnionTypeExtensionVisitActionFunc)(nil)
+
+// VisitUnionTypeExtension implements UnionTypeExtensionVisitAction by calling f(node, ctx).
+func (f UnionTypeExtensionVisitActionFunc) VisitUnionTypeExtension(node *ast.UnionTypeExtension, ctx interface{}) Result {
+	return f(node, ctx)
+}
+
 // VariableVisitAction implements visiting function for Variable.
 type VariableVisitAction interface {
 	VisitVariable(node ast.Variable, ctx interface{}) Result
@@ -555,34 +1019,59 @@ func (f VariableDefinitionsVisitActionFunc) VisitVariableDefinitions(node ast.Va
 // A Visitor is provided to Walk to apply actions during AST traversal. It contains a collection of
 // actions to be executed for each type of node during the traversal.
 type Visitor struct {
-	argumentVisitAction            ArgumentVisitAction
-	argumentsVisitAction           ArgumentsVisitAction
-	booleanValueVisitAction        BooleanValueVisitAction
-	definitionsVisitAction         DefinitionsVisitAction
-	directiveVisitAction           DirectiveVisitAction
-	directivesVisitAction          DirectivesVisitAction
-	documentVisitAction            DocumentVisitAction
-	enumValueVisitAction           EnumValueVisitAction
-	fieldVisitAction               FieldVisitAction
-	floatValueVisitAction          FloatValueVisitAction
-	fragmentDefinitionVisitAction  FragmentDefinitionVisitAction
-	fragmentSpreadVisitAction      FragmentSpreadVisitAction
-	inlineFragmentVisitAction      InlineFragmentVisitAction
-	intValueVisitAction            IntValueVisitAction
-	listTypeVisitAction            ListTypeVisitAction
-	listValueVisitAction           ListValueVisitAction
-	nameVisitAction                NameVisitAction
-	namedTypeVisitAction           NamedTypeVisitAction
-	nonNullTypeVisitAction         NonNullTypeVisitAction
-	nullValueVisitAction           NullValueVisitAction
-	objectFieldVisitAction         ObjectFieldVisitAction
-	objectValueVisitAction         ObjectValueVisitAction
-	operationDefinitionVisitAction OperationDefinitionVisitAction
-	selectionSetVisitAction        SelectionSetVisitAction
-	stringValueVisitAction         StringValueVisitAction
-	variableVisitAction            VariableVisitAction
-	variableDefinitionVisitAction  VariableDefinitionVisitAction
-	variableDefinitionsVisitAction VariableDefinitionsVisitAction
+	argumentVisitAction                  ArgumentVisitAction
+	argumentsVisitAction                 ArgumentsVisitAction
+	booleanValueVisitAction              BooleanValueVisitAction
+	definitionsVisitAction               DefinitionsVisitAction
+	directiveVisitAction                 DirectiveVisitAction
+	directiveDefinitionVisitAction       DirectiveDefinitionVisitAction
+	directiveLocationsVisitAction        DirectiveLocationsVisitAction
+	directivesVisitAction                DirectivesVisitAction
+	documentVisitAction                  DocumentVisitAction
+	enumTypeDefinitionVisitAction        EnumTypeDefinitionVisitAction
+	enumTypeExtensionVisitAction         EnumTypeExtensionVisitAction
+	enumValueVisitAction                 EnumValueVisitAction
+	enumValueDefinitionVisitAction       EnumValueDefinitionVisitAction
+	enumValueDefinitionsVisitAction      EnumValueDefinitionsVisitAction
+	fieldVisitAction                     FieldVisitAction
+	fieldDefinitionVisitAction           FieldDefinitionVisitAction
+	fieldDefinitionsVisitAction          FieldDefinitionsVisitAction
+	floatValueVisitAction                FloatValueVisitAction
+	fragmentDefinitionVisitAction        FragmentDefinitionVisitAction
+	fragmentSpreadVisitAction            FragmentSpreadVisitAction
+	inlineFragmentVisitAction            InlineFragmentVisitAction
+	inputObjectTypeDefinitionVisitAction InputObjectTypeDefinitionVisitAction
+	inputObjectTypeExtensionVisitAction  InputObjectTypeExtensionVisitAction
+	inputValueDefinitionVisitAction      InputValueDefinitionVisitAction
+	inputValueDefinitionsVisitAction     InputValueDefinitionsVisitAction
+	intValueVisitAction                  IntValueVisitAction
+	interfaceTypeDefinitionVisitAction   InterfaceTypeDefinitionVisitAction
+	interfaceTypeExtensionVisitAction    InterfaceTypeExtensionVisitAction
+	listTypeVisitAction                  ListTypeVisitAction
+	listValueVisitAction                 ListValueVisitAction
+	nameVisitAction                      NameVisitAction
+	namedTypeVisitAction                 NamedTypeVisitAction
+	namedTypesVisitAction                NamedTypesVisitAction
+	nonNullTypeVisitAction               NonNullTypeVisitAction
+	nullValueVisitAction                 NullValueVisitAction
+	objectFieldVisitAction               ObjectFieldVisitAction
+	objectTypeDefinitionVisitAction      ObjectTypeDefinitionVisitAction
+	objectTypeExtensionVisitAction       ObjectTypeExtensionVisitAction
+	objectValueVisitAction               ObjectValueVisitAction
+	operationDefinitionVisitAction       OperationDefinitionVisitAction
+	operationTypeDefinitionVisitAction   OperationTypeDefinitionVisitAction
+	operationTypeDefinitionsVisitAction  OperationTypeDefinitionsVisitAction
+	scalarTypeDefinitionVisitAction      ScalarTypeDefinitionVisitAction
+	scalarTypeExtensionVisitAction       ScalarTypeExtensionVisitAction
+	schemaDefinitionVisitAction          SchemaDefinitionVisitAction
+	schemaExtensionVisitAction           SchemaExtensionVisitAction
+	selectionSetVisitAction              SelectionSetVisitAction
+	stringValueVisitAction               StringValueVisitAction
+	unionTypeDefinitionVisitAction       UnionTypeDefinitionVisitAction
+	unionTypeExtensionVisitAction        UnionTypeExtensionVisitAction
+	variableVisitAction                  VariableVisitAction
+	variableDefinitionVisitAction        VariableDefinitionVisitAction
+	variableDefinitionsVisitAction       VariableDefinitionsVisitAction
 }
 
 // VisitArgument applies actions on Argument.
@@ -625,6 +1114,22 @@ func (v *Visitor) VisitDirective(node *ast.Directive, ctx interface{}) Result {
 	return Continue
 }
 
+// VisitDirectiveDefinition applies actions on DirectiveDefinition.
+func (v *Visitor) VisitDirectiveDefinition(node *ast.DirectiveDefinition, ctx interface{}) Result {
+	if v.directiveDefinitionVisitAction != nil {
+		return v.directiveDefinitionVisitAction.VisitDirectiveDefinition(node, ctx)
+	}
+	return Continue
+}
+
+// VisitDirectiveLocations applies actions on DirectiveLocations.
+func (v *Visitor) VisitDirectiveLocations(node ast.DirectiveLocations, ctx interface{}) Result {
+	if v.directiveLocationsVisitAction != nil {
+		return v.directiveLocationsVisitAction.VisitDirectiveLocations(node, ctx)
+	}
+	return Continue
+}
+
 // VisitDirectives applies actions on Directives.
 func (v *Visitor) VisitDirectives(node ast.Directives, ctx interface{}) Result {
 	if v.directivesVisitAction != nil {
@@ -641,6 +1146,22 @@ func (v *Visitor) VisitDocument(node ast.Document, ctx interface{}) Result {
 	return Continue
 }
 
+// VisitEnumTypeDefinition applies actions on EnumTypeDefinition.
+func (v *Visitor) VisitEnumTypeDefinition(node *ast.EnumTypeDefinition, ctx interface{}) Result {
+	if v.enumTypeDefinitionVisitAction != nil {
+		return v.enumTypeDefinitionVisitAction.VisitEnumTypeDefinition(node, ctx)
+	}
+	return Continue
+}
+
+// VisitEnumTypeExtension applies actions on EnumTypeExtension.
+func (v *Visitor) VisitEnumTypeExtension(node *ast.EnumTypeExtension, ctx interface{}) Result {
+	if v.enumTypeExtensionVisitAction != nil {
+		return v.enumTypeExtensionVisitAction.VisitEnumTypeExtension(node, ctx)
+	}
+	return Continue
+}
+
 // VisitEnumValue applies actions on EnumValue.
 func (v *Visitor) VisitEnumValue(node ast.EnumValue, ctx interface{}) Result {
 	if v.enumValueVisitAction != nil {
@@ -649,18 +1170,50 @@ func (v *Visitor) VisitEnumValue(node ast.EnumValue, ctx interface{}) Result {
 	return Continue
 }
 
-// VisitField applies actions on Field.
-func (v *Visitor) VisitField(node *ast.Field, ctx interface{}) Result {
-	if v.fieldVisitAction != nil {
-		return v.fieldVisitAction.VisitField(node, ctx)
+// VisitEnumValueDefinition applies actions on EnumValueDefinition.
+func (v *Visitor) VisitEnumValueDefinition(node *ast.EnumValueDefinition, ctx interface{}) Result {
+	if v.enumValueDefinitionVisitAction != nil {
+		return v.enumValueDefinitionVisitAction.VisitEnumValueDefinition(node, ctx)
 	}
 	return Continue
 }
 
-// VisitFloatValue applies actions on FloatValue.
-func (v *Visitor) VisitFloatValue(node ast.FloatValue, ctx interface{}) Result {
-	if v.floatValueVisitAction != nil {
-		return v.floatValueVisitAction.VisitFloatValue(node, ctx)
+// VisitEnumValueDefinitions applies actions on EnumValueDefinitions.
+func (v *Visitor) VisitEnumValueDefinitions(node ast.EnumValueDefinitions, ctx interface{}) Result {
+	if v.enumValueDefinitionsVisitAction != nil {
+		return v.enumValueDefinitionsVisitAction.VisitEnumValueDefinitions(node, ctx)
+	}
+	return Continue
+}
+
+// VisitField applies actions on Field.
+func (v *Visitor) VisitField(node *ast.Field, ctx interface{}) Result {
+	if v.fieldVisitAction != nil {
+		return v.fieldVisitAction.VisitField(node, ctx)
+	}
+	return Continue
+}
+
+// VisitFieldDefinition applies actions on FieldDefinition.
+func (v *Visitor) VisitFieldDefinition(node *ast.FieldDefinition, ctx interface{}) Result {
+	if v.fieldDefinitionVisitAction != nil {
+		return v.fieldDefinitionVisitAction.VisitFieldDefinition(node, ctx)
+	}
+	return Continue
+}
+
+// VisitFieldDefinitions applies actions on FieldDefinitions.
+func (v *Visitor) VisitFieldDefinitions(node ast.FieldDefinitions, ctx interface{}) Result {
+	if v.fieldDefinitionsVisitAction != nil {
+		return v.fieldDefinitionsVisitAction.VisitFieldDefinitions(node, ctx)
+	}
+	return Continue
+}
+
+// VisitFloatValue applies actions on FloatValue.
+func (v *Visitor) VisitFloatValue(node ast.FloatValue, ctx interface{}) Result {
+	if v.floatValueVisitAction != nil {
+		return v.floatValueVisitAction.VisitFloatValue(node, ctx)
 	}
 	return Continue
 }
@@ -689,6 +1242,38 @@ func (v *Visitor) VisitInlineFragment(node *ast.InlineFragment, ctx interface{})
 	return Continue
 }
 
+// VisitInputObjectTypeDefinition applies actions on InputObjectTypeDefinition.
+func (v *Visitor) VisitInputObjectTypeDefinition(node *ast.InputObjectTypeDefinition, ctx interface{}) Result {
+	if v.inputObjectTypeDefinitionVisitAction != nil {
+		return v.inputObjectTypeDefinitionVisitAction.VisitInputObjectTypeDefinition(node, ctx)
+	}
+	return Continue
+}
+
+// VisitInputObjectTypeExtension applies actions on InputObjectTypeExtension.
+func (v *Visitor) VisitInputObjectTypeExtension(node *ast.InputObjectTypeExtension, ctx interface{}) Result {
+	if v.inputObjectTypeExtensionVisitAction != nil {
+		return v.inputObjectTypeExtensionVisitAction.VisitInputObjectTypeExtension(node, ctx)
+	}
+	return Continue
+}
+
+// VisitInputValueDefinition applies actions on InputValueDefinition.
+func (v *Visitor) VisitInputValueDefinition(node *ast.InputValueDefinition, ctx interface{}) Result {
+	if v.inputValueDefinitionVisitAction != nil {
+		return v.inputValueDefinitionVisitAction.VisitInputValueDefinition(node, ctx)
+	}
+	return Continue
+}
+
+// VisitInputValueDefinitions applies actions on InputValueDefinitions.
+func (v *Visitor) VisitInputValueDefinitions(node ast.InputValueDefinitions, ctx interface{}) Result {
+	if v.inputValueDefinitionsVisitAction != nil {
+		return v.inputValueDefinitionsVisitAction.VisitInputValueDefinitions(node, ctx)
+	}
+	return Continue
+}
+
 // VisitIntValue applies actions on IntValue.
 func (v *Visitor) VisitIntValue(node ast.IntValue, ctx interface{}) Result {
 	if v.intValueVisitAction != nil {
@@ -697,6 +1282,22 @@ func (v *Visitor) VisitIntValue(node ast.IntValue, ctx interface{}) Result {
 	return Continue
 }
 
+// VisitInterfaceTypeDefinition applies actions on InterfaceTypeDefinition.
+func (v *Visitor) VisitInterfaceTypeDefinition(node *ast.InterfaceTypeDefinition, ctx interface{}) Result {
+	if v.interfaceTypeDefinitionVisitAction != nil {
+		return v.interfaceTypeDefinitionVisitAction.VisitInterfaceTypeDefinition(node, ctx)
+	}
+	return Continue
+}
+
+// VisitInterfaceTypeExtension applies actions on InterfaceTypeExtension.
+func (v *Visitor) VisitInterfaceTypeExtension(node *ast.InterfaceTypeExtension, ctx interface{}) Result {
+	if v.interfaceTypeExtensionVisitAction != nil {
+		return v.interfaceTypeExtensionVisitAction.VisitInterfaceTypeExtension(node, ctx)
+	}
+	return Continue
+}
+
 // VisitListType applies actions on ListType.
 func (v *Visitor) VisitListType(node ast.ListType, ctx interface{}) Result {
 	if v.listTypeVisitAction != nil {
@@ -729,6 +1330,14 @@ func (v *Visitor) VisitNamedType(node ast.NamedType, ctx interface{}) Result {
 	return Continue
 }
 
+// VisitNamedTypes applies actions on NamedTypes.
+func (v *Visitor) VisitNamedTypes(node ast.NamedTypes, ctx interface{}) Result {
+	if v.namedTypesVisitAction != nil {
+		return v.namedTypesVisitAction.VisitNamedTypes(node, ctx)
+	}
+	return Continue
+}
+
 // VisitNonNullType applies actions on NonNullType.
 func (v *Visitor) VisitNonNullType(node ast.NonNullType, ctx interface{}) Result {
 	if v.nonNullTypeVisitAction != nil {
@@ -753,6 +1362,22 @@ func (v *Visitor) VisitObjectField(node *ast.ObjectField, ctx interface{}) Resul
 	return Continue
 }
 
+// VisitObjectTypeDefinition applies actions on ObjectTypeDefinition.
+func (v *Visitor) VisitObjectTypeDefinition(node *ast.ObjectTypeDefinition, ctx interface{}) Result {
+	if v.objectTypeDefinitionVisitAction != nil {
+		return v.objectTypeDefinitionVisitAction.VisitObjectTypeDefinition(node, ctx)
+	}
+	return Continue
+}
+
+// VisitObjectTypeExtension applies actions on ObjectTypeExtension.
+func (v *Visitor) VisitObjectTypeExtension(node *ast.ObjectTypeExtension, ctx interface{}) Result {
+	if v.objectTypeExtensionVisitAction != nil {
+		return v.objectTypeExtensionVisitAction.VisitObjectTypeExtension(node, ctx)
+	}
+	return Continue
+}
+
 // VisitObjectValue applies actions on ObjectValue.
 func (v *Visitor) VisitObjectValue(node ast.ObjectValue, ctx interface{}) Result {
 	if v.objectValueVisitAction != nil {
@@ -769,6 +1394,54 @@ func (v *Visitor) VisitOperationDefinition(node *ast.OperationDefinition, ctx in
 	return Continue
 }
 
+// VisitOperationTypeDefinition applies actions on OperationTypeDefinition.
+func (v *Visitor) VisitOperationTypeDefinition(node *ast.OperationTypeDefinition, ctx interface{}) Result {
+	if v.operationTypeDefinitionVisitAction != nil {
+		return v.operationTypeDefinitionVisitAction.VisitOperationTypeDefinition(node, ctx)
+	}
+	return Continue
+}
+
+// VisitOperationTypeDefinitions applies actions on OperationTypeDefinitions.
+func (v *Visitor) VisitOperationTypeDefinitions(node ast.OperationTypeDefinitions, ctx interface{}) Result {
+	if v.operationTypeDefinitionsVisitAction != nil {
+		return v.operationTypeDefinitionsVisitAction.VisitOperationTypeDefinitions(node, ctx)
+	}
+	return Continue
+}
+
+// VisitScalarTypeDefinition applies actions on ScalarTypeDefinition.
+func (v *Visitor) VisitScalarTypeDefinition(node *ast.ScalarTypeDefinition, ctx interface{}) Result {
+	if v.scalarTypeDefinitionVisitAction != nil {
+		return v.scalarTypeDefinitionVisitAction.VisitScalarTypeDefinition(node, ctx)
+	}
+	return Continue
+}
+
+// VisitScalarTypeExtension applies actions on ScalarTypeExtension.
+func (v *Visitor) VisitScalarTypeExtension(node *ast.ScalarTypeExtension, ctx interface{}) Result {
+	if v.scalarTypeExtensionVisitAction != nil {
+		return v.scalarTypeExtensionVisitAction.VisitScalarTypeExtension(node, ctx)
+	}
+	return Continue
+}
+
+// VisitSchemaDefinition applies actions on SchemaDefinition.
+func (v *Visitor) VisitSchemaDefinition(node *ast.SchemaDefinition, ctx interface{}) Result {
+	if v.schemaDefinitionVisitAction != nil {
+		return v.schemaDefinitionVisitAction.VisitSchemaDefinition(node, ctx)
+	}
+	return Continue
+}
+
+// VisitSchemaExtension applies actions on SchemaExtension.
+func (v *Visitor) VisitSchemaExtension(node *ast.SchemaExtension, ctx interface{}) Result {
+	if v.schemaExtensionVisitAction != nil {
+		return v.schemaExtensionVisitAction.VisitSchemaExtension(node, ctx)
+	}
+	return Continue
+}
+
 // VisitSelectionSet applies actions on SelectionSet.
 func (v *Visitor) VisitSelectionSet(node ast.SelectionSet, ctx interface{}) Result {
 	if v.selectionSetVisitAction != nil {
@@ -785,6 +1458,22 @@ func (v *Visitor) VisitStringValue(node ast.StringValue, ctx interface{}) Result
 	return Continue
 }
 
+// VisitUnionTypeDefinition applies actions on UnionTypeDefinition.
+func (v *Visitor) VisitUnionTypeDefinition(node *ast.UnionTypeDefinition, ctx interface{}) Result {
+	if v.unionTypeDefinitionVisitAction != nil {
+		return v.unionTypeDefinitionVisitAction.VisitUnionTypeDefinition(node, ctx)
+	}
+	return Continue
+}
+
+// VisitUnionTypeExtension applies actions on UnionTypeExtension.
+func (v *Visitor) VisitUnionTypeExtension(node *ast.UnionTypeExtension, ctx interface{}) Result {
+	if v.unionTypeExtensionVisitAction != nil {
+		return v.unionTypeExtensionVisitAction.VisitUnionTypeExtension(node, ctx)
+	}
+	return Continue
+}
+
 // VisitVariable applies actions on Variable.
 func (v *Visitor) VisitVariable(node ast.Variable, ctx interface{}) Result {
 	if v.variableVisitAction != nil {
@@ -812,6 +1501,51 @@ func (v *Visitor) VisitVariableDefinitions(node ast.VariableDefinitions, ctx int
 // NewNodeVisitor creates a visitor instance which performs the given action when encountering Node.
 func NewNodeVisitor(action NodeVisitAction) *Visitor {
 	return &Visitor{
+		unionTypeExtensionVisitAction: UnionTypeExtensionVisitActionFunc(func(node *ast.UnionTypeExtension, ctx interface{}) Result {
+			return action.VisitNode(node, ctx)
+		}),
+		scalarTypeExtensionVisitAction: ScalarTypeExtensionVisitActionFunc(func(node *ast.ScalarTypeExtension, ctx interface{}) Result {
+			return action.VisitNode(node, ctx)
+		}),
+		objectTypeExtensionVisitAction: ObjectTypeExtensionVisitActionFunc(func(node *ast.ObjectTypeExtension, ctx interface{}) Result {
+			return action.VisitNode(node, ctx)
+		}),
+		interfaceTypeExtensionVisitAction: InterfaceTypeExtensionVisitActionFunc(func(node *ast.InterfaceTypeExtension, ctx interface{}) Result {
+			return action.VisitNode(node, ctx)
+		}),
+		inputObjectTypeExtensionVisitAction: InputObjectTypeExtensionVisitActionFunc(func(node *ast.InputObjectTypeExtension, ctx interface{}) Result {
+			return action.VisitNode(node, ctx)
+		}),
+		enumTypeExtensionVisitAction: EnumTypeExtensionVisitActionFunc(func(node *ast.EnumTypeExtension, ctx interface{}) Result {
+			return action.VisitNode(node, ctx)
+		}),
+		schemaExtensionVisitAction: SchemaExtensionVisitActionFunc(func(node *ast.SchemaExtension, ctx interface{}) Result {
+			return action.VisitNode(node, ctx)
+		}),
+		unionTypeDefinitionVisitAction: UnionTypeDefinitionVisitActionFunc(func(node *ast.UnionTypeDefinition, ctx interface{}) Result {
+			return action.VisitNode(node, ctx)
+		}),
+		scalarTypeDefinitionVisitAction: ScalarTypeDefinitionVisitActionFunc(func(node *ast.ScalarTypeDefinition, ctx interface{}) Result {
+			return action.VisitNode(node, ctx)
+		}),
+		objectTypeDefinitionVisitAction: ObjectTypeDefinitionVisitActionFunc(func(node *ast.ObjectTypeDefinition, ctx interface{}) Result {
+			return action.VisitNode(node, ctx)
+		}),
+		interfaceTypeDefinitionVisitAction: InterfaceTypeDefinitionVisitActionFunc(func(node *ast.InterfaceTypeDefinition, ctx interface{}) Result {
+			return action.VisitNode(node, ctx)
+		}),
+		inputObjectTypeDefinitionVisitAction: InputObjectTypeDefinitionVisitActionFunc(func(node *ast.InputObjectTypeDefinition, ctx interface{}) Result {
+			return action.VisitNode(node, ctx)
+		}),
+		enumTypeDefinitionVisitAction: EnumTypeDefinitionVisitActionFunc(func(node *ast.EnumTypeDefinition, ctx interface{}) Result {
+			return action.VisitNode(node, ctx)
+		}),
+		schemaDefinitionVisitAction: SchemaDefinitionVisitActionFunc(func(node *ast.SchemaDefinition, ctx interface{}) Result {
+			return action.VisitNode(node, ctx)
+		}),
+		directiveDefinitionVisitAction: DirectiveDefinitionVisitActionFunc(func(node *ast.DirectiveDefinition, ctx interface{}) Result {
+			return action.VisitNode(node, ctx)
+		}),
 		inlineFragmentVisitAction: InlineFragmentVisitActionFunc(func(node *ast.InlineFragment, ctx interface{}) Result {
 			return action.VisitNode(node, ctx)
 		}),
@@ -872,18 +1606,48 @@ func NewNodeVisitor(action NodeVisitAction) *Visitor {
 		selectionSetVisitAction: SelectionSetVisitActionFunc(func(node ast.SelectionSet, ctx interface{}) Result {
 			return action.VisitNode(node, ctx)
 		}),
+		operationTypeDefinitionsVisitAction: OperationTypeDefinitionsVisitActionFunc(func(node ast.OperationTypeDefinitions, ctx interface{}) Result {
+			return action.VisitNode(node, ctx)
+		}),
+		operationTypeDefinitionVisitAction: OperationTypeDefinitionVisitActionFunc(func(node *ast.OperationTypeDefinition, ctx interface{}) Result {
+			return action.VisitNode(node, ctx)
+		}),
 		objectFieldVisitAction: ObjectFieldVisitActionFunc(func(node *ast.ObjectField, ctx interface{}) Result {
 			return action.VisitNode(node, ctx)
 		}),
+		namedTypesVisitAction: NamedTypesVisitActionFunc(func(node ast.NamedTypes, ctx interface{}) Result {
+			return action.VisitNode(node, ctx)
+		}),
 		nameVisitAction: NameVisitActionFunc(func(node ast.Name, ctx interface{}) Result {
 			return action.VisitNode(node, ctx)
 		}),
+		inputValueDefinitionsVisitAction: InputValueDefinitionsVisitActionFunc(func(node ast.InputValueDefinitions, ctx interface{}) Result {
+			return action.VisitNode(node, ctx)
+		}),
+		inputValueDefinitionVisitAction: InputValueDefinitionVisitActionFunc(func(node *ast.InputValueDefinition, ctx interface{}) Result {
+			return action.VisitNode(node, ctx)
+		}),
+		fieldDefinitionsVisitAction: FieldDefinitionsVisitActionFunc(func(node ast.FieldDefinitions, ctx interface{}) Result {
+			return action.VisitNode(node, ctx)
+		}),
+		fieldDefinitionVisitAction: FieldDefinitionVisitActionFunc(func(node *ast.FieldDefinition, ctx interface{}) Result {
+			return action.VisitNode(node, ctx)
+		}),
+		enumValueDefinitionsVisitAction: EnumValueDefinitionsVisitActionFunc(func(node ast.EnumValueDefinitions, ctx interface{}) Result {
+			return action.VisitNode(node, ctx)
+		}),
+		enumValueDefinitionVisitAction: EnumValueDefinitionVisitActionFunc(func(node *ast.EnumValueDefinition, ctx interface{}) Result {
+			return action.VisitNode(node, ctx)
+		}),
 		documentVisitAction: DocumentVisitActionFunc(func(node ast.Document, ctx interface{}) Result {
 			return action.VisitNode(node, ctx)
 		}),
 		directivesVisitAction: DirectivesVisitActionFunc(func(node ast.Directives, ctx interface{}) Result {
 			return action.VisitNode(node, ctx)
 		}),
+		directiveLocationsVisitAction: DirectiveLocationsVisitActionFunc(func(node ast.DirectiveLocations, ctx interface{}) Result {
+			return action.VisitNode(node, ctx)
+		}),
 		directiveVisitAction: DirectiveVisitActionFunc(func(node *ast.Directive, ctx interface{}) Result {
 			return action.VisitNode(node, ctx)
 		}),
@@ -950,6 +1714,51 @@ func NewValueVisitor(action ValueVisitAction) *Visitor {
 // NewDefinitionVisitor creates a visitor instance which performs the given action when encountering Definition.
 func NewDefinitionVisitor(action DefinitionVisitAction) *Visitor {
 	return &Visitor{
+		unionTypeExtensionVisitAction: UnionTypeExtensionVisitActionFunc(func(node *ast.UnionTypeExtension, ctx interface{}) Result {
+			return action.VisitDefinition(node, ctx)
+		}),
+		scalarTypeExtensionVisitAction: ScalarTypeExtensionVisitActionFunc(func(node *ast.ScalarTypeExtension, ctx interface{}) Result {
+			return action.VisitDefinition(node, ctx)
+		}),
+		objectTypeExtensionVisitAction: ObjectTypeExtensionVisitActionFunc(func(node *ast.ObjectTypeExtension, ctx interface{}) Result {
+			return action.VisitDefinition(node, ctx)
+		}),
+		interfaceTypeExtensionVisitAction: InterfaceTypeExtensionVisitActionFunc(func(node *ast.InterfaceTypeExtension, ctx interface{}) Result {
+			return action.VisitDefinition(node, ctx)
+		}),
+		inputObjectTypeExtensionVisitAction: InputObjectTypeExtensionVisitActionFunc(func(node *ast.InputObjectTypeExtension, ctx interface{}) Result {
+			return action.VisitDefinition(node, ctx)
+		}),
+		enumTypeExtensionVisitAction: EnumTypeExtensionVisitActionFunc(func(node *ast.EnumTypeExtension, ctx interface{}) Result {
+			return action.VisitDefinition(node, ctx)
+		}),
+		schemaExtensionVisitAction: SchemaExtensionVisitActionFunc(func(node *ast.SchemaExtension, ctx interface{}) Result {
+			return action.VisitDefinition(node, ctx)
+		}),
+		unionTypeDefinitionVisitAction: UnionTypeDefinitionVisitActionFunc(func(node *ast.UnionTypeDefinition, ctx interface{}) Result {
+			return action.VisitDefinition(node, ctx)
+		}),
+		scalarTypeDefinitionVisitAction: ScalarTypeDefinitionVisitActionFunc(func(node *ast.ScalarTypeDefinition, ctx interface{}) Result {
+			return action.VisitDefinition(node, ctx)
+		}),
+		objectTypeDefinitionVisitAction: ObjectTypeDefinitionVisitActionFunc(func(node *ast.ObjectTypeDefinition, ctx interface{}) Result {
+			return action.VisitDefinition(node, ctx)
+		}),
+		interfaceTypeDefinitionVisitAction: InterfaceTypeDefinitionVisitActionFunc(func(node *ast.InterfaceTypeDefinition, ctx interface{}) Result {
+			return action.VisitDefinition(node, ctx)
+		}),
+		inputObjectTypeDefinitionVisitAction: InputObjectTypeDefinitionVisitActionFunc(func(node *ast.InputObjectTypeDefinition, ctx interface{}) Result {
+			return action.VisitDefinition(node, ctx)
+		}),
+		enumTypeDefinitionVisitAction: EnumTypeDefinitionVisitActionFunc(func(node *ast.EnumTypeDefinition, ctx interface{}) Result {
+			return action.VisitDefinition(node, ctx)
+		}),
+		schemaDefinitionVisitAction: SchemaDefinitionVisitActionFunc(func(node *ast.SchemaDefinition, ctx interface{}) Result {
+			return action.VisitDefinition(node, ctx)
+		}),
+		directiveDefinitionVisitAction: DirectiveDefinitionVisitActionFunc(func(node *ast.DirectiveDefinition, ctx interface{}) Result {
+			return action.VisitDefinition(node, ctx)
+		}),
 		inlineFragmentVisitAction: InlineFragmentVisitActionFunc(func(node *ast.InlineFragment, ctx interface{}) Result {
 			return action.VisitDefinition(node, ctx)
 		}),
@@ -983,6 +1792,111 @@ func NewSelectionVisitor(action SelectionVisitAction) *Visitor {
 	}
 }
 
+// NewTypeSystemDefinitionVisitor creates a visitor instance which performs the given action when encountering TypeSystemDefinition.
+func NewTypeSystemDefinitionVisitor(action TypeSystemDefinitionVisitAction) *Visitor {
+	return &Visitor{
+		unionTypeDefinitionVisitAction: UnionTypeDefinitionVisitActionFunc(func(node *ast.UnionTypeDefinition, ctx interface{}) Result {
+			return action.VisitTypeSystemDefinition(node, ctx)
+		}),
+		scalarTypeDefinitionVisitAction: ScalarTypeDefinitionVisitActionFunc(func(node *ast.ScalarTypeDefinition, ctx interface{}) Result {
+			return action.VisitTypeSystemDefinition(node, ctx)
+		}),
+		objectTypeDefinitionVisitAction: ObjectTypeDefinitionVisitActionFunc(func(node *ast.ObjectTypeDefinition, ctx interface{}) Result {
+			return action.VisitTypeSystemDefinition(node, ctx)
+		}),
+		interfaceTypeDefinitionVisitAction: InterfaceTypeDefinitionVisitActionFunc(func(node *ast.InterfaceTypeDefinition, ctx interface{}) Result {
+			return action.VisitTypeSystemDefinition(node, ctx)
+		}),
+		inputObjectTypeDefinitionVisitAction: InputObjectTypeDefinitionVisitActionFunc(func(node *ast.InputObjectTypeDefinition, ctx interface{}) Result {
+			return action.VisitTypeSystemDefinition(node, ctx)
+		}),
+		enumTypeDefinitionVisitAction: EnumTypeDefinitionVisitActionFunc(func(node *ast.EnumTypeDefinition, ctx interface{}) Result {
+			return action.VisitTypeSystemDefinition(node, ctx)
+		}),
+		schemaDefinitionVisitAction: SchemaDefinitionVisitActionFunc(func(node *ast.SchemaDefinition, ctx interface{}) Result {
+			return action.VisitTypeSystemDefinition(node, ctx)
+		}),
+		directiveDefinitionVisitAction: DirectiveDefinitionVisitActionFunc(func(node *ast.DirectiveDefinition, ctx interface{}) Result {
+			return action.VisitTypeSystemDefinition(node, ctx)
+		}),
+	}
+}
+
+// NewTypeDefinitionVisitor creates a visitor instance which performs the given action when encountering TypeDefinition.
+func NewTypeDefinitionVisitor(action TypeDefinitionVisitAction) *Visitor {
+	return &Visitor{
+		unionTypeDefinitionVisitAction: UnionTypeDefinitionVisitActionFunc(func(node *ast.UnionTypeDefinition, ctx interface{}) Result {
+			return action.VisitTypeDefinition(node, ctx)
+		}),
+		scalarTypeDefinitionVisitAction: ScalarTypeDefinitionVisitActionFunc(func(node *ast.ScalarTypeDefinition, ctx interface{}) Result {
+			return action.VisitTypeDefinition(node, ctx)
+		}),
+		objectTypeDefinitionVisitAction: ObjectTypeDefinitionVisitActionFunc(func(node *ast.ObjectTypeDefinition, ctx interface{}) Result {
+			return action.VisitTypeDefinition(node, ctx)
+		}),
+		interfaceTypeDefinitionVisitAction: InterfaceTypeDefinitionVisitActionFunc(func(node *ast.InterfaceTypeDefinition, ctx interface{}) Result {
+			return action.VisitTypeDefinition(node, ctx)
+		}),
+		inputObjectTypeDefinitionVisitAction: InputObjectTypeDefinitionVisitActionFunc(func(node *ast.InputObjectTypeDefinition, ctx interface{}) Result {
+			return action.VisitTypeDefinition(node, ctx)
+		}),
+		enumTypeDefinitionVisitAction: EnumTypeDefinitionVisitActionFunc(func(node *ast.EnumTypeDefinition, ctx interface{}) Result {
+			return action.VisitTypeDefinition(node, ctx)
+		}),
+	}
+}
+
+// NewTypeSystemExtensionVisitor creates a visitor instance which performs the given action when encountering TypeSystemExtension.
+func NewTypeSystemExtensionVisitor(action TypeSystemExtensionVisitAction) *Visitor {
+	return &Visitor{
+		unionTypeExtensionVisitAction: UnionTypeExtensionVisitActionFunc(func(node *ast.UnionTypeExtension, ctx interface{}) Result {
+			return action.VisitTypeSystemExtension(node, ctx)
+		}),
+		scalarTypeExtensionVisitAction: ScalarTypeExtensionVisitActionFunc(func(node *ast.ScalarTypeExtension, ctx interface{}) Result {
+			return action.VisitTypeSystemExtension(node, ctx)
+		}),
+		objectTypeExtensionVisitAction: ObjectTypeExtensionVisitActionFunc(func(node *ast.ObjectTypeExtension, ctx interface{}) Result {
+			return action.VisitTypeSystemExtension(node, ctx)
+		}),
+		interfaceTypeExtensionVisitAction: InterfaceTypeExtensionVisitActionFunc(func(node *ast.InterfaceTypeExtension, ctx interface{}) Result {
+			return action.VisitTypeSystemExtension(node, ctx)
+		}),
+		inputObjectTypeExtensionVisitAction: InputObjectTypeExtensionVisitActionFunc(func(node *ast.InputObjectTypeExtension, ctx interface{}) Result {
+			return action.VisitTypeSystemExtension(node, ctx)
+		}),
+		enumTypeExtensionVisitAction: EnumTypeExtensionVisitActionFunc(func(node *ast.EnumTypeExtension, ctx interface{}) Result {
+			return action.VisitTypeSystemExtension(node, ctx)
+		}),
+		schemaExtensionVisitAction: SchemaExtensionVisitActionFunc(func(node *ast.SchemaExtension, ctx interface{}) Result {
+			return action.VisitTypeSystemExtension(node, ctx)
+		}),
+	}
+}
+
+// NewTypeExtensionVisitor creates a visitor instance which performs the given action when encountering TypeExtension.
+func NewTypeExtensionVisitor(action TypeExtensionVisitAction) *Visitor {
+	return &Visitor{
+		unionTypeExtensionVisitAction: UnionTypeExtensionVisitActionFunc(func(node *ast.UnionTypeExtension, ctx interface{}) Result {
+			return action.VisitTypeExtension(node, ctx)
+		}),
+		scalarTypeExtensionVisitAction: ScalarTypeExtensionVisitActionFunc(func(node *ast.ScalarTypeExtension, ctx interface{}) Result {
+			return action.VisitTypeExtension(node, ctx)
+		}),
+		objectTypeExtensionVisitAction: ObjectTypeExtensionVisitActionFunc(func(node *ast.ObjectTypeExtension, ctx interface{}) Result {
+			return action.VisitTypeExtension(node, ctx)
+		}),
+		interfaceTypeExtensionVisitAction: InterfaceTypeExtensionVisitActionFunc(func(node *ast.InterfaceTypeExtension, ctx interface{}) Result {
+			return action.VisitTypeExtension(node, ctx)
+		}),
+		inputObjectTypeExtensionVisitAction: InputObjectTypeExtensionVisitActionFunc(func(node *ast.InputObjectTypeExtension, ctx interface{}) Result {
+			return action.VisitTypeExtension(node, ctx)
+		}),
+		enumTypeExtensionVisitAction: EnumTypeExtensionVisitActionFunc(func(node *ast.EnumTypeExtension, ctx interface{}) Result {
+			return action.VisitTypeExtension(node, ctx)
+		}),
+	}
+}
+
 // NewArgumentVisitor creates a visitor instance which performs the given action when encountering Argument.
 func NewArgumentVisitor(action ArgumentVisitAction) *Visitor {
 	return &Visitor{
@@ -1018,6 +1932,20 @@ func NewDirectiveVisitor(action DirectiveVisitAction) *Visitor {
 	}
 }
 
+// NewDirectiveDefinitionVisitor creates a visitor instance which performs the given action when encountering DirectiveDefinition.
+func NewDirectiveDefinitionVisitor(action DirectiveDefinitionVisitAction) *Visitor {
+	return &Visitor{
+		directiveDefinitionVisitAction: action,
+	}
+}
+
+// NewDirectiveLocationsVisitor creates a visitor instance which performs the given action when encountering DirectiveLocations.
+func NewDirectiveLocationsVisitor(action DirectiveLocationsVisitAction) *Visitor {
+	return &Visitor{
+		directiveLocationsVisitAction: action,
+	}
+}
+
 // NewDirectivesVisitor creates a visitor instance which performs the given action when encountering Directives.
 func NewDirectivesVisitor(action DirectivesVisitAction) *Visitor {
 	return &Visitor{
@@ -1032,6 +1960,20 @@ func NewDocumentVisitor(action DocumentVisitAction) *Visitor {
 	}
 }
 
+// NewEnumTypeDefinitionVisitor creates a visitor instance which performs the given action when encountering EnumTypeDefinition.
+func NewEnumTypeDefinitionVisitor(action EnumTypeDefinitionVisitAction) *Visitor {
+	return &Visitor{
+		enumTypeDefinitionVisitAction: action,
+	}
+}
+
+// NewEnumTypeExtensionVisitor creates a visitor instance which performs the given action when encountering EnumTypeExtension.
+func NewEnumTypeExtensionVisitor(action EnumTypeExtensionVisitAction) *Visitor {
+	return &Visitor{
+		enumTypeExtensionVisitAction: action,
+	}
+}
+
 // NewEnumValueVisitor creates a visitor instance which performs the given action when encountering EnumValue.
 func NewEnumValueVisitor(action EnumValueVisitAction) *Visitor {
 	return &Visitor{
@@ -1039,6 +1981,20 @@ func NewEnumValueVisitor(action EnumValueVisitAction) *Visitor {
 	}
 }
 
+// NewEnumValueDefinitionVisitor creates a visitor instance which performs the given action when encountering EnumValueDefinition.
+func NewEnumValueDefinitionVisitor(action EnumValueDefinitionVisitAction) *Visitor {
+	return &Visitor{
+		enumValueDefinitionVisitAction: action,
+	}
+}
+
+// NewEnumValueDefinitionsVisitor creates a visitor instance which performs the given action when encountering EnumValueDefinitions.
+func NewEnumValueDefinitionsVisitor(action EnumValueDefinitionsVisitAction) *Visitor {
+	return &Visitor{
+		enumValueDefinitionsVisitAction: action,
+	}
+}
+
 // NewFieldVisitor creates a visitor instance which performs the given action when encountering Field.
 func NewFieldVisitor(action FieldVisitAction) *Visitor {
 	return &Visitor{
@@ -1046,6 +2002,20 @@ func NewFieldVisitor(action FieldVisitAction) *Visitor {
 	}
 }
 
+// NewFieldDefinitionVisitor creates a visitor instance which performs the given action when encountering FieldDefinition.
+func NewFieldDefinitionVisitor(action FieldDefinitionVisitAction) *Visitor {
+	return &Visitor{
+		fieldDefinitionVisitAction: action,
+	}
+}
+
+// NewFieldDefinitionsVisitor creates a visitor instance which performs the given action when encountering FieldDefinitions.
+func NewFieldDefinitionsVisitor(action FieldDefinitionsVisitAction) *Visitor {
+	return &Visitor{
+		fieldDefinitionsVisitAction: action,
+	}
+}
+
 // NewFloatValueVisitor creates a visitor instance which performs the given action when encountering FloatValue.
 func NewFloatValueVisitor(action FloatValueVisitAction) *Visitor {
 	return &Visitor{
@@ -1074,24 +2044,66 @@ func NewInlineFragmentVisitor(action InlineFragmentVisitAction) *Visitor {
 	}
 }
 
-// NewIntValueVisitor creates a visitor instance which performs the given action when encountering IntValue.
-func NewIntValueVisitor(action IntValueVisitAction) *Visitor {
+// NewInputObjectTypeDefinitionVisitor creates a visitor instance which performs the given action when encountering InputObjectTypeDefinition.
+func NewInputObjectTypeDefinitionVisitor(action InputObjectTypeDefinitionVisitAction) *Visitor {
 	return &Visitor{
-		intValueVisitAction: action,
+		inputObjectTypeDefinitionVisitAction: action,
 	}
 }
 
-// NewListTypeVisitor creates a visitor instance which performs the given action when encountering ListType.
-func NewListTypeVisitor(action ListTypeVisitAction) *Visitor {
+// NewInputObjectTypeExtensionVisitor creates a visitor instance which performs the given action when encountering InputObjectTypeExtension.
+func NewInputObjectTypeExtensionVisitor(action InputObjectTypeExtensionVisitAction) *Visitor {
 	return &Visitor{
-		listTypeVisitAction: action,
+		inputObjectTypeExtensionVisitAction: action,
 	}
 }
 
-// NewListValueVisitor creates a visitor instance which performs the given action when encountering ListValue.
-func NewListValueVisitor(action ListValueVisitAction) *Visitor {
+// NewInputValueDefinitionVisitor creates a visitor instance which performs the given action when encountering InputValueDefinition.
+func NewInputValueDefinitionVisitor(action InputValueDefinitionVisitAction) *Visitor {
 	return &Visitor{
-		listValueVisitAction: action,
+		inputValueDefinitionVisitAction: action,
+	}
+}
+
+// NewInputValueDefinitionsVisitor creates a visitor instance which performs the given action when encountering InputValueDefinitions.
+func NewInputValueDefinitionsVisitor(action InputValueDefinitionsVisitAction) *Visitor {
+	return &Visitor{
+		inputValueDefinitionsVisitAction: action,
+	}
+}
+
+// NewIntValueVisitor creates a visitor instance which performs the given action when encountering IntValue.
+func NewIntValueVisitor(action IntValueVisitAction) *Visitor {
+	return &Visitor{
+		intValueVisitAction: action,
+	}
+}
+
+// NewInterfaceTypeDefinitionVisitor creates a visitor instance which performs the given action when encountering InterfaceTypeDefinition.
+func NewInterfaceTypeDefinitionVisitor(action InterfaceTypeDefinitionVisitAction) *Visitor {
+	return &Visitor{
+		interfaceTypeDefinitionVisitAction: action,
+	}
+}
+
+// NewInterfaceTypeExtensionVisitor creates a visitor instance which performs the given action when encountering InterfaceTypeExtension.
+func NewInterfaceTypeExtensionVisitor(action InterfaceTypeExtensionVisitAction) *Visitor {
+	return &Visitor{
+		interfaceTypeExtensionVisitAction: action,
+	}
+}
+
+// NewListTypeVisitor creates a visitor instance which performs the given action when encountering ListType.
+func NewListTypeVisitor(action ListTypeVisitAction) *Visitor {
+	return &Visitor{
+		listTypeVisitAction: action,
+	}
+}
+
+// NewListValueVisitor creates a visitor instance which performs the given action when encountering ListValue.
+func NewListValueVisitor(action ListValueVisitAction) *Visitor {
+	return &Visitor{
+		listValueVisitAction: action,
 	}
 }
 
@@ -1109,6 +2121,13 @@ func NewNamedTypeVisitor(action NamedTypeVisitAction) *Visitor {
 	}
 }
 
+// NewNamedTypesVisitor creates a visitor instance which performs the given action when encountering NamedTypes.
+func NewNamedTypesVisitor(action NamedTypesVisitAction) *Visitor {
+	return &Visitor{
+		namedTypesVisitAction: action,
+	}
+}
+
 // NewNonNullTypeVisitor creates a visitor instance which performs the given action when encountering NonNullType.
 func NewNonNullTypeVisitor(action NonNullTypeVisitAction) *Visitor {
 	return &Visitor{
@@ -1130,6 +2149,20 @@ func NewObjectFieldVisitor(action ObjectFieldVisitAction) *Visitor {
 	}
 }
 
+// NewObjectTypeDefinitionVisitor creates a visitor instance which performs the given action when encountering ObjectTypeDefinition.
+func NewObjectTypeDefinitionVisitor(action ObjectTypeDefinitionVisitAction) *Visitor {
+	return &Visitor{
+		objectTypeDefinitionVisitAction: action,
+	}
+}
+
+// NewObjectTypeExtensionVisitor creates a visitor instance which performs the given action when encountering ObjectTypeExtension.
+func NewObjectTypeExtensionVisitor(action ObjectTypeExtensionVisitAction) *Visitor {
+	return &Visitor{
+		objectTypeExtensionVisitAction: action,
+	}
+}
+
 // NewObjectValueVisitor creates a visitor instance which performs the given action when encountering ObjectValue.
 func NewObjectValueVisitor(action ObjectValueVisitAction) *Visitor {
 	return &Visitor{
@@ -1144,6 +2177,48 @@ func NewOperationDefinitionVisitor(action OperationDefinitionVisitAction) *Visit
 	}
 }
 
+// NewOperationTypeDefinitionVisitor creates a visitor instance which performs the given action when encountering OperationTypeDefinition.
+func NewOperationTypeDefinitionVisitor(action OperationTypeDefinitionVisitAction) *Visitor {
+	return &Visitor{
+		operationTypeDefinitionVisitAction: action,
+	}
+}
+
+// NewOperationTypeDefinitionsVisitor creates a visitor instance which performs the given action when encountering OperationTypeDefinitions.
+func NewOperationTypeDefinitionsVisitor(action OperationTypeDefinitionsVisitAction) *Visitor {
+	return &Visitor{
+		operationTypeDefinitionsVisitAction: action,
+	}
+}
+
+// NewScalarTypeDefinitionVisitor creates a visitor instance which performs the given action when encountering ScalarTypeDefinition.
+func NewScalarTypeDefinitionVisitor(action ScalarTypeDefinitionVisitAction) *Visitor {
+	return &Visitor{
+		scalarTypeDefinitionVisitAction: action,
+	}
+}
+
+// NewScalarTypeExtensionVisitor creates a visitor instance which performs the given action when encountering ScalarTypeExtension.
+func NewScalarTypeExtensionVisitor(action ScalarTypeExtensionVisitAction) *Visitor {
+	return &Visitor{
+		scalarTypeExtensionVisitAction: action,
+	}
+}
+
+// NewSchemaDefinitionVisitor creates a visitor instance which performs the given action when encountering SchemaDefinition.
+func NewSchemaDefinitionVisitor(action SchemaDefinitionVisitAction) *Visitor {
+	return &Visitor{
+		schemaDefinitionVisitAction: action,
+	}
+}
+
+// NewSchemaExtensionVisitor creates a visitor instance which performs the given action when encountering SchemaExtension.
+func NewSchemaExtensionVisitor(action SchemaExtensionVisitAction) *Visitor {
+	return &Visitor{
+		schemaExtensionVisitAction: action,
+	}
+}
+
 // NewSelectionSetVisitor creates a visitor instance which performs the given action when encountering SelectionSet.
 func NewSelectionSetVisitor(action SelectionSetVisitAction) *Visitor {
 	return &Visitor{
@@ -1158,6 +2233,20 @@ func NewStringValueVisitor(action StringValueVisitAction) *Visitor {
 	}
 }
 
+// NewUnionTypeDefinitionVisitor creates a visitor instance which performs the given action when encountering UnionTypeDefinition.
+func NewUnionTypeDefinitionVisitor(action UnionTypeDefinitionVisitAction) *Visitor {
+	return &Visitor{
+		unionTypeDefinitionVisitAction: action,
+	}
+}
+
+// NewUnionTypeExtensionVisitor creates a visitor instance which performs the given action when encountering UnionTypeExtension.
+func NewUnionTypeExtensionVisitor(action UnionTypeExtensionVisitAction) *Visitor {
+	return &Visitor{
+		unionTypeExtensionVisitAction: action,
+	}
+}
+
 // NewVariableVisitor creates a visitor instance which performs the given action when encountering Variable.
 func NewVariableVisitor(action VariableVisitAction) *Visitor {
 	return &Visitor{
@@ -1190,14 +2279,34 @@ func walkNode(node ast.Node, ctx interface{}, v *Visitor) bool {
 		cont = walkDefinitions(node, ctx, v)
 	case *ast.Directive:
 		cont = walkDirective(node, ctx, v)
+	case ast.DirectiveLocations:
+		cont = walkDirectiveLocations(node, ctx, v)
 	case ast.Directives:
 		cont = walkDirectives(node, ctx, v)
 	case ast.Document:
 		cont = walkDocument(node, ctx, v)
+	case *ast.EnumValueDefinition:
+		cont = walkEnumValueDefinition(node, ctx, v)
+	case ast.EnumValueDefinitions:
+		cont = walkEnumValueDefinitions(node, ctx, v)
+	case *ast.FieldDefinition:
+		cont = walkFieldDefinition(node, ctx, v)
+	case ast.FieldDefinitions:
+		cont = walkFieldDefinitions(node, ctx, v)
+	case *ast.InputValueDefinition:
+		cont = walkInputValueDefinition(node, ctx, v)
+	case ast.InputValueDefinitions:
+		cont = walkInputValueDefinitions(node, ctx, v)
 	case ast.Name:
 		cont = walkName(node, ctx, v)
+	case ast.NamedTypes:
+		cont = walkNamedTypes(node, ctx, v)
 	case *ast.ObjectField:
 		cont = walkObjectField(node, ctx, v)
+	case *ast.OperationTypeDefinition:
+		cont = walkOperationTypeDefinition(node, ctx, v)
+	case ast.OperationTypeDefinitions:
+		cont = walkOperationTypeDefinitions(node, ctx, v)
 	case ast.SelectionSet:
 		cont = walkSelectionSet(node, ctx, v)
 	case *ast.VariableDefinition:
@@ -1210,8 +2319,6 @@ func walkNode(node ast.Node, ctx interface{}, v *Visitor) bool {
 		cont = walkValue(node, ctx, v)
 	case ast.Definition:
 		cont = walkDefinition(node, ctx, v)
-	case ast.Selection:
-		cont = walkSelection(node, ctx, v)
 	default:
 		panic(fmt.Sprintf("unexpected node type %T when visiting Node", node))
 	}
@@ -1281,6 +2388,10 @@ func walkDefinition(node ast.Definition, ctx interface{}, v *Visitor) bool {
 		cont = walkOperationDefinition(node, ctx, v)
 	case ast.Selection:
 		cont = walkSelection(node, ctx, v)
+	case ast.TypeSystemDefinition:
+		cont = walkTypeSystemDefinition(node, ctx, v)
+	case ast.TypeSystemExtension:
+		cont = walkTypeSystemExtension(node, ctx, v)
 	default:
 		panic(fmt.Sprintf("unexpected node type %T when visiting Definition", node))
 	}
@@ -1310,6 +2421,92 @@ func walkSelection(node ast.Selection, ctx interface{}, v *Visitor) bool {
 	return true
 }
 
+func walkTypeSystemDefinition(node ast.TypeSystemDefinition, ctx interface{}, v *Visitor) bool {
+	var cont bool
+	switch node := node.(type) {
+	case *ast.DirectiveDefinition:
+		cont = walkDirectiveDefinition(node, ctx, v)
+	case *ast.SchemaDefinition:
+		cont = walkSchemaDefinition(node, ctx, v)
+	case ast.TypeDefinition:
+		cont = walkTypeDefinition(node, ctx, v)
+	default:
+		panic(fmt.Sprintf("unexpected node type %T when visiting TypeSystemDefinition", node))
+	}
+	if !cont {
+		return false
+	}
+
+	return true
+}
+
+func walkTypeDefinition(node ast.TypeDefinition, ctx interface{}, v *Visitor) bool {
+	var cont bool
+	switch node := node.(type) {
+	case *ast.EnumTypeDefinition:
+		cont = walkEnumTypeDefinition(node, ctx, v)
+	case *ast.InputObjectTypeDefinition:
+		cont = walkInputObjectTypeDefinition(node, ctx, v)
+	case *ast.InterfaceTypeDefinition:
+		cont = walkInterfaceTypeDefinition(node, ctx, v)
+	case *ast.ObjectTypeDefinition:
+		cont = walkObjectTypeDefinition(node, ctx, v)
+	case *ast.ScalarTypeDefinition:
+		cont = walkScalarTypeDefinition(node, ctx, v)
+	case *ast.UnionTypeDefinition:
+		cont = walkUnionTypeDefinition(node, ctx, v)
+	default:
+		panic(fmt.Sprintf("unexpected node type %T when visiting TypeDefinition", node))
+	}
+	if !cont {
+		return false
+	}
+
+	return true
+}
+
+func walkTypeSystemExtension(node ast.TypeSystemExtension, ctx interface{}, v *Visitor) bool {
+	var cont bool
+	switch node := node.(type) {
+	case *ast.SchemaExtension:
+		cont = walkSchemaExtension(node, ctx, v)
+	case ast.TypeExtension:
+		cont = walkTypeExtension(node, ctx, v)
+	default:
+		panic(fmt.Sprintf("unexpected node type %T when visiting TypeSystemExtension", node))
+	}
+	if !cont {
+		return false
+	}
+
+	return true
+}
+
+func walkTypeExtension(node ast.TypeExtension, ctx interface{}, v *Visitor) bool {
+	var cont bool
+	switch node := node.(type) {
+	case *ast.EnumTypeExtension:
+		cont = walkEnumTypeExtension(node, ctx, v)
+	case *ast.InputObjectTypeExtension:
+		cont = walkInputObjectTypeExtension(node, ctx, v)
+	case *ast.InterfaceTypeExtension:
+		cont = walkInterfaceTypeExtension(node, ctx, v)
+	case *ast.ObjectTypeExtension:
+		cont = walkObjectTypeExtension(node, ctx, v)
+	case *ast.ScalarTypeExtension:
+		cont = walkScalarTypeExtension(node, ctx, v)
+	case *ast.UnionTypeExtension:
+		cont = walkUnionTypeExtension(node, ctx, v)
+	default:
+		panic(fmt.Sprintf("unexpected node type %T when visiting TypeExtension", node))
+	}
+	if !cont {
+		return false
+	}
+
+	return true
+}
+
 func walkArgument(node *ast.Argument, ctx interface{}, v *Visitor) bool {
 	if result := v.VisitArgument(node, ctx); result != Continue {
 		return result != Break
@@ -1382,6 +2579,49 @@ func walkDirective(node *ast.Directive, ctx interface{}, v *Visitor) bool {
 	return true
 }
 
+func walkDirectiveDefinition(node *ast.DirectiveDefinition, ctx interface{}, v *Visitor) bool {
+	if result := v.VisitDirectiveDefinition(node, ctx); result != Continue {
+		return result != Break
+	}
+
+	// Visit Description.
+	if !node.Description.IsNil() {
+		if cont := walkStringValue(node.Description, ctx, v); !cont {
+			return false
+		}
+	}
+	// Visit Name.
+	if cont := walkName(node.Name, ctx, v); !cont {
+		return false
+	}
+	// Visit Arguments.
+	if len(node.Arguments) != 0 {
+		if cont := walkInputValueDefinitions(node.Arguments, ctx, v); !cont {
+			return false
+		}
+	}
+	// Visit Locations.
+	if cont := walkDirectiveLocations(node.Locations, ctx, v); !cont {
+		return false
+	}
+
+	return true
+}
+
+func walkDirectiveLocations(node ast.DirectiveLocations, ctx interface{}, v *Visitor) bool {
+	if result := v.VisitDirectiveLocations(node, ctx); result != Continue {
+		return result != Break
+	}
+
+	for _, childNode := range node {
+		if cont := walkName(childNode, ctx, v); !cont {
+			return false
+		}
+	}
+
+	return true
+}
+
 func walkDirectives(node ast.Directives, ctx interface{}, v *Visitor) bool {
 	if result := v.VisitDirectives(node, ctx); result != Continue {
 		return result != Break
@@ -1409,22 +2649,14 @@ func walkDocument(node ast.Document, ctx interface{}, v *Visitor) bool {
 	return true
 }
 
-func walkEnumValue(node ast.EnumValue, ctx interface{}, v *Visitor) bool {
-	if result := v.VisitEnumValue(node, ctx); result != Continue {
-		return result != Break
-	}
-
-	return true
-}
-
-func walkField(node *ast.Field, ctx interface{}, v *Visitor) bool {
-	if result := v.VisitField(node, ctx); result != Continue {
+func walkEnumTypeDefinition(node *ast.EnumTypeDefinition, ctx interface{}, v *Visitor) bool {
+	if result := v.VisitEnumTypeDefinition(node, ctx); result != Continue {
 		return result != Break
 	}
 
-	// Visit Alias.
-	if !node.Alias.IsNil() {
-		if cont := walkName(node.Alias, ctx, v); !cont {
+	// Visit Description.
+	if !node.Description.IsNil() {
+		if cont := walkStringValue(node.Description, ctx, v); !cont {
 			return false
 		}
 	}
@@ -1432,21 +2664,40 @@ func walkField(node *ast.Field, ctx interface{}, v *Visitor) bool {
 	if cont := walkName(node.Name, ctx, v); !cont {
 		return false
 	}
-	// Visit Arguments.
-	if len(node.Arguments) != 0 {
-		if cont := walkArguments(node.Arguments, ctx, v); !cont {
+	// Visit Directives.
+	if len(node.Directives) != 0 {
+		if cont := walkDirectives(node.Directives, ctx, v); !cont {
+			return false
+		}
+	}
+	// Visit Values.
+	if len(node.Values) != 0 {
+		if cont := walkEnumValueDefinitions(node.Values, ctx, v); !cont {
 			return false
 		}
 	}
+
+	return true
+}
+
+func walkEnumTypeExtension(node *ast.EnumTypeExtension, ctx interface{}, v *Visitor) bool {
+	if result := v.VisitEnumTypeExtension(node, ctx); result != Continue {
+		return result != Break
+	}
+
+	// Visit Name.
+	if cont := walkName(node.Name, ctx, v); !cont {
+		return false
+	}
 	// Visit Directives.
 	if len(node.Directives) != 0 {
 		if cont := walkDirectives(node.Directives, ctx, v); !cont {
 			return false
 		}
 	}
-	// Visit SelectionSet.
-	if len(node.SelectionSet) != 0 {
-		if cont := walkSelectionSet(node.SelectionSet, ctx, v); !cont {
+	// Visit Values.
+	if len(node.Values) != 0 {
+		if cont := walkEnumValueDefinitions(node.Values, ctx, v); !cont {
 			return false
 		}
 	}
@@ -1454,31 +2705,27 @@ func walkField(node *ast.Field, ctx interface{}, v *Visitor) bool {
 	return true
 }
 
-func walkFloatValue(node ast.FloatValue, ctx interface{}, v *Visitor) bool {
-	if result := v.VisitFloatValue(node, ctx); result != Continue {
+func walkEnumValue(node ast.EnumValue, ctx interface{}, v *Visitor) bool {
+	if result := v.VisitEnumValue(node, ctx); result != Continue {
 		return result != Break
 	}
 
 	return true
 }
 
-func walkFragmentDefinition(node *ast.FragmentDefinition, ctx interface{}, v *Visitor) bool {
-	if result := v.VisitFragmentDefinition(node, ctx); result != Continue {
+func walkEnumValueDefinition(node *ast.EnumValueDefinition, ctx interface{}, v *Visitor) bool {
+	if result := v.VisitEnumValueDefinition(node, ctx); result != Continue {
 		return result != Break
 	}
 
-	// Visit Name.
-	if cont := walkName(node.Name, ctx, v); !cont {
-		return false
-	}
-	// Visit VariableDefinitions.
-	if len(node.VariableDefinitions) != 0 {
-		if cont := walkVariableDefinitions(node.VariableDefinitions, ctx, v); !cont {
+	// Visit Description.
+	if !node.Description.IsNil() {
+		if cont := walkStringValue(node.Description, ctx, v); !cont {
 			return false
 		}
 	}
-	// Visit TypeCondition.
-	if cont := walkNamedType(node.TypeCondition, ctx, v); !cont {
+	// Visit Name.
+	if cont := walkName(node.Name, ctx, v); !cont {
 		return false
 	}
 	// Visit Directives.
@@ -1487,26 +2734,17 @@ func walkFragmentDefinition(node *ast.FragmentDefinition, ctx interface{}, v *Vi
 			return false
 		}
 	}
-	// Visit SelectionSet.
-	if cont := walkSelectionSet(node.SelectionSet, ctx, v); !cont {
-		return false
-	}
 
 	return true
 }
 
-func walkFragmentSpread(node *ast.FragmentSpread, ctx interface{}, v *Visitor) bool {
-	if result := v.VisitFragmentSpread(node, ctx); result != Continue {
+func walkEnumValueDefinitions(node ast.EnumValueDefinitions, ctx interface{}, v *Visitor) bool {
+	if result := v.VisitEnumValueDefinitions(node, ctx); result != Continue {
 		return result != Break
 	}
 
-	// Visit Name.
-	if cont := walkName(node.Name, ctx, v); !cont {
-		return false
-	}
-	// Visit Directives.
-	if len(node.Directives) != 0 {
-		if cont := walkDirectives(node.Directives, ctx, v); !cont {
+	for _, childNode := range node {
+		if cont := walkEnumValueDefinition(childNode, ctx, v); !cont {
 			return false
 		}
 	}
@@ -1514,14 +2752,24 @@ func walkFragmentSpread(node *ast.FragmentSpread, ctx interface{}, v *Visitor) b
 	return true
 }
 
-func walkInlineFragment(node *ast.InlineFragment, ctx interface{}, v *Visitor) bool {
-	if result := v.VisitInlineFragment(node, ctx); result != Continue {
+func walkField(node *ast.Field, ctx interface{}, v *Visitor) bool {
+	if result := v.VisitField(node, ctx); result != Continue {
 		return result != Break
 	}
 
-	// Visit TypeCondition.
-	if !node.TypeCondition.Name.IsNil() {
-		if cont := walkNamedType(node.TypeCondition, ctx, v); !cont {
+	// Visit Alias.
+	if !node.Alias.IsNil() {
+		if cont := walkName(node.Alias, ctx, v); !cont {
+			return false
+		}
+	}
+	// Visit Name.
+	if cont := walkName(node.Name, ctx, v); !cont {
+		return false
+	}
+	// Visit Arguments.
+	if len(node.Arguments) != 0 {
+		if cont := walkArguments(node.Arguments, ctx, v); !cont {
 			return false
 		}
 	}
@@ -1532,24 +2780,333 @@ func walkInlineFragment(node *ast.InlineFragment, ctx interface{}, v *Visitor) b
 		}
 	}
 	// Visit SelectionSet.
-	if cont := walkSelectionSet(node.SelectionSet, ctx, v); !cont {
-		return false
+	if len(node.SelectionSet) != 0 {
+		if cont := walkSelectionSet(node.SelectionSet, ctx, v); !cont {
+			return false
+		}
 	}
 
 	return true
 }
 
-func walkIntValue(node ast.IntValue, ctx interface{}, v *Visitor) bool {
-	if result := v.VisitIntValue(node, ctx); result != Continue {
+func walkFieldDefinition(node *ast.FieldDefinition, ctx interface{}, v *Visitor) bool {
+	if result := v.VisitFieldDefinition(node, ctx); result != Continue {
 		return result != Break
 	}
 
-	return true
-}
-
-func walkListType(node ast.ListType, ctx interface{}, v *Visitor) bool {
-	if result := v.VisitListType(node, ctx); result != Continue {
-		return result != Break
+	// Visit Description.
+	if !node.Description.IsNil() {
+		if cont := walkStringValue(node.Description, ctx, v); !cont {
+			return false
+		}
+	}
+	// Visit Name.
+	if cont := walkName(node.Name, ctx, v); !cont {
+		return false
+	}
+	// Visit Arguments.
+	if len(node.Arguments) != 0 {
+		if cont := walkInputValueDefinitions(node.Arguments, ctx, v); !cont {
+			return false
+		}
+	}
+	// Visit Type.
+	if cont := walkType(node.Type, ctx, v); !cont {
+		return false
+	}
+	// Visit Directives.
+	if len(node.Directives) != 0 {
+		if cont := walkDirectives(node.Directives, ctx, v); !cont {
+			return false
+		}
+	}
+
+	return true
+}
+
+func walkFieldDefinitions(node ast.FieldDefinitions, ctx interface{}, v *Visitor) bool {
+	if result := v.VisitFieldDefinitions(node, ctx); result != Continue {
+		return result != Break
+	}
+
+	for _, childNode := range node {
+		if cont := walkFieldDefinition(childNode, ctx, v); !cont {
+			return false
+		}
+	}
+
+	return true
+}
+
+func walkFloatValue(node ast.FloatValue, ctx interface{}, v *Visitor) bool {
+	if result := v.VisitFloatValue(node, ctx); result != Continue {
+		return result != Break
+	}
+
+	return true
+}
+
+func walkFragmentDefinition(node *ast.FragmentDefinition, ctx interface{}, v *Visitor) bool {
+	if result := v.VisitFragmentDefinition(node, ctx); result != Continue {
+		return result != Break
+	}
+
+	// Visit Name.
+	if cont := walkName(node.Name, ctx, v); !cont {
+		return false
+	}
+	// Visit VariableDefinitions.
+	if len(node.VariableDefinitions) != 0 {
+		if cont := walkVariableDefinitions(node.VariableDefinitions, ctx, v); !cont {
+			return false
+		}
+	}
+	// Visit TypeCondition.
+	if cont := walkNamedType(node.TypeCondition, ctx, v); !cont {
+		return false
+	}
+	// Visit Directives.
+	if len(node.Directives) != 0 {
+		if cont := walkDirectives(node.Directives, ctx, v); !cont {
+			return false
+		}
+	}
+	// Visit SelectionSet.
+	if cont := walkSelectionSet(node.SelectionSet, ctx, v); !cont {
+		return false
+	}
+
+	return true
+}
+
+func walkFragmentSpread(node *ast.FragmentSpread, ctx interface{}, v *Visitor) bool {
+	if result := v.VisitFragmentSpread(node, ctx); result != Continue {
+		return result != Break
+	}
+
+	// Visit Name.
+	if cont := walkName(node.Name, ctx, v); !cont {
+		return false
+	}
+	// Visit Directives.
+	if len(node.Directives) != 0 {
+		if cont := walkDirectives(node.Directives, ctx, v); !cont {
+			return false
+		}
+	}
+
+	return true
+}
+
+func walkInlineFragment(node *ast.InlineFragment, ctx interface{}, v *Visitor) bool {
+	if result := v.VisitInlineFragment(node, ctx); result != Continue {
+		return result != Break
+	}
+
+	// Visit TypeCondition.
+	if !node.TypeCondition.Name.IsNil() {
+		if cont := walkNamedType(node.TypeCondition, ctx, v); !cont {
+			return false
+		}
+	}
+	// Visit Directives.
+	if len(node.Directives) != 0 {
+		if cont := walkDirectives(node.Directives, ctx, v); !cont {
+			return false
+		}
+	}
+	// Visit SelectionSet.
+	if cont := walkSelectionSet(node.SelectionSet, ctx, v); !cont {
+		return false
+	}
+
+	return true
+}
+
+func walkInputObjectTypeDefinition(node *ast.InputObjectTypeDefinition, ctx interface{}, v *Visitor) bool {
+	if result := v.VisitInputObjectTypeDefinition(node, ctx); result != Continue {
+		return result != Break
+	}
+
+	// Visit Description.
+	if !node.Description.IsNil() {
+		if cont := walkStringValue(node.Description, ctx, v); !cont {
+			return false
+		}
+	}
+	// Visit Name.
+	if cont := walkName(node.Name, ctx, v); !cont {
+		return false
+	}
+	// Visit Directives.
+	if len(node.Directives) != 0 {
+		if cont := walkDirectives(node.Directives, ctx, v); !cont {
+			return false
+		}
+	}
+	// Visit Fields.
+	if len(node.Fields) != 0 {
+		if cont := walkInputValueDefinitions(node.Fields, ctx, v); !cont {
+			return false
+		}
+	}
+
+	return true
+}
+
+func walkInputObjectTypeExtension(node *ast.InputObjectTypeExtension, ctx interface{}, v *Visitor) bool {
+	if result := v.VisitInputObjectTypeExtension(node, ctx); result != Continue {
+		return result != Break
+	}
+
+	// Visit Name.
+	if cont := walkName(node.Name, ctx, v); !cont {
+		return false
+	}
+	// Visit Directives.
+	if len(node.Directives) != 0 {
+		if cont := walkDirectives(node.Directives, ctx, v); !cont {
+			return false
+		}
+	}
+	// Visit Fields.
+	if len(node.Fields) != 0 {
+		if cont := walkInputValueDefinitions(node.Fields, ctx, v); !cont {
+			return false
+		}
+	}
+
+	return true
+}
+
+func walkInputValueDefinition(node *ast.InputValueDefinition, ctx interface{}, v *Visitor) bool {
+	if result := v.VisitInputValueDefinition(node, ctx); result != Continue {
+		return result != Break
+	}
+
+	// Visit Description.
+	if !node.Description.IsNil() {
+		if cont := walkStringValue(node.Description, ctx, v); !cont {
+			return false
+		}
+	}
+	// Visit Name.
+	if cont := walkName(node.Name, ctx, v); !cont {
+		return false
+	}
+	// Visit Type.
+	if cont := walkType(node.Type, ctx, v); !cont {
+		return false
+	}
+	// Visit DefaultValue.
+	if node.DefaultValue != nil {
+		if cont := walkValue(node.DefaultValue, ctx, v); !cont {
+			return false
+		}
+	}
+	// Visit Directives.
+	if len(node.Directives) != 0 {
+		if cont := walkDirectives(node.Directives, ctx, v); !cont {
+			return false
+		}
+	}
+
+	return true
+}
+
+func walkInputValueDefinitions(node ast.InputValueDefinitions, ctx interface{}, v *Visitor) bool {
+	if result := v.VisitInputValueDefinitions(node, ctx); result != Continue {
+		return result != Break
+	}
+
+	for _, childNode := range node {
+		if cont := walkInputValueDefinition(childNode, ctx, v); !cont {
+			return false
+		}
+	}
+
+	return true
+}
+
+func walkIntValue(node ast.IntValue, ctx interface{}, v *Visitor) bool {
+	if result := v.VisitIntValue(node, ctx); result != Continue {
+		return result != Break
+	}
+
+	return true
+}
+
+func walkInterfaceTypeDefinition(node *ast.InterfaceTypeDefinition, ctx interface{}, v *Visitor) bool {
+	if result := v.VisitInterfaceTypeDefinition(node, ctx); result != Continue {
+		return result != Break
+	}
+
+	// Visit Description.
+	if !node.Description.IsNil() {
+		if cont := walkStringValue(node.Description, ctx, v); !cont {
+			return false
+		}
+	}
+	// Visit Name.
+	if cont := walkName(node.Name, ctx, v); !cont {
+		return false
+	}
+	// Visit Interfaces.
+	if len(node.Interfaces) != 0 {
+		if cont := walkNamedTypes(node.Interfaces, ctx, v); !cont {
+			return false
+		}
+	}
+	// Visit Directives.
+	if len(node.Directives) != 0 {
+		if cont := walkDirectives(node.Directives, ctx, v); !cont {
+			return false
+		}
+	}
+	// Visit Fields.
+	if len(node.Fields) != 0 {
+		if cont := walkFieldDefinitions(node.Fields, ctx, v); !cont {
+			return false
+		}
+	}
+
+	return true
+}
+
+func walkInterfaceTypeExtension(node *ast.InterfaceTypeExtension, ctx interface{}, v *Visitor) bool {
+	if result := v.VisitInterfaceTypeExtension(node, ctx); result != Continue {
+		return result != Break
+	}
+
+	// Visit Name.
+	if cont := walkName(node.Name, ctx, v); !cont {
+		return false
+	}
+	// Visit Interfaces.
+	if len(node.Interfaces) != 0 {
+		if cont := walkNamedTypes(node.Interfaces, ctx, v); !cont {
+			return false
+		}
+	}
+	// Visit Directives.
+	if len(node.Directives) != 0 {
+		if cont := walkDirectives(node.Directives, ctx, v); !cont {
+			return false
+		}
+	}
+	// Visit Fields.
+	if len(node.Fields) != 0 {
+		if cont := walkFieldDefinitions(node.Fields, ctx, v); !cont {
+			return false
+		}
+	}
+
+	return true
+}
+
+func walkListType(node ast.ListType, ctx interface{}, v *Visitor) bool {
+	if result := v.VisitListType(node, ctx); result != Continue {
+		return result != Break
 	}
 
 	// Visit ItemType.
@@ -1595,6 +3152,20 @@ func walkNamedType(node ast.NamedType, ctx interface{}, v *Visitor) bool {
 	return true
 }
 
+func walkNamedTypes(node ast.NamedTypes, ctx interface{}, v *Visitor) bool {
+	if result := v.VisitNamedTypes(node, ctx); result != Continue {
+		return result != Break
+	}
+
+	for _, childNode := range node {
+		if cont := walkNamedType(childNode, ctx, v); !cont {
+			return false
+		}
+	}
+
+	return true
+}
+
 func walkNonNullType(node ast.NonNullType, ctx interface{}, v *Visitor) bool {
 	if result := v.VisitNonNullType(node, ctx); result != Continue {
 		return result != Break
@@ -1641,6 +3212,74 @@ func walkObjectField(node *ast.ObjectField, ctx interface{}, v *Visitor) bool {
 	return true
 }
 
+func walkObjectTypeDefinition(node *ast.ObjectTypeDefinition, ctx interface{}, v *Visitor) bool {
+	if result := v.VisitObjectTypeDefinition(node, ctx); result != Continue {
+		return result != Break
+	}
+
+	// Visit Description.
+	if !node.Description.IsNil() {
+		if cont := walkStringValue(node.Description, ctx, v); !cont {
+			return false
+		}
+	}
+	// Visit Name.
+	if cont := walkName(node.Name, ctx, v); !cont {
+		return false
+	}
+	// Visit Interfaces.
+	if len(node.Interfaces) != 0 {
+		if cont := walkNamedTypes(node.Interfaces, ctx, v); !cont {
+			return false
+		}
+	}
+	// Visit Directives.
+	if len(node.Directives) != 0 {
+		if cont := walkDirectives(node.Directives, ctx, v); !cont {
+			return false
+		}
+	}
+	// Visit Fields.
+	if len(node.Fields) != 0 {
+		if cont := walkFieldDefinitions(node.Fields, ctx, v); !cont {
+			return false
+		}
+	}
+
+	return true
+}
+
+func walkObjectTypeExtension(node *ast.ObjectTypeExtension, ctx interface{}, v *Visitor) bool {
+	if result := v.VisitObjectTypeExtension(node, ctx); result != Continue {
+		return result != Break
+	}
+
+	// Visit Name.
+	if cont := walkName(node.Name, ctx, v); !cont {
+		return false
+	}
+	// Visit Interfaces.
+	if len(node.Interfaces) != 0 {
+		if cont := walkNamedTypes(node.Interfaces, ctx, v); !cont {
+			return false
+		}
+	}
+	// Visit Directives.
+	if len(node.Directives) != 0 {
+		if cont := walkDirectives(node.Directives, ctx, v); !cont {
+			return false
+		}
+	}
+	// Visit Fields.
+	if len(node.Fields) != 0 {
+		if cont := walkFieldDefinitions(node.Fields, ctx, v); !cont {
+			return false
+		}
+	}
+
+	return true
+}
+
 func walkObjectValue(node ast.ObjectValue, ctx interface{}, v *Visitor) bool {
 	if result := v.VisitObjectValue(node, ctx); result != Continue {
 		return result != Break
@@ -1686,6 +3325,121 @@ func walkOperationDefinition(node *ast.OperationDefinition, ctx interface{}, v *
 	return true
 }
 
+func walkOperationTypeDefinition(node *ast.OperationTypeDefinition, ctx interface{}, v *Visitor) bool {
+	if result := v.VisitOperationTypeDefinition(node, ctx); result != Continue {
+		return result != Break
+	}
+
+	// Visit Type.
+	if cont := walkNamedType(node.Type, ctx, v); !cont {
+		return false
+	}
+
+	return true
+}
+
+func walkOperationTypeDefinitions(node ast.OperationTypeDefinitions, ctx interface{}, v *Visitor) bool {
+	if result := v.VisitOperationTypeDefinitions(node, ctx); result != Continue {
+		return result != Break
+	}
+
+	for _, childNode := range node {
+		if cont := walkOperationTypeDefinition(childNode, ctx, v); !cont {
+			return false
+		}
+	}
+
+	return true
+}
+
+func walkScalarTypeDefinition(node *ast.ScalarTypeDefinition, ctx interface{}, v *Visitor) bool {
+	if result := v.VisitScalarTypeDefinition(node, ctx); result != Continue {
+		return result != Break
+	}
+
+	// Visit Description.
+	if !node.Description.IsNil() {
+		if cont := walkStringValue(node.Description, ctx, v); !cont {
+			return false
+		}
+	}
+	// Visit Name.
+	if cont := walkName(node.Name, ctx, v); !cont {
+		return false
+	}
+	// Visit Directives.
+	if len(node.Directives) != 0 {
+		if cont := walkDirectives(node.Directives, ctx, v); !cont {
+			return false
+		}
+	}
+
+	return true
+}
+
+func walkScalarTypeExtension(node *ast.ScalarTypeExtension, ctx interface{}, v *Visitor) bool {
+	if result := v.VisitScalarTypeExtension(node, ctx); result != Continue {
+		return result != Break
+	}
+
+	// Visit Name.
+	if cont := walkName(node.Name, ctx, v); !cont {
+		return false
+	}
+	// Visit Directives.
+	if cont := walkDirectives(node.Directives, ctx, v); !cont {
+		return false
+	}
+
+	return true
+}
+
+func walkSchemaDefinition(node *ast.SchemaDefinition, ctx interface{}, v *Visitor) bool {
+	if result := v.VisitSchemaDefinition(node, ctx); result != Continue {
+		return result != Break
+	}
+
+	// Visit Description.
+	if !node.Description.IsNil() {
+		if cont := walkStringValue(node.Description, ctx, v); !cont {
+			return false
+		}
+	}
+	// Visit Directives.
+	if len(node.Directives) != 0 {
+		if cont := walkDirectives(node.Directives, ctx, v); !cont {
+			return false
+		}
+	}
+	// Visit OperationTypes.
+	if cont := walkOperationTypeDefinitions(node.OperationTypes, ctx, v); !cont {
+		return false
+	}
+
+	return true
+}
+
+func walkSchemaExtension(node *ast.SchemaExtension, ctx interface{}, v *Visitor) bool {
+	if result := v.VisitSchemaExtension(node, ctx); result != Continue {
+		return result != Break
+	}
+
+	// Visit Directives.
+	if len(node.Directives) != 0 {
+		if cont := walkDirectives(node.Directives, ctx, v); !cont {
+			return false
+		}
+	}
+	// Visit OperationTypes.
+	if len(node.OperationTypes) != 0 {
+		if cont := walkOperationTypeDefinitions(node.OperationTypes, ctx, v); !cont {
+			return false
+		}
+	}
+
+	return true
+}
+
 func walkSelectionSet(node ast.SelectionSet, ctx interface{}, v *Visitor) bool {
 	if result := v.VisitSelectionSet(node, ctx); result != Continue {
 		return result != Break
@@ -1708,6 +3462,62 @@ func walkStringValue(node ast.StringValue, ctx interface{}, v *Visitor) bool {
 	return true
 }
 
+func walkUnionTypeDefinition(node *ast.UnionTypeDefinition, ctx interface{}, v *Visitor) bool {
+	if result := v.VisitUnionTypeDefinition(node, ctx); result != Continue {
+		return result != Break
+	}
+
+	// Visit Description.
+	if !node.Description.IsNil() {
+		if cont := walkStringValue(node.Description, ctx, v); !cont {
+			return false
+		}
+	}
+	// Visit Name.
+	if cont := walkName(node.Name, ctx, v); !cont {
+		return false
+	}
+	// Visit Directives.
+	if len(node.Directives) != 0 {
+		if cont := walkDirectives(node.Directives, ctx, v); !cont {
+			return false
+		}
+	}
+	// Visit Types.
+	if len(node.Types) != 0 {
+		if cont := walkNamedTypes(node.Types, ctx, v); !cont {
+			return false
+		}
+	}
+
+	return true
+}
+
+func walkUnionTypeExtension(node *ast.UnionTypeExtension, ctx interface{}, v *Visitor) bool {
+	if result := v.VisitUnionTypeExtension(node, ctx); result != Continue {
+		return result != Break
+	}
+
+	// Visit Name.
+	if cont := walkName(node.Name, ctx, v); !cont {
+		return false
+	}
+	// Visit Directives.
+	if len(node.Directives) != 0 {
+		if cont := walkDirectives(node.Directives, ctx, v); !cont {
+			return false
+		}
+	}
+	// Visit Types.
+	if len(node.Types) != 0 {
+		if cont := walkNamedTypes(node.Types, ctx, v); !cont {
+			return false
+		}
+	}
+
+	return true
+}
+
 func walkVariable(node ast.Variable, ctx interface{}, v *Visitor) bool {
 	if result := v.VisitVariable(node, ctx); result != Continue {
 		return result != Break
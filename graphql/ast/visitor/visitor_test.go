@@ -179,6 +179,123 @@ var _ = Describe("Visitor", func() {
 		}))
 	})
 
+	It("visits type system definitions and extensions", func() {
+		var visited [][2]interface{}
+
+		doc := parse(`
+schema { query: Query }
+"Description" type Query implements Node @onObject { field(arg: Int = 1): [String!] }
+interface Node { id: ID }
+union U = A | B
+enum E { V @onEnumValue }
+input I { f: String = "s" }
+scalar S
+directive @d(a: Int) on FIELD | OBJECT
+extend type Query implements Node @onObject { other: Int }
+extend schema @onSchema
+`)
+
+		visitor.Walk(doc, nil, visitor.NewNodeVisitor(
+			visitor.NodeVisitActionFunc(func(node ast.Node, ctx interface{}) visitor.Result {
+				visited = append(visited, [2]interface{}{
+					nodeKind(node),
+					nodeValue(node),
+				})
+				return visitor.Continue
+			})))
+
+		Expect(visited).Should(Equal([][2]interface{}{
+			{"Document", nil},
+			{"Definitions", nil},
+			{"SchemaDefinition", nil},
+			{"OperationTypeDefinitions", nil},
+			{"OperationTypeDefinition", nil},
+			{"NamedType", nil},
+			{"Name", "Query"},
+			{"ObjectTypeDefinition", nil},
+			{"StringValue", "Description"},
+			{"Name", "Query"},
+			{"NamedTypes", nil},
+			{"NamedType", nil},
+			{"Name", "Node"},
+			{"Directives", nil},
+			{"Directive", nil},
+			{"Name", "onObject"},
+			{"FieldDefinitions", nil},
+			{"FieldDefinition", nil},
+			{"Name", "field"},
+			{"InputValueDefinitions", nil},
+			{"InputValueDefinition", nil},
+			{"Name", "arg"},
+			{"NamedType", nil},
+			{"Name", "Int"},
+			{"IntValue", int32(1)},
+			{"ListType", nil},
+			{"NonNullType", nil},
+			{"NamedType", nil},
+			{"Name", "String"},
+			{"InterfaceTypeDefinition", nil},
+			{"Name", "Node"},
+			{"FieldDefinitions", nil},
+			{"FieldDefinition", nil},
+			{"Name", "id"},
+			{"NamedType", nil},
+			{"Name", "ID"},
+			{"UnionTypeDefinition", nil},
+			{"Name", "U"},
+			{"NamedTypes", nil},
+			{"NamedType", nil},
+			{"Name", "A"},
+			{"NamedType", nil},
+			{"Name", "B"},
+			{"EnumTypeDefinition", nil},
+			{"Name", "E"},
+			{"EnumValueDefinitions", nil},
+			{"EnumValueDefinition", nil},
+			{"Name", "V"},
+			{"Directives", nil},
+			{"Directive", nil},
+			{"Name", "onEnumValue"},
+			{"InputObjectTypeDefinition", nil},
+			{"Name", "I"},
+			{"InputValueDefinitions", nil},
+			{"InputValueDefinition", nil},
+			{"Name", "f"},
+			{"NamedType", nil},
+			{"Name", "String"},
+			{"StringValue", "s"},
+			{"ScalarTypeDefinition", nil},
+			{"Name", "S"},
+			{"DirectiveDefinition", nil},
+			{"Name", "d"},
+			{"InputValueDefinitions", nil},
+			{"InputValueDefinition", nil},
+			{"Name", "a"},
+			{"NamedType", nil},
+			{"Name", "Int"},
+			{"DirectiveLocations", nil},
+			{"Name", "FIELD"},
+			{"Name", "OBJECT"},
+			{"ObjectTypeExtension", nil},
+			{"Name", "Query"},
+			{"NamedTypes", nil},
+			{"NamedType", nil},
+			{"Name", "Node"},
+			{"Directives", nil},
+			{"Directive", nil},
+			{"Name", "onObject"},
+			{"FieldDefinitions", nil},
+			{"FieldDefinition", nil},
+			{"Name", "other"},
+			{"NamedType", nil},
+			{"Name", "Int"},
+			{"SchemaExtension", nil},
+			{"Directives", nil},
+			{"Directive", nil},
+			{"Name", "onSchema"},
+		}))
+	})
+
 	It("visits kitchen sink", func() {
 		kitchenSink, err := ioutil.ReadFile("../../parser/kitchen-sink.graphql")
 		Expect(err).ShouldNot(HaveOccurred())
@@ -0,0 +1,250 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package graphql
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/botobag/artemis/graphql/ast"
+	"github.com/botobag/artemis/graphql/token"
+)
+
+// integerStringRegExp matches a string that represents an integer.
+var integerStringRegExp = regexp.MustCompile(`^-?(?:0|[1-9][0-9]*)$`)
+
+// newToken creates a token for an AST node that is not parsed from a source.
+func newToken(kind token.Kind, value string) *token.Token {
+	return &token.Token{
+		Kind:  kind,
+		Value: value,
+	}
+}
+
+// ASTFromValue produces a GraphQL Value AST given a Go value and its GraphQL type. It is the inverse
+// of the input coercion: the value is an internal value (e.g., a default value of an argument) and
+// the AST is a literal that would be coerced to the value.
+//
+// Leaf values are serialized with CoerceResultValue of their types before being converted to AST.
+// Therefore a scalar whose result coercer produces a bool, a number or a string can be converted.
+// A string that is serialized from ID and represents an integer is converted to an IntValue.
+//
+// | Go Value                 | GraphQL Value        |
+// | ------------------------ | -------------------- |
+// | map[string]interface{}   | Input Object         |
+// | slice or array           | List                 |
+// | bool                     | Boolean              |
+// | string                   | String / Enum Value  |
+// | integers                 | Int                  |
+// | floats                   | Int / Float          |
+// | enum internal value      | Enum Value           |
+// | nil                      | NullValue            |
+//
+// It returns a nil ast.Value if the value cannot be represented with the type (i.e., nil for
+// Non-Null type).
+//
+// Reference: graphql-js/src/utilities/astFromValue.js
+func ASTFromValue(value interface{}, t Type) (ast.Value, error) {
+	if t, ok := t.(NonNull); ok {
+		astValue, err := ASTFromValue(value, t.InnerType())
+		if err != nil {
+			return nil, err
+		}
+		if _, isNull := astValue.(ast.NullValue); isNull {
+			return nil, nil
+		}
+		return astValue, nil
+	}
+
+	// Only explicit nil. (Note that "undefined" value cannot be represented in Go.)
+	if value == nil {
+		return ast.NullValue{
+			Token: newToken(token.KindName, "null"),
+		}, nil
+	}
+
+	switch t := t.(type) {
+	case List:
+		// Convert Go array to GraphQL list. If the itemType is a list, that means we have to convert
+		// each item to a list value.
+		itemType := t.ElementType()
+		v := reflect.ValueOf(value)
+		if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+			// Not a list. Treat the value as a list with a single item.
+			return ASTFromValue(value, itemType)
+		}
+
+		numItems := v.Len()
+		values := make([]ast.Value, 0, numItems)
+		for i := 0; i < numItems; i++ {
+			itemValue, err := ASTFromValue(v.Index(i).Interface(), itemType)
+			if err != nil {
+				return nil, err
+			}
+			if itemValue != nil {
+				values = append(values, itemValue)
+			}
+		}
+
+		if len(values) == 0 {
+			return ast.ListValue{
+				ValuesOrStartToken: newToken(token.KindLeftBracket, ""),
+			}, nil
+		}
+		return ast.ListValue{
+			ValuesOrStartToken: values,
+		}, nil
+
+	case InputObject:
+		// Populate the fields of the input object by creating ASTs from each value in the map according
+		// to the fields in the input type.
+		fieldValues, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, NewError(fmt.Sprintf("Cannot convert value to AST: %s is not an object for %s.",
+				Inspect(value), t.Name()))
+		}
+
+		// Sort field names to produce a deterministic result.
+		fields := t.Fields()
+		fieldNames := make([]string, 0, len(fields))
+		for name := range fields {
+			fieldNames = append(fieldNames, name)
+		}
+		sort.Strings(fieldNames)
+
+		var fieldNodes []*ast.ObjectField
+		for _, name := range fieldNames {
+			fieldValue, exists := fieldValues[name]
+			if !exists {
+				continue
+			}
+
+			fieldNode, err := ASTFromValue(fieldValue, fields[name].Type())
+			if err != nil {
+				return nil, err
+			}
+			if fieldNode != nil {
+				fieldNodes = append(fieldNodes, &ast.ObjectField{
+					Name: ast.Name{
+						Token: newToken(token.KindName, name),
+					},
+					Value: fieldNode,
+				})
+			}
+		}
+
+		if len(fieldNodes) == 0 {
+			return ast.ObjectValue{
+				FieldsOrStartToken: newToken(token.KindLeftBrace, ""),
+			}, nil
+		}
+		return ast.ObjectValue{
+			FieldsOrStartToken: fieldNodes,
+		}, nil
+
+	case Enum:
+		// Find the enum value whose internal value matches the value.
+		for name, enumValue := range t.Values() {
+			if reflect.DeepEqual(enumValue.Value(), value) {
+				return ast.EnumValue{
+					Token: newToken(token.KindName, name),
+				}, nil
+			}
+		}
+
+		// Try its result coercer.
+		name, err := t.CoerceResultValue(value)
+		if err != nil {
+			return nil, err
+		}
+		return ast.EnumValue{
+			Token: newToken(token.KindName, name.(string)),
+		}, nil
+
+	case Scalar:
+		// Since value is an internal value, it must be serialized prior to being converted to AST.
+		serialized, err := t.CoerceResultValue(value)
+		if err != nil {
+			return nil, err
+		}
+		if serialized == nil {
+			return nil, nil
+		}
+		return scalarValueToAST(serialized, t)
+	}
+
+	return nil, NewError(fmt.Sprintf("Unknown type: %s.", Inspect(t)))
+}
+
+// scalarValueToAST converts a serialized scalar value into AST.
+func scalarValueToAST(value interface{}, t Scalar) (ast.Value, error) {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			return ast.BooleanValue{
+				Token: newToken(token.KindName, "true"),
+			}, nil
+		}
+		return ast.BooleanValue{
+			Token: newToken(token.KindName, "false"),
+		}, nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return ast.IntValue{
+			Token: newToken(token.KindInt, strconv.FormatInt(v.Int(), 10)),
+		}, nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return ast.IntValue{
+			Token: newToken(token.KindInt, strconv.FormatUint(v.Uint(), 10)),
+		}, nil
+
+	case reflect.Float32, reflect.Float64:
+		f := v.Float()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			break
+		}
+		// Floats that are integral are represented as IntValue.
+		if f == math.Trunc(f) && math.Abs(f) < 1e21 {
+			return ast.IntValue{
+				Token: newToken(token.KindInt, strconv.FormatFloat(f, 'f', -1, 64)),
+			}, nil
+		}
+		return ast.FloatValue{
+			Token: newToken(token.KindFloat, strconv.FormatFloat(f, 'g', -1, 64)),
+		}, nil
+
+	case reflect.String:
+		s := v.String()
+		// ID types can use Int literals.
+		if t == ID() && integerStringRegExp.MatchString(s) {
+			return ast.IntValue{
+				Token: newToken(token.KindInt, s),
+			}, nil
+		}
+		return ast.StringValue{
+			Token: newToken(token.KindString, s),
+		}, nil
+	}
+
+	return nil, NewError(fmt.Sprintf("Cannot convert value to AST: %s.", Inspect(value)))
+}
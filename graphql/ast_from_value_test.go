@@ -0,0 +1,189 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package graphql_test
+
+import (
+	"math"
+
+	"github.com/botobag/artemis/graphql"
+	"github.com/botobag/artemis/graphql/ast"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// graphql-js/src/utilities/__tests__/astFromValue-test.js@f529809
+var _ = Describe("ASTFromValue", func() {
+	// printValue converts value into AST and prints the result.
+	printValue := func(value interface{}, t graphql.Type) string {
+		astValue, err := graphql.ASTFromValue(value, t)
+		Expect(err).ShouldNot(HaveOccurred())
+		if astValue == nil {
+			return "<nil>"
+		}
+		return ast.Print(astValue)
+	}
+
+	expectError := func(value interface{}, t graphql.Type) {
+		_, err := graphql.ASTFromValue(value, t)
+		Expect(err).Should(HaveOccurred())
+	}
+
+	It("converts boolean values to ASTs", func() {
+		Expect(printValue(true, graphql.Boolean())).Should(Equal("true"))
+		Expect(printValue(false, graphql.Boolean())).Should(Equal("false"))
+		Expect(printValue(nil, graphql.Boolean())).Should(Equal("null"))
+		Expect(printValue(0, graphql.Boolean())).Should(Equal("false"))
+		Expect(printValue(1, graphql.Boolean())).Should(Equal("true"))
+
+		nonNullBoolean := graphql.MustNewNonNullOfType(graphql.Boolean())
+		Expect(printValue(0, nonNullBoolean)).Should(Equal("false"))
+	})
+
+	It("converts Int values to Int ASTs", func() {
+		Expect(printValue(-1, graphql.Int())).Should(Equal("-1"))
+		Expect(printValue(123.0, graphql.Int())).Should(Equal("123"))
+		Expect(printValue(1e4, graphql.Int())).Should(Equal("10000"))
+
+		// GraphQL spec does not allow coercing non-integer values to Int to avoid accidental data loss.
+		expectError(123.5, graphql.Int())
+
+		// Note: outside the bounds of 32bit signed int.
+		expectError(1e40, graphql.Int())
+	})
+
+	It("converts Float values to Int/Float ASTs", func() {
+		Expect(printValue(-1, graphql.Float())).Should(Equal("-1"))
+		Expect(printValue(123.0, graphql.Float())).Should(Equal("123"))
+		Expect(printValue(123.5, graphql.Float())).Should(Equal("123.5"))
+		Expect(printValue(1e4, graphql.Float())).Should(Equal("10000"))
+		Expect(printValue(1e40, graphql.Float())).Should(Equal("1e+40"))
+		expectError(math.Inf(1), graphql.Float())
+	})
+
+	It("converts String values to String ASTs", func() {
+		Expect(printValue("hello", graphql.String())).Should(Equal(`"hello"`))
+		Expect(printValue("VALUE", graphql.String())).Should(Equal(`"VALUE"`))
+		Expect(printValue("VA\nLUE", graphql.String())).Should(Equal(`"VA\nLUE"`))
+		Expect(printValue(123, graphql.String())).Should(Equal(`"123"`))
+		Expect(printValue(false, graphql.String())).Should(Equal(`"false"`))
+		Expect(printValue(nil, graphql.String())).Should(Equal("null"))
+	})
+
+	It("converts ID values to Int/String ASTs", func() {
+		Expect(printValue("hello", graphql.ID())).Should(Equal(`"hello"`))
+		Expect(printValue("VALUE", graphql.ID())).Should(Equal(`"VALUE"`))
+
+		// Note: EnumValues cannot contain non-identifier characters
+		Expect(printValue("VA\nLUE", graphql.ID())).Should(Equal(`"VA\nLUE"`))
+
+		// Note: IntValues are used when possible.
+		Expect(printValue(-1, graphql.ID())).Should(Equal("-1"))
+		Expect(printValue(123, graphql.ID())).Should(Equal("123"))
+		Expect(printValue("01", graphql.ID())).Should(Equal(`"01"`))
+
+		expectError(false, graphql.ID())
+
+		Expect(printValue(nil, graphql.ID())).Should(Equal("null"))
+	})
+
+	It("does not converts NonNull values to NullValue", func() {
+		nonNullBoolean := graphql.MustNewNonNullOfType(graphql.Boolean())
+		Expect(printValue(nil, nonNullBoolean)).Should(Equal("<nil>"))
+	})
+
+	complexValue := map[string]interface{}{
+		"someArbitrary": "complexValue",
+	}
+
+	myEnum := graphql.MustNewEnum(&graphql.EnumConfig{
+		Name: "MyEnum",
+		Values: graphql.EnumValueDefinitionMap{
+			"HELLO":   {},
+			"GOODBYE": {},
+			"COMPLEX": {
+				Value: complexValue,
+			},
+		},
+	})
+
+	It("converts string values to Enum ASTs if possible", func() {
+		Expect(printValue("HELLO", myEnum)).Should(Equal("HELLO"))
+		Expect(printValue(complexValue, myEnum)).Should(Equal("COMPLEX"))
+
+		// Note: case sensitive
+		expectError("hello", myEnum)
+
+		// Note: Not a valid enum value
+		expectError("VALUE", myEnum)
+	})
+
+	It("converts array values to List ASTs", func() {
+		Expect(printValue([]string{"FOO", "BAR"}, graphql.MustNewListOfType(graphql.String()))).Should(
+			Equal(`["FOO", "BAR"]`))
+
+		Expect(printValue([]interface{}{"HELLO", "GOODBYE"}, graphql.MustNewListOfType(myEnum))).Should(
+			Equal(`[HELLO, GOODBYE]`))
+
+		Expect(printValue([]interface{}{}, graphql.MustNewListOfType(graphql.Int()))).Should(Equal(`[]`))
+	})
+
+	It("converts list singletons", func() {
+		Expect(printValue("FOO", graphql.MustNewListOfType(graphql.String()))).Should(Equal(`"FOO"`))
+	})
+
+	It("converts input objects", func() {
+		inputObj := graphql.MustNewInputObject(&graphql.InputObjectConfig{
+			Name: "MyInputObj",
+			Fields: graphql.InputFields{
+				"foo": {
+					Type: graphql.T(graphql.Float()),
+				},
+				"bar": {
+					Type: graphql.T(myEnum),
+				},
+			},
+		})
+
+		Expect(printValue(map[string]interface{}{
+			"foo": 3,
+			"bar": "HELLO",
+		}, inputObj)).Should(Equal(`{bar: HELLO, foo: 3}`))
+
+		Expect(printValue(map[string]interface{}{}, inputObj)).Should(Equal(`{}`))
+
+		expectError("foo", inputObj)
+	})
+
+	It("converts input objects with explicit nulls", func() {
+		inputObj := graphql.MustNewInputObject(&graphql.InputObjectConfig{
+			Name: "MyInputObjWithNulls",
+			Fields: graphql.InputFields{
+				"foo": {
+					Type: graphql.T(graphql.Float()),
+				},
+				"bar": {
+					Type: graphql.T(myEnum),
+				},
+			},
+		})
+
+		Expect(printValue(map[string]interface{}{
+			"foo": nil,
+		}, inputObj)).Should(Equal(`{foo: null}`))
+	})
+})
@@ -0,0 +1,349 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package graphql
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/botobag/artemis/internal/util"
+)
+
+// DecodeTagName is the key of the struct field tag used by ArgumentValues.Decode and
+// VariableValues.Decode to find the name of the argument (or input field, or variable) for a struct
+// field. The name is the first comma-separated part of the tag value. The rest options (if any) are
+// ignored. A struct field with tag "-" is skipped. Fields in anonymous embedded structs of exported
+// types are promoted. If the name is not specified, the name of the
+// struct field in lower camel case (e.g., "firstName" for FirstName and "id" for ID) is used.
+const DecodeTagName = "graphql"
+
+// Decode stores the argument values into the struct pointed by dst. Values for enums and scalars
+// are assigned to the struct fields if they're assignable or can be converted to the field types
+// without losing precision. Specifically, an integer is decoded into an integer field only if it's
+// in the range of the field type and into a float field only if the float represents it exactly. A
+// float decoded into a float32 field is rounded to the nearest float32 but must be in its range.
+// Input objects are decoded into structs (or maps with string keys) and
+// lists are decoded into slices (or arrays). A pointer field is allocated for non-null value and
+// left nil if the value is null or not given. A string value is also accepted by the field whose
+// type implements encoding.TextUnmarshaler.
+//
+// Decode returns an error that describes the path to the value that cannot be decoded (e.g.,
+// "filter.episodes[1]").
+func (args ArgumentValues) Decode(dst interface{}) error {
+	return decodeValueMap(args.values, dst, "argument")
+}
+
+// Decode stores the variable values into the struct pointed by dst. See ArgumentValues.Decode for
+// the details.
+func (vars VariableValues) Decode(dst interface{}) error {
+	return decodeValueMap(vars.values, dst, "variable")
+}
+
+// decodeValueMap decodes values into the struct pointed by dst. kind is used in error messages to
+// describe the values.
+func decodeValueMap(values map[string]interface{}, dst interface{}, kind string) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return NewError(fmt.Sprintf("Decode expects a non-nil pointer to a struct, but got %T", dst))
+	}
+
+	if err := decodeStruct(v.Elem(), values, ""); err != nil {
+		return NewError(fmt.Sprintf(`cannot decode %s value at "%s": %s`, kind, err.path, err.message))
+	}
+
+	return nil
+}
+
+// decodeError is returned by the decode functions to describe the value that fails.
+type decodeError struct {
+	path    string
+	message string
+}
+
+func newDecodeError(path string, value interface{}, t reflect.Type) *decodeError {
+	return &decodeError{
+		path:    path,
+		message: fmt.Sprintf("cannot decode %T into %s", value, t),
+	}
+}
+
+// decodeField describes a struct field to be decoded.
+type decodeField struct {
+	name  string
+	index []int
+}
+
+// decodeFieldsCache caches the []decodeField for a struct type.
+var decodeFieldsCache util.SyncMap
+
+// decodeFieldsOf returns the fields to be decoded in the given struct type.
+func decodeFieldsOf(t reflect.Type) []decodeField {
+	if fields, ok := decodeFieldsCache.Load(t); ok {
+		return fields.([]decodeField)
+	}
+	fields, _ := decodeFieldsCache.LoadOrStore(t, appendDecodeFields(nil, t, nil))
+	return fields.([]decodeField)
+}
+
+func appendDecodeFields(fields []decodeField, t reflect.Type, parentIndex []int) []decodeField {
+	for i, numFields := 0, t.NumField(); i < numFields; i++ {
+		field := t.Field(i)
+
+		tag := field.Tag.Get(DecodeTagName)
+		if tag == "-" {
+			continue
+		}
+		name := tag
+		if comma := strings.IndexByte(tag, ','); comma >= 0 {
+			name = tag[:comma]
+		}
+
+		// Skip unexported fields. This includes embedded structs of unexported types whose fields
+		// cannot be set with reflection.
+		if len(field.PkgPath) > 0 {
+			continue
+		}
+
+		index := make([]int, len(parentIndex)+1)
+		copy(index, parentIndex)
+		index[len(parentIndex)] = i
+
+		// Promote fields in anonymous embedded structs.
+		if field.Anonymous && len(name) == 0 {
+			fieldType := field.Type
+			if fieldType.Kind() == reflect.Ptr {
+				fieldType = fieldType.Elem()
+			}
+			if fieldType.Kind() == reflect.Struct {
+				fields = appendDecodeFields(fields, fieldType, index)
+				continue
+			}
+		}
+
+		if len(name) == 0 {
+			name = util.LowerCamelCase(field.Name)
+		}
+
+		fields = append(fields, decodeField{
+			name:  name,
+			index: index,
+		})
+	}
+
+	return fields
+}
+
+// fieldByIndex is like reflect.Value.FieldByIndex but allocates nil embedded struct pointers.
+func fieldByIndex(v reflect.Value, index []int) reflect.Value {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v
+}
+
+func appendPath(path string, name string) string {
+	if len(path) == 0 {
+		return name
+	}
+	return path + "." + name
+}
+
+// decodeStruct decodes values into the struct fields in dst.
+func decodeStruct(dst reflect.Value, values map[string]interface{}, path string) *decodeError {
+	for _, field := range decodeFieldsOf(dst.Type()) {
+		value, exists := values[field.name]
+		if !exists {
+			continue
+		}
+		if err := decodeValue(fieldByIndex(dst, field.index), value, appendPath(path, field.name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+// decodeValue decodes a coerced input value into dst.
+func decodeValue(dst reflect.Value, value interface{}, path string) *decodeError {
+	if value == nil {
+		dst.Set(reflect.Zero(dst.Type()))
+		return nil
+	}
+
+	v := reflect.ValueOf(value)
+	if v.Type().AssignableTo(dst.Type()) {
+		dst.Set(v)
+		return nil
+	}
+
+	if s, ok := value.(string); ok && reflect.PtrTo(dst.Type()).Implements(textUnmarshalerType) {
+		if err := dst.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s)); err != nil {
+			return &decodeError{
+				path:    path,
+				message: err.Error(),
+			}
+		}
+		return nil
+	}
+
+	switch dst.Kind() {
+	case reflect.Ptr:
+		elem := reflect.New(dst.Type().Elem())
+		if err := decodeValue(elem.Elem(), value, path); err != nil {
+			return err
+		}
+		dst.Set(elem)
+		return nil
+
+	case reflect.Struct:
+		if fields, ok := value.(map[string]interface{}); ok {
+			return decodeStruct(dst, fields, path)
+		}
+
+	case reflect.Map:
+		fields, ok := value.(map[string]interface{})
+		if !ok || dst.Type().Key().Kind() != reflect.String {
+			break
+		}
+		m := reflect.MakeMapWithSize(dst.Type(), len(fields))
+		elemType := dst.Type().Elem()
+		for name, fieldValue := range fields {
+			elem := reflect.New(elemType).Elem()
+			if err := decodeValue(elem, fieldValue, appendPath(path, name)); err != nil {
+				return err
+			}
+			m.SetMapIndex(reflect.ValueOf(name).Convert(dst.Type().Key()), elem)
+		}
+		dst.Set(m)
+		return nil
+
+	case reflect.Slice, reflect.Array:
+		items, ok := value.([]interface{})
+		if !ok {
+			break
+		}
+		if dst.Kind() == reflect.Slice {
+			dst.Set(reflect.MakeSlice(dst.Type(), len(items), len(items)))
+		} else if dst.Len() != len(items) {
+			return &decodeError{
+				path:    path,
+				message: fmt.Sprintf("cannot decode %d items into %s", len(items), dst.Type()),
+			}
+		}
+		for i, item := range items {
+			if err := decodeValue(dst.Index(i), item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		switch v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			if !dst.OverflowInt(v.Int()) {
+				dst.SetInt(v.Int())
+				return nil
+			}
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			if u := v.Uint(); int64(u) >= 0 && !dst.OverflowInt(int64(u)) {
+				dst.SetInt(int64(u))
+				return nil
+			}
+		}
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		switch v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			if i := v.Int(); i >= 0 && !dst.OverflowUint(uint64(i)) {
+				dst.SetUint(uint64(i))
+				return nil
+			}
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			if !dst.OverflowUint(v.Uint()) {
+				dst.SetUint(v.Uint())
+				return nil
+			}
+		}
+
+	case reflect.Float32, reflect.Float64:
+		switch v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			if f, ok := intToFloat(v.Int(), dst.Type().Bits()); ok {
+				dst.SetFloat(f)
+				return nil
+			}
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			if f, ok := uintToFloat(v.Uint(), dst.Type().Bits()); ok {
+				dst.SetFloat(f)
+				return nil
+			}
+		case reflect.Float32, reflect.Float64:
+			if !dst.OverflowFloat(v.Float()) {
+				dst.SetFloat(v.Float())
+				return nil
+			}
+		}
+
+	case reflect.String, reflect.Bool:
+		// Handle named string and bool types (e.g., enum values).
+		if v.Kind() == dst.Kind() {
+			dst.Set(v.Convert(dst.Type()))
+			return nil
+		}
+	}
+
+	return newDecodeError(path, value, dst.Type())
+}
+
+// Range of float64 values that can be converted into int64 and uint64 without overflow
+const (
+	minFloat64ForInt64  float64 = -(1 << 63)
+	maxFloat64ForInt64  float64 = 1 << 63
+	maxFloat64ForUint64 float64 = 1 << 64
+)
+
+// roundFloat rounds f to the nearest float of the given bit size (either 32 or 64).
+func roundFloat(f float64, bitSize int) float64 {
+	if bitSize == 32 {
+		return float64(float32(f))
+	}
+	return f
+}
+
+// intToFloat converts i to a float of the given bit size. It returns false if the float cannot
+// represent i exactly.
+func intToFloat(i int64, bitSize int) (float64, bool) {
+	f := roundFloat(float64(i), bitSize)
+	// Check the range first because converting an out-of-range float to int64 is
+	// implementation-specific.
+	return f, f >= minFloat64ForInt64 && f < maxFloat64ForInt64 && int64(f) == i
+}
+
+// uintToFloat is the same as intToFloat but for uint64.
+func uintToFloat(u uint64, bitSize int) (float64, bool) {
+	f := roundFloat(float64(u), bitSize)
+	return f, f < maxFloat64ForUint64 && uint64(f) == u
+}
@@ -0,0 +1,228 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package graphql_test
+
+import (
+	"math"
+	"time"
+
+	"github.com/botobag/artemis/graphql"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type decodeEpisode int
+
+const (
+	decodeNewHope decodeEpisode = iota + 4
+	decodeEmpire
+	decodeJedi
+)
+
+type decodeColor string
+
+type DecodeTimestamps struct {
+	Since *time.Time
+	Until *time.Time `graphql:"before"`
+}
+
+type decodeFilter struct {
+	*DecodeTimestamps
+	Episodes  []decodeEpisode
+	MinHeight *float64
+	Colors    [2]decodeColor
+	Extras    map[string]int
+}
+
+type decodeArgs struct {
+	ID       string `graphql:"id,description=ignored"`
+	Count    int8
+	Limit    *uint
+	Ratio    float32
+	Enabled  bool
+	Filter   *decodeFilter
+	Filters  []decodeFilter
+	Ignored  string `graphql:"-"`
+	internal string
+}
+
+var _ = Describe("Decode", func() {
+	It("decodes argument values into struct", func() {
+		since := time.Date(1977, time.May, 25, 0, 0, 0, 0, time.UTC)
+
+		args := graphql.NewArgumentValues(map[string]interface{}{
+			"id":      "1000",
+			"count":   12,
+			"limit":   int64(100),
+			"ratio":   0.5,
+			"enabled": true,
+			"ignored": "x",
+			"filter": map[string]interface{}{
+				"episodes":  []interface{}{decodeNewHope, decodeJedi},
+				"minHeight": 1,
+				"colors":    []interface{}{"RED", decodeColor("BLUE")},
+				"extras": map[string]interface{}{
+					"a": 1,
+				},
+				"since":  since,
+				"before": "1983-05-25T00:00:00Z",
+			},
+			"filters": []interface{}{
+				map[string]interface{}{
+					"episodes":  []interface{}{decodeEmpire},
+					"minHeight": nil,
+				},
+			},
+		})
+
+		var result decodeArgs
+		Expect(args.Decode(&result)).Should(Succeed())
+
+		limit := uint(100)
+		minHeight := 1.0
+		until := time.Date(1983, time.May, 25, 0, 0, 0, 0, time.UTC)
+		Expect(result).Should(Equal(decodeArgs{
+			ID:      "1000",
+			Count:   12,
+			Limit:   &limit,
+			Ratio:   0.5,
+			Enabled: true,
+			Filter: &decodeFilter{
+				DecodeTimestamps: &DecodeTimestamps{
+					Since: &since,
+					Until: &until,
+				},
+				Episodes:  []decodeEpisode{decodeNewHope, decodeJedi},
+				MinHeight: &minHeight,
+				Colors:    [2]decodeColor{"RED", "BLUE"},
+				Extras: map[string]int{
+					"a": 1,
+				},
+			},
+			Filters: []decodeFilter{
+				{
+					Episodes: []decodeEpisode{decodeEmpire},
+				},
+			},
+		}))
+	})
+
+	It("decodes variable values into struct", func() {
+		vars := graphql.NewVariableValues(map[string]interface{}{
+			"id":    "1000",
+			"limit": nil,
+		})
+
+		limit := uint(1)
+		result := decodeArgs{
+			Limit: &limit,
+		}
+		Expect(vars.Decode(&result)).Should(Succeed())
+		Expect(result).Should(Equal(decodeArgs{
+			ID: "1000",
+		}))
+	})
+
+	It("rejects non-pointer destination", func() {
+		Expect(graphql.NoArgumentValues().Decode(decodeArgs{})).Should(MatchError(
+			"Decode expects a non-nil pointer to a struct, but got graphql_test.decodeArgs"))
+		Expect(graphql.NoArgumentValues().Decode((*decodeArgs)(nil))).Should(MatchError(
+			"Decode expects a non-nil pointer to a struct, but got *graphql_test.decodeArgs"))
+	})
+
+	It("decodes numbers into float fields if they fit", func() {
+		var result decodeArgs
+		Expect(graphql.NewArgumentValues(map[string]interface{}{
+			"ratio": 1 << 24,
+			"filter": map[string]interface{}{
+				"minHeight": int64(math.MinInt64),
+			},
+		}).Decode(&result)).Should(Succeed())
+		Expect(result.Ratio).Should(Equal(float32(1 << 24)))
+		Expect(*result.Filter.MinHeight).Should(Equal(float64(math.MinInt64)))
+
+		// Floats are rounded to the nearest float32.
+		Expect(graphql.NewArgumentValues(map[string]interface{}{
+			"ratio": 0.1,
+			"filter": map[string]interface{}{
+				"minHeight": uint64(1 << 63),
+			},
+		}).Decode(&result)).Should(Succeed())
+		Expect(result.Ratio).Should(Equal(float32(0.1)))
+		Expect(*result.Filter.MinHeight).Should(Equal(float64(1 << 63)))
+
+		Expect(graphql.NewArgumentValues(map[string]interface{}{
+			"ratio": -math.MaxFloat32,
+		}).Decode(&result)).Should(Succeed())
+		Expect(result.Ratio).Should(Equal(float32(-math.MaxFloat32)))
+	})
+
+	It("reports path to the value that cannot be decoded", func() {
+		var result decodeArgs
+
+		Expect(graphql.NewArgumentValues(map[string]interface{}{
+			"count": 1000,
+		}).Decode(&result)).Should(MatchError(`cannot decode argument value at "count": cannot decode int into int8`))
+
+		Expect(graphql.NewArgumentValues(map[string]interface{}{
+			"filters": []interface{}{
+				map[string]interface{}{},
+				map[string]interface{}{
+					"episodes": []interface{}{decodeJedi, "EMPIRE"},
+				},
+			},
+		}).Decode(&result)).Should(MatchError(
+			`cannot decode argument value at "filters[1].episodes[1]": cannot decode string into graphql_test.decodeEpisode`))
+
+		Expect(graphql.NewArgumentValues(map[string]interface{}{
+			"filter": map[string]interface{}{
+				"colors": []interface{}{"RED"},
+			},
+		}).Decode(&result)).Should(MatchError(
+			`cannot decode argument value at "filter.colors": cannot decode 1 items into [2]graphql_test.decodeColor`))
+
+		Expect(graphql.NewArgumentValues(map[string]interface{}{
+			"ratio": math.MaxFloat64,
+		}).Decode(&result)).Should(MatchError(`cannot decode argument value at "ratio": cannot decode float64 into float32`))
+
+		Expect(graphql.NewArgumentValues(map[string]interface{}{
+			"ratio": 1<<24 + 1,
+		}).Decode(&result)).Should(MatchError(`cannot decode argument value at "ratio": cannot decode int into float32`))
+
+		Expect(graphql.NewArgumentValues(map[string]interface{}{
+			"filter": map[string]interface{}{
+				"minHeight": int64(1<<53 + 1),
+			},
+		}).Decode(&result)).Should(MatchError(
+			`cannot decode argument value at "filter.minHeight": cannot decode int64 into float64`))
+
+		Expect(graphql.NewArgumentValues(map[string]interface{}{
+			"filter": map[string]interface{}{
+				"minHeight": uint64(math.MaxUint64),
+			},
+		}).Decode(&result)).Should(MatchError(
+			`cannot decode argument value at "filter.minHeight": cannot decode uint64 into float64`))
+
+		Expect(graphql.NewVariableValues(map[string]interface{}{
+			"filter": map[string]interface{}{
+				"since": "tomorrow",
+			},
+		}).Decode(&result)).Should(MatchError(
+			`cannot decode variable value at "filter.since": parsing time "tomorrow" as "2006-01-02T15:04:05Z07:00": cannot parse "tomorrow" as "2006"`))
+	})
+})
@@ -58,6 +58,9 @@ type DirectiveConfig struct {
 
 	// Arguments to be provided when using the directive
 	Args ArgumentConfigMap
+
+	// IsRepeatable indicates whether the directive can be used multiple times at a single location.
+	IsRepeatable bool
 }
 
 // DeepCopy makes a copy of receiver.
@@ -93,6 +96,9 @@ type Directive interface {
 
 	// Args indicates the arguments taken by the directive.
 	Args() []Argument
+
+	// IsRepeatable returns true if the directive can be used multiple times at a single location.
+	IsRepeatable() bool
 }
 
 // directive provides an implementation to Schema which creates schema from a SchemaConfig.
@@ -154,3 +160,8 @@ func (d *directive) Locations() []DirectiveLocation {
 func (d *directive) Args() []Argument {
 	return d.args
 }
+
+// IsRepeatable implements Directive.
+func (d *directive) IsRepeatable() bool {
+	return d.config.IsRepeatable
+}
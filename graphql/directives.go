@@ -16,7 +16,9 @@
 
 package graphql
 
-// This files implements 3 directives required by specification.
+// This files implements 3 directives required by specification, the @oneOf and the @specifiedBy
+// directives. It also provides the @defer and the @stream directives for incremental delivery which
+// are not included in StandardDirectives.
 //
 // Reference: https://graphql.github.io/graphql-spec/June2018/#sec-Type-System.Directives
 
@@ -97,6 +99,8 @@ var deprecatedDirective = MustNewDirective(&DirectiveConfig{
 	Description: "Marks an element of a GraphQL schema as no longer supported.",
 	Locations: []DirectiveLocation{
 		DirectiveLocationFieldDefinition,
+		DirectiveLocationArgumentDefinition,
+		DirectiveLocationInputFieldDefinition,
 		DirectiveLocationEnumValue,
 	},
 	Args: ArgumentConfigMap{
@@ -115,6 +119,125 @@ func DeprecatedDirective() Directive {
 	return deprecatedDirective
 }
 
+//===----------------------------------------------------------------------------------------====//
+// @oneOf
+//===----------------------------------------------------------------------------------------====//
+// The @oneOf directive is used within the type system definition language to indicate an Input
+// Object is a OneOf Input Object where exactly one of its fields must be provided and non-null.
+
+var oneOfDirective = MustNewDirective(&DirectiveConfig{
+	Name:        "oneOf",
+	Description: "Indicates exactly one field must be supplied and this field must not be `null`.",
+	Locations: []DirectiveLocation{
+		DirectiveLocationInputObject,
+	},
+})
+
+// OneOfDirective returns directive definition for @oneOf.
+func OneOfDirective() Directive {
+	return oneOfDirective
+}
+
+//===----------------------------------------------------------------------------------------====//
+// @specifiedBy
+//===----------------------------------------------------------------------------------------====//
+// The @specifiedBy directive is used within the type system definition language to provide a URL
+// for specifying the behavior of custom scalar definitions.
+
+var specifiedByDirective = MustNewDirective(&DirectiveConfig{
+	Name:        "specifiedBy",
+	Description: "Exposes a URL that specifies the behavior of this scalar.",
+	Locations: []DirectiveLocation{
+		DirectiveLocationScalar,
+	},
+	Args: ArgumentConfigMap{
+		"url": {
+			Type:        NonNullOfType(String()),
+			Description: "The URL that specifies the behavior of this scalar.",
+		},
+	},
+})
+
+// SpecifiedByDirective returns directive definition for @specifiedBy.
+func SpecifiedByDirective() Directive {
+	return specifiedByDirective
+}
+
+//===----------------------------------------------------------------------------------------====//
+// @defer
+//===----------------------------------------------------------------------------------------====//
+// The @defer directive may be provided for fragment spreads and inline fragments to inform the
+// executor to delay the execution of the current fragment to indicate deprioritization of the
+// current fragment. Results of the fragment are delivered in a subsequent payload.
+//
+// The directive is not part of StandardDirectives. Schemas that support incremental delivery must
+// include it in SchemaConfig.Directives explicitly.
+
+var deferDirective = MustNewDirective(&DirectiveConfig{
+	Name: "defer",
+	Description: "Directs the executor to defer this fragment when the `if` argument is true or " +
+		"undefined.",
+	Locations: []DirectiveLocation{
+		DirectiveLocationFragmentSpread,
+		DirectiveLocationInlineFragment,
+	},
+	Args: ArgumentConfigMap{
+		"if": {
+			Type:         NonNullOfType(Boolean()),
+			Description:  "Deferred when true or undefined.",
+			DefaultValue: true,
+		},
+		"label": {
+			Type:        T(String()),
+			Description: "Unique name",
+		},
+	},
+})
+
+// DeferDirective returns directive definition for @defer.
+func DeferDirective() Directive {
+	return deferDirective
+}
+
+//===----------------------------------------------------------------------------------------====//
+// @stream
+//===----------------------------------------------------------------------------------------====//
+// The @stream directive may be provided for a field of List type so that the executor delivers the
+// items after the first initialCount ones in subsequent payloads.
+//
+// The directive is not part of StandardDirectives. Schemas that support incremental delivery must
+// include it in SchemaConfig.Directives explicitly.
+
+var streamDirective = MustNewDirective(&DirectiveConfig{
+	Name: "stream",
+	Description: "Directs the executor to stream plural fields when the `if` argument is true or " +
+		"undefined.",
+	Locations: []DirectiveLocation{
+		DirectiveLocationField,
+	},
+	Args: ArgumentConfigMap{
+		"if": {
+			Type:         NonNullOfType(Boolean()),
+			Description:  "Stream when true or undefined.",
+			DefaultValue: true,
+		},
+		"label": {
+			Type:        T(String()),
+			Description: "Unique name",
+		},
+		"initialCount": {
+			Type:         NonNullOfType(Int()),
+			Description:  "Number of items to return immediately",
+			DefaultValue: 0,
+		},
+	},
+})
+
+// StreamDirective returns directive definition for @stream.
+func StreamDirective() Directive {
+	return streamDirective
+}
+
 // StandardDirectives returns list of directives that should be included in a standard GraphQL as
 // per specification.
 //
@@ -127,5 +250,9 @@ func StandardDirectives() []Directive {
 		IncludeDirective(),
 		// @deprecated
 		DeprecatedDirective(),
+		// @oneOf
+		OneOfDirective(),
+		// @specifiedBy
+		SpecifiedByDirective(),
 	}
 }
@@ -187,6 +187,9 @@ type EnumConfig struct {
 	// Description for the enum type
 	Description string
 
+	// Directives applied to the type
+	Directives AppliedDirectives
+
 	// Values to be defined in the enum
 	Values EnumValueDefinitionMap
 
@@ -206,6 +209,7 @@ func (config *EnumConfig) TypeData() EnumTypeData {
 	return EnumTypeData{
 		Name:        config.Name,
 		Description: config.Description,
+		Directives:  config.Directives,
 		Values:      config.Values,
 	}
 }
@@ -322,6 +326,11 @@ func (value *enumValue) Deprecation() *Deprecation {
 	return value.def.Deprecation
 }
 
+// Directives implements EnumValue.
+func (value *enumValue) Directives() AppliedDirectives {
+	return value.def.Directives
+}
+
 // enum is our built-in implementation for Enum. It is configured with and built from
 // EnumTypeDefinition.
 type enum struct {
@@ -369,6 +378,11 @@ func (e *enum) Description() string {
 	return e.data.Description
 }
 
+// Directives implements TypeWithDirectives.
+func (e *enum) Directives() AppliedDirectives {
+	return e.data.Directives
+}
+
 // CoerceResultValue implements LeafType.
 func (e *enum) CoerceResultValue(value interface{}) (interface{}, error) {
 	enumValue, err := e.resultCoercer.Coerce(value)
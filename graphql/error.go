@@ -581,9 +581,15 @@ func (errs *Errors) Emplace(message string, args ...interface{}) {
 }
 
 // Append appends list of Error's to the end of the Errors. Note that the given error must be an
-// graphql.Error otherwise it panics. The update is occurred in-place to the given Errors.
+// graphql.Error or an Errors (whose Error's are appended) otherwise it panics. The update is occurred
+// in-place to the given Errors.
 func (errs *Errors) Append(e ...error) {
 	for _, err := range e {
+		if list, ok := err.(Errors); ok {
+			errs.Errors = append(errs.Errors, list.Errors...)
+			continue
+		}
+
 		// The type assertion may fail resulting a panic if args contains unsupported type of value
 		// (in which NewError will return an error built from fmt.Errorf).
 		errs.Errors = append(errs.Errors, err.(*Error))
@@ -615,6 +621,19 @@ func (errs *Errors) AppendErrors(e ...Errors) {
 	errs.Errors = newErrors
 }
 
+// Error implements Go's error interface so Errors can be returned as an error (e.g., from
+// NewSchema.) The messages of the errors are separated by blank lines.
+func (errs Errors) Error() string {
+	var b util.StringBuilder
+	for i, err := range errs.Errors {
+		if i > 0 {
+			b.WriteString("\n\n")
+		}
+		b.WriteString(err.Message)
+	}
+	return b.String()
+}
+
 // HaveOccurred returns true if some errors exist. Use this instead of relying on "errs != nil" for
 // checking existence of error because errs may be an empty array.
 func (errs Errors) HaveOccurred() bool {
@@ -398,3 +398,19 @@ var _ = Describe("Error", func() {
 		})
 	})
 })
+
+var _ = Describe("Errors", func() {
+	It("joins messages of the errors", func() {
+		errs := graphql.ErrorsOf("first error")
+		errs.Emplace("second error")
+		Expect(errs).Should(MatchError("first error\n\nsecond error"))
+	})
+
+	It("flattens Errors when appending", func() {
+		var errs graphql.Errors
+		errs.Append(graphql.NewError("first error"), graphql.ErrorsOf("second error"))
+		Expect(errs.Errors).Should(HaveLen(2))
+		Expect(errs.Errors[0].Message).Should(Equal("first error"))
+		Expect(errs.Errors[1].Message).Should(Equal("second error"))
+	})
+})
@@ -19,6 +19,7 @@ package executor_test
 import (
 	"context"
 
+	"github.com/botobag/artemis/concurrent/future"
 	"github.com/botobag/artemis/graphql"
 	"github.com/botobag/artemis/graphql/parser"
 	"github.com/botobag/artemis/graphql/token"
@@ -46,6 +47,11 @@ var _ = Describe("Execute: Handles execution of abstract types", func() {
 	It("resolveType on Interface yields useful error", func() {
 		petType := graphql.InterfaceConfig{
 			Name: "Pet",
+			Fields: graphql.Fields{
+				"name": {
+					Type: graphql.T(graphql.String()),
+				},
+			},
 		}
 
 		dogType := graphql.ObjectConfig{
@@ -369,6 +375,16 @@ var _ = Describe("Execute: Handles execution of abstract types", func() {
 	It("resolveType on Union without type resolver yields useful error", func() {
 		fooUnion := &graphql.UnionConfig{
 			Name: "FooUnion",
+			PossibleTypes: []graphql.ObjectTypeDefinition{
+				&graphql.ObjectConfig{
+					Name: "FooObject",
+					Fields: graphql.Fields{
+						"bar": {
+							Type: graphql.T(graphql.String()),
+						},
+					},
+				},
+			},
 			/* TypeResolver: nil, */
 		}
 
@@ -404,6 +420,251 @@ var _ = Describe("Execute: Handles execution of abstract types", func() {
 		}`))
 	})
 
+	It("isTypeOf used to resolve runtime type for Interface", func() {
+		petType := &graphql.InterfaceConfig{
+			Name: "Pet",
+			Fields: graphql.Fields{
+				"name": {
+					Type: graphql.T(graphql.String()),
+				},
+			},
+			/* TypeResolver: nil, */
+		}
+
+		dogType := &graphql.ObjectConfig{
+			Name:       "Dog",
+			Interfaces: []graphql.InterfaceTypeDefinition{petType},
+			IsTypeOf: graphql.TypeCheckerFunc(func(ctx context.Context, value interface{}, info graphql.ResolveInfo) (interface{}, error) {
+				_, ok := value.(*Dog)
+				return ok, nil
+			}),
+			Fields: graphql.Fields{
+				"name": {
+					Type: graphql.T(graphql.String()),
+				},
+				"woofs": {
+					Type: graphql.T(graphql.Boolean()),
+				},
+			},
+		}
+
+		catType := &graphql.ObjectConfig{
+			Name:       "Cat",
+			Interfaces: []graphql.InterfaceTypeDefinition{petType},
+			IsTypeOf: graphql.TypeCheckerFunc(func(ctx context.Context, value interface{}, info graphql.ResolveInfo) (interface{}, error) {
+				_, ok := value.(*Cat)
+				return ok, nil
+			}),
+			Fields: graphql.Fields{
+				"name": {
+					Type: graphql.T(graphql.String()),
+				},
+				"meows": {
+					Type: graphql.T(graphql.Boolean()),
+				},
+			},
+		}
+
+		queryType := graphql.MustNewObject(&graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"pets": {
+					Type: graphql.ListOf(petType),
+					Resolver: graphql.FieldResolverFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+						return []interface{}{
+							&Dog{"Odie", true},
+							&Cat{"Garfield", false},
+							&Human{"Jon"},
+						}, nil
+					}),
+				},
+			},
+		})
+
+		schema := graphql.MustNewSchema(&graphql.SchemaConfig{
+			Query: queryType,
+			Types: []graphql.Type{
+				graphql.MustNewObject(dogType),
+				graphql.MustNewObject(catType),
+			},
+		})
+
+		document := parser.MustParse(token.NewSource(`{
+      pets {
+        name
+        ... on Dog {
+          woofs
+        }
+        ... on Cat {
+          meows
+        }
+      }
+    }`))
+
+		Eventually(execute(schema, document)).Should(MatchResultInJSON(`{
+			"data": {
+				"pets": [
+					{
+						"name": "Odie",
+						"woofs": true
+					},
+					{
+						"name": "Garfield",
+						"meows": false
+					},
+					null
+				]
+			},
+			"errors": [
+				{
+					"message": "Abstract type Pet must resolve to an Object type at runtime for field Query.pets with value { Name: \"Jon\" }, received nil.",
+					"locations": [{ "line": 2, "column": 7 }],
+					"path": ["pets", 2]
+				}
+			]
+		}`))
+	})
+
+	It("isTypeOf used to resolve runtime type for Union with async checks", func() {
+		dogType := &graphql.ObjectConfig{
+			Name: "Dog",
+			IsTypeOf: graphql.TypeCheckerFunc(func(ctx context.Context, value interface{}, info graphql.ResolveInfo) (interface{}, error) {
+				_, ok := value.(*Dog)
+				return future.Ready(ok), nil
+			}),
+			Fields: graphql.Fields{
+				"name": {
+					Type: graphql.T(graphql.String()),
+				},
+				"woofs": {
+					Type: graphql.T(graphql.Boolean()),
+				},
+			},
+		}
+
+		catType := &graphql.ObjectConfig{
+			Name: "Cat",
+			IsTypeOf: graphql.TypeCheckerFunc(func(ctx context.Context, value interface{}, info graphql.ResolveInfo) (interface{}, error) {
+				_, ok := value.(*Cat)
+				return future.Ready(ok), nil
+			}),
+			Fields: graphql.Fields{
+				"name": {
+					Type: graphql.T(graphql.String()),
+				},
+				"meows": {
+					Type: graphql.T(graphql.Boolean()),
+				},
+			},
+		}
+
+		petType := &graphql.UnionConfig{
+			Name: "Pet",
+			PossibleTypes: []graphql.ObjectTypeDefinition{
+				dogType,
+				catType,
+			},
+			/* TypeResolver: nil, */
+		}
+
+		queryType := graphql.MustNewObject(&graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"pets": {
+					Type: graphql.ListOf(petType),
+					Resolver: graphql.FieldResolverFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+						return []interface{}{
+							&Dog{"Odie", true},
+							&Cat{"Garfield", false},
+						}, nil
+					}),
+				},
+			},
+		})
+
+		schema := graphql.MustNewSchema(&graphql.SchemaConfig{
+			Query: queryType,
+		})
+
+		document := parser.MustParse(token.NewSource(`{
+      pets {
+        ... on Dog {
+          name
+          woofs
+        }
+        ... on Cat {
+          name
+          meows
+        }
+      }
+    }`))
+
+		Eventually(execute(schema, document)).Should(MatchResultInJSON(`{
+			"data": {
+				"pets": [
+					{
+						"name": "Odie",
+						"woofs": true
+					},
+					{
+						"name": "Garfield",
+						"meows": false
+					}
+				]
+			}
+		}`))
+	})
+
+	It("returning invalid value from isTypeOf yields useful error", func() {
+		fooObject := &graphql.ObjectConfig{
+			Name: "FooObject",
+			IsTypeOf: graphql.TypeCheckerFunc(func(ctx context.Context, value interface{}, info graphql.ResolveInfo) (interface{}, error) {
+				return "yes", nil
+			}),
+			Fields: graphql.Fields{
+				"bar": {
+					Type: graphql.T(graphql.String()),
+				},
+			},
+		}
+
+		fooUnion := &graphql.UnionConfig{
+			Name:          "FooUnion",
+			PossibleTypes: []graphql.ObjectTypeDefinition{fooObject},
+		}
+
+		queryType := graphql.MustNewObject(&graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"foo": {
+					Type: fooUnion,
+					Resolver: graphql.FieldResolverFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+						return "dummy", nil
+					}),
+				},
+			},
+		})
+
+		schema := graphql.MustNewSchema(&graphql.SchemaConfig{
+			Query: queryType,
+		})
+
+		document := parser.MustParse(token.NewSource("{ foo { ... on FooObject { bar } } }"))
+
+		Eventually(execute(schema, document)).Should(MatchResultInJSON(`{
+			"data": {
+				"foo": null
+			},
+			"errors": [
+				{
+					"message": "IsTypeOf of FooObject must return a bool or a future.Future that resolves to a bool, but got: \"yes\".",
+					"locations": [{ "line": 1, "column": 3 }],
+					"path": ["foo"]
+				}
+			]
+		}`))
+	})
+
 	It("returns runtime type from resolve info when accessing fields in Interface", func() {
 		fooInterface := &graphql.InterfaceConfig{
 			Name: "FooInterface",
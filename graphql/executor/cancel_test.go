@@ -0,0 +1,294 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package executor_test
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/botobag/artemis/concurrent"
+	"github.com/botobag/artemis/concurrent/future"
+	"github.com/botobag/artemis/graphql"
+	"github.com/botobag/artemis/graphql/executor"
+	"github.com/botobag/artemis/graphql/parser"
+	"github.com/botobag/artemis/graphql/token"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// pendingFuture is a future.Future that never completes. It saves the waker given to the last Poll.
+type pendingFuture struct {
+	waker atomic.Value
+}
+
+// Poll implements future.Future.
+func (f *pendingFuture) Poll(waker future.Waker) (future.PollResult, error) {
+	f.waker.Store(waker)
+	return future.PollResultPending, nil
+}
+
+var _ = Describe("Execute: Handles context cancellation", func() {
+	var (
+		numResolves int32
+		pending     *pendingFuture
+		blockCh     chan struct{}
+		cancel      context.CancelFunc
+	)
+
+	BeforeEach(func() {
+		numResolves = 0
+		pending = &pendingFuture{}
+		blockCh = make(chan struct{})
+		cancel = nil
+	})
+
+	countedResolver := func(value interface{}) graphql.FieldResolver {
+		return graphql.FieldResolverFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+			atomic.AddInt32(&numResolves, 1)
+			return value, nil
+		})
+	}
+
+	dataType := &graphql.ObjectConfig{
+		Name: "Data",
+		Fields: graphql.Fields{
+			"value": {
+				Type:     graphql.T(graphql.String()),
+				Resolver: countedResolver("value"),
+			},
+		},
+	}
+
+	schema := graphql.MustNewSchema(&graphql.SchemaConfig{
+		Query: graphql.MustNewObject(&graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"a": {
+					Type:     graphql.T(graphql.String()),
+					Resolver: countedResolver("a"),
+				},
+				"data": {
+					Type:     dataType,
+					Resolver: countedResolver(struct{}{}),
+				},
+				"cancel": {
+					Type: graphql.T(graphql.String()),
+					Resolver: graphql.FieldResolverFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+						cancel()
+						return "cancelled", nil
+					}),
+				},
+				"pending": {
+					Type: graphql.T(graphql.String()),
+					Resolver: graphql.FieldResolverFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+						return pending, nil
+					}),
+				},
+				"nonNullPending": {
+					Type: graphql.NonNullOfType(graphql.String()),
+					Resolver: graphql.FieldResolverFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+						return pending, nil
+					}),
+				},
+				"block": {
+					Type: graphql.T(graphql.String()),
+					Resolver: graphql.FieldResolverFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+						// Simulate a resolver that doesn't respect the context.
+						<-blockCh
+						return "block", nil
+					}),
+				},
+			},
+		}),
+	})
+
+	executeQuery := func(ctx context.Context, query string, opts ...executor.ExecuteOption) *executor.ExecutionResult {
+		operation := executor.MustPrepare(schema, parser.MustParse(token.NewSource(query)))
+		return operation.Execute(ctx, opts...)
+	}
+
+	It("does not run resolvers when the context is done", func() {
+		var ctx context.Context
+		ctx, cancel = context.WithCancel(context.Background())
+		cancel()
+
+		// The error from the context is reported only once.
+		Expect(executeQuery(ctx, `{ a, data { value } }`)).Should(MatchResultInJSON(`{
+			"data": {
+				"a": null,
+				"data": null
+			},
+			"errors": [
+				{
+					"message": "context canceled",
+					"locations": [{ "line": 1, "column": 3 }],
+					"path": ["a"]
+				}
+			]
+		}`))
+		Expect(numResolves).Should(BeZero())
+	})
+
+	It("stops scheduling fields after the context is cancelled", func() {
+		var ctx context.Context
+		ctx, cancel = context.WithCancel(context.Background())
+		defer cancel()
+
+		Expect(executeQuery(ctx, `{ a, cancel, data { value } }`)).Should(MatchResultInJSON(`{
+			"data": {
+				"a": "a",
+				"cancel": "cancelled",
+				"data": null
+			},
+			"errors": [
+				{
+					"message": "context canceled",
+					"locations": [{ "line": 1, "column": 14 }],
+					"path": ["data"]
+				}
+			]
+		}`))
+		Expect(numResolves).Should(Equal(int32(1)))
+	})
+
+	It("fails the fields waiting for futures when the deadline passes", func() {
+		var ctx context.Context
+		ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		Expect(executeQuery(ctx, `{ a, pending }`)).Should(MatchResultInJSON(`{
+			"data": {
+				"a": "a",
+				"pending": null
+			},
+			"errors": [
+				{
+					"message": "context deadline exceeded",
+					"locations": [{ "line": 1, "column": 6 }],
+					"path": ["pending"]
+				}
+			]
+		}`))
+
+		// Waking the cancelled task should be harmless.
+		waker := pending.waker.Load().(future.Waker)
+		Expect(waker.Wake()).Should(Succeed())
+	})
+
+	It("reports the error once for the fields that are failed by the cancellation", func() {
+		var ctx context.Context
+		ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		Expect(executeQuery(ctx, `{ a, pending, alias: pending }`)).Should(MatchResultInJSON(`{
+			"data": {
+				"a": "a",
+				"pending": null,
+				"alias": null
+			},
+			"errors": [
+				{
+					"message": "context deadline exceeded",
+					"locations": [{ "line": 1, "column": 6 }],
+					"path": ["pending"]
+				}
+			]
+		}`))
+	})
+
+	It("propagates cancellation errors as per non-nullability", func() {
+		var ctx context.Context
+		ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		Expect(executeQuery(ctx, `{ a, nonNullPending }`)).Should(MatchResultInJSON(`{
+			"data": null,
+			"errors": [
+				{
+					"message": "context deadline exceeded",
+					"locations": [{ "line": 1, "column": 6 }],
+					"path": ["nonNullPending"]
+				}
+			]
+		}`))
+	})
+
+	It("does not wait for resolvers running on the concurrent executor", func() {
+		workerPool, err := concurrent.NewWorkerPoolExecutor(concurrent.WorkerPoolExecutorConfig{
+			MinPoolSize: 2,
+			MaxPoolSize: 4,
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		defer func() {
+			// Unblock the resolver to shut down the worker pool.
+			close(blockCh)
+			terminated, err := workerPool.Shutdown()
+			Expect(err).ShouldNot(HaveOccurred())
+			Eventually(terminated).Should(Receive())
+		}()
+
+		var ctx context.Context
+		ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		Expect(executeQuery(ctx, `{ block }`, executor.ConcurrentExecutor(workerPool))).Should(
+			MatchResultInJSON(`{
+				"data": {
+					"block": null
+				},
+				"errors": [
+					{
+						"message": "context deadline exceeded",
+						"locations": [{ "line": 1, "column": 3 }],
+						"path": ["block"]
+					}
+				]
+			}`))
+	})
+
+	It("reports the error once for the resolvers running on the concurrent executor", func() {
+		workerPool, err := concurrent.NewWorkerPoolExecutor(concurrent.WorkerPoolExecutorConfig{
+			MinPoolSize: 2,
+			MaxPoolSize: 4,
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		defer func() {
+			// Unblock the resolvers to shut down the worker pool.
+			close(blockCh)
+			terminated, err := workerPool.Shutdown()
+			Expect(err).ShouldNot(HaveOccurred())
+			Eventually(terminated).Should(Receive())
+		}()
+
+		var ctx context.Context
+		ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		result := executeQuery(ctx, `{ block, alias: block }`, executor.ConcurrentExecutor(workerPool))
+		Expect(&executor.ExecutionResult{Data: result.Data}).Should(MatchResultInJSON(`{
+			"data": {
+				"block": null,
+				"alias": null
+			}
+		}`))
+		// The cancelled tasks are failed in random order.
+		Expect(result.Errors.Errors).Should(HaveLen(1))
+		Expect(result.Errors.Errors[0].Message).Should(Equal("context deadline exceeded"))
+	})
+})
@@ -0,0 +1,278 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package executor_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/botobag/artemis/concurrent"
+	"github.com/botobag/artemis/concurrent/future"
+	"github.com/botobag/artemis/graphql"
+	"github.com/botobag/artemis/graphql/executor"
+	"github.com/botobag/artemis/graphql/parser"
+	"github.com/botobag/artemis/graphql/token"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Execute: Handles execution with concurrent executor", func() {
+	var (
+		workerPool *concurrent.WorkerPoolExecutor
+		execute    ExecuteFunc
+	)
+
+	BeforeEach(func() {
+		var err error
+		workerPool, err = concurrent.NewWorkerPoolExecutor(concurrent.WorkerPoolExecutorConfig{
+			MinPoolSize: 4,
+			MaxPoolSize: 8,
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		execute = wrapExecute(executor.ConcurrentExecutor(workerPool))
+	})
+
+	AfterEach(func() {
+		terminated, err := workerPool.Shutdown()
+		Expect(err).ShouldNot(HaveOccurred())
+		Eventually(terminated).Should(Receive())
+	})
+
+	It("runs resolvers of sibling fields in parallel", func() {
+		// Each resolver waits until all of the resolvers have started. This never completes if they're
+		// run serially.
+		var started sync.WaitGroup
+		started.Add(3)
+		allStarted := make(chan struct{})
+		go func() {
+			started.Wait()
+			close(allStarted)
+		}()
+
+		resolver := graphql.FieldResolverFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+			started.Done()
+			select {
+			case <-allStarted:
+				return info.Path().String(), nil
+			case <-time.After(5 * time.Second):
+				return nil, errors.New("resolvers were not run in parallel")
+			}
+		})
+
+		schema := graphql.MustNewSchema(&graphql.SchemaConfig{
+			Query: graphql.MustNewObject(&graphql.ObjectConfig{
+				Name: "Query",
+				Fields: graphql.Fields{
+					"a": {Type: graphql.T(graphql.String()), Resolver: resolver},
+					"b": {Type: graphql.T(graphql.String()), Resolver: resolver},
+					"c": {Type: graphql.T(graphql.String()), Resolver: resolver},
+				},
+			}),
+		})
+
+		result := execute(schema, parser.MustParse(token.NewSource(`{ a, b, c }`)))
+		Expect(result).Should(MatchResultInJSON(`{
+			"data": {
+				"a": "a",
+				"b": "b",
+				"c": "c"
+			}
+		}`))
+	})
+
+	It("completes nested values and handles errors", func() {
+		type Data struct {
+			Value string
+		}
+
+		dataType := &graphql.ObjectConfig{
+			Name: "Data",
+		}
+		dataType.Fields = graphql.Fields{
+			"value": {
+				Type: graphql.T(graphql.String()),
+				Resolver: graphql.FieldResolverFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+					return source.(*Data).Value, nil
+				}),
+			},
+			"async": {
+				Type: graphql.T(graphql.String()),
+				Resolver: graphql.FieldResolverFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+					return future.Ready(source.(*Data).Value), nil
+				}),
+			},
+			"error": {
+				Type: graphql.T(graphql.String()),
+				Resolver: graphql.FieldResolverFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+					if source.(*Data).Value == "x" {
+						return nil, errors.New("error")
+					}
+					return source.(*Data).Value, nil
+				}),
+			},
+			"list": {
+				Type: graphql.ListOf(dataType),
+				Resolver: graphql.FieldResolverFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+					return []*Data{{"x"}, {"y"}}, nil
+				}),
+			},
+		}
+
+		schema := graphql.MustNewSchema(&graphql.SchemaConfig{
+			Query: graphql.MustNewObject(&graphql.ObjectConfig{
+				Name: "Query",
+				Fields: graphql.Fields{
+					"data": {
+						Type: dataType,
+						Resolver: graphql.FieldResolverFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+							return &Data{"root"}, nil
+						}),
+					},
+				},
+			}),
+		})
+
+		result := execute(schema, parser.MustParse(token.NewSource(`{
+			data {
+				value
+				async
+				list {
+					value
+					async
+				}
+				broken: list {
+					value
+					error
+				}
+			}
+		}`)))
+
+		Expect(result).Should(MatchResultInJSON(`{
+			"errors": [
+				{
+					"message": "error",
+					"locations": [{ "line": 11, "column": 6 }],
+					"path": ["data", "broken", 0, "error"]
+				}
+			],
+			"data": {
+				"data": {
+					"value": "root",
+					"async": "root",
+					"list": [
+						{ "value": "x", "async": "x" },
+						{ "value": "y", "async": "y" }
+					],
+					"broken": [
+						{ "value": "x", "error": null },
+						{ "value": "y", "error": "y" }
+					]
+				}
+			}
+		}`))
+	})
+
+	It("executes root fields of mutation serially", func() {
+		var (
+			mutex  sync.Mutex
+			events []string
+			// Number of mutation resolvers that are running
+			running int32
+		)
+
+		record := func(event string) {
+			mutex.Lock()
+			events = append(events, event)
+			mutex.Unlock()
+		}
+
+		resultType := graphql.MustNewObject(&graphql.ObjectConfig{
+			Name: "Result",
+			Fields: graphql.Fields{
+				"value": {
+					Type: graphql.T(graphql.Int()),
+					Resolver: graphql.FieldResolverFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+						// Delay the completion of subfields.
+						time.Sleep(10 * time.Millisecond)
+						record(info.Path().String())
+						return source, nil
+					}),
+				},
+			},
+		})
+
+		mutate := graphql.FieldResolverFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+			if atomic.AddInt32(&running, 1) != 1 {
+				return nil, errors.New("mutations are run concurrently")
+			}
+			defer atomic.AddInt32(&running, -1)
+			record(info.Path().String())
+			return info.Args().Get("value"), nil
+		})
+
+		schema := graphql.MustNewSchema(&graphql.SchemaConfig{
+			Query: graphql.MustNewObject(&graphql.ObjectConfig{
+				Name: "Query",
+				Fields: graphql.Fields{
+					"value": {Type: graphql.T(graphql.Int())},
+				},
+			}),
+			Mutation: graphql.MustNewObject(&graphql.ObjectConfig{
+				Name: "Mutation",
+				Fields: graphql.Fields{
+					"mutate": {
+						Type: graphql.T(resultType),
+						Args: graphql.ArgumentConfigMap{
+							"value": {
+								Type: graphql.T(graphql.Int()),
+							},
+						},
+						Resolver: mutate,
+					},
+				},
+			}),
+		})
+
+		result := execute(schema, parser.MustParse(token.NewSource(`mutation {
+			first: mutate(value: 1) { value }
+			second: mutate(value: 2) { value }
+			third: mutate(value: 3) { value }
+		}`)))
+
+		Expect(result).Should(MatchResultInJSON(`{
+			"data": {
+				"first": { "value": 1 },
+				"second": { "value": 2 },
+				"third": { "value": 3 }
+			}
+		}`))
+
+		Expect(events).Should(Equal([]string{
+			"first",
+			"first.value",
+			"second",
+			"second.value",
+			"third",
+			"third.value",
+		}))
+	})
+})
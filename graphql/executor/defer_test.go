@@ -0,0 +1,474 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package executor_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/botobag/artemis/graphql"
+	"github.com/botobag/artemis/graphql/parser"
+	"github.com/botobag/artemis/graphql/token"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// graphql-js/src/execution/__tests__/defer-test.ts
+var _ = Describe("Execute: defer directive", func() {
+	friendType := &graphql.ObjectConfig{
+		Name: "Friend",
+		Fields: graphql.Fields{
+			"id": {
+				Type: graphql.T(graphql.ID()),
+			},
+			"name": {
+				Type: graphql.T(graphql.String()),
+			},
+		},
+	}
+
+	heroType := &graphql.ObjectConfig{
+		Name: "Hero",
+		Fields: graphql.Fields{
+			"id": {
+				Type: graphql.T(graphql.ID()),
+			},
+			"name": {
+				Type: graphql.T(graphql.String()),
+			},
+			"errorField": {
+				Type: graphql.T(graphql.String()),
+				Resolver: graphql.FieldResolverFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+					return nil, errors.New("bad")
+				}),
+			},
+			"nonNullErrorField": {
+				Type: graphql.NonNullOfType(graphql.String()),
+				Resolver: graphql.FieldResolverFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+					return nil, nil
+				}),
+			},
+			"friends": {
+				Type: graphql.ListOf(friendType),
+			},
+		},
+	}
+
+	hero := map[string]interface{}{
+		"id":   "1",
+		"name": "Luke",
+		"friends": []map[string]interface{}{
+			{"id": "2", "name": "Han"},
+			{"id": "3", "name": "Leia"},
+			{"id": "4", "name": "C-3PO"},
+		},
+	}
+
+	schema := graphql.MustNewSchema(&graphql.SchemaConfig{
+		Query: graphql.MustNewObject(&graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"hero": {
+					Type: heroType,
+					Resolver: graphql.FieldResolverFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+						return hero, nil
+					}),
+				},
+			},
+		}),
+		Directives: graphql.DirectiveList{
+			graphql.DeferDirective(),
+		},
+	})
+
+	executeQuery := func(query string) interface{} {
+		return execute(schema, parser.MustParse(token.NewSource(query)))
+	}
+
+	It("Can defer fragments containing scalar types", func() {
+		Expect(executeQuery(`
+      query HeroNameQuery {
+        hero {
+          id
+          ...NameFragment @defer
+        }
+      }
+      fragment NameFragment on Hero {
+        id
+        name
+      }
+    `)).Should(MatchIncrementalResultsInJSON(`[
+			{
+				"data": {
+					"hero": {
+						"id": "1"
+					}
+				},
+				"hasNext": true
+			},
+			{
+				"data": {
+					"id": "1",
+					"name": "Luke"
+				},
+				"path": ["hero"],
+				"hasNext": false
+			}
+		]`))
+	})
+
+	It("Can disable defer using if argument", func() {
+		Expect(executeQuery(`
+      query HeroNameQuery {
+        hero {
+          id
+          ...NameFragment @defer(if: false)
+        }
+      }
+      fragment NameFragment on Hero {
+        name
+      }
+    `)).Should(MatchIncrementalResultsInJSON(`[
+			{
+				"data": {
+					"hero": {
+						"id": "1",
+						"name": "Luke"
+					}
+				}
+			}
+		]`))
+	})
+
+	It("Can defer fragments on the top level Query field", func() {
+		Expect(executeQuery(`
+      query HeroNameQuery {
+        ...QueryFragment @defer(label: "DeferQuery")
+      }
+      fragment QueryFragment on Query {
+        hero {
+          id
+        }
+      }
+    `)).Should(MatchIncrementalResultsInJSON(`[
+			{
+				"data": {},
+				"hasNext": true
+			},
+			{
+				"data": {
+					"hero": {
+						"id": "1"
+					}
+				},
+				"path": [],
+				"label": "DeferQuery",
+				"hasNext": false
+			}
+		]`))
+	})
+
+	It("Can defer an inline fragment", func() {
+		Expect(executeQuery(`
+      query HeroNameQuery {
+        hero {
+          id
+          ... on Hero @defer(label: "InlineDeferred") {
+            name
+          }
+        }
+      }
+    `)).Should(MatchIncrementalResultsInJSON(`[
+			{
+				"data": {
+					"hero": {
+						"id": "1"
+					}
+				},
+				"hasNext": true
+			},
+			{
+				"data": {
+					"name": "Luke"
+				},
+				"path": ["hero"],
+				"label": "InlineDeferred",
+				"hasNext": false
+			}
+		]`))
+	})
+
+	It("Can defer a fragment within an already deferred fragment", func() {
+		Expect(executeQuery(`
+      query HeroNameQuery {
+        hero {
+          id
+          ...TopFragment @defer(label: "DeferTop")
+        }
+      }
+      fragment TopFragment on Hero {
+        name
+        ...NestedFragment @defer(label: "DeferNested")
+      }
+      fragment NestedFragment on Hero {
+        friends {
+          name
+        }
+      }
+    `)).Should(MatchIncrementalResultsInJSON(`[
+			{
+				"data": {
+					"hero": {
+						"id": "1"
+					}
+				},
+				"hasNext": true
+			},
+			{
+				"data": {
+					"name": "Luke"
+				},
+				"path": ["hero"],
+				"label": "DeferTop",
+				"hasNext": true
+			},
+			{
+				"data": {
+					"friends": [
+						{ "name": "Han" },
+						{ "name": "Leia" },
+						{ "name": "C-3PO" }
+					]
+				},
+				"path": ["hero"],
+				"label": "DeferNested",
+				"hasNext": false
+			}
+		]`))
+	})
+
+	It("Can defer fragments in the items of a list", func() {
+		Expect(executeQuery(`
+      query HeroNameQuery {
+        hero {
+          friends {
+            id
+            ... @defer {
+              name
+            }
+          }
+        }
+      }
+    `)).Should(MatchIncrementalResultsInJSON(`[
+			{
+				"data": {
+					"hero": {
+						"friends": [
+							{ "id": "2" },
+							{ "id": "3" },
+							{ "id": "4" }
+						]
+					}
+				},
+				"hasNext": true
+			},
+			{
+				"data": { "name": "Han" },
+				"path": ["hero", "friends", 0],
+				"hasNext": true
+			},
+			{
+				"data": { "name": "Leia" },
+				"path": ["hero", "friends", 1],
+				"hasNext": true
+			},
+			{
+				"data": { "name": "C-3PO" },
+				"path": ["hero", "friends", 2],
+				"hasNext": false
+			}
+		]`))
+	})
+
+	It("Handles errors thrown in deferred fragments", func() {
+		Expect(executeQuery(`
+      query HeroNameQuery {
+        hero {
+          id
+          ...NameFragment @defer
+        }
+      }
+      fragment NameFragment on Hero {
+        errorField
+      }
+    `)).Should(MatchIncrementalResultsInJSON(`[
+			{
+				"data": {
+					"hero": {
+						"id": "1"
+					}
+				},
+				"hasNext": true
+			},
+			{
+				"errors": [
+					{
+						"message": "bad",
+						"locations": [{ "line": 9, "column": 9 }],
+						"path": ["hero", "errorField"]
+					}
+				],
+				"data": {
+					"errorField": null
+				},
+				"path": ["hero"],
+				"hasNext": false
+			}
+		]`))
+	})
+
+	It("Handles non-nullable errors thrown in deferred fragments", func() {
+		Expect(executeQuery(`
+      query HeroNameQuery {
+        hero {
+          id
+          ...NameFragment @defer
+        }
+      }
+      fragment NameFragment on Hero {
+        id
+        nonNullErrorField
+      }
+    `)).Should(MatchIncrementalResultsInJSON(`[
+			{
+				"data": {
+					"hero": {
+						"id": "1"
+					}
+				},
+				"hasNext": true
+			},
+			{
+				"errors": [
+					{
+						"message": "Cannot return null for non-nullable field Hero.nonNullErrorField.",
+						"locations": [{ "line": 10, "column": 9 }],
+						"path": ["hero", "nonNullErrorField"]
+					}
+				],
+				"data": null,
+				"path": ["hero"],
+				"hasNext": false
+			}
+		]`))
+	})
+
+	It("Handles non-nullable errors thrown outside deferred fragments", func() {
+		Expect(executeQuery(`
+      query HeroNameQuery {
+        hero {
+          nonNullErrorField
+          ...NameFragment @defer
+        }
+      }
+      fragment NameFragment on Hero {
+        id
+      }
+    `)).Should(MatchIncrementalResultsInJSON(`[
+			{
+				"errors": [
+					{
+						"message": "Cannot return null for non-nullable field Hero.nonNullErrorField.",
+						"locations": [{ "line": 4, "column": 11 }],
+						"path": ["hero", "nonNullErrorField"]
+					}
+				],
+				"data": {
+					"hero": null
+				},
+				"hasNext": true
+			},
+			{
+				"hasNext": false
+			}
+		]`))
+	})
+
+	It("Provides path to the deferred fields for resolvers", func() {
+		var paths []string
+		schema := graphql.MustNewSchema(&graphql.SchemaConfig{
+			Query: graphql.MustNewObject(&graphql.ObjectConfig{
+				Name: "Query",
+				Fields: graphql.Fields{
+					"hero": {
+						Type: &graphql.ObjectConfig{
+							Name: "Hero",
+							Fields: graphql.Fields{
+								"name": {
+									Type: graphql.T(graphql.String()),
+									Resolver: graphql.FieldResolverFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+										paths = append(paths, info.Path().String())
+										return "Luke", nil
+									}),
+								},
+							},
+						},
+						Resolver: graphql.FieldResolverFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+							return hero, nil
+						}),
+					},
+				},
+			}),
+		})
+
+		Expect(execute(schema, parser.MustParse(token.NewSource(`
+      {
+        hero {
+          ... @defer {
+            name
+          }
+        }
+        alias: hero {
+          ... @defer {
+            name
+          }
+        }
+      }
+    `)))).Should(MatchIncrementalResultsInJSON(`[
+			{
+				"data": {
+					"hero": {},
+					"alias": {}
+				},
+				"hasNext": true
+			},
+			{
+				"data": { "name": "Luke" },
+				"path": ["hero"],
+				"hasNext": true
+			},
+			{
+				"data": { "name": "Luke" },
+				"path": ["alias"],
+				"hasNext": false
+			}
+		]`))
+
+		Expect(paths).Should(Equal([]string{"hero.name", "alias.name"}))
+	})
+})
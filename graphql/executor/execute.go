@@ -21,9 +21,11 @@ import (
 	"fmt"
 	"io"
 	"reflect"
+	"sort"
 	"sync"
 	"sync/atomic"
 
+	"github.com/botobag/artemis/concurrent"
 	"github.com/botobag/artemis/concurrent/future"
 	"github.com/botobag/artemis/graphql"
 	"github.com/botobag/artemis/graphql/ast"
@@ -36,6 +38,26 @@ import (
 type ExecutionResult struct {
 	Data   *ResultNode
 	Errors graphql.Errors
+
+	// Subsequent is non-nil when the execution of some fragments is deferred by @defer or some list
+	// items are streamed by @stream. It delivers the subsequent payloads that contain results for
+	// them. The last payload has its HasNext set to false after which the channel is closed. Callers
+	// must drain the channel, cancel the context given to Execute or call Close to release the
+	// resources associated with the execution.
+	Subsequent <-chan *IncrementalResult
+
+	// Publisher of the payloads delivered via Subsequent; It is stopped by Close.
+	publisher *incrementalPublisher
+}
+
+// Close stops delivering the subsequent payloads that haven't been received from Subsequent and
+// closes the channel. Callers that are not going to drain Subsequent should call Close to release
+// the resources associated with the execution. It is safe to call Close more than once and after
+// Subsequent has been drained.
+func (result *ExecutionResult) Close() {
+	if result.publisher != nil {
+		result.publisher.stop()
+	}
 }
 
 // MarshalJSONTo writes the JSON encoding of result to the w. It makes use of jsonwriter
@@ -74,12 +96,35 @@ func collectFields(
 	}
 
 	if childNodes == nil {
+		// Find the selection sets in node to process.
+		var selectionSets []ast.SelectionSet
+		if node.IsRoot() {
+			selectionSets = []ast.SelectionSet{ctx.Operation().Definition().SelectionSet}
+		} else {
+			selectionSets = make([]ast.SelectionSet, len(node.Definitions))
+			for i, definition := range node.Definitions {
+				selectionSets[i] = definition.SelectionSet
+			}
+		}
+
 		// Load selection set into ExecutionNode's.
-		var err error
-		childNodes, err = buildChildExecutionNodesForSelectionSet(ctx, node, runtimeType)
+		var (
+			deferredFragments []*deferredFragment
+			err               error
+		)
+		childNodes, deferredFragments, err = buildChildExecutionNodesForSelectionSet(
+			ctx, node, runtimeType, selectionSets)
 		if err != nil {
 			return nil, err
 		}
+
+		// Store the fragments deferred by @defer. See deferFragments.
+		if len(deferredFragments) > 0 {
+			if node.deferredFragments == nil {
+				node.deferredFragments = map[graphql.Object][]*deferredFragment{}
+			}
+			node.deferredFragments[runtimeType] = deferredFragments
+		}
 	}
 
 	// Store the result before return.
@@ -88,11 +133,13 @@ func collectFields(
 	return childNodes, nil
 }
 
-// Build ExecutionNode's for the selection set of given node.
+// Build ExecutionNode's for the given selection sets of the parentNode. The fragments in the
+// selection sets deferred by @defer are not expanded; They are returned in the second return value.
 func buildChildExecutionNodesForSelectionSet(
 	ctx *ExecutionContext,
 	parentNode *ExecutionNode,
-	runtimeType graphql.Object) ([]*ExecutionNode, error) {
+	runtimeType graphql.Object,
+	selectionSets []ast.SelectionSet) ([]*ExecutionNode, []*deferredFragment, error) {
 	// Boolean set to prevent named fragment to be applied twice or more in a selection set.
 	visitedFragmentNames := map[string]bool{}
 
@@ -103,6 +150,9 @@ func buildChildExecutionNodesForSelectionSet(
 	// The result nodes
 	childNodes := []*ExecutionNode{}
 
+	// The fragments deferred by @defer
+	var deferredFragments []*deferredFragment
+
 	type taskData struct {
 		// The Selection Set that is being processed into childNodes
 		selectionSet ast.SelectionSet
@@ -114,19 +164,12 @@ func buildChildExecutionNodesForSelectionSet(
 	// Stack contains task to be processed.
 	var stack []taskData
 
-	// Initialize the stack. Find the selection sets in parentNode to process.
-	if parentNode.IsRoot() {
-		stack = []taskData{
-			{ctx.Operation().Definition().SelectionSet, 0},
-		}
-	} else {
-		definitions := parentNode.Definitions
-		numDefinitions := len(definitions)
-		stack = make([]taskData, numDefinitions)
-		// stack is LIFO so place the selection sets in reverse order.
-		for i, definition := range definitions {
-			stack[numDefinitions-i-1].selectionSet = definition.SelectionSet
-		}
+	// Initialize the stack with the selection sets to process.
+	numSelectionSets := len(selectionSets)
+	stack = make([]taskData, numSelectionSets)
+	// stack is LIFO so place the selection sets in reverse order.
+	for i, selectionSet := range selectionSets {
+		stack[numSelectionSets-i-1].selectionSet = selectionSet
 	}
 
 	for len(stack) > 0 {
@@ -149,7 +192,7 @@ func buildChildExecutionNodesForSelectionSet(
 			// Check @skip and @include.
 			shouldInclude, err := shouldIncludeNode(ctx, selection)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			} else if !shouldInclude {
 				continue
 			}
@@ -180,7 +223,13 @@ func buildChildExecutionNodesForSelectionSet(
 					// Get argument values.
 					args, err := values.ArgumentValues(fieldDef, selection, ctx.VariableValues())
 					if err != nil {
-						return nil, err
+						return nil, nil, err
+					}
+
+					// Check @stream.
+					stream, err := streamDirectiveOf(ctx, selection)
+					if err != nil {
+						return nil, nil, err
 					}
 
 					// Build a node.
@@ -189,6 +238,7 @@ func buildChildExecutionNodesForSelectionSet(
 						Definitions: []*ast.Field{selection},
 						Field:       fieldDef,
 						Args:        args,
+						stream:      stream,
 					}
 
 					// Add to result.
@@ -206,6 +256,15 @@ func buildChildExecutionNodesForSelectionSet(
 					}
 				}
 
+				// Check @defer.
+				fragment, err := deferredFragmentOf(ctx, selection.Directives, selection.SelectionSet)
+				if err != nil {
+					return nil, nil, err
+				} else if fragment != nil {
+					deferredFragments = append(deferredFragments, fragment)
+					break
+				}
+
 				// Push a task to process selection set in the fragment.
 				stack = append(stack, taskData{
 					selectionSet: selection.SelectionSet,
@@ -232,6 +291,15 @@ func buildChildExecutionNodesForSelectionSet(
 					break
 				}
 
+				// Check @defer.
+				fragment, err := deferredFragmentOf(ctx, selection.Directives, fragmentDef.SelectionSet)
+				if err != nil {
+					return nil, nil, err
+				} else if fragment != nil {
+					deferredFragments = append(deferredFragments, fragment)
+					break
+				}
+
 				// Push a task to process selection set in the fragment.
 				stack = append(stack, taskData{
 					selectionSet: fragmentDef.SelectionSet,
@@ -242,7 +310,7 @@ func buildChildExecutionNodesForSelectionSet(
 		}
 	} // for len(stack) > 0 {
 
-	return childNodes, nil
+	return childNodes, deferredFragments, nil
 }
 
 // Determines if a field should be included based on the @include and @skip directives, where @skip
@@ -349,34 +417,56 @@ func execute(ctx *ExecutionContext) *ExecutionResult {
 	// Allocate result node.
 	result := &ResultNode{}
 
+	// Setup the publisher for the subsequent payloads (if any).
+	ctx.incremental = &incrementalPublisher{}
+	ctx.incremental.deferFragments(
+		ctx,
+		rootNode,
+		operation.RootType(),
+		rootNode.deferredFragments[operation.RootType()],
+		result,
+		ctx.RootValue())
+
 	// Create executor.
 	//
 	// TODO: Rename executor to executeState and move as part of ExecutionContext.
-	executor := newExecutor()
+	executor := newExecutor(ctx.Context(), ctx.ConcurrentExecutor())
 
-	// Dispatch tasks for executing node.
+	// Dispatch tasks for executing node. Root fields of a mutation are executed serially [0].
+	//
+	// [0]: https://graphql.github.io/graphql-spec/June2018/#sec-Mutation
 	dispatchTasksForObject(
 		ctx,
 		executor,
 		result,
 		nodes,
-		ctx.RootValue())
+		ctx.RootValue(),
+		operation.Type() == ast.OperationTypeMutation)
+
+	// Wait for all tasks to complete.
+	executor.Wait()
 
 	// Return the result.
 	return &ExecutionResult{
-		Data:   result,
-		Errors: executor.errs,
+		Data:       result,
+		Errors:     executor.errs,
+		Subsequent: ctx.incremental.start(ctx),
+		publisher:  ctx.incremental,
 	}
 }
 
 // Dispatch tasks for evaluating an object value comprised of the fields specified in childNodes.
 // Return the ResultNode that contains
+//
+// If serial is true, the task for a field is not dispatched until the execution of the previous
+// field completes.
 func dispatchTasksForObject(
 	ctx *ExecutionContext,
 	executor *executor,
 	result *ResultNode,
 	childNodes []*ExecutionNode,
-	value interface{}) {
+	value interface{},
+	serial bool) {
 
 	numChildNodes := len(childNodes)
 
@@ -405,6 +495,10 @@ func dispatchTasksForObject(
 		task := newExecuteNodeTask(executor, ctx, childNode, nodeResult, value)
 
 		executor.Dispatch(task)
+
+		if serial {
+			executor.Wait()
+		}
 	}
 }
 
@@ -433,6 +527,10 @@ func newExecuteNodeTask(
 	task.node = node
 	task.result = result
 	task.source = source
+	task.path = graphql.ResponsePath{}
+	task.resolved = false
+	task.value = nil
+	task.err = nil
 	// Initialze reference count to 1.
 	task.refCount = 1
 
@@ -463,6 +561,14 @@ type ExecuteNodeTask struct {
 	// Source value which is passed to the field resolver; This is the field value of the parent.
 	source interface{}
 
+	// When the field resolver is run by a concurrent executor, the response path to the field is
+	// computed in advance (because ResultNode's are not safe to be read from other goroutines) and
+	// the value returned from the resolver is stored in value and err.
+	path     graphql.ResponsePath
+	resolved bool
+	value    interface{}
+	err      error
+
 	// Track the number of references to this object. See retain and release.
 	refCount int64
 }
@@ -493,14 +599,38 @@ func (task *ExecuteNodeTask) run() {
 		field  = node.Field
 	)
 
-	// Get field resolver to execute.
-	resolver := field.Resolver()
-	if resolver == nil {
-		resolver = ctx.Operation().DefaultFieldResolver()
+	var (
+		value interface{}
+		err   error
+	)
+
+	if task.resolved {
+		// The field value has been resolved by the concurrent executor. See resolveConcurrently.
+		value, err = task.value, task.err
+		task.value, task.err = nil, nil
+	} else if ctxErr := ctx.Context().Err(); ctxErr != nil {
+		// Note that the resolver is not run if the execution has been cancelled. The field is failed
+		// with the error from the context instead.
+		task.handleContextError(ctxErr, result)
+		task.release()
+		return
+	} else {
+		// Get field resolver to execute.
+		resolver := field.Resolver()
+		if resolver == nil {
+			resolver = ctx.Operation().DefaultFieldResolver()
+		}
+
+		info := task.newResolveInfoFor(result)
+		if task.executor.concurrentExecutor != nil && task.resolveConcurrently(resolver, info) {
+			// The task will be resumed when the resolver returns.
+			return
+		}
+
+		// Execute resolver to retrieve the field value
+		value, err = resolveField(ctx.Context(), resolver, task.source, info)
 	}
 
-	// Execute resolver to retrieve the field value
-	value, err := resolver.Resolve(ctx.Context(), task.source, task.newResolveInfoFor(result))
 	if err != nil {
 		task.handleNodeError(err, result)
 		task.release()
@@ -516,21 +646,84 @@ func (task *ExecuteNodeTask) run() {
 	return
 }
 
+// cancel implements Task. It is called when the execution is cancelled while the field resolver is
+// running on the concurrent executor.
+func (task *ExecuteNodeTask) cancel(err error) {
+	task.handleContextError(err, task.result)
+	// The resolver holds its own reference to the task (see resolveConcurrently) so the task is not
+	// put back to the free list until the resolver returns.
+	task.release()
+}
+
+// resolveConcurrently submits a task to the concurrent executor to run the field resolver with the
+// given info. The ExecuteNodeTask yields and is resumed when the resolver returns. Return false if
+// the submission failed (e.g., the executor has been shut down) in which case the caller should run
+// the resolver by itself.
+func (task *ExecuteNodeTask) resolveConcurrently(resolver graphql.FieldResolver, info graphql.ResolveInfo) bool {
+	// Compute the path before the resolver is run. See comments for task.path.
+	task.path = task.ctx.pathOf(task.result)
+
+	// Increment the reference count because the task is now referenced by the resolver which may
+	// still be running after the task is cancelled.
+	task.retain()
+
+	_, err := task.executor.concurrentExecutor.Submit(concurrent.TaskFunc(func() (interface{}, error) {
+		task.value, task.err = resolveField(task.ctx.Context(), resolver, task.source, info)
+		task.resolved = true
+		task.executor.Resume(task)
+		task.release()
+		return nil, nil
+	}))
+	if err != nil {
+		task.path = graphql.ResponsePath{}
+		task.release()
+		return false
+	}
+
+	task.executor.Yield(task)
+	return true
+}
+
 // handleNodeError first creates a graphql.Error for an error value (which includes additional
 // information such as field location) to be included in the GraphQL response and then adds the
 // error to the ctx (using ctx.AppendErrors) to indicate a failed field execution.
 func (task *ExecuteNodeTask) handleNodeError(err error, result *ResultNode) {
-	node := task.node
+	// Convert the panic recovered from the user code into an error.
+	if p, ok := err.(*panicError); ok {
+		err = task.ctx.handlePanic(p, task.newResolveInfoFor(result))
+	}
 
+	e := newFieldError(err, task.node, task.ctx.pathOf(result))
+
+	// Set result value to a nil value.
+	result.Kind = ResultKindNil
+	result.Value = nil
+
+	// Append error to task.errs.
+	task.executor.AppendError(e, result)
+}
+
+// handleContextError is like handleNodeError but for the error returned from Context.Err when the
+// execution is cancelled. See executor.AppendContextError.
+func (task *ExecuteNodeTask) handleContextError(err error, result *ResultNode) {
+	e := newFieldError(err, task.node, task.ctx.pathOf(result))
+
+	// Set result value to a nil value.
+	result.Kind = ResultKindNil
+	result.Value = nil
+
+	task.executor.AppendContextError(e, result)
+}
+
+// newFieldError wraps an error occurred when executing the field specified by node as a
+// graphql.Error with location of the field definitions and the given response path.
+func newFieldError(err error, node *ExecutionNode, path graphql.ResponsePath) *graphql.Error {
 	// Attach location info.
 	locations := make([]graphql.ErrorLocation, len(node.Definitions))
 	for i := range node.Definitions {
 		locations[i] = graphql.ErrorLocationOfASTNode(node.Definitions[i])
 	}
 
-	// Compute response path.
-	path := result.Path()
-
 	// Wrap it as a graphql.Error to ensure a consistent Error interface.
 	e, ok := err.(*graphql.Error)
 	if !ok {
@@ -540,12 +733,7 @@ func (task *ExecuteNodeTask) handleNodeError(err error, result *ResultNode) {
 		e.Path = path
 	}
 
-	// Set result value to a nil value.
-	result.Kind = ResultKindNil
-	result.Value = nil
-
-	// Append error to task.errs.
-	task.executor.AppendError(e, result)
+	return e
 }
 
 // completeValue implements "Value Completion" [0]. It ensures the value resolved from the field
@@ -579,6 +767,7 @@ func (task *ExecuteNodeTask) completeValuePrologue(
 	// not be ready yet. Dispatch a task to poll its result.
 	if value, ok := value.(future.Future); ok {
 		task.executor.Dispatch(&AsyncValueTask{
+			executor: task.executor,
 			// Increment the reference count because the task is now referenced by the AsyncValueTask.
 			nodeTask:        task.retain(),
 			dataLoaderCycle: task.executor.DataLoaderCycle(),
@@ -664,9 +853,16 @@ func (task *ExecuteNodeTask) completeWrappingValue(
 			continue
 		}
 
+		// Stream the items in the list value of the field if requested by @stream.
+		if result == task.result && task.node.stream != nil {
+			task.completeStreamedListValue(listType, result, value)
+			continue
+		}
+
 		// Complete a list value by completing each item in the list with the inner type.
 		elementType := listType.ElementType()
 		elementWrappingType, isWrappingElementType := elementType.(graphql.WrappingType)
+		isNullableElementType := !graphql.IsNonNullType(elementType)
 
 		// The following code is a bit mess. If the value implements Iterable interfaces, we want to
 		// enumerates the its item values via its custom iterator. Otherwise, we fallback to use
@@ -752,16 +948,11 @@ func (task *ExecuteNodeTask) completeWrappingValue(
 				if err == iterator.Done {
 					break
 				} else if err != nil {
-					node := task.node
-					task.handleNodeError(
-						graphql.NewError(
-							fmt.Sprintf("Error occurred while enumerates values in the list field %s.%s.",
-								parentFieldType(task.ctx, node).Name(), node.Field.Name()), err),
-						result)
+					task.handleNodeError(newListIterationError(task.ctx, task.node, err), result)
 					break
 				} else {
 					// Prepare resultNode for element.
-					resultNode := resultNodes.EmplaceBack(result, !isNonNullType)
+					resultNode := resultNodes.EmplaceBack(result, isNullableElementType)
 
 					if isWrappingElementType {
 						queue = append(queue, ValueNode{
@@ -784,7 +975,7 @@ func (task *ExecuteNodeTask) completeWrappingValue(
 
 			if isWrappingElementType {
 				for i := 0; i < numElements; i++ {
-					resultNode := resultNodes.EmplaceBack(result, !isNonNullType)
+					resultNode := resultNodes.EmplaceBack(result, isNullableElementType)
 					queue = append(queue, ValueNode{
 						returnType: elementWrappingType,
 						result:     resultNode,
@@ -793,7 +984,7 @@ func (task *ExecuteNodeTask) completeWrappingValue(
 				}
 			} else { // !isWrappingElementType
 				for i := 0; i < numElements; i++ {
-					resultNode := resultNodes.EmplaceBack(result, !isNonNullType)
+					resultNode := resultNodes.EmplaceBack(result, isNullableElementType)
 					value := v.Index(i).Interface()
 					if !task.completeNonWrappingValue(elementType, resultNode, value) {
 						// If the err causes the parent to be nil'ed, stop procsessing the remaining elements.
@@ -848,12 +1039,15 @@ func (task *ExecuteNodeTask) completeLeafValue(
 	result *ResultNode,
 	value interface{}) (ok bool) {
 
-	coercedValue, err := returnType.CoerceResultValue(value)
+	coercedValue, err := coerceResultValue(returnType, value)
 	if err != nil {
-		// See comments in graphql.NewCoercionError for the rules of handling error.
-		if e, ok := err.(*graphql.Error); !ok || e.Kind != graphql.ErrKindCoercion {
-			// Wrap the error in our own.
-			err = graphql.NewDefaultResultCoercionError(returnType.Name(), value, err)
+		// See comments in graphql.NewCoercionError for the rules of handling error. Panic is reported
+		// as is by handleNodeError.
+		if _, isPanic := err.(*panicError); !isPanic {
+			if e, ok := err.(*graphql.Error); !ok || e.Kind != graphql.ErrKindCoercion {
+				// Wrap the error in our own.
+				err = graphql.NewDefaultResultCoercionError(returnType.Name(), value, err)
+			}
 		}
 		task.handleNodeError(err, result)
 		return false
@@ -879,8 +1073,12 @@ func (task *ExecuteNodeTask) completeObjectValue(
 		return false
 	}
 
+	// Schedule the execution of the fragments deferred by @defer.
+	ctx.incremental.deferFragments(
+		ctx, task.node, returnType, task.node.deferredFragments[returnType], result, value)
+
 	// Dispatch tasks to execute subfields.
-	dispatchTasksForObject(task.ctx, task.executor, result, childNodes, value)
+	dispatchTasksForObject(task.ctx, task.executor, result, childNodes, value, false)
 
 	return true
 }
@@ -890,28 +1088,34 @@ func (task *ExecuteNodeTask) completeAbstractValue(
 	result *ResultNode,
 	value interface{}) (ok bool) {
 
-	var (
-		ctx  = task.ctx
-		node = task.node
-	)
-
 	resolver := returnType.TypeResolver()
 	if resolver == nil {
-		task.handleNodeError(
-			graphql.NewError(
-				fmt.Sprintf("Abstract type %s must provide resolver to resolve to an Object type at "+
-					"runtime for field %s.%s with value %s",
-					returnType.Name(), parentFieldType(ctx, node).Name(), node.Field.Name(),
-					graphql.Inspect(value))), result)
-		return false
+		// Fall back to the IsTypeOf checks on the possible types.
+		return task.completeAbstractValueWithTypeCheckers(returnType, result, value)
 	}
 
-	runtimeType, err := resolver.Resolve(ctx.Context(), value, task.newResolveInfoFor(result))
+	runtimeType, err := resolveType(task.ctx.Context(), resolver, value, task.newResolveInfoFor(result))
 	if err != nil {
 		task.handleNodeError(err, result)
 		return false
 	}
 
+	return task.completeAbstractValueWithRuntimeType(returnType, runtimeType, result, value)
+}
+
+// completeAbstractValueWithRuntimeType ensures that runtimeType resolved for the value is a possible
+// type of returnType and then completes the value with it.
+func (task *ExecuteNodeTask) completeAbstractValueWithRuntimeType(
+	returnType graphql.AbstractType,
+	runtimeType graphql.Object,
+	result *ResultNode,
+	value interface{}) (ok bool) {
+
+	var (
+		ctx  = task.ctx
+		node = task.node
+	)
+
 	if runtimeType == nil {
 		task.handleNodeError(
 			graphql.NewError(
@@ -922,7 +1126,7 @@ func (task *ExecuteNodeTask) completeAbstractValue(
 		return false
 	}
 
-	possibleTypes := task.ctx.Schema().PossibleTypes(returnType)
+	possibleTypes := ctx.Schema().PossibleTypes(returnType)
 	if !possibleTypes.Contains(runtimeType) {
 		task.handleNodeError(
 			graphql.NewError(
@@ -934,6 +1138,100 @@ func (task *ExecuteNodeTask) completeAbstractValue(
 	return task.completeObjectValue(runtimeType, result, value)
 }
 
+// completeAbstractValueWithTypeCheckers determines the runtime Object type for the value of an
+// abstract type that doesn't provide a TypeResolver. It calls the TypeChecker of each possible type
+// (in the order of type names) and uses the first one that accepts the value. Checks that return a
+// Future are waited in an AsyncTypeCheckTask.
+func (task *ExecuteNodeTask) completeAbstractValueWithTypeCheckers(
+	returnType graphql.AbstractType,
+	result *ResultNode,
+	value interface{}) (ok bool) {
+
+	var (
+		ctx  = task.ctx
+		node = task.node
+	)
+
+	// Collect possible types that have a TypeChecker. Sort them by name to make the result
+	// deterministic.
+	var possibleTypes []graphql.Object
+	iter := ctx.Schema().PossibleTypes(returnType).Iterator()
+	for {
+		possibleType, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if object := possibleType.(graphql.Object); object.TypeChecker() != nil {
+			possibleTypes = append(possibleTypes, object)
+		}
+	}
+
+	if len(possibleTypes) == 0 {
+		task.handleNodeError(
+			graphql.NewError(
+				fmt.Sprintf("Abstract type %s must provide resolver to resolve to an Object type at "+
+					"runtime for field %s.%s with value %s",
+					returnType.Name(), parentFieldType(ctx, node).Name(), node.Field.Name(),
+					graphql.Inspect(value))), result)
+		return false
+	}
+
+	sort.Slice(possibleTypes, func(i, j int) bool {
+		return possibleTypes[i].Name() < possibleTypes[j].Name()
+	})
+
+	var (
+		info         = task.newResolveInfoFor(result)
+		pendingTypes []graphql.Object
+		pendingTests []future.Future
+	)
+
+	for _, possibleType := range possibleTypes {
+		isTypeOf, err := checkType(ctx.Context(), possibleType.TypeChecker(), value, info)
+		if err != nil {
+			task.handleNodeError(err, result)
+			return false
+		}
+
+		switch isTypeOf := isTypeOf.(type) {
+		case bool:
+			if isTypeOf {
+				return task.completeObjectValue(possibleType, result, value)
+			}
+
+		case future.Future:
+			pendingTypes = append(pendingTypes, possibleType)
+			pendingTests = append(pendingTests, isTypeOf)
+
+		default:
+			task.handleNodeError(
+				graphql.NewError(
+					fmt.Sprintf("IsTypeOf of %s must return a bool or a future.Future that resolves to a "+
+						"bool, but got: %s.", possibleType.Name(), graphql.Inspect(isTypeOf))), result)
+			return false
+		}
+	}
+
+	if len(pendingTests) == 0 {
+		// None of the possible types accepts the value.
+		return task.completeAbstractValueWithRuntimeType(returnType, nil, result, value)
+	}
+
+	task.executor.Dispatch(&AsyncTypeCheckTask{
+		executor: task.executor,
+		// Increment the reference count because the task is now referenced by the AsyncTypeCheckTask.
+		nodeTask:        task.retain(),
+		dataLoaderCycle: task.executor.DataLoaderCycle(),
+		isTypeOf:        future.Join(pendingTests...),
+		possibleTypes:   pendingTypes,
+		returnType:      returnType,
+		result:          result,
+		value:           value,
+	})
+
+	return true
+}
+
 // newResolveInfoFor creates a ResolveInfo to resolve result with current task context.
 func (task *ExecuteNodeTask) newResolveInfoFor(result *ResultNode) graphql.ResolveInfo {
 	if result == task.result {
@@ -1015,7 +1313,11 @@ func (task *ExecuteNodeTask) Field() graphql.Field {
 
 // Path implements graphql.ResolveInfo.
 func (task *ExecuteNodeTask) Path() graphql.ResponsePath {
-	return task.result.Path()
+	if !task.path.Empty() {
+		// Return a copy to prevent the callers from modifying the path shared with others.
+		return task.path.Clone()
+	}
+	return task.ctx.pathOf(task.result)
 }
 
 // Args implements graphql.ResolveInfo.
@@ -1030,6 +1332,10 @@ func (task *ExecuteNodeTask) Args() graphql.ArgumentValues {
 // AsyncValueTask polls a Future to get a value from an asynchronous computation. The value will be
 // used to complete node execution (by calling completeValue with the value).
 type AsyncValueTask struct {
+	// Executor that runs this task; wake resumes the task on it. Note that nodeTask may have been put
+	// back to the free list (and reused by others) when wake is called after the cancellation.
+	executor *executor
+
 	// Node that requires the value to complete
 	nodeTask *ExecuteNodeTask
 
@@ -1051,9 +1357,10 @@ var _ Task = (*AsyncValueTask)(nil)
 // run implements Task.
 func (task *AsyncValueTask) run() {
 	// Poll task.value to see whether it is ready.
-	value, err := task.value.Poll(future.WakerFunc(task.wake))
+	value, err := pollFuture(task.value, future.WakerFunc(task.wake))
 	if err != nil {
 		task.nodeTask.handleNodeError(err, task.result)
+		task.nodeTask.release()
 	} else if value != future.PollResultPending {
 		task.nodeTask.completeValue(task.returnType, task.result, value)
 		task.nodeTask.release()
@@ -1067,9 +1374,90 @@ func (task *AsyncValueTask) run() {
 	}
 }
 
-// wake dispatch the task to the executor (again) to poll its result.
+// cancel implements Task.
+func (task *AsyncValueTask) cancel(err error) {
+	task.nodeTask.handleContextError(err, task.result)
+	task.nodeTask.release()
+}
+
+// wake dispatch the task to the executor (again) to poll its result. It is a no-op if the task has
+// been cancelled.
 func (task *AsyncValueTask) wake() error {
-	task.nodeTask.executor.Resume(task)
+	task.executor.Resume(task)
+	return nil
+}
+
+//===----------------------------------------------------------------------------------------====//
+// AsyncTypeCheckTask
+//===----------------------------------------------------------------------------------------====//
+
+// AsyncTypeCheckTask waits the results of IsTypeOf checks that return a Future to determine the
+// runtime Object type for a value of abstract type. The first type whose check resolves to true
+// will be used to complete the value.
+type AsyncTypeCheckTask struct {
+	// Executor that runs this task; See comments in AsyncValueTask.
+	executor *executor
+
+	// Node that requires the runtime type to complete
+	nodeTask *ExecuteNodeTask
+
+	// dataLoaderCycle specifies which cycle of data loaders dispatching this task is waiting for. See
+	// comments for DataLoaderCycle type in executor.go for details.
+	dataLoaderCycle DataLoaderCycle
+
+	// The future that joins the results of IsTypeOf from possibleTypes
+	isTypeOf future.Future
+
+	// The types being checked; possibleTypes[i] corresponds to the i-th value in isTypeOf's result.
+	possibleTypes []graphql.Object
+
+	// Corresponding parameters to call completeAbstractValueWithRuntimeType
+	returnType graphql.AbstractType
+	result     *ResultNode
+	value      interface{}
+}
+
+// AsyncTypeCheckTask implements Task.
+var _ Task = (*AsyncTypeCheckTask)(nil)
+
+// run implements Task.
+func (task *AsyncTypeCheckTask) run() {
+	// Poll task.isTypeOf to see whether all checks are done.
+	value, err := pollFuture(task.isTypeOf, future.WakerFunc(task.wake))
+	if err != nil {
+		task.nodeTask.handleNodeError(err, task.result)
+		task.nodeTask.release()
+	} else if value != future.PollResultPending {
+		var runtimeType graphql.Object
+		for i, isTypeOf := range value.([]interface{}) {
+			if isTypeOf, ok := isTypeOf.(bool); ok && isTypeOf {
+				runtimeType = task.possibleTypes[i]
+				break
+			}
+		}
+		task.nodeTask.completeAbstractValueWithRuntimeType(
+			task.returnType, runtimeType, task.result, task.value)
+		task.nodeTask.release()
+	} else {
+		// Results are not available at the time. Someone will perform the computation and notifies us
+		// via wake when they are ready.
+		task.nodeTask.executor.Yield(task)
+
+		// Dispatch data loaders if there's any pending data loading.
+		tryDispatchDataLoaders(task.nodeTask.ctx, task.nodeTask.executor, task.dataLoaderCycle)
+	}
+}
+
+// cancel implements Task.
+func (task *AsyncTypeCheckTask) cancel(err error) {
+	task.nodeTask.handleContextError(err, task.result)
+	task.nodeTask.release()
+}
+
+// wake dispatch the task to the executor (again) to poll its result. It is a no-op if the task has
+// been cancelled.
+func (task *AsyncTypeCheckTask) wake() error {
+	task.executor.Resume(task)
 	return nil
 }
 
@@ -1086,6 +1474,12 @@ func tryDispatchDataLoaders(
 		return
 	}
 
+	if ctx.Context().Err() != nil {
+		// Don't start new data fetching after the execution was cancelled. The tasks waiting for the
+		// data will be cancelled by executor.
+		return
+	}
+
 	for {
 		// Obtain current data loader cycle.
 		curCycle := executor.DataLoaderCycle()
@@ -457,6 +457,54 @@ var _ = Describe("Execute: Handles basic execution tasks", func() {
 			Equal(graphql.NewVariableValues(map[string]interface{}{"var": "abc"})))
 	})
 
+	It("provides directives applied to the field being resolved", func() {
+		cacheControlDirective := graphql.MustNewDirective(&graphql.DirectiveConfig{
+			Name: "cacheControl",
+			Locations: []graphql.DirectiveLocation{
+				graphql.DirectiveLocationFieldDefinition,
+			},
+			Args: graphql.ArgumentConfigMap{
+				"maxAge": {
+					Type: graphql.T(graphql.Int()),
+				},
+			},
+		})
+
+		var maxAge interface{}
+
+		queryType := graphql.MustNewObject(&graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"test": {
+					Type: graphql.T(graphql.String()),
+					Directives: graphql.AppliedDirectives{
+						{
+							Name: "cacheControl",
+							Args: map[string]interface{}{
+								"maxAge": 60,
+							},
+						},
+					},
+					Resolver: graphql.FieldResolverFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+						if directive := info.Field().Directives().Lookup("cacheControl"); directive != nil {
+							maxAge = directive.Args["maxAge"]
+						}
+						return "ok", nil
+					}),
+				},
+			},
+		})
+
+		schema := graphql.MustNewSchema(&graphql.SchemaConfig{
+			Query:      queryType,
+			Directives: graphql.DirectiveList{cacheControlDirective},
+		})
+
+		Expect(execute(schema, parser.MustParse(token.NewSource(`{ test }`)))).Should(
+			MatchResultInJSON(`{"data": {"test": "ok"}}`))
+		Expect(maxAge).Should(Equal(60))
+	})
+
 	It("threads root value context correctly", func() {
 		document := parser.MustParse(token.NewSource(`query Example { a }`))
 
@@ -1335,7 +1383,8 @@ var _ = Describe("Execute: Handles basic execution tasks", func() {
 		}`))
 	})
 
-	// We don't have isTypeOf.
+	// IsTypeOf is only used to resolve runtime type for abstract types when TypeResolver is not given.
+	// It is not checked when completing value for an Object type.
 	// It("fails when an isTypeOf check is not met", func() {
 	// })
 
@@ -19,6 +19,7 @@ package executor
 import (
 	"context"
 
+	"github.com/botobag/artemis/concurrent"
 	"github.com/botobag/artemis/graphql"
 	"github.com/botobag/artemis/graphql/internal/value"
 )
@@ -45,6 +46,22 @@ type ExecutionContext struct {
 	// variableValues contains values to the parameters in current query. The values has passed input
 	// coercion.
 	variableValues graphql.VariableValues
+
+	// concurrentExecutor runs field resolvers concurrently if it is provided.
+	concurrentExecutor concurrent.Executor
+
+	// panicHandler is notified with the panics recovered during execution if it is provided.
+	panicHandler PanicHandler
+
+	// incremental collects the deferred fragments and the streamed lists for delivering their results
+	// in subsequent payloads. It is shared by the copies of the context made for executing them.
+	incremental *incrementalPublisher
+
+	// When executing a deferred fragment or a streamed list item, resultRoot is the root of the result
+	// tree in the subsequent payload and resultRootPath is its path in the response. Both are unset
+	// when executing the initial result.
+	resultRoot     *ResultNode
+	resultRootPath graphql.ResponsePath
 }
 
 // newExecutionContext initializes an ExecutionContext given the operation to execute and the
@@ -60,12 +77,14 @@ func newExecutionContext(ctx context.Context, operation *PreparedOperation, opti
 	}
 
 	return &ExecutionContext{
-		ctx:               ctx,
-		dataLoaderManager: options.DataLoaderManager,
-		operation:         operation,
-		rootValue:         options.RootValue,
-		appContext:        options.AppContext,
-		variableValues:    variableValues,
+		ctx:                ctx,
+		dataLoaderManager:  options.DataLoaderManager,
+		operation:          operation,
+		rootValue:          options.RootValue,
+		appContext:         options.AppContext,
+		variableValues:     variableValues,
+		concurrentExecutor: options.ConcurrentExecutor,
+		panicHandler:       options.PanicHandler,
 	}, graphql.NoErrors()
 }
 
@@ -103,3 +122,16 @@ func (context *ExecutionContext) AppContext() interface{} {
 func (context *ExecutionContext) VariableValues() graphql.VariableValues {
 	return context.variableValues
 }
+
+// ConcurrentExecutor returns context.concurrentExecutor.
+func (context *ExecutionContext) ConcurrentExecutor() concurrent.Executor {
+	return context.concurrentExecutor
+}
+
+// pathOf returns the path in the response to the given result node.
+func (context *ExecutionContext) pathOf(result *ResultNode) graphql.ResponsePath {
+	if context.resultRoot == nil {
+		return result.Path()
+	}
+	return result.pathFrom(context.resultRoot, context.resultRootPath)
+}
@@ -104,6 +104,14 @@ type ExecutionNode struct {
 	// The child nodes of this node; Note that this is a map where key is the concrete type of the
 	// node. Selection Sets in a field may vary subject to its runtime type.
 	Children map[graphql.Object][]*ExecutionNode
+
+	// The fragments in the selection set of this node that are deferred by @defer; Like Children,
+	// they are keyed by the concrete type of the node. They are not expanded into Children.
+	deferredFragments map[graphql.Object][]*deferredFragment
+
+	// Arguments to @stream if the items in the list value of this field are to be streamed; nil
+	// otherwise.
+	stream *streamDirective
 }
 
 // IsRoot returns true if this node represents a root node.
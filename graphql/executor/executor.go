@@ -17,8 +17,10 @@
 package executor
 
 import (
+	"context"
 	"sync"
 
+	"github.com/botobag/artemis/concurrent"
 	"github.com/botobag/artemis/graphql"
 )
 
@@ -27,6 +29,11 @@ import (
 type Task interface {
 	// run defines operations performed by the task.
 	run()
+
+	// cancel is called instead of run for a task that is waiting for resumption when the execution
+	// is cancelled (i.e., the context is done.) It fails the task with err which is the error
+	// returned from Context.Err.
+	cancel(err error)
 }
 
 // DataLoaderCycle is used to determine when an AsyncValueTask should dispatches. The following
@@ -54,8 +61,11 @@ func propagateExecutionError(result *ResultNode) {
 	// encountered.
 	//
 	// Reference: https://graphql.github.io/graphql-spec/June2018/#sec-Errors-and-Non-Nullability
-	for result != nil && result.ShouldRejectNull() {
+	for result.ShouldRejectNull() {
 		result = result.Parent
+		if result == nil {
+			break
+		}
 		result.Kind = ResultKindNil
 		result.Value = nil
 	}
@@ -74,6 +84,12 @@ type executor struct {
 	// Errors that occurred during the execution
 	errs graphql.Errors
 
+	// Set to true after the error from Context.Err has been added to errs. See AppendContextError.
+	contextErrReported bool
+
+	// Context for the execution. Yielded tasks are cancelled once it is done.
+	ctx context.Context
+
 	// See comments for DataLoaderCycle.
 	dataLoaderCycle DataLoaderCycle
 
@@ -82,11 +98,18 @@ type executor struct {
 	yieldCond  sync.Cond
 	// Queue of the tasks yielded during task execution
 	yieldTasks map[Task]yieldTaskState
+	// Set to true after the yielded tasks have been cancelled. Resume becomes no-op thereafter.
+	cancelled bool
+
+	// If non-nil, ExecuteNodeTask's run field resolvers on this executor. See ConcurrentExecutor.
+	concurrentExecutor concurrent.Executor
 }
 
-func newExecutor() *executor {
+func newExecutor(ctx context.Context, concurrentExecutor concurrent.Executor) *executor {
 	e := &executor{
-		yieldTasks: map[Task]yieldTaskState{},
+		ctx:                ctx,
+		yieldTasks:         map[Task]yieldTaskState{},
+		concurrentExecutor: concurrentExecutor,
 	}
 	e.yieldCond = sync.Cond{
 		L: &e.yieldMutex,
@@ -99,8 +122,20 @@ func (e *executor) Dispatch(task Task) {
 	// Run the specified task.
 	task.run()
 
+	// When field resolvers are run by a concurrent executor, return immediately so the caller can
+	// continue to dispatch the sibling fields for running their resolvers in parallel. The yielded
+	// tasks are processed in Wait.
+	if e.concurrentExecutor != nil {
+		return
+	}
+
 	// task may generate (yield) other tasks during its processing. Process them before return.
+	e.Wait()
+}
 
+// Wait processes the tasks yielded during execution and blocks until all of them are completed. If
+// the context is done, the tasks that haven't completed are cancelled and Wait returns immediately.
+func (e *executor) Wait() {
 	// Acquire mutex to wait for yielded tasks.
 	mutex := &e.yieldMutex
 	mutex.Lock()
@@ -108,7 +143,23 @@ func (e *executor) Dispatch(task Task) {
 	// Load yielded tasks.
 	yieldTasks := e.yieldTasks
 
+	// Closed on return to stop the goroutine that wakes up Wait when the context is done.
+	var stopWatch chan struct{}
+
 	for {
+		// Cancel the remaining tasks if the context is done.
+		if err := e.ctx.Err(); err != nil && len(yieldTasks) > 0 {
+			e.cancelled = true
+			e.yieldTasks = map[Task]yieldTaskState{}
+			mutex.Unlock()
+			for task := range yieldTasks {
+				task.cancel(err)
+			}
+			mutex.Lock()
+			// Cancelled tasks don't yield. There's no more tasks to wait.
+			break
+		}
+
 		hasResumedTask := false
 
 		// Find the first task that has been resumed.
@@ -143,11 +194,34 @@ func (e *executor) Dispatch(task Task) {
 		//
 		// Block on Cond to wait for signal from Resume.
 		if !hasResumedTask {
+			// Also wake up when the context is done.
+			if stopWatch == nil && e.ctx.Done() != nil {
+				stopWatch = make(chan struct{})
+				go e.watchContext(stopWatch)
+			}
 			e.yieldCond.Wait()
 		}
 	}
 
 	mutex.Unlock()
+
+	if stopWatch != nil {
+		close(stopWatch)
+	}
+}
+
+// watchContext wakes up Wait when the context is done. It returns when stop is closed.
+func (e *executor) watchContext(stop <-chan struct{}) {
+	select {
+	case <-e.ctx.Done():
+		// Acquire the mutex to ensure the signal wouldn't be missed by Wait which may be checking the
+		// context with the mutex held.
+		e.yieldMutex.Lock()
+		e.yieldCond.Broadcast()
+		e.yieldMutex.Unlock()
+
+	case <-stop:
+	}
 }
 
 // Yield pauses the execution of the given task. It is used by tasks (e.g., AsyncValueTask) to
@@ -170,6 +244,11 @@ func (e *executor) Yield(task Task) {
 func (e *executor) Resume(task Task) {
 	mutex := &e.yieldMutex
 	mutex.Lock()
+	if e.cancelled {
+		// The task has been cancelled.
+		mutex.Unlock()
+		return
+	}
 	e.yieldTasks[task] = yieldTaskStateResumed
 	mutex.Unlock()
 
@@ -185,7 +264,8 @@ func (e *executor) DataLoaderCycle() DataLoaderCycle {
 // IncDataLoaderCycle incremnts data loader cycle counter by one. See comments in
 // tryDispatchDataLoaders.
 func (e *executor) IncDataLoaderCycle(expected DataLoaderCycle) bool {
-	// Tasks are executed serially. Therefore it is safe to increment the counter directly.
+	// Tasks are executed serially (only field resolvers may be run by a concurrent executor which
+	// never calls tryDispatchDataLoaders.) Therefore it is safe to increment the counter directly.
 	e.dataLoaderCycle++
 	return true
 }
@@ -198,9 +278,22 @@ func (e *executor) IncDataLoaderCycle(expected DataLoaderCycle) bool {
 func (e *executor) AppendError(err *graphql.Error, result *ResultNode) {
 	// Check parent result node to see whether the field is erroneous. If so, discard the error as per
 	// spec.
-	result = result.Parent
-	if !result.IsNil() {
+	if !result.Parent.IsNil() {
 		e.errs.Append(err)
 		propagateExecutionError(result)
 	}
 }
+
+// AppendContextError is like AppendError but for the error returned from Context.Err. Once the
+// context is done, every field that hasn't completed fails with the same error. Only the first one
+// is added to the error list to avoid flooding the response with duplicates while the null is still
+// propagated for every field.
+func (e *executor) AppendContextError(err *graphql.Error, result *ResultNode) {
+	if !result.Parent.IsNil() {
+		if !e.contextErrReported {
+			e.contextErrReported = true
+			e.errs.Append(err)
+		}
+		propagateExecutionError(result)
+	}
+}
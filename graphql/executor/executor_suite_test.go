@@ -45,6 +45,26 @@ func MatchResultInJSON(resultJSON string) types.GomegaMatcher {
 	return WithTransform(stringify, MatchJSON(resultJSON))
 }
 
+// MatchIncrementalResultsInJSON matches an ExecutionResult and the subsequent payloads delivered
+// via its Subsequent channel (which is drained by the matcher) with a JSON array containing the
+// JSON encoding of them in order.
+func MatchIncrementalResultsInJSON(resultsJSON string) types.GomegaMatcher {
+	stringify := func(result *executor.ExecutionResult) []byte {
+		var buf bytes.Buffer
+		buf.WriteString("[")
+		Expect(result.MarshalJSONTo(&buf)).Should(Succeed())
+		if result.Subsequent != nil {
+			for payload := range result.Subsequent {
+				buf.WriteString(",")
+				Expect(payload.MarshalJSONTo(&buf)).Should(Succeed())
+			}
+		}
+		buf.WriteString("]")
+		return buf.Bytes()
+	}
+	return WithTransform(stringify, MatchJSON(resultsJSON))
+}
+
 // Prototype of "execute" function
 type ExecuteFunc func(schema graphql.Schema, document ast.Document, opts ...interface{}) *executor.ExecutionResult
 
@@ -0,0 +1,570 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package executor
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"reflect"
+	"sync"
+
+	"github.com/botobag/artemis/graphql"
+	"github.com/botobag/artemis/graphql/ast"
+	values "github.com/botobag/artemis/graphql/internal/value"
+	"github.com/botobag/artemis/iterator"
+	"github.com/botobag/artemis/jsonwriter"
+)
+
+// This file implements incremental delivery for @defer and @stream. The fragments deferred by
+// @defer and the list items after the initialCount ones of a field with @stream are not included in
+// the initial result. Instead, they are queued when they're encountered during execution and are
+// run one by one in a separate goroutine after the initial result is returned. The result of each
+// one is delivered in a subsequent payload (i.e., IncrementalResult) via
+// ExecutionResult.Subsequent.
+
+// IncrementalResult is a subsequent payload that contains the result of a fragment deferred by
+// @defer or an item in a list streamed by @stream.
+type IncrementalResult struct {
+	// Data contains the result of a deferred fragment. It is nil for a payload of @stream.
+	Data *ResultNode
+
+	// Items contains a list of the streamed items. It is nil for a payload of @defer.
+	Items *ResultNode
+
+	// Path in the response to the object of the deferred fragment or to the first item in Items
+	Path graphql.ResponsePath
+
+	// Label given to the @defer or @stream
+	Label string
+
+	// Errors occurred while producing Data or Items
+	Errors graphql.Errors
+
+	// HasNext is true if there're more payloads to follow.
+	HasNext bool
+}
+
+// MarshalJSONTo writes the JSON encoding of result to the w. See ExecutionResult.MarshalJSONTo.
+func (result *IncrementalResult) MarshalJSONTo(w io.Writer) error {
+	stream := jsonwriter.NewStream(w)
+	stream.WriteValue(NewIncrementalResultMarshaler(result))
+	stream.WriteRawString("\n")
+	return stream.Flush()
+}
+
+// MarshalJSON implements json.Marshaler interface for IncrementalResult.
+func (result IncrementalResult) MarshalJSON() ([]byte, error) {
+	return jsonwriter.Marshal(NewIncrementalResultMarshaler(&result))
+}
+
+//===----------------------------------------------------------------------------------------====//
+// @defer and @stream
+//===----------------------------------------------------------------------------------------====//
+
+// deferredFragment is a fragment (either an inline fragment or a fragment spread) whose execution
+// is deferred by @defer.
+type deferredFragment struct {
+	// Label given to the @defer
+	label string
+
+	// Selection set in the fragment
+	selectionSet ast.SelectionSet
+
+	// Cache for ExecutionNode's and the nested deferred fragments in the selection set. See
+	// collectFields.
+	childNodes        []*ExecutionNode
+	deferredFragments []*deferredFragment
+}
+
+// deferredFragmentOf returns a deferredFragment for the fragment with the given directives and
+// selection set if it should be deferred by @defer. Otherwise, return nil.
+func deferredFragmentOf(
+	ctx *ExecutionContext,
+	directives ast.Directives,
+	selectionSet ast.SelectionSet) (*deferredFragment, error) {
+
+	args, err := values.DirectiveValues(graphql.DeferDirective(), directives, ctx.VariableValues())
+	if err != nil {
+		return nil, err
+	}
+
+	if shouldDefer, ok := args.Get("if").(bool); !ok || !shouldDefer {
+		return nil, nil
+	}
+
+	label, _ := args.Get("label").(string)
+	return &deferredFragment{
+		label:        label,
+		selectionSet: selectionSet,
+	}, nil
+}
+
+// collectFields builds ExecutionNode's for the selection set in the fragment. The nested fragments
+// that are deferred are returned in the second return value. Note that the node and runtimeType are
+// always the same for a deferredFragment (because it is cached in node for the runtimeType) so the
+// results are cached in the fragment.
+func (fragment *deferredFragment) collectFields(
+	ctx *ExecutionContext,
+	node *ExecutionNode,
+	runtimeType graphql.Object) ([]*ExecutionNode, []*deferredFragment, error) {
+
+	if fragment.childNodes == nil {
+		childNodes, deferredFragments, err := buildChildExecutionNodesForSelectionSet(
+			ctx, node, runtimeType, []ast.SelectionSet{fragment.selectionSet})
+		if err != nil {
+			return nil, nil, err
+		}
+		fragment.childNodes = childNodes
+		fragment.deferredFragments = deferredFragments
+	}
+
+	return fragment.childNodes, fragment.deferredFragments, nil
+}
+
+// streamDirective contains arguments to the @stream applied to a field.
+type streamDirective struct {
+	// Label given to the @stream
+	label string
+
+	// Number of items to be included in the initial result
+	initialCount int
+}
+
+// streamDirectiveOf returns arguments to the @stream applied to the given field if the field should
+// be streamed. Otherwise, return nil. Note that initialCount is checked when completing the field
+// value so a negative value is reported as a field error.
+func streamDirectiveOf(ctx *ExecutionContext, field *ast.Field) (*streamDirective, error) {
+	args, err := values.DirectiveValues(graphql.StreamDirective(), field.Directives, ctx.VariableValues())
+	if err != nil {
+		return nil, err
+	}
+
+	if shouldStream, ok := args.Get("if").(bool); !ok || !shouldStream {
+		return nil, nil
+	}
+
+	initialCount, _ := args.Get("initialCount").(int)
+	label, _ := args.Get("label").(string)
+	return &streamDirective{
+		label:        label,
+		initialCount: initialCount,
+	}, nil
+}
+
+// completeStreamedListValue completes the list value of a field with @stream. The first
+// initialCount items are completed in place. The remaining ones are streamed in subsequent
+// payloads.
+func (task *ExecuteNodeTask) completeStreamedListValue(
+	listType graphql.List,
+	result *ResultNode,
+	value interface{}) {
+
+	var (
+		ctx    = task.ctx
+		node   = task.node
+		stream = node.stream
+	)
+
+	if stream.initialCount < 0 {
+		task.handleNodeError(graphql.NewError("initialCount must be a non-negative integer"), result)
+		return
+	}
+
+	iter := listIteratorOf(value)
+	if iter == nil {
+		task.handleNodeError(
+			graphql.NewError(
+				fmt.Sprintf("Expected Iterable, but did not find one for field %s.%s.",
+					parentFieldType(ctx, node).Name(), node.Field.Name())),
+			result)
+		return
+	}
+
+	var (
+		elementType = listType.ElementType()
+		nullable    = !graphql.IsNonNullType(elementType)
+		resultNodes = NewResultNodeList()
+	)
+
+	// Complete result.
+	result.Kind = ResultKindList
+	result.Value = resultNodes
+
+	index := 0
+	for ; index < stream.initialCount; index++ {
+		value, err := iter.Next()
+		if err == iterator.Done {
+			return
+		} else if err != nil {
+			task.handleNodeError(newListIterationError(ctx, node, err), result)
+			return
+		}
+
+		resultNode := resultNodes.EmplaceBack(result, nullable)
+		task.completeValue(elementType, resultNode, value)
+
+		// If the error causes the list to be nil'ed, stop procsessing the remaining elements.
+		if result.IsNil() {
+			return
+		}
+	}
+
+	if iter, ok := iter.(*sliceIterator); ok && iter.Done() {
+		// No more items to stream.
+		return
+	}
+
+	// Stream the remaining items.
+	ctx.incremental.enqueue(&streamWork{
+		ctx:         ctx,
+		node:        node,
+		elementType: elementType,
+		nullable:    nullable,
+		iter:        iter,
+		index:       index,
+		result:      result,
+		path:        ctx.pathOf(result),
+	})
+}
+
+// newListIterationError creates an error for the error returned from the iterator of the list value
+// of the field specified by node.
+func newListIterationError(ctx *ExecutionContext, node *ExecutionNode, err error) error {
+	return graphql.NewError(
+		fmt.Sprintf("Error occurred while enumerates values in the list field %s.%s.",
+			parentFieldType(ctx, node).Name(), node.Field.Name()), err)
+}
+
+// listIteratorOf returns an iterator to enumerate the items in a list value. Return nil if value is
+// neither an Iterable nor an array or a slice.
+func listIteratorOf(value interface{}) graphql.Iterator {
+	if iterable, ok := value.(graphql.Iterable); ok {
+		return iterable.Iterator()
+	}
+
+	v := reflect.ValueOf(value)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Array && v.Kind() != reflect.Slice {
+		return nil
+	}
+
+	return &sliceIterator{v: v}
+}
+
+// sliceIterator implements graphql.Iterator to enumerate the elements in an array or a slice.
+type sliceIterator struct {
+	v     reflect.Value
+	index int
+}
+
+// Next implements graphql.Iterator.
+func (iter *sliceIterator) Next() (interface{}, error) {
+	if iter.Done() {
+		return nil, iterator.Done
+	}
+	value := iter.v.Index(iter.index).Interface()
+	iter.index++
+	return value, nil
+}
+
+// Done returns true if all elements have been enumerated.
+func (iter *sliceIterator) Done() bool {
+	return iter.index >= iter.v.Len()
+}
+
+//===----------------------------------------------------------------------------------------====//
+// incrementalPublisher
+//===----------------------------------------------------------------------------------------====//
+
+// incrementalWork is a unit of work that produces a subsequent payload.
+type incrementalWork interface {
+	// run performs the work and returns the payload to deliver. It returns nil if there's nothing to
+	// deliver. The work may enqueue more works to the publisher.
+	run(publisher *incrementalPublisher) *IncrementalResult
+}
+
+// incrementalPublisher collects the works for the deferred fragments and the streamed lists
+// encountered during execution and runs them to deliver subsequent payloads. The works enqueued
+// during the execution for the initial result are run after the initial result is completed. They
+// are run serially in the order they're enqueued, so no synchronization is required.
+type incrementalPublisher struct {
+	// Queue of the works to be run
+	pending []incrementalWork
+
+	// Closed by stop to abandon the pending works
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+// enqueue adds a work to the end of the queue.
+func (publisher *incrementalPublisher) enqueue(work incrementalWork) {
+	publisher.pending = append(publisher.pending, work)
+}
+
+// deferFragments enqueues works for executing the given fragments in the selection set of node.
+// result is the ResultNode for the object value with the given runtime type.
+func (publisher *incrementalPublisher) deferFragments(
+	ctx *ExecutionContext,
+	node *ExecutionNode,
+	runtimeType graphql.Object,
+	fragments []*deferredFragment,
+	result *ResultNode,
+	value interface{}) {
+
+	if len(fragments) == 0 {
+		return
+	}
+
+	path := ctx.pathOf(result)
+	for _, fragment := range fragments {
+		publisher.enqueue(&deferredFragmentWork{
+			ctx:         ctx,
+			node:        node,
+			runtimeType: runtimeType,
+			fragment:    fragment,
+			result:      result,
+			path:        path,
+			value:       value,
+		})
+	}
+}
+
+// start starts a goroutine to run the pending works and returns a channel for receiving the
+// payloads. Return nil if there's no work.
+func (publisher *incrementalPublisher) start(ctx *ExecutionContext) <-chan *IncrementalResult {
+	if len(publisher.pending) == 0 {
+		return nil
+	}
+
+	payloads := make(chan *IncrementalResult)
+	publisher.done = make(chan struct{})
+	go publisher.run(ctx.Context(), payloads)
+	return payloads
+}
+
+// stop abandons the pending works and closes the channel returned from start. It is safe to call
+// stop more than once and after all payloads have been delivered.
+func (publisher *incrementalPublisher) stop() {
+	if publisher.done == nil {
+		return
+	}
+	publisher.stopOnce.Do(func() {
+		close(publisher.done)
+	})
+}
+
+// run runs the pending works and sends their payloads to the channel until there's no more works, c
+// is done or the publisher is stopped.
+func (publisher *incrementalPublisher) run(c context.Context, payloads chan<- *IncrementalResult) {
+	defer close(payloads)
+
+	// Stop running the pending works once the context is done or the publisher is stopped.
+	for len(publisher.pending) > 0 && c.Err() == nil && !publisher.stopped() {
+		var work incrementalWork
+		work, publisher.pending = publisher.pending[0], publisher.pending[1:]
+
+		payload := work.run(publisher)
+		if payload == nil {
+			if len(publisher.pending) > 0 {
+				continue
+			}
+			// Deliver an empty payload to notify the end of payloads.
+			payload = &IncrementalResult{}
+		}
+		payload.HasNext = len(publisher.pending) > 0
+
+		select {
+		case payloads <- payload:
+		case <-c.Done():
+			return
+		case <-publisher.done:
+			return
+		}
+	}
+}
+
+// stopped returns true if stop has been called.
+func (publisher *incrementalPublisher) stopped() bool {
+	select {
+	case <-publisher.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// isResultNulled returns true if result or any of its ancestors has been resolved to nil (e.g.,
+// because of an error.)
+func isResultNulled(result *ResultNode) bool {
+	for ; result != nil; result = result.Parent {
+		if result.IsNil() {
+			return true
+		}
+	}
+	return false
+}
+
+// deferredFragmentWork executes a deferred fragment.
+type deferredFragmentWork struct {
+	// Context at the time the fragment was deferred
+	ctx *ExecutionContext
+
+	// The node whose selection set contains the fragment
+	node *ExecutionNode
+
+	// The runtime type of the object value
+	runtimeType graphql.Object
+
+	// The fragment to execute
+	fragment *deferredFragment
+
+	// ResultNode for the object value and its path in the response
+	result *ResultNode
+	path   graphql.ResponsePath
+
+	// The object value
+	value interface{}
+}
+
+// run implements incrementalWork.
+func (work *deferredFragmentWork) run(publisher *incrementalPublisher) *IncrementalResult {
+	// Skip the fragment if the object has been nulled.
+	if isResultNulled(work.result) {
+		return nil
+	}
+
+	var (
+		result  = &ResultNode{}
+		payload = &IncrementalResult{
+			Data:  result,
+			Path:  work.path,
+			Label: work.fragment.label,
+		}
+	)
+
+	// Make a copy of context for computing the path to the results in the payload.
+	ctx := *work.ctx
+	ctx.resultRoot = result
+	ctx.resultRootPath = work.path
+
+	childNodes, deferredFragments, err := work.fragment.collectFields(&ctx, work.node, work.runtimeType)
+	if err != nil {
+		payload.Errors = graphql.ErrorsOf(err.(*graphql.Error))
+		return payload
+	}
+
+	publisher.deferFragments(&ctx, work.node, work.runtimeType, deferredFragments, result, work.value)
+
+	executor := newExecutor(ctx.Context(), ctx.ConcurrentExecutor())
+	dispatchTasksForObject(&ctx, executor, result, childNodes, work.value, false)
+	executor.Wait()
+
+	payload.Errors = executor.errs
+	return payload
+}
+
+// streamWork completes the next item in a list streamed by @stream.
+type streamWork struct {
+	// Context at the time the list was completed
+	ctx *ExecutionContext
+
+	// The field node with @stream
+	node *ExecutionNode
+
+	// Type of the items in the list and whether the item allows nil value
+	elementType graphql.Type
+	nullable    bool
+
+	// Iterator for the remaining items and the index of the next item
+	iter  graphql.Iterator
+	index int
+
+	// ResultNode for the list value and its path in the response
+	result *ResultNode
+	path   graphql.ResponsePath
+}
+
+// run implements incrementalWork.
+func (work *streamWork) run(publisher *incrementalPublisher) *IncrementalResult {
+	// Stop streaming if the list has been nulled.
+	if isResultNulled(work.result) {
+		return nil
+	}
+
+	value, err := work.iter.Next()
+	if err == iterator.Done {
+		return nil
+	}
+
+	var (
+		node = work.node
+		path = work.path.Clone()
+
+		items   = &ResultNode{}
+		payload = &IncrementalResult{
+			Items: items,
+			Label: node.stream.label,
+		}
+	)
+	path.AppendIndex(work.index)
+	payload.Path = path
+
+	if err != nil {
+		// Report the error at the list and stop streaming.
+		payload.Errors = graphql.ErrorsOf(
+			newFieldError(newListIterationError(work.ctx, node, err), node, work.path))
+		return payload
+	}
+
+	// Allocate ResultNode for the item.
+	itemNodes := NewFixedSizeResultNodeList(1)
+	items.Kind = ResultKindList
+	items.Value = itemNodes
+	item := itemNodes.EmplaceBack(items, work.nullable)
+
+	// Make a copy of context for computing the path to the results in the payload.
+	ctx := *work.ctx
+	ctx.resultRoot = item
+	ctx.resultRootPath = path
+
+	// Complete the item. Note that the result of the task is set to items (instead of item) so the
+	// item (which could be a list) wouldn't be streamed again.
+	executor := newExecutor(ctx.Context(), ctx.ConcurrentExecutor())
+	task := newExecuteNodeTask(executor, &ctx, node, items, nil)
+	task.completeValue(work.elementType, item, value)
+	executor.Wait()
+	task.release()
+
+	payload.Errors = executor.errs
+
+	// Stop streaming if a non-null item resolves to null. The error has nulled items.
+	if items.IsNil() {
+		return payload
+	}
+
+	// Continue to stream the next item.
+	work.index++
+	if iter, ok := work.iter.(*sliceIterator); !ok || !iter.Done() {
+		publisher.enqueue(work)
+	}
+
+	return payload
+}
@@ -0,0 +1,300 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package executor_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/botobag/artemis/graphql"
+	"github.com/botobag/artemis/graphql/parser"
+	"github.com/botobag/artemis/graphql/token"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// graphql-js/src/execution/__tests__/nonnull-test.js@8c96dc8
+var _ = Describe("Execute: handles non-nullable types", func() {
+	dataType := &graphql.ObjectConfig{
+		Name: "DataType",
+	}
+
+	throwingResolver := graphql.FieldResolverFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+		return nil, errors.New(info.Field().Name())
+	})
+
+	nullResolver := graphql.FieldResolverFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+		return nil, nil
+	})
+
+	nestResolver := graphql.FieldResolverFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+		return struct{}{}, nil
+	})
+
+	dataType.Fields = graphql.Fields{
+		"sync": {
+			Type:     graphql.T(graphql.String()),
+			Resolver: throwingResolver,
+		},
+		"syncNonNull": {
+			Type:     graphql.NonNullOfType(graphql.String()),
+			Resolver: throwingResolver,
+		},
+		"syncReturnsNullNonNull": {
+			Type:     graphql.NonNullOfType(graphql.String()),
+			Resolver: nullResolver,
+		},
+		"syncNest": {
+			Type:     dataType,
+			Resolver: nestResolver,
+		},
+		"syncNonNullNest": {
+			Type:     graphql.NonNullOf(dataType),
+			Resolver: nestResolver,
+		},
+	}
+
+	schema := graphql.MustNewSchema(&graphql.SchemaConfig{
+		Query: graphql.MustNewObject(&graphql.ObjectConfig{
+			Name:   "Query",
+			Fields: dataType.Fields,
+		}),
+	})
+
+	executeQuery := func(query string) interface{} {
+		return execute(schema, parser.MustParse(token.NewSource(query)))
+	}
+
+	It("nulls a nullable field", func() {
+		Expect(executeQuery(`{ sync }`)).Should(MatchResultInJSON(`{
+			"data": { "sync": null },
+			"errors": [
+				{
+					"message": "sync",
+					"locations": [{ "line": 1, "column": 3 }],
+					"path": ["sync"]
+				}
+			]
+		}`))
+	})
+
+	It("nulls a returned object that contains a non-nullable field", func() {
+		Expect(executeQuery(`{ syncNest { syncNonNull } }`)).Should(MatchResultInJSON(`{
+			"data": { "syncNest": null },
+			"errors": [
+				{
+					"message": "syncNonNull",
+					"locations": [{ "line": 1, "column": 14 }],
+					"path": ["syncNest", "syncNonNull"]
+				}
+			]
+		}`))
+	})
+
+	It("nulls a returned object that contains a non-nullable field that returns null", func() {
+		Expect(executeQuery(`{ syncNest { sync, syncReturnsNullNonNull } }`)).Should(MatchResultInJSON(`{
+			"data": { "syncNest": null },
+			"errors": [
+				{
+					"message": "sync",
+					"locations": [{ "line": 1, "column": 14 }],
+					"path": ["syncNest", "sync"]
+				},
+				{
+					"message": "Cannot return null for non-nullable field DataType.syncReturnsNullNonNull.",
+					"locations": [{ "line": 1, "column": 20 }],
+					"path": ["syncNest", "syncReturnsNullNonNull"]
+				}
+			]
+		}`))
+	})
+
+	It("nulls the nearest nullable ancestor across non-nullable fields", func() {
+		Expect(executeQuery(`
+      {
+        syncNest {
+          syncNonNullNest {
+            syncNonNullNest {
+              syncNonNull
+            }
+          }
+        }
+        sync
+      }
+    `)).Should(MatchResultInJSON(`{
+			"data": {
+				"syncNest": null,
+				"sync": null
+			},
+			"errors": [
+				{
+					"message": "syncNonNull",
+					"locations": [{ "line": 6, "column": 15 }],
+					"path": ["syncNest", "syncNonNullNest", "syncNonNullNest", "syncNonNull"]
+				},
+				{
+					"message": "sync",
+					"locations": [{ "line": 10, "column": 9 }],
+					"path": ["sync"]
+				}
+			]
+		}`))
+	})
+
+	It("nulls the top level if non-nullable field", func() {
+		Expect(executeQuery(`{ syncNonNull }`)).Should(MatchResultInJSON(`{
+			"data": null,
+			"errors": [
+				{
+					"message": "syncNonNull",
+					"locations": [{ "line": 1, "column": 3 }],
+					"path": ["syncNonNull"]
+				}
+			]
+		}`))
+	})
+})
+
+// graphql-js/src/execution/__tests__/lists-test.js@8c96dc8
+var _ = Describe("Execute: Handles list nullability", func() {
+	// check executes { nest { list } } with "list" of the given type that returns the given value.
+	check := func(listType graphql.TypeDefinition, value interface{}, expected string) {
+		schema := graphql.MustNewSchema(&graphql.SchemaConfig{
+			Query: graphql.MustNewObject(&graphql.ObjectConfig{
+				Name: "Query",
+				Fields: graphql.Fields{
+					"nest": {
+						Type: &graphql.ObjectConfig{
+							Name: "DataType",
+							Fields: graphql.Fields{
+								"list": {
+									Type: listType,
+									Resolver: graphql.FieldResolverFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+										return value, nil
+									}),
+								},
+							},
+						},
+						Resolver: graphql.FieldResolverFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+							return struct{}{}, nil
+						}),
+					},
+				},
+			}),
+		})
+
+		Expect(execute(schema, parser.MustParse(token.NewSource(`{ nest { list } }`)))).Should(
+			MatchResultInJSON(expected))
+	}
+
+	Describe("[T]", func() {
+		listType := graphql.ListOfType(graphql.Int())
+
+		It("contains values", func() {
+			check(listType, []interface{}{1, 2}, `{ "data": { "nest": { "list": [1, 2] } } }`)
+		})
+
+		It("contains null", func() {
+			check(listType, []interface{}{1, nil, 2}, `{ "data": { "nest": { "list": [1, null, 2] } } }`)
+		})
+	})
+
+	Describe("[T]!", func() {
+		listType := graphql.NonNullOf(graphql.ListOfType(graphql.Int()))
+
+		It("contains null", func() {
+			check(listType, []interface{}{1, nil, 2}, `{ "data": { "nest": { "list": [1, null, 2] } } }`)
+		})
+
+		It("returns null", func() {
+			check(listType, nil, `{
+				"data": { "nest": null },
+				"errors": [
+					{
+						"message": "Cannot return null for non-nullable field DataType.list.",
+						"locations": [{ "line": 1, "column": 10 }],
+						"path": ["nest", "list"]
+					}
+				]
+			}`)
+		})
+	})
+
+	Describe("[T!]", func() {
+		listType := graphql.ListOf(graphql.NonNullOfType(graphql.Int()))
+
+		It("contains values", func() {
+			check(listType, []interface{}{1, 2}, `{ "data": { "nest": { "list": [1, 2] } } }`)
+		})
+
+		It("contains null", func() {
+			check(listType, []interface{}{1, nil, 2}, `{
+				"data": { "nest": { "list": null } },
+				"errors": [
+					{
+						"message": "Cannot return null for non-nullable field DataType.list.",
+						"locations": [{ "line": 1, "column": 10 }],
+						"path": ["nest", "list", 1]
+					}
+				]
+			}`)
+		})
+
+		It("returns null", func() {
+			check(listType, nil, `{ "data": { "nest": { "list": null } } }`)
+		})
+	})
+
+	Describe("[T!]!", func() {
+		listType := graphql.NonNullOf(graphql.ListOf(graphql.NonNullOfType(graphql.Int())))
+
+		It("contains values", func() {
+			check(listType, []interface{}{1, 2}, `{ "data": { "nest": { "list": [1, 2] } } }`)
+		})
+
+		It("contains null", func() {
+			check(listType, []interface{}{1, nil, 2}, `{
+				"data": { "nest": null },
+				"errors": [
+					{
+						"message": "Cannot return null for non-nullable field DataType.list.",
+						"locations": [{ "line": 1, "column": 10 }],
+						"path": ["nest", "list", 1]
+					}
+				]
+			}`)
+		})
+	})
+
+	Describe("[[T!]]", func() {
+		listType := graphql.ListOf(graphql.ListOf(graphql.NonNullOfType(graphql.Int())))
+
+		It("nulls the inner list that contains null", func() {
+			check(listType, []interface{}{[]interface{}{1}, []interface{}{2, nil}}, `{
+				"data": { "nest": { "list": [[1], null] } },
+				"errors": [
+					{
+						"message": "Cannot return null for non-nullable field DataType.list.",
+						"locations": [{ "line": 1, "column": 10 }],
+						"path": ["nest", "list", 1, 1]
+					}
+				]
+			}`)
+		})
+	})
+})
@@ -0,0 +1,162 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package executor
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+
+	"github.com/botobag/artemis/concurrent/future"
+	"github.com/botobag/artemis/graphql"
+)
+
+// PanicHandler is notified with the panics recovered during execution. Executor recovers from the
+// panics in field resolvers, type resolvers, IsTypeOf checks, CoerceResultValue of leaf types, the
+// polls of the futures returned by them and the source streams of subscriptions, and reports each of them as a field error which is
+// propagated as described in "Errors and Non-Nullability" [0]. The message of the field error
+// doesn't include the panic value which may contain sensitive information; The value is kept in the
+// underlying error (i.e., graphql.Error.Err) instead. A PanicHandler can be given to Execute via
+// OnPanic to report the panics (e.g., to log them or send them to an error tracking service) and to
+// customize the field errors.
+//
+// [0]: https://graphql.github.io/graphql-spec/June2018/#sec-Errors-and-Non-Nullability
+type PanicHandler interface {
+	// HandlePanic is called with the value passed to panic and the stack trace of the goroutine that
+	// panicked (as returned by runtime/debug.Stack). info describes the field being executed when the
+	// panic occurred and is only valid during the call. It is called on the goroutine that executes
+	// the operation even if the panic occurred in a resolver run by the ConcurrentExecutor. For a panic
+	// in the Iterator of a subscription's source stream, it is called on the goroutine that reads the
+	// stream and the error is delivered as an error event which ends the stream.
+	//
+	// If a non-nil error is returned, it is reported as the field error in place of the default one.
+	// This allows the handler to opt in to exposing the panic value to clients. Return nil to report
+	// the default error with a generic message.
+	HandlePanic(ctx context.Context, value interface{}, stack []byte, info graphql.ResolveInfo) error
+}
+
+// PanicHandlerFunc is an adapter to allow the use of ordinary functions as PanicHandler.
+type PanicHandlerFunc func(ctx context.Context, value interface{}, stack []byte, info graphql.ResolveInfo) error
+
+// HandlePanic calls f(ctx, value, stack, info).
+func (f PanicHandlerFunc) HandlePanic(
+	ctx context.Context,
+	value interface{},
+	stack []byte,
+	info graphql.ResolveInfo) error {
+	return f(ctx, value, stack, info)
+}
+
+// panicError carries the value recovered from a panic and the stack trace of the goroutine that
+// panicked. It is returned from the functions below in place of the panic and is converted into a
+// field error with handlePanic.
+type panicError struct {
+	value interface{}
+	stack []byte
+}
+
+// Error implements Go's error interface.
+func (err *panicError) Error() string {
+	return fmt.Sprint(err.value)
+}
+
+// recoverPanic recovers from a panic and stores it as a panicError in err. It must be called
+// directly by a deferred function call.
+func recoverPanic(err *error) {
+	if value := recover(); value != nil {
+		*err = &panicError{
+			value: value,
+			stack: debug.Stack(),
+		}
+	}
+}
+
+// handlePanic notifies the PanicHandler (if any) with the panic carried by err and then returns an
+// error to be reported for the field specified by info. Unless the PanicHandler provides one, the
+// error has a generic message and wraps the panic value (or err if the value is not an error.)
+func (context *ExecutionContext) handlePanic(err *panicError, info graphql.ResolveInfo) error {
+	if handler := context.panicHandler; handler != nil {
+		if e := handler.HandlePanic(context.Context(), err.value, err.stack, info); e != nil {
+			return e
+		}
+	}
+
+	message := fmt.Sprintf("Internal error occurred when executing field %s.%s.",
+		info.Object().Name(), info.Field().Name())
+	if cause, ok := err.value.(error); ok {
+		return graphql.NewError(message, cause)
+	}
+	return graphql.NewError(message, err)
+}
+
+// The following functions call into user code that may panic. Each of them recovers from the panic
+// and returns it as a panicError.
+
+func resolveField(
+	ctx context.Context,
+	resolver graphql.FieldResolver,
+	source interface{},
+	info graphql.ResolveInfo) (value interface{}, err error) {
+
+	defer recoverPanic(&err)
+	return resolver.Resolve(ctx, source, info)
+}
+
+func subscribeField(
+	ctx context.Context,
+	subscriber graphql.FieldSubscriber,
+	source interface{},
+	info graphql.ResolveInfo) (value interface{}, err error) {
+
+	defer recoverPanic(&err)
+	return subscriber.Subscribe(ctx, source, info)
+}
+
+func resolveType(
+	ctx context.Context,
+	resolver graphql.TypeResolver,
+	value interface{},
+	info graphql.ResolveInfo) (runtimeType graphql.Object, err error) {
+
+	defer recoverPanic(&err)
+	return resolver.Resolve(ctx, value, info)
+}
+
+func checkType(
+	ctx context.Context,
+	checker graphql.TypeChecker,
+	value interface{},
+	info graphql.ResolveInfo) (isTypeOf interface{}, err error) {
+
+	defer recoverPanic(&err)
+	return checker.IsTypeOf(ctx, value, info)
+}
+
+func coerceResultValue(leafType graphql.LeafType, value interface{}) (coercedValue interface{}, err error) {
+	defer recoverPanic(&err)
+	return leafType.CoerceResultValue(value)
+}
+
+func pollFuture(f future.Future, waker future.Waker) (result future.PollResult, err error) {
+	defer recoverPanic(&err)
+	return f.Poll(waker)
+}
+
+func nextValue(iter graphql.Iterator) (value interface{}, err error) {
+	defer recoverPanic(&err)
+	return iter.Next()
+}
@@ -0,0 +1,415 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package executor_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/botobag/artemis/concurrent"
+	"github.com/botobag/artemis/concurrent/future"
+	"github.com/botobag/artemis/graphql"
+	"github.com/botobag/artemis/graphql/executor"
+	"github.com/botobag/artemis/graphql/parser"
+	"github.com/botobag/artemis/graphql/token"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// panicFuture is a future.Future that panics when being polled.
+type panicFuture struct{}
+
+// Poll implements future.Future.
+func (panicFuture) Poll(waker future.Waker) (future.PollResult, error) {
+	panic("poll")
+}
+
+// recordedPanic records the arguments passed to PanicHandler.
+type recordedPanic struct {
+	value interface{}
+	stack string
+	path  string
+}
+
+var _ = Describe("Execute: Handles panics", func() {
+	panicResolver := func(value interface{}) graphql.FieldResolver {
+		return graphql.FieldResolverFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+			panic(value)
+		})
+	}
+
+	dataType := &graphql.ObjectConfig{
+		Name: "Data",
+	}
+	dataType.Fields = graphql.Fields{
+		"value": {
+			Type: graphql.T(graphql.String()),
+			Resolver: graphql.FieldResolverFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+				return "ok", nil
+			}),
+		},
+		"panic": {
+			Type:     graphql.T(graphql.String()),
+			Resolver: panicResolver("boom"),
+		},
+		"nonNullPanic": {
+			Type:     graphql.NonNullOfType(graphql.String()),
+			Resolver: panicResolver("boom"),
+		},
+	}
+
+	// A scalar that panics when serializing "bad".
+	fragileScalar := &graphql.ScalarConfig{
+		Name: "Fragile",
+		ResultCoercer: graphql.ScalarResultCoercerFunc(func(value interface{}) (interface{}, error) {
+			if value == "bad" {
+				panic("cannot serialize")
+			}
+			return value, nil
+		}),
+	}
+
+	resolvedType := &graphql.ObjectConfig{
+		Name: "Resolved",
+		Fields: graphql.Fields{
+			"value": {
+				Type: graphql.T(graphql.String()),
+			},
+		},
+	}
+
+	checkedType := &graphql.ObjectConfig{
+		Name: "Checked",
+		IsTypeOf: graphql.TypeCheckerFunc(func(ctx context.Context, value interface{}, info graphql.ResolveInfo) (interface{}, error) {
+			panic("isTypeOf")
+		}),
+		Fields: graphql.Fields{
+			"value": {
+				Type: graphql.T(graphql.String()),
+			},
+		},
+	}
+
+	schema := graphql.MustNewSchema(&graphql.SchemaConfig{
+		Query: graphql.MustNewObject(&graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"panic": {
+					Type:     graphql.T(graphql.String()),
+					Resolver: panicResolver("boom"),
+				},
+				"panicError": {
+					Type:     graphql.T(graphql.String()),
+					Resolver: panicResolver(errors.New("bad thing")),
+				},
+				"nonNullPanic": {
+					Type:     graphql.NonNullOfType(graphql.String()),
+					Resolver: panicResolver("boom"),
+				},
+				"data": {
+					Type: dataType,
+					Resolver: graphql.FieldResolverFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+						return struct{}{}, nil
+					}),
+				},
+				"fragileList": {
+					Type: graphql.ListOf(fragileScalar),
+					Resolver: graphql.FieldResolverFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+						return []string{"good", "bad", "good"}, nil
+					}),
+				},
+				"resolved": {
+					Type: &graphql.UnionConfig{
+						Name:          "ResolvedUnion",
+						PossibleTypes: []graphql.ObjectTypeDefinition{resolvedType},
+						TypeResolver: graphql.TypeResolverFunc(func(ctx context.Context, value interface{}, info graphql.ResolveInfo) (graphql.Object, error) {
+							panic("resolveType")
+						}),
+					},
+					Resolver: graphql.FieldResolverFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+						return struct{}{}, nil
+					}),
+				},
+				"checked": {
+					Type: &graphql.UnionConfig{
+						Name:          "CheckedUnion",
+						PossibleTypes: []graphql.ObjectTypeDefinition{checkedType},
+					},
+					Resolver: graphql.FieldResolverFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+						return struct{}{}, nil
+					}),
+				},
+				"future": {
+					Type: graphql.T(graphql.String()),
+					Resolver: graphql.FieldResolverFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+						return panicFuture{}, nil
+					}),
+				},
+			},
+		}),
+		Directives: graphql.DirectiveList{
+			graphql.DeferDirective(),
+		},
+	})
+
+	executeQuery := func(query string, opts ...interface{}) *executor.ExecutionResult {
+		return execute(schema, parser.MustParse(token.NewSource(query)), opts...)
+	}
+
+	It("reports panics in resolvers as field errors", func() {
+		Expect(executeQuery(`{ panic, panicError, data { value } }`)).Should(MatchResultInJSON(`{
+			"data": {
+				"panic": null,
+				"panicError": null,
+				"data": {
+					"value": "ok"
+				}
+			},
+			"errors": [
+				{
+					"message": "Internal error occurred when executing field Query.panic.",
+					"locations": [{ "line": 1, "column": 3 }],
+					"path": ["panic"]
+				},
+				{
+					"message": "Internal error occurred when executing field Query.panicError.",
+					"locations": [{ "line": 1, "column": 10 }],
+					"path": ["panicError"]
+				}
+			]
+		}`))
+	})
+
+	It("propagates panics in non-null fields to the parent", func() {
+		Expect(executeQuery(`{ data { value, nonNullPanic } }`)).Should(MatchResultInJSON(`{
+			"data": {
+				"data": null
+			},
+			"errors": [
+				{
+					"message": "Internal error occurred when executing field Data.nonNullPanic.",
+					"locations": [{ "line": 1, "column": 17 }],
+					"path": ["data", "nonNullPanic"]
+				}
+			]
+		}`))
+
+		Expect(executeQuery(`{ nonNullPanic, data { value } }`)).Should(MatchResultInJSON(`{
+			"data": null,
+			"errors": [
+				{
+					"message": "Internal error occurred when executing field Query.nonNullPanic.",
+					"locations": [{ "line": 1, "column": 3 }],
+					"path": ["nonNullPanic"]
+				}
+			]
+		}`))
+	})
+
+	It("reports panics in CoerceResultValue of scalars", func() {
+		Expect(executeQuery(`{ fragileList }`)).Should(MatchResultInJSON(`{
+			"data": {
+				"fragileList": ["good", null, "good"]
+			},
+			"errors": [
+				{
+					"message": "Internal error occurred when executing field Query.fragileList.",
+					"locations": [{ "line": 1, "column": 3 }],
+					"path": ["fragileList", 1]
+				}
+			]
+		}`))
+	})
+
+	It("reports panics in type resolvers and IsTypeOf checks", func() {
+		Expect(executeQuery(`{
+			resolved { ... on Resolved { value } }
+			checked { ... on Checked { value } }
+		}`)).Should(MatchResultInJSON(`{
+			"data": {
+				"resolved": null,
+				"checked": null
+			},
+			"errors": [
+				{
+					"message": "Internal error occurred when executing field Query.resolved.",
+					"locations": [{ "line": 2, "column": 4 }],
+					"path": ["resolved"]
+				},
+				{
+					"message": "Internal error occurred when executing field Query.checked.",
+					"locations": [{ "line": 3, "column": 4 }],
+					"path": ["checked"]
+				}
+			]
+		}`))
+	})
+
+	It("reports panics when polling futures", func() {
+		Expect(executeQuery(`{ future }`)).Should(MatchResultInJSON(`{
+			"data": {
+				"future": null
+			},
+			"errors": [
+				{
+					"message": "Internal error occurred when executing field Query.future.",
+					"locations": [{ "line": 1, "column": 3 }],
+					"path": ["future"]
+				}
+			]
+		}`))
+	})
+
+	It("reports panics in deferred fragments", func() {
+		Expect(executeQuery(`{ data { value ... @defer { panic } } }`)).Should(
+			MatchIncrementalResultsInJSON(`[
+			{
+				"data": {
+					"data": {
+						"value": "ok"
+					}
+				},
+				"hasNext": true
+			},
+			{
+				"errors": [
+					{
+						"message": "Internal error occurred when executing field Data.panic.",
+						"locations": [{ "line": 1, "column": 29 }],
+						"path": ["data", "panic"]
+					}
+				],
+				"data": {
+					"panic": null
+				},
+				"path": ["data"],
+				"hasNext": false
+			}
+		]`))
+	})
+
+	It("notifies PanicHandler with the panic value and the stack trace", func() {
+		var panics []recordedPanic
+		handler := executor.PanicHandlerFunc(func(ctx context.Context, value interface{}, stack []byte, info graphql.ResolveInfo) error {
+			panics = append(panics, recordedPanic{
+				value: value,
+				stack: string(stack),
+				path:  info.Path().String(),
+			})
+			return nil
+		})
+
+		result := executeQuery(`{ data { panic }, fragileList }`, executor.OnPanic(handler))
+		Expect(result.Errors.Errors).Should(HaveLen(2))
+		Expect(panics).Should(HaveLen(2))
+
+		Expect(panics[0].value).Should(Equal("boom"))
+		Expect(panics[0].stack).Should(ContainSubstring("panic_test.go"))
+		Expect(panics[0].path).Should(Equal("data.panic"))
+
+		Expect(panics[1].value).Should(Equal("cannot serialize"))
+		Expect(panics[1].stack).Should(ContainSubstring("panic_test.go"))
+		Expect(panics[1].path).Should(Equal("fragileList[1]"))
+	})
+
+	It("keeps the panic value in the underlying error", func() {
+		result := executeQuery(`{ panic, panicError }`)
+		Expect(result.Errors.Errors).Should(HaveLen(2))
+		Expect(result.Errors.Errors[0].Err).Should(MatchError("boom"))
+		Expect(result.Errors.Errors[1].Err).Should(MatchError("bad thing"))
+	})
+
+	It("reports the error returned from PanicHandler", func() {
+		handler := executor.PanicHandlerFunc(func(ctx context.Context, value interface{}, stack []byte, info graphql.ResolveInfo) error {
+			if info.Field().Name() == "panic" {
+				return graphql.NewError(fmt.Sprintf("%s panicked: %v", info.Field().Name(), value))
+			}
+			return nil
+		})
+
+		Expect(executeQuery(`{ panic, panicError }`, executor.OnPanic(handler))).Should(MatchResultInJSON(`{
+			"data": {
+				"panic": null,
+				"panicError": null
+			},
+			"errors": [
+				{
+					"message": "panic panicked: boom",
+					"locations": [{ "line": 1, "column": 3 }],
+					"path": ["panic"]
+				},
+				{
+					"message": "Internal error occurred when executing field Query.panicError.",
+					"locations": [{ "line": 1, "column": 10 }],
+					"path": ["panicError"]
+				}
+			]
+		}`))
+	})
+
+	Describe("with concurrent executor", func() {
+		var workerPool *concurrent.WorkerPoolExecutor
+
+		BeforeEach(func() {
+			var err error
+			workerPool, err = concurrent.NewWorkerPoolExecutor(concurrent.WorkerPoolExecutorConfig{
+				MinPoolSize: 2,
+				MaxPoolSize: 4,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			terminated, err := workerPool.Shutdown()
+			Expect(err).ShouldNot(HaveOccurred())
+			Eventually(terminated).Should(Receive())
+		})
+
+		It("reports panics in resolvers run on the executor", func() {
+			// PanicHandler is called on the goroutine that executes the operation so no synchronization
+			// is required.
+			var paths []string
+			handler := executor.PanicHandlerFunc(func(ctx context.Context, value interface{}, stack []byte, info graphql.ResolveInfo) error {
+				paths = append(paths, info.Path().String())
+				return nil
+			})
+
+			Expect(executeQuery(
+				`{ data { value, panic } }`,
+				executor.ConcurrentExecutor(workerPool),
+				executor.OnPanic(handler),
+			)).Should(MatchResultInJSON(`{
+				"data": {
+					"data": {
+						"value": "ok",
+						"panic": null
+					}
+				},
+				"errors": [
+					{
+						"message": "Internal error occurred when executing field Data.panic.",
+						"locations": [{ "line": 1, "column": 17 }],
+						"path": ["data", "panic"]
+					}
+				]
+			}`))
+			Expect(paths).Should(Equal([]string{"data.panic"}))
+		})
+	})
+})
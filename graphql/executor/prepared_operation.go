@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/botobag/artemis/concurrent"
 	"github.com/botobag/artemis/graphql"
 	"github.com/botobag/artemis/graphql/ast"
 	"github.com/botobag/artemis/graphql/validator"
@@ -255,10 +256,12 @@ func (operation *PreparedOperation) VariableDefinitions() []*ast.VariableDefinit
 
 // executeOptions contains parameter to execute a prepared operation.
 type executeOptions struct {
-	DataLoaderManager graphql.DataLoaderManager
-	RootValue         interface{}
-	AppContext        interface{}
-	VariableValues    map[string]interface{}
+	DataLoaderManager  graphql.DataLoaderManager
+	RootValue          interface{}
+	AppContext         interface{}
+	VariableValues     map[string]interface{}
+	ConcurrentExecutor concurrent.Executor
+	PanicHandler       PanicHandler
 }
 
 // ExecuteOption configures execution of a PreparedOperation.
@@ -295,8 +298,38 @@ func VariableValues(variables map[string]interface{}) ExecuteOption {
 	}
 }
 
+// ConcurrentExecutor specifies a concurrent.Executor (such as concurrent.WorkerPoolExecutor) for
+// running field resolvers. When it is given, resolvers of sibling fields are run in parallel on the
+// executor. Completion of the resolved values (including writes to the ResultNode's and error
+// collection) still occurs on the goroutine that calls Execute so no additional synchronization is
+// required for them. For mutation, the root fields are still executed serially as required by the
+// specification [0]: the execution of a root field and all of its subfields completes before the
+// next root field starts.
+//
+// Note that resolvers as well as the DataLoaderManager and the data loaders used by them must be
+// safe for concurrent use.
+//
+// [0]: https://graphql.github.io/graphql-spec/June2018/#sec-Mutation
+func ConcurrentExecutor(executor concurrent.Executor) ExecuteOption {
+	return func(options *executeOptions) {
+		options.ConcurrentExecutor = executor
+	}
+}
+
+// OnPanic specifies a PanicHandler to be notified with the panics recovered during execution. The
+// panics are reported as field errors in the response regardless of whether a PanicHandler is
+// given. The handler may customize the field errors. See PanicHandler for details.
+func OnPanic(handler PanicHandler) ExecuteOption {
+	return func(options *executeOptions) {
+		options.PanicHandler = handler
+	}
+}
+
 // Execute executes the given operation.  ctx specifies deadline and/or cancellation for
-// executor, etc..
+// executor, etc.. Once ctx is done, executor stops running resolvers and dispatching data loaders.
+// Fields that haven't completed are failed with the error returned from ctx.Err() which is
+// propagated as any other field errors. The error is only reported once (for the first failed
+// field) in the response.
 func (operation *PreparedOperation) Execute(c context.Context, opts ...ExecuteOption) *ExecutionResult {
 	var options executeOptions
 
@@ -138,7 +138,7 @@ func (info *ResolveInfo) Field() graphql.Field {
 
 // Path implements graphql.ResolveInfo.
 func (info *ResolveInfo) Path() graphql.ResponsePath {
-	return info.ResultNode.Path()
+	return info.ExecutionContext.pathOf(info.ResultNode)
 }
 
 // Args implements graphql.ResolveInfo.
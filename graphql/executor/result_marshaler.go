@@ -53,6 +53,68 @@ func (marshaler resultMarshaler) MarshalJSONTo(stream *jsonwriter.Stream) error
 		stream.WriteValue(NewResultNodeMarshaler(result.Data))
 	}
 
+	// Indicate that there're subsequent payloads for @defer and @stream.
+	if result.Subsequent != nil {
+		if result.Errors.HaveOccurred() || result.Data != nil {
+			stream.WriteMore()
+		}
+		stream.WriteObjectField("hasNext")
+		stream.WriteBool(true)
+	}
+
+	stream.WriteObjectEnd()
+
+	return nil
+}
+
+// incrementalResultMarshaler implements jsonwriter.ValueMarshaler to encode IncrementalResult to
+// JSON.
+type incrementalResultMarshaler struct {
+	result *IncrementalResult
+}
+
+// NewIncrementalResultMarshaler creates marshaler to write JSON encoding for given
+// IncrementalResult with jsonwriter.
+func NewIncrementalResultMarshaler(result *IncrementalResult) jsonwriter.ValueMarshaler {
+	return incrementalResultMarshaler{result}
+}
+
+// MarshalJSONTo implements jsonwriter.ValueMarshaler.
+func (marshaler incrementalResultMarshaler) MarshalJSONTo(stream *jsonwriter.Stream) error {
+	result := marshaler.result
+	stream.WriteObjectStart()
+
+	// Like ExecutionResult, place the "errors" first.
+	if result.Errors.HaveOccurred() {
+		stream.WriteObjectField("errors")
+		stream.WriteValue(graphql.NewErrorsMarshaler(result.Errors))
+		stream.WriteMore()
+	}
+
+	if result.Data != nil || result.Items != nil {
+		if result.Data != nil {
+			stream.WriteObjectField("data")
+			stream.WriteValue(NewResultNodeMarshaler(result.Data))
+		} else {
+			stream.WriteObjectField("items")
+			stream.WriteValue(NewResultNodeMarshaler(result.Items))
+		}
+		stream.WriteMore()
+
+		stream.WriteObjectField("path")
+		stream.WriteValue(graphql.NewResponsePathMarshaler(&result.Path))
+		stream.WriteMore()
+
+		if len(result.Label) > 0 {
+			stream.WriteObjectField("label")
+			stream.WriteString(result.Label)
+			stream.WriteMore()
+		}
+	}
+
+	stream.WriteObjectField("hasNext")
+	stream.WriteBool(result.HasNext)
+
 	stream.WriteObjectEnd()
 
 	return nil
@@ -130,8 +130,16 @@ func (node *ResultNode) ObjectValue() *ObjectResultValue {
 
 // Path in the response to this node.
 func (node *ResultNode) Path() graphql.ResponsePath {
+	return node.pathFrom(nil, graphql.ResponsePath{})
+}
+
+// pathFrom computes the path to this node given that root is one of its ancestors whose path in
+// the response is rootPath. It is used to compute the path to the nodes in the result tree of a
+// subsequent payload for @defer and @stream where the root of the tree is not the root of the
+// response.
+func (node *ResultNode) pathFrom(root *ResultNode, rootPath graphql.ResponsePath) graphql.ResponsePath {
 	var (
-		path      graphql.ResponsePath
+		path      = rootPath.Clone()
 		pathKeys  []interface{}
 		childNode = node
 	)
@@ -140,7 +148,7 @@ func (node *ResultNode) Path() graphql.ResponsePath {
 		return path
 	}
 
-	for node := node.Parent; node != nil; node = node.Parent {
+	for node := node.Parent; node != nil && childNode != root; childNode, node = node, node.Parent {
 		if node.IsList() {
 			pathKeys = append(pathKeys, node.ListValue().IndexOf(childNode))
 		} else if node.IsObject() {
@@ -152,12 +160,7 @@ func (node *ResultNode) Path() graphql.ResponsePath {
 			fieldIndex := int((childNodeAddr - firstFieldNodeAddr) / sizeOfResultNode)
 
 			pathKeys = append(pathKeys, node.ObjectValue().ExecutionNodes[fieldIndex].ResponseKey())
-		} else {
-			// ??
-			continue
 		}
-
-		childNode = node
 	}
 
 	// Pour keys in pathKeys to path in reverse order.
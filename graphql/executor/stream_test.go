@@ -0,0 +1,515 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package executor_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/botobag/artemis/graphql"
+	"github.com/botobag/artemis/graphql/executor"
+	"github.com/botobag/artemis/graphql/parser"
+	"github.com/botobag/artemis/graphql/token"
+	"github.com/botobag/artemis/iterator"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// streamTestIterable is an Iterable that yields values. Before yielding the value at index i, it
+// waits on blocks[i] if one is given. It returns an error at errorIndex if it is non-negative.
+type streamTestIterable struct {
+	values     []interface{}
+	blocks     map[int]chan struct{}
+	errorIndex int
+}
+
+// Iterator implements graphql.Iterable.
+func (iterable *streamTestIterable) Iterator() graphql.Iterator {
+	return &streamTestIterator{iterable: iterable}
+}
+
+type streamTestIterator struct {
+	iterable *streamTestIterable
+	index    int
+}
+
+// Next implements graphql.Iterator.
+func (iter *streamTestIterator) Next() (interface{}, error) {
+	iterable := iter.iterable
+	index := iter.index
+	if block, exists := iterable.blocks[index]; exists {
+		<-block
+	}
+	if index == iterable.errorIndex {
+		return nil, errors.New("bad")
+	}
+	if index >= len(iterable.values) {
+		return nil, iterator.Done
+	}
+	iter.index++
+	return iterable.values[index], nil
+}
+
+// graphql-js/src/execution/__tests__/stream-test.ts
+var _ = Describe("Execute: stream directive", func() {
+	friendType := &graphql.ObjectConfig{
+		Name: "Friend",
+		Fields: graphql.Fields{
+			"id": {
+				Type: graphql.T(graphql.ID()),
+			},
+			"name": {
+				Type: graphql.T(graphql.String()),
+				Resolver: graphql.FieldResolverFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+					name := source.(map[string]interface{})["name"]
+					if name == "Han" {
+						return nil, errors.New("bad")
+					}
+					return name, nil
+				}),
+			},
+		},
+	}
+
+	friends := []map[string]interface{}{
+		{"id": "1", "name": "Luke"},
+		{"id": "2", "name": "Han"},
+		{"id": "3", "name": "Leia"},
+	}
+
+	var rootValue map[string]interface{}
+
+	schema := graphql.MustNewSchema(&graphql.SchemaConfig{
+		Query: graphql.MustNewObject(&graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"scalarList": {
+					Type: graphql.ListOfType(graphql.String()),
+				},
+				"friendList": {
+					Type: graphql.ListOf(friendType),
+				},
+				"nonNullFriendList": {
+					Type: graphql.ListOf(graphql.NonNullOf(friendType)),
+				},
+				"nestedObject": {
+					Type: &graphql.ObjectConfig{
+						Name: "NestedObject",
+						Fields: graphql.Fields{
+							"scalarField": {
+								Type: graphql.T(graphql.String()),
+							},
+						},
+					},
+				},
+			},
+		}),
+		Directives: graphql.DirectiveList{
+			graphql.DeferDirective(),
+			graphql.StreamDirective(),
+		},
+	})
+
+	BeforeEach(func() {
+		rootValue = map[string]interface{}{
+			"scalarList":   []string{"apple", "banana", "coconut"},
+			"friendList":   friends,
+			"nestedObject": map[string]interface{}{"scalarField": "slow"},
+		}
+	})
+
+	executeQuery := func(query string) *executor.ExecutionResult {
+		return execute(schema, parser.MustParse(token.NewSource(query)), executor.RootValue(rootValue))
+	}
+
+	It("Can stream a list field", func() {
+		Expect(executeQuery(`
+      {
+        scalarList @stream(initialCount: 1)
+      }
+    `)).Should(MatchIncrementalResultsInJSON(`[
+			{
+				"data": {
+					"scalarList": ["apple"]
+				},
+				"hasNext": true
+			},
+			{
+				"items": ["banana"],
+				"path": ["scalarList", 1],
+				"hasNext": true
+			},
+			{
+				"items": ["coconut"],
+				"path": ["scalarList", 2],
+				"hasNext": false
+			}
+		]`))
+	})
+
+	It("Can use default value of initialCount", func() {
+		Expect(executeQuery(`
+      {
+        scalarList @stream
+      }
+    `)).Should(MatchIncrementalResultsInJSON(`[
+			{
+				"data": {
+					"scalarList": []
+				},
+				"hasNext": true
+			},
+			{
+				"items": ["apple"],
+				"path": ["scalarList", 0],
+				"hasNext": true
+			},
+			{
+				"items": ["banana"],
+				"path": ["scalarList", 1],
+				"hasNext": true
+			},
+			{
+				"items": ["coconut"],
+				"path": ["scalarList", 2],
+				"hasNext": false
+			}
+		]`))
+	})
+
+	It("Negative values of initialCount throw field errors", func() {
+		Expect(executeQuery(`
+      {
+        scalarList @stream(initialCount: -2)
+      }
+    `)).Should(MatchIncrementalResultsInJSON(`[
+			{
+				"errors": [
+					{
+						"message": "initialCount must be a non-negative integer",
+						"locations": [{ "line": 3, "column": 9 }],
+						"path": ["scalarList"]
+					}
+				],
+				"data": {
+					"scalarList": null
+				}
+			}
+		]`))
+	})
+
+	It("Returns label from stream directive", func() {
+		Expect(executeQuery(`
+      {
+        scalarList @stream(initialCount: 2, label: "scalar-stream")
+      }
+    `)).Should(MatchIncrementalResultsInJSON(`[
+			{
+				"data": {
+					"scalarList": ["apple", "banana"]
+				},
+				"hasNext": true
+			},
+			{
+				"items": ["coconut"],
+				"path": ["scalarList", 2],
+				"label": "scalar-stream",
+				"hasNext": false
+			}
+		]`))
+	})
+
+	It("Can disable @stream using if argument", func() {
+		Expect(executeQuery(`
+      {
+        scalarList @stream(initialCount: 0, if: false)
+      }
+    `)).Should(MatchIncrementalResultsInJSON(`[
+			{
+				"data": {
+					"scalarList": ["apple", "banana", "coconut"]
+				}
+			}
+		]`))
+	})
+
+	It("Does not stream when initialCount covers the whole list", func() {
+		Expect(executeQuery(`
+      {
+        scalarList @stream(initialCount: 3)
+      }
+    `)).Should(MatchIncrementalResultsInJSON(`[
+			{
+				"data": {
+					"scalarList": ["apple", "banana", "coconut"]
+				}
+			}
+		]`))
+	})
+
+	It("Can stream a field that returns a list of objects", func() {
+		Expect(executeQuery(`
+      {
+        friendList @stream(initialCount: 2) {
+          id
+        }
+      }
+    `)).Should(MatchIncrementalResultsInJSON(`[
+			{
+				"data": {
+					"friendList": [{ "id": "1" }, { "id": "2" }]
+				},
+				"hasNext": true
+			},
+			{
+				"items": [{ "id": "3" }],
+				"path": ["friendList", 2],
+				"hasNext": false
+			}
+		]`))
+	})
+
+	It("Handles errors in the fields of the streamed items", func() {
+		Expect(executeQuery(`
+      {
+        friendList @stream(initialCount: 1) {
+          name
+          id
+        }
+      }
+    `)).Should(MatchIncrementalResultsInJSON(`[
+			{
+				"data": {
+					"friendList": [{ "name": "Luke", "id": "1" }]
+				},
+				"hasNext": true
+			},
+			{
+				"errors": [
+					{
+						"message": "bad",
+						"locations": [{ "line": 4, "column": 11 }],
+						"path": ["friendList", 1, "name"]
+					}
+				],
+				"items": [{ "name": null, "id": "2" }],
+				"path": ["friendList", 1],
+				"hasNext": true
+			},
+			{
+				"items": [{ "name": "Leia", "id": "3" }],
+				"path": ["friendList", 2],
+				"hasNext": false
+			}
+		]`))
+	})
+
+	It("Handles null returned in non-null list items after initialCount is reached", func() {
+		rootValue["nonNullFriendList"] = []interface{}{friends[0], nil, friends[2]}
+		Expect(executeQuery(`
+      {
+        nonNullFriendList @stream(initialCount: 1) {
+          id
+        }
+      }
+    `)).Should(MatchIncrementalResultsInJSON(`[
+			{
+				"data": {
+					"nonNullFriendList": [{ "id": "1" }]
+				},
+				"hasNext": true
+			},
+			{
+				"errors": [
+					{
+						"message": "Cannot return null for non-nullable field Query.nonNullFriendList.",
+						"locations": [{ "line": 3, "column": 9 }],
+						"path": ["nonNullFriendList", 1]
+					}
+				],
+				"items": null,
+				"path": ["nonNullFriendList", 1],
+				"hasNext": false
+			}
+		]`))
+	})
+
+	It("Handles null returned in non-null list items before initialCount is reached", func() {
+		rootValue["nonNullFriendList"] = []interface{}{friends[0], nil, friends[2]}
+		Expect(executeQuery(`
+      {
+        nonNullFriendList @stream(initialCount: 2) {
+          id
+        }
+      }
+    `)).Should(MatchIncrementalResultsInJSON(`[
+			{
+				"errors": [
+					{
+						"message": "Cannot return null for non-nullable field Query.nonNullFriendList.",
+						"locations": [{ "line": 3, "column": 9 }],
+						"path": ["nonNullFriendList", 1]
+					}
+				],
+				"data": {
+					"nonNullFriendList": null
+				}
+			}
+		]`))
+	})
+
+	It("Handles errors from the iterator of the list", func() {
+		rootValue["scalarList"] = &streamTestIterable{
+			values:     []interface{}{"apple", "banana"},
+			errorIndex: 1,
+		}
+
+		Expect(executeQuery(`
+      {
+        scalarList @stream(initialCount: 1)
+      }
+    `)).Should(MatchIncrementalResultsInJSON(`[
+			{
+				"data": {
+					"scalarList": ["apple"]
+				},
+				"hasNext": true
+			},
+			{
+				"errors": [
+					{
+						"message": "Error occurred while enumerates values in the list field Query.scalarList.",
+						"locations": [{ "line": 3, "column": 9 }],
+						"path": ["scalarList"]
+					}
+				],
+				"items": null,
+				"path": ["scalarList", 1],
+				"hasNext": false
+			}
+		]`))
+	})
+
+	It("Enumerates the remaining items lazily", func() {
+		block := make(chan struct{})
+		rootValue["scalarList"] = &streamTestIterable{
+			values: []interface{}{"apple", "banana", "coconut"},
+			blocks: map[int]chan struct{}{
+				1: block,
+			},
+			errorIndex: -1,
+		}
+
+		// The initial result is returned without waiting for the second item.
+		result := executeQuery(`
+      {
+        scalarList @stream(initialCount: 1)
+      }
+    `)
+		Expect(result.Data.MarshalJSON()).Should(MatchJSON(`{"scalarList": ["apple"]}`))
+
+		close(block)
+		Expect(result).Should(MatchIncrementalResultsInJSON(`[
+			{
+				"data": {
+					"scalarList": ["apple"]
+				},
+				"hasNext": true
+			},
+			{
+				"items": ["banana"],
+				"path": ["scalarList", 1],
+				"hasNext": true
+			},
+			{
+				"items": ["coconut"],
+				"path": ["scalarList", 2],
+				"hasNext": true
+			},
+			{
+				"hasNext": false
+			}
+		]`))
+	})
+
+	It("Can stream a list in a deferred fragment", func() {
+		Expect(executeQuery(`
+      {
+        ... @defer {
+          friendList @stream(initialCount: 1) {
+            id
+          }
+        }
+      }
+    `)).Should(MatchIncrementalResultsInJSON(`[
+			{
+				"data": {},
+				"hasNext": true
+			},
+			{
+				"data": {
+					"friendList": [{ "id": "1" }]
+				},
+				"path": [],
+				"hasNext": true
+			},
+			{
+				"items": [{ "id": "2" }],
+				"path": ["friendList", 1],
+				"hasNext": true
+			},
+			{
+				"items": [{ "id": "3" }],
+				"path": ["friendList", 2],
+				"hasNext": false
+			}
+		]`))
+	})
+
+	It("Stops delivering payloads when the result is closed", func() {
+		operation := executor.MustPrepare(schema, parser.MustParse(token.NewSource(`
+      {
+        scalarList @stream
+      }
+    `)))
+		result := operation.Execute(context.Background(), executor.RootValue(rootValue))
+		Expect(result.Subsequent).ShouldNot(BeNil())
+
+		result.Close()
+		Eventually(result.Subsequent).Should(BeClosed())
+
+		// Close can be called more than once.
+		result.Close()
+	})
+
+	It("Stops delivering payloads when the context is done", func() {
+		c, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		operation := executor.MustPrepare(schema, parser.MustParse(token.NewSource(`
+      {
+        scalarList @stream
+      }
+    `)))
+		result := operation.Execute(c, executor.RootValue(rootValue))
+		Expect(result.Subsequent).ShouldNot(BeNil())
+
+		cancel()
+		Eventually(result.Subsequent).Should(BeClosed())
+	})
+})
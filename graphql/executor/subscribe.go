@@ -0,0 +1,395 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package executor
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"reflect"
+	"sync"
+
+	"github.com/botobag/artemis/graphql"
+	"github.com/botobag/artemis/graphql/ast"
+	"github.com/botobag/artemis/iterator"
+)
+
+// SourceEvent is an event received from the source stream of a subscription [0].
+//
+// [0]: https://graphql.github.io/graphql-spec/June2018/#sec-Source-Stream
+type SourceEvent struct {
+	// Value of the event; It is used as the root value to execute the selection set of the
+	// subscription for producing a response.
+	Value interface{}
+
+	// Err is set when the source stream yields an error (either an error value is received from the
+	// stream or an error is returned from the Iterator.) The error has been located at the root field
+	// of the subscription.
+	Err error
+}
+
+// CreateSourceEventStream implements "CreateSourceEventStream" [0]. It resolves the source stream
+// for the root field of the subscription operation (using the field's graphql.FieldSubscriber)
+// and returns a channel which delivers the events from the source stream. The returned channel is
+// closed when the source stream ends or when c is done, at which time the source stream is torn
+// down.
+//
+// Most applications should use Subscribe which maps each event to an execution result. This is
+// useful for the applications which need to handle source events (e.g., filtering or batching)
+// before executing them with Execute.
+//
+// [0]: https://graphql.github.io/graphql-spec/June2018/#CreateSourceEventStream()
+func (operation *PreparedOperation) CreateSourceEventStream(
+	c context.Context,
+	opts ...ExecuteOption) (<-chan SourceEvent, graphql.Errors) {
+
+	ctx, errs := operation.newSubscriptionContext(c, opts)
+	if errs.HaveOccurred() {
+		return nil, errs
+	}
+
+	stream, errs := createSourceEventStream(ctx)
+	if errs.HaveOccurred() {
+		return nil, errs
+	}
+
+	return stream.events, graphql.NoErrors()
+}
+
+// Subscribe implements "Subscribe" [0] for a subscription operation. It creates the source stream
+// (see CreateSourceEventStream) and returns a response stream in which each event from the source
+// stream is mapped to the result of executing the selection set of the subscription with the event
+// as root value [1]. An error yielded by the source stream is reported in the result for that
+// event.
+//
+// The returned channel is closed when the source stream ends or when c is done. Callers should
+// cancel c to unsubscribe; The source stream is torn down in that case.
+//
+// [0]: https://graphql.github.io/graphql-spec/June2018/#Subscribe()
+// [1]: https://graphql.github.io/graphql-spec/June2018/#MapSourceToResponseEvent()
+func (operation *PreparedOperation) Subscribe(
+	c context.Context,
+	opts ...ExecuteOption) (<-chan *ExecutionResult, graphql.Errors) {
+
+	ctx, errs := operation.newSubscriptionContext(c, opts)
+	if errs.HaveOccurred() {
+		return nil, errs
+	}
+
+	stream, errs := createSourceEventStream(ctx)
+	if errs.HaveOccurred() {
+		return nil, errs
+	}
+
+	results := make(chan *ExecutionResult)
+	go mapSourceToResponse(ctx, stream, results)
+
+	return results, graphql.NoErrors()
+}
+
+// newSubscriptionContext initializes an ExecutionContext for subscribing the operation.
+func (operation *PreparedOperation) newSubscriptionContext(
+	c context.Context,
+	opts []ExecuteOption) (*ExecutionContext, graphql.Errors) {
+
+	if operation.Type() != ast.OperationTypeSubscription {
+		return nil, graphql.ErrorsOf(
+			"Can only subscribe to subscription operations.",
+			[]graphql.ErrorLocation{graphql.ErrorLocationOfASTNode(operation.Definition())})
+	}
+
+	var options executeOptions
+
+	// Get options.
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return newExecutionContext(c, operation, &options)
+}
+
+// sourceEventStream reads events from the source stream returned by a FieldSubscriber and sends
+// them to the events channel.
+type sourceEventStream struct {
+	// Context that is given to the FieldSubscriber; It is canceled when the stream is terminated.
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// The source stream returned by the FieldSubscriber
+	source interface{}
+
+	// The ExecutionNode and ResultNode for the root field; They're used to locate errors.
+	node   *ExecutionNode
+	result *ResultNode
+
+	// The ExecutionContext of the subscription and the ResolveInfo of the root field; They're used to
+	// report the panics from the source stream.
+	executionContext *ExecutionContext
+	info             *ResolveInfo
+
+	// Channel to deliver source events
+	events chan SourceEvent
+
+	// Make sure the source stream is torn down only once.
+	terminateOnce sync.Once
+}
+
+// createSourceEventStream resolves the source stream for the root field of the subscription and
+// starts a goroutine to read events from the stream.
+func createSourceEventStream(ctx *ExecutionContext) (*sourceEventStream, graphql.Errors) {
+	var (
+		operation = ctx.Operation()
+
+		// Root node is a special node which behaves like a field with nil parent and definition.
+		rootNode = &ExecutionNode{
+			Parent:      nil,
+			Definitions: nil,
+		}
+	)
+
+	// Collect fields in the top-level selection set.
+	nodes, err := collectFields(ctx, rootNode, operation.RootType())
+	if err != nil {
+		return nil, graphql.ErrorsOf(err.(*graphql.Error))
+	}
+
+	if len(nodes) == 0 {
+		return nil, graphql.ErrorsOf(
+			"Subscription operation must select a root field.",
+			[]graphql.ErrorLocation{graphql.ErrorLocationOfASTNode(operation.Definition())})
+	}
+
+	// Subscription operation has exactly one root field [0]. Set up ResultNode's for the field so
+	// errors can be reported with response path.
+	//
+	// [0]: https://graphql.github.io/graphql-spec/June2018/#sec-Single-root-field
+	var (
+		node   = nodes[0]
+		field  = node.Field
+		result = &ResultNode{}
+	)
+	fieldResults := make([]ResultNode, 1)
+	fieldResults[0].Parent = result
+	result.Kind = ResultKindObject
+	result.Value = &ObjectResultValue{
+		ExecutionNodes: nodes[:1],
+		FieldValues:    fieldResults,
+	}
+
+	stream := &sourceEventStream{
+		node:             node,
+		result:           &fieldResults[0],
+		executionContext: ctx,
+		events:           make(chan SourceEvent),
+	}
+	stream.ctx, stream.cancel = context.WithCancel(ctx.Context())
+
+	info := &ResolveInfo{
+		ExecutionContext: ctx,
+		ExecutionNode:    node,
+		ResultNode:       stream.result,
+	}
+	stream.info = info
+
+	// Call subscriber to obtain the source stream. Fallback to the default field resolver if the
+	// field doesn't provide one.
+	var source interface{}
+	if subscriber := field.Subscriber(); subscriber != nil {
+		source, err = subscribeField(stream.ctx, subscriber, ctx.RootValue(), info)
+	} else {
+		source, err = resolveField(stream.ctx, operation.DefaultFieldResolver(), ctx.RootValue(), info)
+	}
+
+	if p, ok := err.(*panicError); ok {
+		err = ctx.handlePanic(p, info)
+	}
+
+	if err == nil {
+		// Check the type of source stream.
+		if _, ok := source.(graphql.Iterator); !ok {
+			sourceValue := reflect.ValueOf(source)
+			if sourceValue.Kind() != reflect.Chan || sourceValue.Type().ChanDir()&reflect.RecvDir == 0 {
+				err = fmt.Errorf("Subscription field must return a channel or an Iterator, but got %T.", source)
+			}
+		}
+	}
+
+	stream.source = source
+	if err != nil {
+		stream.terminate()
+		return nil, graphql.ErrorsOf(stream.newError(err))
+	}
+
+	// Tear down the source stream when the context is done. This also unblocks the reading from an
+	// Iterator that closes itself on Close.
+	go func() {
+		<-stream.ctx.Done()
+		stream.terminate()
+	}()
+
+	// Start reading events.
+	go stream.run()
+
+	return stream, graphql.NoErrors()
+}
+
+// newError locates err at the root field of the subscription.
+func (stream *sourceEventStream) newError(err error) *graphql.Error {
+	return newFieldError(err, stream.node, stream.result.Path())
+}
+
+// terminate cancels the context given to the FieldSubscriber and closes the source stream if it
+// implements io.Closer.
+func (stream *sourceEventStream) terminate() {
+	stream.terminateOnce.Do(func() {
+		stream.cancel()
+		if closer, ok := stream.source.(io.Closer); ok {
+			closer.Close()
+		}
+	})
+}
+
+// send delivers an event to the events channel. It returns false if the stream has been terminated.
+func (stream *sourceEventStream) send(event SourceEvent) bool {
+	if e, ok := event.Value.(error); ok {
+		event.Value = nil
+		event.Err = e
+	}
+
+	if event.Err != nil {
+		event.Err = stream.newError(event.Err)
+	}
+
+	select {
+	case stream.events <- event:
+		return true
+	case <-stream.ctx.Done():
+		return false
+	}
+}
+
+// run reads events from the source stream until the stream ends or is terminated.
+func (stream *sourceEventStream) run() {
+	defer close(stream.events)
+	defer stream.terminate()
+
+	if iter, ok := stream.source.(graphql.Iterator); ok {
+		results := stream.readIterator(iter)
+		for {
+			select {
+			case result := <-results:
+				if result.err == iterator.Done {
+					return
+				} else if result.err != nil {
+					// Report the error and end the stream.
+					stream.send(SourceEvent{Err: result.err})
+					return
+				} else if !stream.send(SourceEvent{Value: result.value}) {
+					return
+				}
+
+			case <-stream.ctx.Done():
+				return
+			}
+		}
+	}
+
+	cases := []reflect.SelectCase{
+		{
+			Dir:  reflect.SelectRecv,
+			Chan: reflect.ValueOf(stream.source),
+		},
+		{
+			Dir:  reflect.SelectRecv,
+			Chan: reflect.ValueOf(stream.ctx.Done()),
+		},
+	}
+
+	for {
+		chosen, value, ok := reflect.Select(cases)
+		if chosen != 0 || !ok {
+			// Either the context is done or the channel is closed.
+			return
+		}
+
+		if !stream.send(SourceEvent{Value: value.Interface()}) {
+			return
+		}
+	}
+}
+
+// iteratorResult contains the values returned from a call to Iterator.Next.
+type iteratorResult struct {
+	value interface{}
+	err   error
+}
+
+// readIterator calls Next on the given Iterator in a separate goroutine and sends the results to the
+// returned channel. This allows run to return as soon as the stream is terminated even if Next is
+// blocked and the Iterator neither implements io.Closer nor honors the context given to the
+// FieldSubscriber. In that case, the goroutine is abandoned and exits once Next returns. A panic in
+// Next is recovered and sent as an error which ends the stream.
+func (stream *sourceEventStream) readIterator(iter graphql.Iterator) <-chan iteratorResult {
+	results := make(chan iteratorResult)
+	go func() {
+		for {
+			value, err := nextValue(iter)
+			if p, ok := err.(*panicError); ok {
+				err = stream.executionContext.handlePanic(p, stream.info)
+			}
+
+			select {
+			case results <- iteratorResult{value, err}:
+			case <-stream.ctx.Done():
+				return
+			}
+
+			if err != nil {
+				return
+			}
+		}
+	}()
+	return results
+}
+
+// mapSourceToResponse executes the selection set of the subscription for each event from the
+// source stream and sends the results to the results channel.
+func mapSourceToResponse(ctx *ExecutionContext, stream *sourceEventStream, results chan<- *ExecutionResult) {
+	defer close(results)
+
+	for event := range stream.events {
+		var result *ExecutionResult
+		if event.Err != nil {
+			result = &ExecutionResult{
+				Errors: graphql.ErrorsOf(event.Err),
+			}
+		} else {
+			// Execute the selection set with the event as root value.
+			eventCtx := *ctx
+			eventCtx.rootValue = event.Value
+			result = execute(&eventCtx)
+		}
+
+		// Note that stream.ctx is canceled once the source stream ends. Wait on the context given by
+		// the caller so the results for the remaining events are still delivered.
+		select {
+		case results <- result:
+		case <-ctx.Context().Done():
+			return
+		}
+	}
+}
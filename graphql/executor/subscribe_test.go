@@ -0,0 +1,466 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package executor_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/botobag/artemis/graphql"
+	"github.com/botobag/artemis/graphql/executor"
+	"github.com/botobag/artemis/graphql/parser"
+	"github.com/botobag/artemis/graphql/token"
+	"github.com/botobag/artemis/internal/testutil"
+	"github.com/botobag/artemis/iterator"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type Email struct {
+	From    string `graphql:"from"`
+	Subject string `graphql:"subject"`
+}
+
+// emailIterator implements graphql.Iterator and io.Closer for sending emails to a subscription.
+type emailIterator struct {
+	emails []*Email
+	// Next blocks on this channel after all emails are sent until the iterator is closed.
+	closed chan struct{}
+}
+
+func newEmailIterator(emails ...*Email) *emailIterator {
+	return &emailIterator{
+		emails: emails,
+		closed: make(chan struct{}),
+	}
+}
+
+func (iter *emailIterator) Next() (interface{}, error) {
+	if len(iter.emails) > 0 {
+		email := iter.emails[0]
+		iter.emails = iter.emails[1:]
+		return map[string]interface{}{
+			"importantEmail": map[string]interface{}{"email": email},
+		}, nil
+	}
+	<-iter.closed
+	return nil, iterator.Done
+}
+
+func (iter *emailIterator) Close() error {
+	close(iter.closed)
+	return nil
+}
+
+// blockingIterator implements graphql.Iterator whose Next blocks until the channel is closed.
+type blockingIterator chan struct{}
+
+func (iter blockingIterator) Next() (interface{}, error) {
+	<-iter
+	return nil, iterator.Done
+}
+
+// panickingIterator implements graphql.Iterator whose Next panics after all emails are sent.
+type panickingIterator struct {
+	emailIterator
+}
+
+func (iter *panickingIterator) Next() (interface{}, error) {
+	if len(iter.emails) == 0 {
+		panic("out of emails")
+	}
+	return iter.emailIterator.Next()
+}
+
+// graphql-js/src/subscription/__tests__/subscribe-test.js@8c96dc8
+var _ = Describe("Subscribe", func() {
+	var (
+		subscriber graphql.FieldSubscriber
+		schema     graphql.Schema
+	)
+
+	BeforeEach(func() {
+		subscriber = nil
+
+		emailType := graphql.MustNewObject(&graphql.ObjectConfig{
+			Name: "Email",
+			Fields: graphql.Fields{
+				"from": {
+					Type: graphql.T(graphql.String()),
+				},
+				"subject": {
+					Type: graphql.T(graphql.String()),
+				},
+			},
+		})
+
+		emailEventType := graphql.MustNewObject(&graphql.ObjectConfig{
+			Name: "EmailEvent",
+			Fields: graphql.Fields{
+				"email": {
+					Type: graphql.T(emailType),
+				},
+			},
+		})
+
+		queryType := graphql.MustNewObject(&graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"inbox": {
+					Type: graphql.ListOfType(emailType),
+				},
+			},
+		})
+
+		subscriptionType := graphql.MustNewObject(&graphql.ObjectConfig{
+			Name: "Subscription",
+			Fields: graphql.Fields{
+				"importantEmail": {
+					Type: graphql.T(emailEventType),
+					// Forward to the subscriber set by test.
+					Subscriber: graphql.FieldSubscriberFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+						return subscriber.Subscribe(ctx, source, info)
+					}),
+				},
+			},
+		})
+
+		schema = graphql.MustNewSchema(&graphql.SchemaConfig{
+			Query:        queryType,
+			Subscription: subscriptionType,
+		})
+	})
+
+	prepare := func(query string) *executor.PreparedOperation {
+		return executor.MustPrepare(schema, parser.MustParse(token.NewSource(query)))
+	}
+
+	subscribe := func(c context.Context, opts ...executor.ExecuteOption) <-chan *executor.ExecutionResult {
+		results, errs := prepare(`
+      subscription {
+        importantEmail {
+          email {
+            from
+            subject
+          }
+        }
+      }
+    `).Subscribe(c, opts...)
+		Expect(errs.HaveOccurred()).Should(BeFalse())
+		return results
+	}
+
+	It("produces a result for each event sent to a channel", func() {
+		events := make(chan interface{})
+		subscriber = graphql.FieldSubscriberFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+			Expect(info.Path().String()).Should(Equal("importantEmail"))
+			go func() {
+				defer close(events)
+				events <- map[string]interface{}{
+					"importantEmail": map[string]interface{}{
+						"email": &Email{From: "yuzhi@graphql.org", Subject: "Alright"},
+					},
+				}
+				events <- map[string]interface{}{
+					"importantEmail": map[string]interface{}{
+						"email": &Email{From: "hyo@graphql.org", Subject: "Tools"},
+					},
+				}
+			}()
+			return (<-chan interface{})(events), nil
+		})
+
+		results := subscribe(context.Background())
+
+		Eventually(results).Should(Receive(MatchResultInJSON(`{
+			"data": {
+				"importantEmail": {
+					"email": {
+						"from": "yuzhi@graphql.org",
+						"subject": "Alright"
+					}
+				}
+			}
+		}`)))
+
+		Eventually(results).Should(Receive(MatchResultInJSON(`{
+			"data": {
+				"importantEmail": {
+					"email": {
+						"from": "hyo@graphql.org",
+						"subject": "Tools"
+					}
+				}
+			}
+		}`)))
+
+		// Results channel is closed after the source is closed.
+		Eventually(results).Should(BeClosed())
+	})
+
+	It("produces a result for each event returned from an iterator", func() {
+		iter := newEmailIterator(&Email{From: "yuzhi@graphql.org", Subject: "Alright"})
+		subscriber = graphql.FieldSubscriberFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+			return iter, nil
+		})
+
+		c, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		results := subscribe(c)
+
+		Eventually(results).Should(Receive(MatchResultInJSON(`{
+			"data": {
+				"importantEmail": {
+					"email": {
+						"from": "yuzhi@graphql.org",
+						"subject": "Alright"
+					}
+				}
+			}
+		}`)))
+	})
+
+	It("tears down the source stream when the context is canceled", func() {
+		var (
+			iter          = newEmailIterator()
+			subscriberCtx context.Context
+		)
+		subscriber = graphql.FieldSubscriberFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+			subscriberCtx = ctx
+			return iter, nil
+		})
+
+		c, cancel := context.WithCancel(context.Background())
+		results := subscribe(c)
+
+		Consistently(results).ShouldNot(Receive())
+
+		cancel()
+
+		Eventually(results).Should(BeClosed())
+		Eventually(iter.closed).Should(BeClosed())
+		Eventually(subscriberCtx.Done()).Should(BeClosed())
+	})
+
+	It("terminates the subscription while the source iterator is blocked", func() {
+		// blockCh blocks Next of an iterator that implements neither io.Closer nor honors the context.
+		blockCh := make(chan struct{})
+		defer close(blockCh)
+
+		subscriber = graphql.FieldSubscriberFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+			return blockingIterator(blockCh), nil
+		})
+
+		c, cancel := context.WithCancel(context.Background())
+		results := subscribe(c)
+
+		Consistently(results).ShouldNot(Receive())
+
+		cancel()
+
+		Eventually(results).Should(BeClosed())
+	})
+
+	It("reports errors from the source stream in the result of the event", func() {
+		events := make(chan interface{}, 2)
+		events <- errors.New("unable to fetch email")
+		events <- map[string]interface{}{
+			"importantEmail": map[string]interface{}{
+				"email": &Email{From: "yuzhi@graphql.org", Subject: "Alright"},
+			},
+		}
+		close(events)
+
+		subscriber = graphql.FieldSubscriberFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+			return events, nil
+		})
+
+		results := subscribe(context.Background())
+
+		Eventually(results).Should(Receive(MatchResultInJSON(`{
+			"errors": [{
+				"message": "unable to fetch email",
+				"locations": [{ "line": 3, "column": 9 }],
+				"path": ["importantEmail"]
+			}]
+		}`)))
+
+		// The stream continues after an error event.
+		Eventually(results).Should(Receive(MatchResultInJSON(`{
+			"data": {
+				"importantEmail": {
+					"email": {
+						"from": "yuzhi@graphql.org",
+						"subject": "Alright"
+					}
+				}
+			}
+		}`)))
+
+		Eventually(results).Should(BeClosed())
+	})
+
+	It("reports panics in the source iterator and ends the stream", func() {
+		subscriber = graphql.FieldSubscriberFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+			return &panickingIterator{
+				emailIterator: *newEmailIterator(&Email{From: "yuzhi@graphql.org", Subject: "Alright"}),
+			}, nil
+		})
+
+		panics := make(chan interface{}, 1)
+		handler := executor.PanicHandlerFunc(func(ctx context.Context, value interface{}, stack []byte, info graphql.ResolveInfo) error {
+			Expect(info.Path().String()).Should(Equal("importantEmail"))
+			panics <- value
+			return nil
+		})
+
+		results := subscribe(context.Background(), executor.OnPanic(handler))
+
+		Eventually(results).Should(Receive(MatchResultInJSON(`{
+			"data": {
+				"importantEmail": {
+					"email": {
+						"from": "yuzhi@graphql.org",
+						"subject": "Alright"
+					}
+				}
+			}
+		}`)))
+
+		Eventually(results).Should(Receive(MatchResultInJSON(`{
+			"errors": [{
+				"message": "Internal error occurred when executing field Subscription.importantEmail.",
+				"locations": [{ "line": 3, "column": 9 }],
+				"path": ["importantEmail"]
+			}]
+		}`)))
+
+		Eventually(results).Should(BeClosed())
+		Expect(panics).Should(Receive(Equal("out of emails")))
+	})
+
+	It("resolves source stream with default field resolver when subscriber is not given", func() {
+		schema = graphql.MustNewSchema(&graphql.SchemaConfig{
+			Query: schema.Query(),
+			Subscription: graphql.MustNewObject(&graphql.ObjectConfig{
+				Name: "DefaultSubscription",
+				Fields: graphql.Fields{
+					"importantEmail": {
+						Type: graphql.T(schema.Subscription().Fields()["importantEmail"].Type()),
+					},
+				},
+			}),
+		})
+
+		events := make(chan interface{}, 1)
+		events <- map[string]interface{}{
+			"importantEmail": map[string]interface{}{
+				"email": &Email{From: "yuzhi@graphql.org", Subject: "Alright"},
+			},
+		}
+		close(events)
+
+		results := subscribe(context.Background(), executor.RootValue(map[string]interface{}{
+			"importantEmail": events,
+		}))
+
+		Eventually(results).Should(Receive(MatchResultInJSON(`{
+			"data": {
+				"importantEmail": {
+					"email": {
+						"from": "yuzhi@graphql.org",
+						"subject": "Alright"
+					}
+				}
+			}
+		}`)))
+		Eventually(results).Should(BeClosed())
+	})
+
+	It("returns an error if subscriber fails", func() {
+		subscriber = graphql.FieldSubscriberFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+			return nil, errors.New("test error")
+		})
+
+		_, errs := prepare(`subscription { importantEmail { email { from } } }`).
+			Subscribe(context.Background())
+		Expect(&executor.ExecutionResult{Errors: errs}).Should(MatchResultInJSON(`{
+			"errors": [{
+				"message": "test error",
+				"locations": [{ "line": 1, "column": 16 }],
+				"path": ["importantEmail"]
+			}]
+		}`))
+	})
+
+	It("returns an error if subscriber doesn't return a stream", func() {
+		subscriber = graphql.FieldSubscriberFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+			return "test", nil
+		})
+
+		_, errs := prepare(`subscription { importantEmail { email { from } } }`).
+			Subscribe(context.Background())
+		Expect(&executor.ExecutionResult{Errors: errs}).Should(MatchResultInJSON(`{
+			"errors": [{
+				"message": "Subscription field must return a channel or an Iterator, but got string.",
+				"locations": [{ "line": 1, "column": 16 }],
+				"path": ["importantEmail"]
+			}]
+		}`))
+	})
+
+	It("returns an error when subscribing to a query", func() {
+		_, errs := prepare(`query { inbox { from } }`).Subscribe(context.Background())
+		Expect(errs).Should(testutil.ConsistOfGraphQLErrors(
+			testutil.MatchGraphQLError(
+				testutil.MessageEqual("Can only subscribe to subscription operations."),
+				testutil.LocationEqual(graphql.ErrorLocation{Line: 1, Column: 1}),
+			),
+		))
+	})
+
+	It("creates a source event stream", func() {
+		events := make(chan interface{}, 2)
+		events <- "event"
+		events <- errors.New("event error")
+		close(events)
+
+		subscriber = graphql.FieldSubscriberFunc(func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+			return events, nil
+		})
+
+		stream, errs := prepare(`subscription { importantEmail { email { from } } }`).
+			CreateSourceEventStream(context.Background())
+		Expect(errs.HaveOccurred()).Should(BeFalse())
+
+		var event executor.SourceEvent
+		Eventually(stream).Should(Receive(&event))
+		Expect(event).Should(Equal(executor.SourceEvent{Value: "event"}))
+
+		Eventually(stream).Should(Receive(&event))
+		Expect(event.Value).Should(BeNil())
+		Expect(event.Err).Should(testutil.MatchGraphQLError(
+			testutil.MessageEqual("event error"),
+			testutil.LocationEqual(graphql.ErrorLocation{Line: 1, Column: 16}),
+		))
+		Expect(event.Err.(*graphql.Error).Path.String()).Should(Equal("importantEmail"))
+
+		Eventually(stream).Should(BeClosed())
+	})
+})
@@ -322,7 +322,7 @@ var _ = Describe("Execute: Union and intersection types", func() {
               "name": "name"
             }
           ],
-          "interfaces": null,
+          "interfaces": [],
           "possibleTypes": [
             {
               "name": "Person"
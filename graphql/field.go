@@ -47,6 +47,43 @@ func (f FieldResolverFunc) Resolve(
 // FieldResolverFunc implements FieldResolver.
 var _ FieldResolver = FieldResolverFunc(nil)
 
+// FieldSubscriber creates the source event stream for a root field of a subscription operation.
+//
+// Reference: https://graphql.github.io/graphql-spec/June2018/#ResolveFieldEventStream()
+type FieldSubscriber interface {
+	// Context carries deadlines and cancelation signals. It is canceled when the subscription is
+	// terminated. Implementation should release the resources allocated for the source stream (e.g.,
+	// stop the goroutine that feeds the channel) when the context is done.
+	//
+	// Source is the root value given to the execution.
+	//
+	// Info contains a collection of information about the current execution state.
+	//
+	// The returned source stream is either a receive-able Go channel or an Iterator. Each value
+	// received from the stream is an event which is used as the root value to execute the selection
+	// set of the subscription. An event that is an error value is reported as an error in the
+	// response for that event. The stream ends when the channel is closed or the Iterator returns
+	// iterator.Done. If the stream also implements io.Closer, it is closed when the subscription is
+	// terminated. Otherwise, an Iterator should return from Next when the context is done; The
+	// subscription is terminated without waiting for a blocked Next, but the goroutine that calls
+	// Next is only released after Next returns.
+	Subscribe(ctx context.Context, source interface{}, info ResolveInfo) (interface{}, error)
+}
+
+// FieldSubscriberFunc is an adapter to allow the use of ordinary functions as FieldSubscriber.
+type FieldSubscriberFunc func(ctx context.Context, source interface{}, info ResolveInfo) (interface{}, error)
+
+// Subscribe calls f(ctx, source, info).
+func (f FieldSubscriberFunc) Subscribe(
+	ctx context.Context,
+	source interface{},
+	info ResolveInfo) (interface{}, error) {
+	return f(ctx, source, info)
+}
+
+// FieldSubscriberFunc implements FieldSubscriber.
+var _ FieldSubscriber = FieldSubscriberFunc(nil)
+
 // Fields maps field name to its definition. In general, this should be named as "FieldConfigMap".
 // However, this type is used frequently so we try to make it shorter to save some typing efforts.
 // Unfortunately we cannot offer FieldConfig as Field because the name is used for representing
@@ -67,8 +104,15 @@ type FieldConfig struct {
 	// Resolver for resolving field value during execution
 	Resolver FieldResolver
 
+	// Subscriber for creating the source event stream when the field is the root field of a
+	// subscription operation (optional)
+	Subscriber FieldSubscriber
+
 	// Deprecation is non-nil when the value is tagged as deprecated.
 	Deprecation *Deprecation
+
+	// Directives applied to the field
+	Directives AppliedDirectives
 }
 
 // FieldMap maps field name to the Field.
@@ -130,8 +174,17 @@ type Field interface {
 	// Reference: https://graphql.github.io/graphql-spec/June2018/#ResolveFieldValue()
 	Resolver() FieldResolver
 
+	// Subscriber creates the source event stream for the field when it is the root field of a
+	// subscription operation.
+	//
+	// Reference: https://graphql.github.io/graphql-spec/June2018/#ResolveFieldEventStream()
+	Subscriber() FieldSubscriber
+
 	// Deprecation is non-nil when the field is tagged as deprecated.
 	Deprecation() *Deprecation
+
+	// Directives returns the directives applied to the field.
+	Directives() AppliedDirectives
 }
 
 // field is our built-in implementation for Field.
@@ -169,11 +222,21 @@ func (f *field) Resolver() FieldResolver {
 	return f.config.Resolver
 }
 
+// Subscriber implements Field.
+func (f *field) Subscriber() FieldSubscriber {
+	return f.config.Subscriber
+}
+
 // Deprecation implements Field.
 func (f *field) Deprecation() *Deprecation {
 	return f.config.Deprecation
 }
 
+// Directives implements Field.
+func (f *field) Directives() AppliedDirectives {
+	return f.config.Directives
+}
+
 // ArgumentConfigMap maps argument name to its definition.
 type ArgumentConfigMap map[string]ArgumentConfig
 
@@ -198,6 +261,12 @@ type ArgumentConfig struct {
 
 	// DefaultValue specified the value to be assigned to the argument when no value is provided.
 	DefaultValue interface{}
+
+	// Deprecation is non-nil when the argument is tagged as deprecated.
+	Deprecation *Deprecation
+
+	// Directives applied to the argument
+	Directives AppliedDirectives
 }
 
 // buildArguments builds a list of Argument from an ArgumentConfigMap.
@@ -222,6 +291,8 @@ func buildArguments(argConfigMap ArgumentConfigMap, typeDefResolver typeDefiniti
 		arg.description = argConfig.Description
 		arg.ttype = argType
 		arg.defaultValue = argConfig.DefaultValue
+		arg.deprecation = argConfig.Deprecation
+		arg.directives = argConfig.Directives
 
 		argIdx++
 	}
@@ -237,6 +308,8 @@ type Argument struct {
 	description  string
 	ttype        Type
 	defaultValue interface{}
+	deprecation  *Deprecation
+	directives   AppliedDirectives
 }
 
 // Name of the argument
@@ -269,6 +342,16 @@ func (arg *Argument) DefaultValue() interface{} {
 	return arg.defaultValue
 }
 
+// Deprecation is non-nil when the argument is tagged as deprecated.
+func (arg *Argument) Deprecation() *Deprecation {
+	return arg.deprecation
+}
+
+// Directives returns the directives applied to the argument.
+func (arg *Argument) Directives() AppliedDirectives {
+	return arg.directives
+}
+
 // IsRequiredArgument returns true if value must be provided to the argument for execution.
 func IsRequiredArgument(arg *Argument) bool {
 	return IsNonNullType(arg.Type()) && !arg.HasDefaultValue()
@@ -41,6 +41,12 @@ type InputFieldDefinition struct {
 
 	// DefaultValue specified the value to be assigned to the field when no input is provided.
 	DefaultValue interface{}
+
+	// Deprecation is non-nil when the field is tagged as deprecated.
+	Deprecation *Deprecation
+
+	// Directives applied to the field
+	Directives AppliedDirectives
 }
 
 // BuildInputFieldMap builds an InputFieldMap from given InputFields.
@@ -62,6 +68,8 @@ func BuildInputFieldMap(inputFieldDefMap InputFields, typeDefResolver typeDefini
 			description:  inputFieldDef.Description,
 			ttype:        inputFieldType,
 			defaultValue: inputFieldDef.DefaultValue,
+			deprecation:  inputFieldDef.Deprecation,
+			directives:   inputFieldDef.Directives,
 		}
 	}
 
@@ -74,6 +82,8 @@ type inputField struct {
 	description  string
 	ttype        Type
 	defaultValue interface{}
+	deprecation  *Deprecation
+	directives   AppliedDirectives
 }
 
 var _ InputField = (*inputField)(nil)
@@ -108,6 +118,16 @@ func (f *inputField) DefaultValue() interface{} {
 	return f.defaultValue
 }
 
+// Deprecation implements InputField.
+func (f *inputField) Deprecation() *Deprecation {
+	return f.deprecation
+}
+
+// Directives implements InputField.
+func (f *inputField) Directives() AppliedDirectives {
+	return f.directives
+}
+
 // InputObjectConfig provides specification to define a InputObject type. It is served as a
 // convenient way to create a InputObjectTypeDefinition for creating an input object type.
 type InputObjectConfig struct {
@@ -119,8 +139,15 @@ type InputObjectConfig struct {
 	// Description for the InputObject type
 	Description string
 
+	// Directives applied to the type
+	Directives AppliedDirectives
+
 	// Fields to be defined in the InputObject Type
 	Fields InputFields
+
+	// IsOneOf indicates whether the InputObject is a OneOf Input Object which requires exactly one of
+	// its fields to be provided with a non-null value.
+	IsOneOf bool
 }
 
 var (
@@ -133,7 +160,9 @@ func (config *InputObjectConfig) TypeData() InputObjectTypeData {
 	return InputObjectTypeData{
 		Name:        config.Name,
 		Description: config.Description,
+		Directives:  config.Directives,
 		Fields:      config.Fields,
+		IsOneOf:     config.IsOneOf,
 	}
 }
 
@@ -222,7 +251,17 @@ func (o *inputObject) Description() string {
 	return o.data.Description
 }
 
+// Directives implements TypeWithDirectives.
+func (o *inputObject) Directives() AppliedDirectives {
+	return o.data.Directives
+}
+
 // Fields implements InputObject.
 func (o *inputObject) Fields() InputFieldMap {
 	return o.fields
 }
+
+// IsOneOf implements InputObject.
+func (o *inputObject) IsOneOf() bool {
+	return o.data.IsOneOf
+}
@@ -27,10 +27,16 @@ type InterfaceConfig struct {
 	// Description for the Interface type
 	Description string
 
+	// Directives applied to the type
+	Directives AppliedDirectives
+
 	// TypeResolver resolves the concrete Object type implementing the defining interface from given
 	// value.
 	TypeResolver TypeResolver
 
+	// Interfaces that implemented by the defining Interface
+	Interfaces []InterfaceTypeDefinition
+
 	// Fields in the Interface Type
 	Fields Fields
 }
@@ -45,6 +51,8 @@ func (config *InterfaceConfig) TypeData() InterfaceTypeData {
 	return InterfaceTypeData{
 		Name:        config.Name,
 		Description: config.Description,
+		Directives:  config.Directives,
+		Interfaces:  config.Interfaces,
 		Fields:      config.Fields,
 	}
 }
@@ -102,6 +110,20 @@ func (creator *interfaceTypeCreator) Finalize(t Type, typeDefResolver typeDefini
 	}
 	iface.fields = fieldMap
 
+	// Resolve interface type.
+	numInterfaces := len(iface.data.Interfaces)
+	if numInterfaces > 0 {
+		interfaces := make([]Interface, numInterfaces)
+		for i, ifaceTypeDef := range iface.data.Interfaces {
+			t, err := typeDefResolver(ifaceTypeDef)
+			if err != nil {
+				return err
+			}
+			interfaces[i] = t.(Interface)
+		}
+		iface.interfaces = interfaces
+	}
+
 	return nil
 }
 
@@ -112,6 +134,7 @@ type iface struct {
 	data         InterfaceTypeData
 	typeResolver TypeResolver
 	fields       FieldMap
+	interfaces   []Interface
 }
 
 var _ Interface = (*iface)(nil)
@@ -152,7 +175,17 @@ func (iface *iface) Description() string {
 	return iface.data.Description
 }
 
+// Directives implements TypeWithDirectives.
+func (iface *iface) Directives() AppliedDirectives {
+	return iface.data.Directives
+}
+
 // Fields implements Interface.
 func (iface *iface) Fields() FieldMap {
 	return iface.fields
 }
+
+// Interfaces implements Interface.
+func (iface *iface) Interfaces() []Interface {
+	return iface.interfaces
+}
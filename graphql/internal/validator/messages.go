@@ -23,6 +23,12 @@ import (
 	"github.com/botobag/artemis/internal/util"
 )
 
+// NonExecutableDefinitionMessage returns message describing error occurred in rule "Executable
+// Definitions" (rules.ExecutableDefinitions).
+func NonExecutableDefinitionMessage(defName string) string {
+	return fmt.Sprintf("The %s definition is not executable.", defName)
+}
+
 // DuplicateOperationNameMessage returns message describing error occurred in rule "Operation Name
 // Uniqueness" (rules.UniqueOperationNames).
 func DuplicateOperationNameMessage(operationName string) string {
@@ -307,6 +313,18 @@ func RequiredFieldMessage(typeName string, fieldName string, fieldTypeName strin
 		typeName, fieldName, fieldTypeName)
 }
 
+// OneOfInputObjectKeyCountMessage returns message describing error occurred in rule "Value Type
+// Correctness" (rules.ValuesOfCorrectType).
+func OneOfInputObjectKeyCountMessage(typeName string) string {
+	return fmt.Sprintf(`OneOf Input Object "%s" must specify exactly one key.`, typeName)
+}
+
+// OneOfInputObjectNullFieldMessage returns message describing error occurred in rule "Value Type
+// Correctness" (rules.ValuesOfCorrectType).
+func OneOfInputObjectNullFieldMessage(typeName string, fieldName string) string {
+	return fmt.Sprintf(`Field "%s.%s" must be non-null.`, typeName, fieldName)
+}
+
 // UnknownFieldMessage returns message describing error occurred in rule "Value Type Correctness"
 // (rules.ValuesOfCorrectType).
 func UnknownFieldMessage(typeName string, fieldName string, suggestedFields []string) string {
@@ -388,3 +406,53 @@ func BadVarPosMessage(variableName string, variableType string, expectedType str
 	return fmt.Sprintf(`Variable "$%s" of type "%s" used in position expecting type "%s".`,
 		variableName, variableType, expectedType)
 }
+
+// NullableVarForOneOfMessage returns message describing error occurred in rule "All Variable
+// Usages Are Allowed" (rules.VariablesInAllowedPosition).
+func NullableVarForOneOfMessage(variableName string, typeName string) string {
+	return fmt.Sprintf(`Variable "$%s" must be non-nullable to be used for OneOf Input Object "%s".`,
+		variableName, typeName)
+}
+
+// DuplicateDeferStreamLabelMessage returns message describing error occurred in rule "Defer/Stream
+// Directive Labels Are Unique" (rules.DeferStreamDirectiveLabel).
+func DuplicateDeferStreamLabelMessage() string {
+	return "Defer/Stream directive label argument must be unique."
+}
+
+// NonStaticDeferStreamLabelMessage returns message describing error occurred in rule "Defer/Stream
+// Directive Labels Are Unique" (rules.DeferStreamDirectiveLabel).
+func NonStaticDeferStreamLabelMessage(directiveName string) string {
+	return fmt.Sprintf(`Directive "%s"'s label argument must be a static string.`, directiveName)
+}
+
+// StreamOnNonListFieldMessage returns message describing error occurred in rule "Stream Directive
+// On List Field" (rules.StreamDirectiveOnListField).
+func StreamOnNonListFieldMessage(fieldName string, typeName string) string {
+	return fmt.Sprintf(`Stream directive cannot be used on non-list field "%s" on type "%s".`,
+		fieldName, typeName)
+}
+
+// DeferOnRootMutationTypeMessage returns message describing error occurred in rule "Defer/Stream
+// Directive Not On Root Field" (rules.DeferStreamDirectiveOnRootField).
+func DeferOnRootMutationTypeMessage(typeName string) string {
+	return fmt.Sprintf(`Defer directive cannot be used on root mutation type "%s".`, typeName)
+}
+
+// DeferOnRootSubscriptionTypeMessage returns message describing error occurred in rule
+// "Defer/Stream Directive Not On Root Field" (rules.DeferStreamDirectiveOnRootField).
+func DeferOnRootSubscriptionTypeMessage(typeName string) string {
+	return fmt.Sprintf(`Defer directive cannot be used on root subscription type "%s".`, typeName)
+}
+
+// StreamOnRootMutationTypeMessage returns message describing error occurred in rule "Defer/Stream
+// Directive Not On Root Field" (rules.DeferStreamDirectiveOnRootField).
+func StreamOnRootMutationTypeMessage(typeName string) string {
+	return fmt.Sprintf(`Stream directive cannot be used on root mutation type "%s".`, typeName)
+}
+
+// StreamOnRootSubscriptionTypeMessage returns message describing error occurred in rule
+// "Defer/Stream Directive Not On Root Field" (rules.DeferStreamDirectiveOnRootField).
+func StreamOnRootSubscriptionTypeMessage(typeName string) string {
+	return fmt.Sprintf(`Stream directive cannot be used on root subscription type "%s".`, typeName)
+}
@@ -147,6 +147,19 @@ func CoerceFromAST(value ast.Value, t graphql.Type, variables graphql.VariableVa
 			}
 			coercedValues[field.Name()] = fieldValue
 		}
+
+		// Ensure exactly one non-null field is provided to a OneOf Input Object.
+		if ttype.IsOneOf() {
+			if len(coercedValues) != 1 {
+				return nil, fmt.Errorf(`exactly one field must be specified for OneOf type "%s"`, ttype.Name())
+			}
+			for name, fieldValue := range coercedValues {
+				if fieldValue == nil {
+					return nil, fmt.Errorf(`field "%s" of OneOf type "%s" must be non-null`, name, ttype.Name())
+				}
+			}
+		}
+
 		return coercedValues, nil
 
 	case graphql.Scalar:
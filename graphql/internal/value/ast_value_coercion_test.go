@@ -141,6 +141,8 @@ var _ = Describe("CoerceFromAST", func() {
 		nonNullListOfNonNullBool graphql.Type
 
 		testInputObj graphql.Type
+
+		testOneOfInputObj graphql.Type
 	)
 
 	BeforeEach(func() {
@@ -169,6 +171,19 @@ var _ = Describe("CoerceFromAST", func() {
 				},
 			},
 		})
+
+		testOneOfInputObj = graphql.MustNewInputObject(&graphql.InputObjectConfig{
+			Name: "TestOneOfInput",
+			Fields: graphql.InputFields{
+				"a": {
+					Type: graphql.T(graphql.String()),
+				},
+				"b": {
+					Type: graphql.T(graphql.Int()),
+				},
+			},
+			IsOneOf: true,
+		})
 	})
 
 	It("coerces to null unless non-null", func() {
@@ -251,6 +266,42 @@ var _ = Describe("CoerceFromAST", func() {
 		})
 	})
 
+	It("coerces OneOf input objects according to input coercion rules", func() {
+		runTestCasesForType(testOneOfInputObj, []testCase{
+			{
+				valueText: `{ a: "abc" }`,
+				hasError:  false,
+				expectedValue: map[string]interface{}{
+					"a": "abc",
+				},
+			},
+			{
+				valueText: `{ b: 123 }`,
+				hasError:  false,
+				expectedValue: map[string]interface{}{
+					"b": 123,
+				},
+			},
+			{`{ a: null }`, true, nil},
+			{`{ a: "abc", b: 123 }`, true, nil},
+			{`{ a: null, b: 123 }`, true, nil},
+			{`{}`, true, nil},
+		})
+	})
+
+	It("rejects OneOf input objects with multiple fields from variables", func() {
+		Expect(valueFromASTWithVars(vars{"a": "abc"}, testOneOfInputObj, "{ a: $a, b: $b }")).Should(Equal(
+			map[string]interface{}{
+				"a": "abc",
+			}))
+
+		_, err := valueFromASTWithVars(vars{"a": "abc", "b": 123}, testOneOfInputObj, "{ a: $a, b: $b }")
+		Expect(err).Should(HaveOccurred())
+
+		_, err = valueFromASTWithVars(vars{"a": nil}, testOneOfInputObj, "{ a: $a }")
+		Expect(err).Should(HaveOccurred())
+	})
+
 	It("accepts variable values assuming already coerced", func() {
 		_, err := valueFromASTWithVars(vars{}, graphql.Boolean(), "$var")
 		Expect(err).Should(HaveOccurred())
@@ -271,6 +271,34 @@ func coerceValueImpl(value interface{}, t graphql.Type, blameNode ast.Node, path
 		if errs.HaveOccurred() {
 			return nil, errs
 		}
+
+		// Ensure exactly one non-null field is provided to a OneOf Input Object.
+		if t.IsOneOf() {
+			if len(coercedValue) != 1 {
+				return nil, graphql.ErrorsOf(
+					newCoercionError(
+						fmt.Sprintf(`Exactly one key must be specified for OneOf type %s`, graphql.Inspect(t)),
+						blameNode,
+						path,
+						"",  /* subMessage */
+						nil, /* originalError */
+					))
+			}
+
+			for name, fieldValue := range coercedValue {
+				if fieldValue == nil {
+					return nil, graphql.ErrorsOf(
+						newCoercionError(
+							fmt.Sprintf(`Field "%s" must be non-null`, name),
+							blameNode,
+							path,
+							"",  /* subMessage */
+							nil, /* originalError */
+						))
+				}
+			}
+		}
+
 		return coercedValue, graphql.NoErrors()
 	}
 
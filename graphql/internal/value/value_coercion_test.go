@@ -312,6 +312,61 @@ var _ = Describe("CoerceValue", func() {
 		})
 	})
 
+	Describe("for OneOf InputObject", func() {
+		var TestInputObject graphql.Type
+
+		BeforeEach(func() {
+			TestInputObject = graphql.MustNewInputObject(&graphql.InputObjectConfig{
+				Name: "TestInputObject",
+				Fields: graphql.InputFields{
+					"foo": {
+						Type: graphql.T(graphql.Int()),
+					},
+					"bar": {
+						Type: graphql.T(graphql.Int()),
+					},
+				},
+				IsOneOf: true,
+			})
+		})
+
+		It("returns no error for a valid input", func() {
+			Expect(value.CoerceValue(map[string]interface{}{"foo": 123}, TestInputObject, nil)).
+				Should(Equal(map[string]interface{}{"foo": 123}))
+		})
+
+		It("returns an error if more than one field is specified", func() {
+			_, errs := value.CoerceValue(map[string]interface{}{
+				"foo": 123,
+				"bar": nil,
+			}, TestInputObject, nil)
+			Expect(errs).Should(testutil.ConsistOfGraphQLErrors(testutil.MatchGraphQLError(
+				testutil.MessageEqual("Exactly one key must be specified for OneOf type TestInputObject."),
+			)))
+		})
+
+		It("returns an error if no field is specified", func() {
+			_, errs := value.CoerceValue(map[string]interface{}{}, TestInputObject, nil)
+			Expect(errs).Should(testutil.ConsistOfGraphQLErrors(testutil.MatchGraphQLError(
+				testutil.MessageEqual("Exactly one key must be specified for OneOf type TestInputObject."),
+			)))
+		})
+
+		It("returns an error if the field is null", func() {
+			_, errs := value.CoerceValue(map[string]interface{}{"bar": nil}, TestInputObject, nil)
+			Expect(errs).Should(testutil.ConsistOfGraphQLErrors(testutil.MatchGraphQLError(
+				testutil.MessageEqual(`Field "bar" must be non-null.`),
+			)))
+		})
+
+		It("returns an error for an invalid field", func() {
+			_, errs := value.CoerceValue(map[string]interface{}{"foo": "abc"}, TestInputObject, nil)
+			Expect(errs).Should(testutil.ConsistOfGraphQLErrors(testutil.MatchGraphQLError(
+				testutil.MessageEqual("Expected type Int at value.foo; Int cannot represent \"abc\": invalid variable type `string`"),
+			)))
+		})
+	})
+
 	Describe("for List", func() {
 		var TestList graphql.Type
 
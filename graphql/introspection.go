@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/botobag/artemis/graphql/ast"
 	"github.com/botobag/artemis/internal/util"
 	"github.com/botobag/artemis/iterator"
 )
@@ -115,10 +116,25 @@ var _directiveDefinition = &ObjectConfig{
 				return source.(Directive).Locations(), nil
 			}),
 		},
+		"isRepeatable": {
+			Type: NonNullOfType(Boolean()),
+			Resolver: FieldResolverFunc(func(ctx context.Context, source interface{}, info ResolveInfo) (interface{}, error) {
+				return source.(Directive).IsRepeatable(), nil
+			}),
+		},
 		"args": {
 			Type: NonNullOf(ListOf(NonNullOf(_inputValueDefinition))),
+			Args: ArgumentConfigMap{
+				"includeDeprecated": {
+					Type:         T(Boolean()),
+					DefaultValue: false,
+				},
+			},
 			Resolver: FieldResolverFunc(func(ctx context.Context, source interface{}, info ResolveInfo) (interface{}, error) {
-				return _argsIterable{source.(Directive).Args()}, nil
+				return _argsIterable{
+					args:              source.(Directive).Args(),
+					includeDeprecated: info.Args().Get("includeDeprecated").(bool),
+				}, nil
 			}),
 		},
 	},
@@ -251,8 +267,17 @@ var _fieldDefinition = &ObjectConfig{
 		},
 		"args": {
 			Type: NonNullOf(ListOf(NonNullOf(_inputValueDefinition))),
+			Args: ArgumentConfigMap{
+				"includeDeprecated": {
+					Type:         T(Boolean()),
+					DefaultValue: false,
+				},
+			},
 			Resolver: FieldResolverFunc(func(ctx context.Context, source interface{}, info ResolveInfo) (interface{}, error) {
-				return _argsIterable{source.(Field).Args()}, nil
+				return _argsIterable{
+					args:              source.(Field).Args(),
+					includeDeprecated: info.Args().Get("includeDeprecated").(bool),
+				}, nil
 			}),
 		},
 		"type": {
@@ -290,6 +315,7 @@ type inputValue interface {
 	Type() Type
 	HasDefaultValue() bool
 	DefaultValue() interface{}
+	Deprecation() *Deprecation
 }
 
 var _ inputValue = (InputField)(nil)
@@ -327,8 +353,30 @@ var _inputValueDefinition = &ObjectConfig{
 				if !value.HasDefaultValue() {
 					return nil, nil
 				}
-				// FIXME: Inconsistent implementation from graphql-js.
-				return value.DefaultValue(), nil
+				valueAST, err := ASTFromValue(value.DefaultValue(), value.Type())
+				if err != nil {
+					return nil, err
+				}
+				if valueAST == nil {
+					return nil, nil
+				}
+				return ast.Print(valueAST), nil
+			}),
+		},
+		"isDeprecated": {
+			Type: NonNullOfType(Boolean()),
+			Resolver: FieldResolverFunc(func(ctx context.Context, source interface{}, info ResolveInfo) (interface{}, error) {
+				return source.(inputValue).Deprecation().Defined(), nil
+			}),
+		},
+		"deprecationReason": {
+			Type: T(String()),
+			Resolver: FieldResolverFunc(func(ctx context.Context, source interface{}, info ResolveInfo) (interface{}, error) {
+				deprecation := source.(inputValue).Deprecation()
+				if deprecation.Defined() {
+					return deprecation.Reason, nil
+				}
+				return nil, nil
 			}),
 		},
 	},
@@ -468,31 +516,34 @@ func (iter noDeprecatedFieldsIter) Next() (interface{}, error) {
 //===----------------------------------------------------------------------------------------====//
 
 type _argsIterable struct {
-	args []Argument
+	args              []Argument
+	includeDeprecated bool
 }
 
 func (iterable _argsIterable) Iterator() Iterator {
 	return &argsIter{
-		args: iterable.args,
+		args:              iterable.args,
+		includeDeprecated: iterable.includeDeprecated,
 	}
 }
 
 type argsIter struct {
-	args    []Argument
-	nextIdx int
+	args              []Argument
+	includeDeprecated bool
+	nextIdx           int
 }
 
 // Next implements Iterator.
 func (iter *argsIter) Next() (interface{}, error) {
-	var (
-		args    = iter.args
-		nextIdx = iter.nextIdx
-	)
-	if nextIdx >= len(args) {
-		return nil, iterator.Done
+	args := iter.args
+	for iter.nextIdx < len(args) {
+		arg := &args[iter.nextIdx]
+		iter.nextIdx++
+		if iter.includeDeprecated || !arg.Deprecation().Defined() {
+			return arg, nil
+		}
 	}
-	iter.nextIdx++
-	return &args[nextIdx], nil
+	return nil, iterator.Done
 }
 
 //===----------------------------------------------------------------------------------------====//
@@ -532,11 +583,31 @@ func (iter noDeprecatedEnumValuesIter) Next() (interface{}, error) {
 //===----------------------------------------------------------------------------------------====//
 
 type _inputFieldsIterable struct {
-	fields InputFieldMap
+	fields            InputFieldMap
+	includeDeprecated bool
 }
 
 func (iterable _inputFieldsIterable) Iterator() Iterator {
-	return NewMapValuesIterator(iterable.fields)
+	if iterable.includeDeprecated {
+		return NewMapValuesIterator(iterable.fields)
+	}
+	return noDeprecatedInputFieldsIter{util.NewImmutableMapIter(iterable.fields)}
+}
+
+type noDeprecatedInputFieldsIter struct {
+	fieldIter *util.ImmutableMapIter
+}
+
+// Next implements Iterator.
+func (iter noDeprecatedInputFieldsIter) Next() (interface{}, error) {
+	fieldIter := iter.fieldIter
+	for fieldIter.Next() {
+		field := fieldIter.Value().Interface().(InputField)
+		if !field.Deprecation().Defined() {
+			return field, nil
+		}
+	}
+	return nil, iterator.Done
 }
 
 func init() {
@@ -613,14 +684,20 @@ func init() {
 		"interfaces": {
 			Type: ListOf(NonNullOf(_typeDefinition)),
 			Resolver: FieldResolverFunc(func(ctx context.Context, source interface{}, info ResolveInfo) (interface{}, error) {
-				if t, ok := source.(Object); ok {
-					interfaces := t.Interfaces()
-					if interfaces != nil {
-						return interfaces, nil
-					}
-					return []Interface{}, nil
+				var interfaces []Interface
+				switch t := source.(type) {
+				case Object:
+					interfaces = t.Interfaces()
+				case Interface:
+					interfaces = t.Interfaces()
+				default:
+					return nil, nil
 				}
-				return nil, nil
+
+				if interfaces != nil {
+					return interfaces, nil
+				}
+				return []Interface{}, nil
 			}),
 		},
 		"possibleTypes": {
@@ -652,9 +729,36 @@ func init() {
 		},
 		"inputFields": {
 			Type: ListOf(NonNullOf(_inputValueDefinition)),
+			Args: ArgumentConfigMap{
+				"includeDeprecated": {
+					Type:         T(Boolean()),
+					DefaultValue: false,
+				},
+			},
+			Resolver: FieldResolverFunc(func(ctx context.Context, source interface{}, info ResolveInfo) (interface{}, error) {
+				if t, ok := source.(InputObject); ok {
+					return _inputFieldsIterable{
+						fields:            t.Fields(),
+						includeDeprecated: info.Args().Get("includeDeprecated").(bool),
+					}, nil
+				}
+				return nil, nil
+			}),
+		},
+		"isOneOf": {
+			Type: T(Boolean()),
 			Resolver: FieldResolverFunc(func(ctx context.Context, source interface{}, info ResolveInfo) (interface{}, error) {
 				if t, ok := source.(InputObject); ok {
-					return _inputFieldsIterable{t.Fields()}, nil
+					return t.IsOneOf(), nil
+				}
+				return nil, nil
+			}),
+		},
+		"specifiedByURL": {
+			Type: T(String()),
+			Resolver: FieldResolverFunc(func(ctx context.Context, source interface{}, info ResolveInfo) (interface{}, error) {
+				if t, ok := source.(Scalar); ok && len(t.SpecifiedByURL()) > 0 {
+					return t.SpecifiedByURL(), nil
 				}
 				return nil, nil
 			}),
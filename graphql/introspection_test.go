@@ -91,6 +91,19 @@ func sortInspectionResult(resultJSON []byte) ([]byte, error) {
 		sortFieldByNameKey(t, "trueValues")
 		sortFieldByNameKey(t, "falseValues")
 		sortFieldByNameKey(t, "omittedValues")
+
+		// Hack: the test that check includeDeprecated for input fields aliases "inputFields" field to
+		// the following names.
+		sortFieldByNameKey(t, "trueInputFields")
+		sortFieldByNameKey(t, "falseInputFields")
+		sortFieldByNameKey(t, "omittedInputFields")
+		// Hack: the test that check includeDeprecated for arguments aliases "args" field to the
+		// following names.
+		if fields, ok := t["fields"].([]interface{}); ok {
+			for _, field := range fields {
+				sortFieldByNameKey(field.(map[string]interface{}), "trueArgs")
+			}
+		}
 	}
 
 	_schema := result.Data.Schema
@@ -447,7 +460,17 @@ var _ = Describe("Introspection", func() {
                 },
                 {
                   "name": "inputFields",
-                  "args": [],
+                  "args": [
+                    {
+                      "name": "includeDeprecated",
+                      "type": {
+                        "kind": "SCALAR",
+                        "name": "Boolean",
+                        "ofType": null
+                      },
+                      "defaultValue": "false"
+                    }
+                  ],
                   "type": {
                     "kind": "LIST",
                     "name": null,
@@ -464,6 +487,28 @@ var _ = Describe("Introspection", func() {
                   "isDeprecated": false,
                   "deprecationReason": null
                 },
+                {
+                  "name": "isOneOf",
+                  "args": [],
+                  "type": {
+                    "kind": "SCALAR",
+                    "name": "Boolean",
+                    "ofType": null
+                  },
+                  "isDeprecated": false,
+                  "deprecationReason": null
+                },
+                {
+                  "name": "specifiedByURL",
+                  "args": [],
+                  "type": {
+                    "kind": "SCALAR",
+                    "name": "String",
+                    "ofType": null
+                  },
+                  "isDeprecated": false,
+                  "deprecationReason": null
+                },
                 {
                   "name": "ofType",
                   "args": [],
@@ -572,7 +617,17 @@ var _ = Describe("Introspection", func() {
                 },
                 {
                   "name": "args",
-                  "args": [],
+                  "args": [
+                    {
+                      "name": "includeDeprecated",
+                      "type": {
+                        "kind": "SCALAR",
+                        "name": "Boolean",
+                        "ofType": null
+                      },
+                      "defaultValue": "false"
+                    }
+                  ],
                   "type": {
                     "kind": "NON_NULL",
                     "name": null,
@@ -695,6 +750,32 @@ var _ = Describe("Introspection", func() {
                   },
                   "isDeprecated": false,
                   "deprecationReason": null
+                },
+                {
+                  "name": "isDeprecated",
+                  "args": [],
+                  "type": {
+                    "kind": "NON_NULL",
+                    "name": null,
+                    "ofType": {
+                      "kind": "SCALAR",
+                      "name": "Boolean",
+                      "ofType": null
+                    }
+                  },
+                  "isDeprecated": false,
+                  "deprecationReason": null
+                },
+                {
+                  "name": "deprecationReason",
+                  "args": [],
+                  "type": {
+                    "kind": "SCALAR",
+                    "name": "String",
+                    "ofType": null
+                  },
+                  "isDeprecated": false,
+                  "deprecationReason": null
                 }
               ],
               "inputFields": null,
@@ -818,8 +899,33 @@ var _ = Describe("Introspection", func() {
                   "deprecationReason": null
                 },
                 {
-                  "name": "args",
+                  "name": "isRepeatable",
                   "args": [],
+                  "type": {
+                    "kind": "NON_NULL",
+                    "name": null,
+                    "ofType": {
+                      "kind": "SCALAR",
+                      "name": "Boolean",
+                      "ofType": null
+                    }
+                  },
+                  "isDeprecated": false,
+                  "deprecationReason": null
+                },
+                {
+                  "name": "args",
+                  "args": [
+                    {
+                      "name": "includeDeprecated",
+                      "type": {
+                        "kind": "SCALAR",
+                        "name": "Boolean",
+                        "ofType": null
+                      },
+                      "defaultValue": "false"
+                    }
+                  ],
                   "type": {
                     "kind": "NON_NULL",
                     "name": null,
@@ -1003,6 +1109,8 @@ var _ = Describe("Introspection", func() {
               "name": "deprecated",
               "locations": [
                 "FIELD_DEFINITION",
+                "ARGUMENT_DEFINITION",
+                "INPUT_FIELD_DEFINITION",
                 "ENUM_VALUE"
               ],
               "args": [
@@ -1016,6 +1124,34 @@ var _ = Describe("Introspection", func() {
                   }
                 }
               ]
+            },
+            {
+              "name": "oneOf",
+              "locations": [
+                "INPUT_OBJECT"
+              ],
+              "args": []
+            },
+            {
+              "name": "specifiedBy",
+              "locations": [
+                "SCALAR"
+              ],
+              "args": [
+                {
+                  "defaultValue": null,
+                  "name": "url",
+                  "type": {
+                    "kind": "NON_NULL",
+                    "name": null,
+                    "ofType": {
+                      "kind": "SCALAR",
+                      "name": "String",
+                      "ofType": null
+                    }
+                  }
+                }
+              ]
             }
           ]
         }
@@ -1103,7 +1239,7 @@ var _ = Describe("Introspection", func() {
                 "name": "String",
                 "ofType": null
               },
-              "defaultValue": "tes\t de\fault"
+              "defaultValue": "\"tes\\t de\\u000cault\""
             },
             {
               "name": "b",
@@ -1125,7 +1261,7 @@ var _ = Describe("Introspection", func() {
                 "name": "String",
                 "ofType": null
               },
-              "defaultValue": null
+              "defaultValue": "null"
             }
           ]
         }
@@ -1164,6 +1300,56 @@ var _ = Describe("Introspection", func() {
     }`))
 	})
 
+	It("exposes specifiedByURL on scalars", func() {
+		UUID := graphql.MustNewScalar(&graphql.ScalarConfig{
+			Name:           "UUID",
+			SpecifiedByURL: "https://tools.ietf.org/html/rfc4122",
+			ResultCoercer:  graphql.String(),
+		})
+
+		schema := graphql.MustNewSchema(&graphql.SchemaConfig{
+			Query: graphql.MustNewObject(&graphql.ObjectConfig{
+				Name: "Query",
+				Fields: graphql.Fields{
+					"uuid": {
+						Type: graphql.T(UUID),
+					},
+					"string": {
+						Type: graphql.T(graphql.String()),
+					},
+				},
+			}),
+		})
+
+		query := `
+      {
+        uuid: __type(name: "UUID") {
+          specifiedByURL
+        }
+        string: __type(name: "String") {
+          specifiedByURL
+        }
+        query: __type(name: "Query") {
+          specifiedByURL
+        }
+      }
+		`
+
+		Expect(executeQuery(schema, query)).Should(MatchIntrospectionInJSON(`{
+      "data": {
+        "uuid": {
+          "specifiedByURL": "https://tools.ietf.org/html/rfc4122"
+        },
+        "string": {
+          "specifiedByURL": null
+        },
+        "query": {
+          "specifiedByURL": null
+        }
+      }
+    }`))
+	})
+
 	It("identifies deprecated fields", func() {
 		TestType := graphql.MustNewObject(&graphql.ObjectConfig{
 			Name: "TestType",
@@ -1412,6 +1598,167 @@ var _ = Describe("Introspection", func() {
 		})
 	})
 
+	It("identifies deprecated args", func() {
+		TestType := graphql.MustNewObject(&graphql.ObjectConfig{
+			Name: "TestType",
+			Fields: graphql.Fields{
+				"someField": {
+					Type: graphql.T(graphql.String()),
+					Args: graphql.ArgumentConfigMap{
+						"nonDeprecated": {
+							Type: graphql.T(graphql.String()),
+						},
+						"deprecated": {
+							Type: graphql.T(graphql.String()),
+							Deprecation: &graphql.Deprecation{
+								Reason: "Removed in 1.0",
+							},
+						},
+					},
+				},
+			},
+		})
+
+		schema := graphql.MustNewSchema(&graphql.SchemaConfig{
+			Query: TestType,
+		})
+
+		query := `
+      {
+        __type(name: "TestType") {
+          fields {
+            trueArgs: args(includeDeprecated: true) {
+              name
+              isDeprecated
+              deprecationReason
+            }
+            falseArgs: args(includeDeprecated: false) {
+              name
+            }
+            omittedArgs: args {
+              name
+            }
+          }
+        }
+      }
+		`
+
+		Expect(executeQuery(schema, query)).Should(MatchIntrospectionInJSON(`{
+      "data": {
+        "__type": {
+          "fields": [
+            {
+              "trueArgs": [
+                {
+                  "name": "deprecated",
+                  "isDeprecated": true,
+                  "deprecationReason": "Removed in 1.0"
+                },
+                {
+                  "name": "nonDeprecated",
+                  "isDeprecated": false,
+                  "deprecationReason": null
+                }
+              ],
+              "falseArgs": [
+                {
+                  "name": "nonDeprecated"
+                }
+              ],
+              "omittedArgs": [
+                {
+                  "name": "nonDeprecated"
+                }
+              ]
+            }
+          ]
+        }
+      }
+    }`))
+	})
+
+	It("identifies deprecated input fields", func() {
+		TestInputObject := &graphql.InputObjectConfig{
+			Name: "TestInputObject",
+			Fields: graphql.InputFields{
+				"nonDeprecated": {
+					Type: graphql.T(graphql.String()),
+				},
+				"deprecated": {
+					Type: graphql.T(graphql.String()),
+					Deprecation: &graphql.Deprecation{
+						Reason: "Removed in 1.0",
+					},
+				},
+			},
+		}
+
+		TestType := graphql.MustNewObject(&graphql.ObjectConfig{
+			Name: "TestType",
+			Fields: graphql.Fields{
+				"someField": {
+					Type: graphql.T(graphql.String()),
+					Args: graphql.ArgumentConfigMap{
+						"someArg": {
+							Type: TestInputObject,
+						},
+					},
+				},
+			},
+		})
+
+		schema := graphql.MustNewSchema(&graphql.SchemaConfig{
+			Query: TestType,
+		})
+
+		query := `
+      {
+        __type(name: "TestInputObject") {
+          trueInputFields: inputFields(includeDeprecated: true) {
+            name
+            isDeprecated
+            deprecationReason
+          }
+          falseInputFields: inputFields(includeDeprecated: false) {
+            name
+          }
+          omittedInputFields: inputFields {
+            name
+          }
+        }
+      }
+		`
+
+		Expect(executeQuery(schema, query)).Should(MatchIntrospectionInJSON(`{
+      "data": {
+        "__type": {
+          "trueInputFields": [
+            {
+              "name": "deprecated",
+              "isDeprecated": true,
+              "deprecationReason": "Removed in 1.0"
+            },
+            {
+              "name": "nonDeprecated",
+              "isDeprecated": false,
+              "deprecationReason": null
+            }
+          ],
+          "falseInputFields": [
+            {
+              "name": "nonDeprecated"
+            }
+          ],
+          "omittedInputFields": [
+            {
+              "name": "nonDeprecated"
+            }
+          ]
+        }
+      }
+    }`))
+	})
+
 	// It("fails as expected on the __type root field without an arg", func() {
 	// TODO: Validation
 	// })
@@ -83,6 +83,12 @@ func (lexer *Lexer) Advance() (*token.Token, error) {
 func (lexer *Lexer) Lookahead() (*token.Token, error) {
 	tok := lexer.token
 	if tok.Kind != token.KindEOF {
+		// lexer.token is temporarily updated when skipping comments. Restore it on return to not switch
+		// current token.
+		defer func(currentToken *token.Token) {
+			lexer.token = currentToken
+		}(tok)
+
 		for {
 			// Read next token and save to token.net if we haven't done yet.
 			if tok.Next == nil {
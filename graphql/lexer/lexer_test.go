@@ -590,6 +590,28 @@ var _ = Describe("Lexer", func() {
 		}
 	})
 
+	It("looks ahead past comments without switching current token", func() {
+		lexer := lexer.New(token.NewSource(`{
+      #comment
+      field
+    }`))
+
+		leftBrace, err := lexer.Advance()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(leftBrace.Kind).Should(Equal(token.KindLeftBrace))
+
+		field, err := lexer.Lookahead()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(field.Description()).Should(Equal(`Name "field"`))
+		Expect(lexer.Token()).Should(BeIdenticalTo(leftBrace))
+
+		// Comment is still linked between the tokens.
+		Expect(field.Prev.Kind).Should(Equal(token.KindComment))
+		Expect(field.Prev.Prev).Should(BeIdenticalTo(leftBrace))
+
+		Expect(lexer.Advance()).Should(BeIdenticalTo(field))
+	})
+
 	It("accepts empty string", func() {
 		Expect(lexOne(`""`)).Should(MatchToken(&token.Token{
 			Kind:     token.KindString,
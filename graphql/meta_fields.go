@@ -103,11 +103,21 @@ func (schemaMetaField) Resolver() FieldResolver {
 	return schemaMetaFieldResolver{}
 }
 
+// Subscriber implements Field.
+func (schemaMetaField) Subscriber() FieldSubscriber {
+	return nil
+}
+
 // Deprecation is non-nil when the field is tagged as deprecated.
 func (schemaMetaField) Deprecation() *Deprecation {
 	return nil
 }
 
+// Directives implements Field.
+func (schemaMetaField) Directives() AppliedDirectives {
+	return nil
+}
+
 //===----------------------------------------------------------------------------------------====//
 // __type
 //===----------------------------------------------------------------------------------------====//
@@ -151,11 +161,21 @@ func (typeMetaField) Resolver() FieldResolver {
 	return typeMetaFieldResolver{}
 }
 
+// Subscriber implements Field.
+func (typeMetaField) Subscriber() FieldSubscriber {
+	return nil
+}
+
 // Deprecation is non-nil when the field is tagged as deprecated.
 func (typeMetaField) Deprecation() *Deprecation {
 	return nil
 }
 
+// Directives implements Field.
+func (typeMetaField) Directives() AppliedDirectives {
+	return nil
+}
+
 //===----------------------------------------------------------------------------------------====//
 // __typename
 //===----------------------------------------------------------------------------------------====//
@@ -197,11 +217,21 @@ func (typenameMetaField) Resolver() FieldResolver {
 	return typenameMetaFieldResolver{}
 }
 
+// Subscriber implements Field.
+func (typenameMetaField) Subscriber() FieldSubscriber {
+	return nil
+}
+
 // Deprecation is non-nil when the field is tagged as deprecated.
 func (typenameMetaField) Deprecation() *Deprecation {
 	return nil
 }
 
+// Directives implements Field.
+func (typenameMetaField) Directives() AppliedDirectives {
+	return nil
+}
+
 // SchemaMetaFieldDef returns the field that is used to introspect schema.
 func SchemaMetaFieldDef() Field {
 	return schemaMetaField{}
@@ -27,11 +27,17 @@ type ObjectConfig struct {
 	// Description for the Object type
 	Description string
 
+	// Directives applied to the type
+	Directives AppliedDirectives
+
 	// Interfaces that implemented by the defining Object
 	Interfaces []InterfaceTypeDefinition
 
 	// Fields in the object
 	Fields Fields
+
+	// IsTypeOf determines whether a value belongs to the defining Object (optional)
+	IsTypeOf TypeChecker
 }
 
 var (
@@ -44,8 +50,10 @@ func (config *ObjectConfig) TypeData() ObjectTypeData {
 	return ObjectTypeData{
 		Name:        config.Name,
 		Description: config.Description,
+		Directives:  config.Directives,
 		Interfaces:  config.Interfaces,
 		Fields:      config.Fields,
+		IsTypeOf:    config.IsTypeOf,
 	}
 }
 
@@ -149,6 +157,11 @@ func (o *object) Description() string {
 	return o.data.Description
 }
 
+// Directives implements TypeWithDirectives.
+func (o *object) Directives() AppliedDirectives {
+	return o.data.Directives
+}
+
 // Fields implements Object.
 func (o *object) Fields() FieldMap {
 	return o.fields
@@ -158,3 +171,8 @@ func (o *object) Fields() FieldMap {
 func (o *object) Interfaces() []Interface {
 	return o.interfaces
 }
+
+// TypeChecker implements Object.
+func (o *object) TypeChecker() TypeChecker {
+	return o.data.IsTypeOf
+}
@@ -108,7 +108,11 @@ func (p *parser) peek() *token.Token {
 
 // Helper function for creating an error when an unexpected lexed token is encountered.
 func (p *parser) unexpected() error {
-	token := p.lexer.Token()
+	return p.unexpectedToken(p.lexer.Token())
+}
+
+// Similar to unexpected but reports the error at the given token instead of the current one.
+func (p *parser) unexpectedToken(token *token.Token) error {
 	return graphql.NewSyntaxError(
 		p.lexer.Source(), token.Location, fmt.Sprintf("Unexpected %s", token.Description()))
 }
@@ -255,10 +259,15 @@ func (p *parser) parseDefinition() (ast.Definition, error) {
 			return p.parseOperationDefinition()
 		case "fragment":
 			return p.parseFragmentDefinition()
+		case "schema", "scalar", "type", "interface", "union", "enum", "input", "directive":
+			return p.parseTypeSystemDefinition()
+		case "extend":
+			return p.parseTypeSystemExtension()
 		}
 
-	// TODO: TypeSystemDefinition
-	// TODO: TypeSystemExtension
+	case token.KindString, token.KindBlockString:
+		// Type system definitions may begin with a description.
+		return p.parseTypeSystemDefinition()
 
 	case token.KindLeftBrace:
 		// Should be parseExecutableDefinition and then parseOperationDefinition. But directly jump to
@@ -1086,3 +1095,1182 @@ func (p *parser) parseDirective(isConst bool) (*ast.Directive, error) {
 		Arguments: arguments,
 	}, nil
 }
+
+// Implements the parsing rules in the Type Definition section.
+
+//	TypeSystemDefinition ::
+//		SchemaDefinition
+//		TypeDefinition
+//		DirectiveDefinition
+//
+//	TypeDefinition ::
+//		ScalarTypeDefinition
+//		ObjectTypeDefinition
+//		InterfaceTypeDefinition
+//		UnionTypeDefinition
+//		EnumTypeDefinition
+//		InputObjectTypeDefinition
+func (p *parser) parseTypeSystemDefinition() (ast.TypeSystemDefinition, error) {
+	// Many definitions begin with a description and require a lookahead.
+	keywordToken := p.peek()
+	if p.peekDescription() {
+		var err error
+		if keywordToken, err = p.lexer.Lookahead(); err != nil {
+			return nil, err
+		}
+	}
+
+	if keywordToken.Kind == token.KindName {
+		switch keywordToken.Value {
+		case "schema":
+			return p.parseSchemaDefinition()
+		case "scalar":
+			return p.parseScalarTypeDefinition()
+		case "type":
+			return p.parseObjectTypeDefinition()
+		case "interface":
+			return p.parseInterfaceTypeDefinition()
+		case "union":
+			return p.parseUnionTypeDefinition()
+		case "enum":
+			return p.parseEnumTypeDefinition()
+		case "input":
+			return p.parseInputObjectTypeDefinition()
+		case "directive":
+			return p.parseDirectiveDefinition()
+		}
+	}
+
+	return nil, p.unexpectedToken(keywordToken)
+}
+
+// Return true if the current token is a StringValue which could be a description.
+func (p *parser) peekDescription() bool {
+	kind := p.peek().Kind
+	return kind == token.KindString || kind == token.KindBlockString
+}
+
+//	Description ::
+//		StringValue
+func (p *parser) parseDescription() (ast.StringValue, error) {
+	if !p.peekDescription() {
+		return ast.StringValue{}, nil
+	}
+
+	tok := p.peek()
+	if _, err := p.lexer.Advance(); err != nil {
+		return ast.StringValue{}, err
+	}
+
+	return ast.StringValue{
+		Token: tok,
+	}, nil
+}
+
+//	SchemaDefinition ::
+//		Description? schema Directives? { OperationTypeDefinition+ }
+func (p *parser) parseSchemaDefinition() (*ast.SchemaDefinition, error) {
+	description, err := p.parseDescription()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.expectKeyword("schema"); err != nil {
+		return nil, err
+	}
+
+	var directives ast.Directives
+	if p.peek().Kind == token.KindAt {
+		if directives, err = p.parseDirectives(true /* isConst */); err != nil {
+			return nil, err
+		}
+	}
+
+	operationTypes, err := p.parseOperationTypeDefinitions()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ast.SchemaDefinition{
+		Description:    description,
+		Directives:     directives,
+		OperationTypes: operationTypes,
+	}, nil
+}
+
+// Parse "{ OperationTypeDefinition+ }".
+func (p *parser) parseOperationTypeDefinitions() (ast.OperationTypeDefinitions, error) {
+	if _, err := p.expect(token.KindLeftBrace); err != nil {
+		return nil, err
+	}
+
+	operationTypes := make(ast.OperationTypeDefinitions, 0, 1)
+	for {
+		operationType, err := p.parseOperationTypeDefinition()
+		if err != nil {
+			return nil, err
+		}
+		operationTypes = append(operationTypes, operationType)
+
+		stop, err := p.skip(token.KindRightBrace)
+		if err != nil {
+			return nil, err
+		} else if stop {
+			break
+		}
+
+		// Continue parsing an OperationTypeDefinition node.
+	}
+
+	return operationTypes, nil
+}
+
+//	OperationTypeDefinition ::
+//		OperationType : NamedType
+func (p *parser) parseOperationTypeDefinition() (*ast.OperationTypeDefinition, error) {
+	operation := p.peek()
+	if operation.Kind != token.KindName {
+		return nil, p.unexpected()
+	}
+	switch ast.OperationType(operation.Value) {
+	case ast.OperationTypeQuery, ast.OperationTypeMutation, ast.OperationTypeSubscription:
+		if _, err := p.lexer.Advance(); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, p.unexpected()
+	}
+
+	if _, err := p.expect(token.KindColon); err != nil {
+		return nil, err
+	}
+
+	namedType, err := p.parseNamedType()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ast.OperationTypeDefinition{
+		Operation: operation,
+		Type:      namedType,
+	}, nil
+}
+
+//	ScalarTypeDefinition ::
+//		Description? scalar Name Directives?
+func (p *parser) parseScalarTypeDefinition() (*ast.ScalarTypeDefinition, error) {
+	description, err := p.parseDescription()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.expectKeyword("scalar"); err != nil {
+		return nil, err
+	}
+
+	name, err := p.parseName()
+	if err != nil {
+		return nil, err
+	}
+
+	var directives ast.Directives
+	if p.peek().Kind == token.KindAt {
+		if directives, err = p.parseDirectives(true /* isConst */); err != nil {
+			return nil, err
+		}
+	}
+
+	return &ast.ScalarTypeDefinition{
+		Description: description,
+		Name:        name,
+		Directives:  directives,
+	}, nil
+}
+
+//	ObjectTypeDefinition ::
+//		Description? type Name ImplementsInterfaces? Directives? FieldsDefinition?
+func (p *parser) parseObjectTypeDefinition() (*ast.ObjectTypeDefinition, error) {
+	var (
+		interfaces ast.NamedTypes
+		directives ast.Directives
+		fields     ast.FieldDefinitions
+	)
+
+	description, err := p.parseDescription()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.expectKeyword("type"); err != nil {
+		return nil, err
+	}
+
+	name, err := p.parseName()
+	if err != nil {
+		return nil, err
+	}
+
+	if interfaces, err = p.parseImplementsInterfaces(); err != nil {
+		return nil, err
+	}
+
+	if p.peek().Kind == token.KindAt {
+		if directives, err = p.parseDirectives(true /* isConst */); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.peek().Kind == token.KindLeftBrace {
+		if fields, err = p.parseFieldsDefinition(); err != nil {
+			return nil, err
+		}
+	}
+
+	return &ast.ObjectTypeDefinition{
+		Description: description,
+		Name:        name,
+		Interfaces:  interfaces,
+		Directives:  directives,
+		Fields:      fields,
+	}, nil
+}
+
+//	ImplementsInterfaces ::
+//		ImplementsInterfaces & NamedType
+//		implements &? NamedType
+//
+// Return nil if the current token is not an "implements" keyword.
+func (p *parser) parseImplementsInterfaces() (ast.NamedTypes, error) {
+	hasImplements, err := p.skipKeyword("implements")
+	if err != nil {
+		return nil, err
+	} else if !hasImplements {
+		return nil, nil
+	}
+
+	// Optional leading ampersand
+	if _, err := p.skip(token.KindAmp); err != nil {
+		return nil, err
+	}
+
+	var types ast.NamedTypes
+	for {
+		namedType, err := p.parseNamedType()
+		if err != nil {
+			return nil, err
+		}
+		types = append(types, namedType)
+
+		hasMore, err := p.skip(token.KindAmp)
+		if err != nil {
+			return nil, err
+		} else if !hasMore {
+			break
+		}
+
+		// Continue parsing a NamedType node.
+	}
+
+	return types, nil
+}
+
+//	FieldsDefinition ::
+//		{ FieldDefinition+ }
+func (p *parser) parseFieldsDefinition() (ast.FieldDefinitions, error) {
+	if _, err := p.expect(token.KindLeftBrace); err != nil {
+		return nil, err
+	}
+
+	fields := make(ast.FieldDefinitions, 0, 1)
+	for {
+		field, err := p.parseFieldDefinition()
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, field)
+
+		stop, err := p.skip(token.KindRightBrace)
+		if err != nil {
+			return nil, err
+		} else if stop {
+			break
+		}
+
+		// Continue parsing a FieldDefinition node.
+	}
+
+	return fields, nil
+}
+
+//	FieldDefinition ::
+//		Description? Name ArgumentsDefinition? : Type Directives?
+func (p *parser) parseFieldDefinition() (*ast.FieldDefinition, error) {
+	var (
+		arguments  ast.InputValueDefinitions
+		directives ast.Directives
+	)
+
+	description, err := p.parseDescription()
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := p.parseName()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.peek().Kind == token.KindLeftParen {
+		if arguments, err = p.parseArgumentsDefinition(); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := p.expect(token.KindColon); err != nil {
+		return nil, err
+	}
+
+	fieldType, err := p.parseType()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.peek().Kind == token.KindAt {
+		if directives, err = p.parseDirectives(true /* isConst */); err != nil {
+			return nil, err
+		}
+	}
+
+	return &ast.FieldDefinition{
+		Description: description,
+		Name:        name,
+		Arguments:   arguments,
+		Type:        fieldType,
+		Directives:  directives,
+	}, nil
+}
+
+//	ArgumentsDefinition ::
+//		( InputValueDefinition+ )
+func (p *parser) parseArgumentsDefinition() (ast.InputValueDefinitions, error) {
+	return p.parseInputValueDefinitions(token.KindLeftParen, token.KindRightParen)
+}
+
+// Parse a non-empty list of InputValueDefinition's which begins with a lex token of openKind and
+// ends with a lex token of closeKind.
+func (p *parser) parseInputValueDefinitions(
+	openKind token.Kind,
+	closeKind token.Kind) (ast.InputValueDefinitions, error) {
+
+	if _, err := p.expect(openKind); err != nil {
+		return nil, err
+	}
+
+	values := make(ast.InputValueDefinitions, 0, 1)
+	for {
+		value, err := p.parseInputValueDefinition()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+
+		stop, err := p.skip(closeKind)
+		if err != nil {
+			return nil, err
+		} else if stop {
+			break
+		}
+
+		// Continue parsing an InputValueDefinition node.
+	}
+
+	return values, nil
+}
+
+//	InputValueDefinition ::
+//		Description? Name : Type DefaultValue? Directives?
+func (p *parser) parseInputValueDefinition() (*ast.InputValueDefinition, error) {
+	var (
+		defaultValue ast.Value
+		directives   ast.Directives
+	)
+
+	description, err := p.parseDescription()
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := p.parseName()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := p.expect(token.KindColon); err != nil {
+		return nil, err
+	}
+
+	valueType, err := p.parseType()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.peek().Kind == token.KindEquals {
+		if defaultValue, err = p.parseDefaultValue(); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.peek().Kind == token.KindAt {
+		if directives, err = p.parseDirectives(true /* isConst */); err != nil {
+			return nil, err
+		}
+	}
+
+	return &ast.InputValueDefinition{
+		Description:  description,
+		Name:         name,
+		Type:         valueType,
+		DefaultValue: defaultValue,
+		Directives:   directives,
+	}, nil
+}
+
+//	InterfaceTypeDefinition ::
+//		Description? interface Name ImplementsInterfaces? Directives? FieldsDefinition?
+func (p *parser) parseInterfaceTypeDefinition() (*ast.InterfaceTypeDefinition, error) {
+	var (
+		interfaces ast.NamedTypes
+		directives ast.Directives
+		fields     ast.FieldDefinitions
+	)
+
+	description, err := p.parseDescription()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.expectKeyword("interface"); err != nil {
+		return nil, err
+	}
+
+	name, err := p.parseName()
+	if err != nil {
+		return nil, err
+	}
+
+	if interfaces, err = p.parseImplementsInterfaces(); err != nil {
+		return nil, err
+	}
+
+	if p.peek().Kind == token.KindAt {
+		if directives, err = p.parseDirectives(true /* isConst */); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.peek().Kind == token.KindLeftBrace {
+		if fields, err = p.parseFieldsDefinition(); err != nil {
+			return nil, err
+		}
+	}
+
+	return &ast.InterfaceTypeDefinition{
+		Description: description,
+		Name:        name,
+		Interfaces:  interfaces,
+		Directives:  directives,
+		Fields:      fields,
+	}, nil
+}
+
+//	UnionTypeDefinition ::
+//		Description? union Name Directives? UnionMemberTypes?
+func (p *parser) parseUnionTypeDefinition() (*ast.UnionTypeDefinition, error) {
+	var (
+		directives ast.Directives
+		types      ast.NamedTypes
+	)
+
+	description, err := p.parseDescription()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.expectKeyword("union"); err != nil {
+		return nil, err
+	}
+
+	name, err := p.parseName()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.peek().Kind == token.KindAt {
+		if directives, err = p.parseDirectives(true /* isConst */); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.peek().Kind == token.KindEquals {
+		if types, err = p.parseUnionMemberTypes(); err != nil {
+			return nil, err
+		}
+	}
+
+	return &ast.UnionTypeDefinition{
+		Description: description,
+		Name:        name,
+		Directives:  directives,
+		Types:       types,
+	}, nil
+}
+
+//	UnionMemberTypes ::
+//		UnionMemberTypes | NamedType
+//		= |? NamedType
+func (p *parser) parseUnionMemberTypes() (ast.NamedTypes, error) {
+	if _, err := p.expect(token.KindEquals); err != nil {
+		return nil, err
+	}
+
+	// Optional leading pipe
+	if _, err := p.skip(token.KindPipe); err != nil {
+		return nil, err
+	}
+
+	var types ast.NamedTypes
+	for {
+		namedType, err := p.parseNamedType()
+		if err != nil {
+			return nil, err
+		}
+		types = append(types, namedType)
+
+		hasMore, err := p.skip(token.KindPipe)
+		if err != nil {
+			return nil, err
+		} else if !hasMore {
+			break
+		}
+
+		// Continue parsing a NamedType node.
+	}
+
+	return types, nil
+}
+
+//	EnumTypeDefinition ::
+//		Description? enum Name Directives? EnumValuesDefinition?
+func (p *parser) parseEnumTypeDefinition() (*ast.EnumTypeDefinition, error) {
+	var (
+		directives ast.Directives
+		values     ast.EnumValueDefinitions
+	)
+
+	description, err := p.parseDescription()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.expectKeyword("enum"); err != nil {
+		return nil, err
+	}
+
+	name, err := p.parseName()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.peek().Kind == token.KindAt {
+		if directives, err = p.parseDirectives(true /* isConst */); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.peek().Kind == token.KindLeftBrace {
+		if values, err = p.parseEnumValuesDefinition(); err != nil {
+			return nil, err
+		}
+	}
+
+	return &ast.EnumTypeDefinition{
+		Description: description,
+		Name:        name,
+		Directives:  directives,
+		Values:      values,
+	}, nil
+}
+
+//	EnumValuesDefinition ::
+//		{ EnumValueDefinition+ }
+func (p *parser) parseEnumValuesDefinition() (ast.EnumValueDefinitions, error) {
+	if _, err := p.expect(token.KindLeftBrace); err != nil {
+		return nil, err
+	}
+
+	values := make(ast.EnumValueDefinitions, 0, 1)
+	for {
+		value, err := p.parseEnumValueDefinition()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+
+		stop, err := p.skip(token.KindRightBrace)
+		if err != nil {
+			return nil, err
+		} else if stop {
+			break
+		}
+
+		// Continue parsing an EnumValueDefinition node.
+	}
+
+	return values, nil
+}
+
+//	EnumValueDefinition ::
+//		Description? EnumValue Directives?
+//
+//	EnumValue ::
+//		Name but not true or false or null
+func (p *parser) parseEnumValueDefinition() (*ast.EnumValueDefinition, error) {
+	description, err := p.parseDescription()
+	if err != nil {
+		return nil, err
+	}
+
+	if tok := p.peek(); tok.Kind == token.KindName {
+		switch tok.Value {
+		case "true", "false", "null":
+			return nil, graphql.NewSyntaxError(p.lexer.Source(), tok.Location,
+				fmt.Sprintf(`Name "%s" is reserved and cannot be used for an enum value`, tok.Value))
+		}
+	}
+
+	name, err := p.parseName()
+	if err != nil {
+		return nil, err
+	}
+
+	var directives ast.Directives
+	if p.peek().Kind == token.KindAt {
+		if directives, err = p.parseDirectives(true /* isConst */); err != nil {
+			return nil, err
+		}
+	}
+
+	return &ast.EnumValueDefinition{
+		Description: description,
+		Name:        name,
+		Directives:  directives,
+	}, nil
+}
+
+//	InputObjectTypeDefinition ::
+//		Description? input Name Directives? InputFieldsDefinition?
+func (p *parser) parseInputObjectTypeDefinition() (*ast.InputObjectTypeDefinition, error) {
+	var (
+		directives ast.Directives
+		fields     ast.InputValueDefinitions
+	)
+
+	description, err := p.parseDescription()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.expectKeyword("input"); err != nil {
+		return nil, err
+	}
+
+	name, err := p.parseName()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.peek().Kind == token.KindAt {
+		if directives, err = p.parseDirectives(true /* isConst */); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.peek().Kind == token.KindLeftBrace {
+		if fields, err = p.parseInputFieldsDefinition(); err != nil {
+			return nil, err
+		}
+	}
+
+	return &ast.InputObjectTypeDefinition{
+		Description: description,
+		Name:        name,
+		Directives:  directives,
+		Fields:      fields,
+	}, nil
+}
+
+//	InputFieldsDefinition ::
+//		{ InputValueDefinition+ }
+func (p *parser) parseInputFieldsDefinition() (ast.InputValueDefinitions, error) {
+	return p.parseInputValueDefinitions(token.KindLeftBrace, token.KindRightBrace)
+}
+
+//	DirectiveDefinition ::
+//		Description? directive @ Name ArgumentsDefinition? repeatable? on DirectiveLocations
+func (p *parser) parseDirectiveDefinition() (*ast.DirectiveDefinition, error) {
+	description, err := p.parseDescription()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.expectKeyword("directive"); err != nil {
+		return nil, err
+	}
+
+	if _, err := p.expect(token.KindAt); err != nil {
+		return nil, err
+	}
+
+	name, err := p.parseName()
+	if err != nil {
+		return nil, err
+	}
+
+	var arguments ast.InputValueDefinitions
+	if p.peek().Kind == token.KindLeftParen {
+		if arguments, err = p.parseArgumentsDefinition(); err != nil {
+			return nil, err
+		}
+	}
+
+	repeatable := p.peek()
+	if hasRepeatable, err := p.skipKeyword("repeatable"); err != nil {
+		return nil, err
+	} else if !hasRepeatable {
+		repeatable = nil
+	}
+
+	if err := p.expectKeyword("on"); err != nil {
+		return nil, err
+	}
+
+	locations, err := p.parseDirectiveLocations()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ast.DirectiveDefinition{
+		Description: description,
+		Name:        name,
+		Arguments:   arguments,
+		Repeatable:  repeatable,
+		Locations:   locations,
+	}, nil
+}
+
+//	DirectiveLocations ::
+//		DirectiveLocations | DirectiveLocation
+//		|? DirectiveLocation
+func (p *parser) parseDirectiveLocations() (ast.DirectiveLocations, error) {
+	// Optional leading pipe
+	if _, err := p.skip(token.KindPipe); err != nil {
+		return nil, err
+	}
+
+	var locations ast.DirectiveLocations
+	for {
+		location, err := p.parseDirectiveLocation()
+		if err != nil {
+			return nil, err
+		}
+		locations = append(locations, location)
+
+		hasMore, err := p.skip(token.KindPipe)
+		if err != nil {
+			return nil, err
+		} else if !hasMore {
+			break
+		}
+
+		// Continue parsing a DirectiveLocation node.
+	}
+
+	return locations, nil
+}
+
+//	DirectiveLocation ::
+//		ExecutableDirectiveLocation
+//		TypeSystemDirectiveLocation
+//
+//	ExecutableDirectiveLocation :: one of
+//		QUERY
+//		MUTATION
+//		SUBSCRIPTION
+//		FIELD
+//		FRAGMENT_DEFINITION
+//		FRAGMENT_SPREAD
+//		INLINE_FRAGMENT
+//		VARIABLE_DEFINITION
+//
+//	TypeSystemDirectiveLocation :: one of
+//		SCHEMA
+//		SCALAR
+//		OBJECT
+//		FIELD_DEFINITION
+//		ARGUMENT_DEFINITION
+//		INTERFACE
+//		UNION
+//		ENUM
+//		ENUM_VALUE
+//		INPUT_OBJECT
+//		INPUT_FIELD_DEFINITION
+func (p *parser) parseDirectiveLocation() (ast.Name, error) {
+	start := p.peek()
+	name, err := p.parseName()
+	if err != nil {
+		return ast.Name{}, err
+	}
+
+	switch graphql.DirectiveLocation(name.Value()) {
+	case graphql.DirectiveLocationQuery,
+		graphql.DirectiveLocationMutation,
+		graphql.DirectiveLocationSubscription,
+		graphql.DirectiveLocationField,
+		graphql.DirectiveLocationFragmentDefinition,
+		graphql.DirectiveLocationFragmentSpread,
+		graphql.DirectiveLocationInlineFragment,
+		graphql.DirectiveLocationVariableDefinition,
+		graphql.DirectiveLocationSchema,
+		graphql.DirectiveLocationScalar,
+		graphql.DirectiveLocationObject,
+		graphql.DirectiveLocationFieldDefinition,
+		graphql.DirectiveLocationArgumentDefinition,
+		graphql.DirectiveLocationInterface,
+		graphql.DirectiveLocationUnion,
+		graphql.DirectiveLocationEnum,
+		graphql.DirectiveLocationEnumValue,
+		graphql.DirectiveLocationInputObject,
+		graphql.DirectiveLocationInputFieldDefinition:
+		return name, nil
+	}
+
+	return ast.Name{}, p.unexpectedToken(start)
+}
+
+// Implements the parsing rules in the Type Extension section.
+
+//	TypeSystemExtension ::
+//		SchemaExtension
+//		TypeExtension
+//
+//	TypeExtension ::
+//		ScalarTypeExtension
+//		ObjectTypeExtension
+//		InterfaceTypeExtension
+//		UnionTypeExtension
+//		EnumTypeExtension
+//		InputObjectTypeExtension
+func (p *parser) parseTypeSystemExtension() (ast.TypeSystemExtension, error) {
+	keywordToken, err := p.lexer.Lookahead()
+	if err != nil {
+		return nil, err
+	}
+
+	if keywordToken.Kind == token.KindName {
+		switch keywordToken.Value {
+		case "schema":
+			return p.parseSchemaExtension()
+		case "scalar":
+			return p.parseScalarTypeExtension()
+		case "type":
+			return p.parseObjectTypeExtension()
+		case "interface":
+			return p.parseInterfaceTypeExtension()
+		case "union":
+			return p.parseUnionTypeExtension()
+		case "enum":
+			return p.parseEnumTypeExtension()
+		case "input":
+			return p.parseInputObjectTypeExtension()
+		}
+	}
+
+	return nil, p.unexpectedToken(keywordToken)
+}
+
+// Expect "extend" followed by the given keyword and then parse the name of the extending type.
+func (p *parser) parseTypeExtensionName(keyword string) (ast.Name, error) {
+	if err := p.expectKeyword("extend"); err != nil {
+		return ast.Name{}, err
+	}
+
+	if err := p.expectKeyword(keyword); err != nil {
+		return ast.Name{}, err
+	}
+
+	return p.parseName()
+}
+
+//	SchemaExtension ::
+//		extend schema Directives? { OperationTypeDefinition+ }
+//		extend schema Directives
+func (p *parser) parseSchemaExtension() (*ast.SchemaExtension, error) {
+	var (
+		directives     ast.Directives
+		operationTypes ast.OperationTypeDefinitions
+		err            error
+	)
+
+	if err := p.expectKeyword("extend"); err != nil {
+		return nil, err
+	}
+
+	if err := p.expectKeyword("schema"); err != nil {
+		return nil, err
+	}
+
+	if p.peek().Kind == token.KindAt {
+		if directives, err = p.parseDirectives(true /* isConst */); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.peek().Kind == token.KindLeftBrace {
+		if operationTypes, err = p.parseOperationTypeDefinitions(); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(directives) == 0 && len(operationTypes) == 0 {
+		return nil, p.unexpected()
+	}
+
+	return &ast.SchemaExtension{
+		Directives:     directives,
+		OperationTypes: operationTypes,
+	}, nil
+}
+
+//	ScalarTypeExtension ::
+//		extend scalar Name Directives
+func (p *parser) parseScalarTypeExtension() (*ast.ScalarTypeExtension, error) {
+	name, err := p.parseTypeExtensionName("scalar")
+	if err != nil {
+		return nil, err
+	}
+
+	var directives ast.Directives
+	if p.peek().Kind == token.KindAt {
+		if directives, err = p.parseDirectives(true /* isConst */); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(directives) == 0 {
+		return nil, p.unexpected()
+	}
+
+	return &ast.ScalarTypeExtension{
+		Name:       name,
+		Directives: directives,
+	}, nil
+}
+
+//	ObjectTypeExtension ::
+//		extend type Name ImplementsInterfaces? Directives? FieldsDefinition
+//		extend type Name ImplementsInterfaces? Directives
+//		extend type Name ImplementsInterfaces
+func (p *parser) parseObjectTypeExtension() (*ast.ObjectTypeExtension, error) {
+	var (
+		interfaces ast.NamedTypes
+		directives ast.Directives
+		fields     ast.FieldDefinitions
+	)
+
+	name, err := p.parseTypeExtensionName("type")
+	if err != nil {
+		return nil, err
+	}
+
+	if interfaces, err = p.parseImplementsInterfaces(); err != nil {
+		return nil, err
+	}
+
+	if p.peek().Kind == token.KindAt {
+		if directives, err = p.parseDirectives(true /* isConst */); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.peek().Kind == token.KindLeftBrace {
+		if fields, err = p.parseFieldsDefinition(); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(interfaces) == 0 && len(directives) == 0 && len(fields) == 0 {
+		return nil, p.unexpected()
+	}
+
+	return &ast.ObjectTypeExtension{
+		Name:       name,
+		Interfaces: interfaces,
+		Directives: directives,
+		Fields:     fields,
+	}, nil
+}
+
+//	InterfaceTypeExtension ::
+//		extend interface Name ImplementsInterfaces? Directives? FieldsDefinition
+//		extend interface Name ImplementsInterfaces? Directives
+//		extend interface Name ImplementsInterfaces
+func (p *parser) parseInterfaceTypeExtension() (*ast.InterfaceTypeExtension, error) {
+	var (
+		interfaces ast.NamedTypes
+		directives ast.Directives
+		fields     ast.FieldDefinitions
+	)
+
+	name, err := p.parseTypeExtensionName("interface")
+	if err != nil {
+		return nil, err
+	}
+
+	if interfaces, err = p.parseImplementsInterfaces(); err != nil {
+		return nil, err
+	}
+
+	if p.peek().Kind == token.KindAt {
+		if directives, err = p.parseDirectives(true /* isConst */); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.peek().Kind == token.KindLeftBrace {
+		if fields, err = p.parseFieldsDefinition(); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(interfaces) == 0 && len(directives) == 0 && len(fields) == 0 {
+		return nil, p.unexpected()
+	}
+
+	return &ast.InterfaceTypeExtension{
+		Name:       name,
+		Interfaces: interfaces,
+		Directives: directives,
+		Fields:     fields,
+	}, nil
+}
+
+//	UnionTypeExtension ::
+//		extend union Name Directives? UnionMemberTypes
+//		extend union Name Directives
+func (p *parser) parseUnionTypeExtension() (*ast.UnionTypeExtension, error) {
+	var (
+		directives ast.Directives
+		types      ast.NamedTypes
+	)
+
+	name, err := p.parseTypeExtensionName("union")
+	if err != nil {
+		return nil, err
+	}
+
+	if p.peek().Kind == token.KindAt {
+		if directives, err = p.parseDirectives(true /* isConst */); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.peek().Kind == token.KindEquals {
+		if types, err = p.parseUnionMemberTypes(); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(directives) == 0 && len(types) == 0 {
+		return nil, p.unexpected()
+	}
+
+	return &ast.UnionTypeExtension{
+		Name:       name,
+		Directives: directives,
+		Types:      types,
+	}, nil
+}
+
+//	EnumTypeExtension ::
+//		extend enum Name Directives? EnumValuesDefinition
+//		extend enum Name Directives
+func (p *parser) parseEnumTypeExtension() (*ast.EnumTypeExtension, error) {
+	var (
+		directives ast.Directives
+		values     ast.EnumValueDefinitions
+	)
+
+	name, err := p.parseTypeExtensionName("enum")
+	if err != nil {
+		return nil, err
+	}
+
+	if p.peek().Kind == token.KindAt {
+		if directives, err = p.parseDirectives(true /* isConst */); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.peek().Kind == token.KindLeftBrace {
+		if values, err = p.parseEnumValuesDefinition(); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(directives) == 0 && len(values) == 0 {
+		return nil, p.unexpected()
+	}
+
+	return &ast.EnumTypeExtension{
+		Name:       name,
+		Directives: directives,
+		Values:     values,
+	}, nil
+}
+
+//	InputObjectTypeExtension ::
+//		extend input Name Directives? InputFieldsDefinition
+//		extend input Name Directives
+func (p *parser) parseInputObjectTypeExtension() (*ast.InputObjectTypeExtension, error) {
+	var (
+		directives ast.Directives
+		fields     ast.InputValueDefinitions
+	)
+
+	name, err := p.parseTypeExtensionName("input")
+	if err != nil {
+		return nil, err
+	}
+
+	if p.peek().Kind == token.KindAt {
+		if directives, err = p.parseDirectives(true /* isConst */); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.peek().Kind == token.KindLeftBrace {
+		if fields, err = p.parseInputFieldsDefinition(); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(directives) == 0 && len(fields) == 0 {
+		return nil, p.unexpected()
+	}
+
+	return &ast.InputObjectTypeExtension{
+		Name:       name,
+		Directives: directives,
+		Fields:     fields,
+	}, nil
+}
@@ -0,0 +1,612 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package parser_test
+
+import (
+	"io/ioutil"
+
+	"github.com/botobag/artemis/graphql"
+	"github.com/botobag/artemis/graphql/ast"
+	"github.com/botobag/artemis/graphql/parser"
+	"github.com/botobag/artemis/graphql/token"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// graphql-js/src/language/__tests__/schema-parser-test.js@f529809
+var _ = Describe("Schema Parser", func() {
+	// Parse a document that contains exactly one definition and return the definition.
+	parseDefinition := func(s string) ast.Definition {
+		document := parse(s)
+		Expect(document.Definitions).Should(HaveLen(1))
+		return document.Definitions[0]
+	}
+
+	// Return the 0-based byte offsets of the beginning and the end of the given node in source (which
+	// is the same as the "loc" in graphql-js).
+	locationOf := func(node ast.Node) [2]uint {
+		sourceRange := node.TokenRange().SourceRange()
+		return [2]uint{
+			uint(sourceRange.Begin) - 1,
+			uint(sourceRange.End) - 1,
+		}
+	}
+
+	It("simple type", func() {
+		definition := parseDefinition(`
+type Hello {
+  world: String
+}`)
+
+		Expect(definition).Should(BeAssignableToTypeOf(&ast.ObjectTypeDefinition{}))
+		object := definition.(*ast.ObjectTypeDefinition)
+		Expect(locationOf(object)).Should(Equal([2]uint{1, 31}))
+		Expect(object.Description.IsNil()).Should(BeTrue())
+		Expect(object.Name.Value()).Should(Equal("Hello"))
+		Expect(object.Interfaces).Should(BeEmpty())
+		Expect(object.Directives).Should(BeEmpty())
+		Expect(object.Fields).Should(HaveLen(1))
+		Expect(locationOf(object.Fields)).Should(Equal([2]uint{12, 31}))
+
+		field := object.Fields[0]
+		Expect(locationOf(field)).Should(Equal([2]uint{16, 29}))
+		Expect(field.Name.Value()).Should(Equal("world"))
+		Expect(field.Arguments).Should(BeEmpty())
+		Expect(ast.Print(field.Type)).Should(Equal("String"))
+		Expect(field.Directives).Should(BeEmpty())
+	})
+
+	It("parses type with description string", func() {
+		definition := parseDefinition(`
+"Description"
+type Hello {
+  world: String
+}`)
+
+		object := definition.(*ast.ObjectTypeDefinition)
+		Expect(locationOf(object)).Should(Equal([2]uint{1, 45}))
+		Expect(object.Description.Value()).Should(Equal("Description"))
+		Expect(object.Description.IsBlockString()).Should(BeFalse())
+		Expect(locationOf(object.Description)).Should(Equal([2]uint{1, 14}))
+	})
+
+	It("parses type with description multi-line string", func() {
+		definition := parseDefinition(`
+"""
+Description
+"""
+# Even with comments between them
+type Hello {
+  world: String
+}`)
+
+		object := definition.(*ast.ObjectTypeDefinition)
+		Expect(object.Description.Value()).Should(Equal("Description"))
+		Expect(object.Description.IsBlockString()).Should(BeTrue())
+		Expect(locationOf(object.Description)).Should(Equal([2]uint{1, 20}))
+	})
+
+	It("simple extension", func() {
+		definition := parseDefinition(`
+extend type Hello {
+  world: String
+}
+`)
+
+		Expect(definition).Should(BeAssignableToTypeOf(&ast.ObjectTypeExtension{}))
+		extension := definition.(*ast.ObjectTypeExtension)
+		Expect(locationOf(extension)).Should(Equal([2]uint{1, 38}))
+		Expect(extension.Name.Value()).Should(Equal("Hello"))
+		Expect(extension.Interfaces).Should(BeEmpty())
+		Expect(extension.Directives).Should(BeEmpty())
+		Expect(extension.Fields).Should(HaveLen(1))
+		Expect(locationOf(extension.Fields[0])).Should(Equal([2]uint{23, 36}))
+	})
+
+	It("extension with only interfaces", func() {
+		definition := parseDefinition(`extend type Hello implements Greeting`)
+
+		extension := definition.(*ast.ObjectTypeExtension)
+		Expect(locationOf(extension)).Should(Equal([2]uint{0, 37}))
+		Expect(extension.Interfaces).Should(HaveLen(1))
+		Expect(extension.Interfaces[0].Name.Value()).Should(Equal("Greeting"))
+		Expect(extension.Fields).Should(BeEmpty())
+	})
+
+	It("extension without fields followed by extension", func() {
+		document := parse(`
+      extend type Hello implements Greeting
+
+      extend type Hello implements SecondGreeting
+    `)
+
+		Expect(document.Definitions).Should(HaveLen(2))
+		Expect(locationOf(document.Definitions[0])).Should(Equal([2]uint{7, 44}))
+		Expect(locationOf(document.Definitions[1])).Should(Equal([2]uint{52, 95}))
+	})
+
+	It("extension without anything throws", func() {
+		expectSyntaxError(`extend type Hello`, "Unexpected <EOF>", graphql.ErrorLocation{
+			Line:   1,
+			Column: 18,
+		})
+	})
+
+	It("interface extension without anything throws", func() {
+		expectSyntaxError(`extend interface Hello`, "Unexpected <EOF>", graphql.ErrorLocation{
+			Line:   1,
+			Column: 23,
+		})
+	})
+
+	It("extension do not include descriptions", func() {
+		expectSyntaxError(`
+      "Description"
+      extend type Hello {
+        world: String
+      }`, `Unexpected Name "extend"`, graphql.ErrorLocation{
+			Line:   3,
+			Column: 7,
+		})
+
+		expectSyntaxError(`
+      extend "Description" type Hello {
+        world: String
+      }`, `Unexpected String "Description"`, graphql.ErrorLocation{
+			Line:   2,
+			Column: 14,
+		})
+	})
+
+	It("schema extension", func() {
+		definition := parseDefinition(`
+      extend schema {
+        mutation: Mutation
+      }`)
+
+		Expect(definition).Should(BeAssignableToTypeOf(&ast.SchemaExtension{}))
+		extension := definition.(*ast.SchemaExtension)
+		Expect(locationOf(extension)).Should(Equal([2]uint{7, 57}))
+		Expect(extension.Directives).Should(BeEmpty())
+		Expect(extension.OperationTypes).Should(HaveLen(1))
+		Expect(extension.OperationTypes[0].OperationType()).Should(
+			Equal(ast.OperationType(ast.OperationTypeMutation)))
+		Expect(extension.OperationTypes[0].Type.Name.Value()).Should(Equal("Mutation"))
+	})
+
+	It("schema extension with only directives", func() {
+		definition := parseDefinition(`extend schema @directive`)
+
+		extension := definition.(*ast.SchemaExtension)
+		Expect(locationOf(extension)).Should(Equal([2]uint{0, 24}))
+		Expect(extension.Directives).Should(HaveLen(1))
+		Expect(extension.OperationTypes).Should(BeEmpty())
+	})
+
+	It("schema extension without anything throws", func() {
+		expectSyntaxError(`extend schema`, "Unexpected <EOF>", graphql.ErrorLocation{
+			Line:   1,
+			Column: 14,
+		})
+	})
+
+	It("scalar extension", func() {
+		definition := parseDefinition(`extend scalar Hello @directive`)
+
+		Expect(definition).Should(BeAssignableToTypeOf(&ast.ScalarTypeExtension{}))
+		extension := definition.(*ast.ScalarTypeExtension)
+		Expect(locationOf(extension)).Should(Equal([2]uint{0, 30}))
+		Expect(extension.Name.Value()).Should(Equal("Hello"))
+		Expect(extension.Directives).Should(HaveLen(1))
+
+		expectSyntaxError(`extend scalar Hello`, "Unexpected <EOF>", graphql.ErrorLocation{
+			Line:   1,
+			Column: 20,
+		})
+	})
+
+	It("union extension", func() {
+		definition := parseDefinition(`extend union Hello = | Wo | Rld`)
+
+		Expect(definition).Should(BeAssignableToTypeOf(&ast.UnionTypeExtension{}))
+		extension := definition.(*ast.UnionTypeExtension)
+		Expect(locationOf(extension)).Should(Equal([2]uint{0, 31}))
+		Expect(extension.Types).Should(HaveLen(2))
+		Expect(extension.Types[0].Name.Value()).Should(Equal("Wo"))
+		Expect(extension.Types[1].Name.Value()).Should(Equal("Rld"))
+	})
+
+	It("enum extension", func() {
+		definition := parseDefinition(`extend enum Hello { WORLD }`)
+
+		Expect(definition).Should(BeAssignableToTypeOf(&ast.EnumTypeExtension{}))
+		extension := definition.(*ast.EnumTypeExtension)
+		Expect(locationOf(extension)).Should(Equal([2]uint{0, 27}))
+		Expect(extension.Values).Should(HaveLen(1))
+		Expect(extension.Values[0].Name.Value()).Should(Equal("WORLD"))
+	})
+
+	It("input object extension", func() {
+		definition := parseDefinition(`extend input Hello @directive`)
+
+		Expect(definition).Should(BeAssignableToTypeOf(&ast.InputObjectTypeExtension{}))
+		extension := definition.(*ast.InputObjectTypeExtension)
+		Expect(locationOf(extension)).Should(Equal([2]uint{0, 29}))
+		Expect(extension.Directives).Should(HaveLen(1))
+		Expect(extension.Fields).Should(BeEmpty())
+	})
+
+	It("rejects extension of unknown kind", func() {
+		expectSyntaxError(`extend directive @foo on FIELD`, `Unexpected Name "directive"`,
+			graphql.ErrorLocation{
+				Line:   1,
+				Column: 8,
+			})
+	})
+
+	It("simple non-null type", func() {
+		definition := parseDefinition(`
+type Hello {
+  world: String!
+}`)
+
+		object := definition.(*ast.ObjectTypeDefinition)
+		Expect(object.Fields).Should(HaveLen(1))
+		Expect(object.Fields[0].Type).Should(BeAssignableToTypeOf(ast.NonNullType{}))
+		Expect(ast.Print(object.Fields[0].Type)).Should(Equal("String!"))
+	})
+
+	It("simple type inheriting interface", func() {
+		definition := parseDefinition(`type Hello implements World { field: String }`)
+
+		object := definition.(*ast.ObjectTypeDefinition)
+		Expect(locationOf(object)).Should(Equal([2]uint{0, 45}))
+		Expect(object.Interfaces).Should(HaveLen(1))
+		Expect(object.Interfaces[0].Name.Value()).Should(Equal("World"))
+		Expect(locationOf(object.Interfaces)).Should(Equal([2]uint{22, 27}))
+	})
+
+	It("simple type inheriting multiple interfaces", func() {
+		definition := parseDefinition(`type Hello implements Wo & rld { field: String }`)
+
+		object := definition.(*ast.ObjectTypeDefinition)
+		Expect(object.Interfaces).Should(HaveLen(2))
+		Expect(object.Interfaces[0].Name.Value()).Should(Equal("Wo"))
+		Expect(object.Interfaces[1].Name.Value()).Should(Equal("rld"))
+	})
+
+	It("simple type inheriting multiple interfaces with leading ampersand", func() {
+		definition := parseDefinition(`type Hello implements & Wo & rld { field: String }`)
+
+		object := definition.(*ast.ObjectTypeDefinition)
+		Expect(object.Interfaces).Should(HaveLen(2))
+		Expect(object.Interfaces[0].Name.Value()).Should(Equal("Wo"))
+		Expect(object.Interfaces[1].Name.Value()).Should(Equal("rld"))
+	})
+
+	It("single value enum", func() {
+		definition := parseDefinition(`enum Hello { WORLD }`)
+
+		Expect(definition).Should(BeAssignableToTypeOf(&ast.EnumTypeDefinition{}))
+		enum := definition.(*ast.EnumTypeDefinition)
+		Expect(locationOf(enum)).Should(Equal([2]uint{0, 20}))
+		Expect(enum.Name.Value()).Should(Equal("Hello"))
+		Expect(enum.Values).Should(HaveLen(1))
+		Expect(enum.Values[0].Name.Value()).Should(Equal("WORLD"))
+		Expect(locationOf(enum.Values[0])).Should(Equal([2]uint{13, 18}))
+	})
+
+	It("double value enum", func() {
+		definition := parseDefinition(`enum Hello { WO, RLD }`)
+
+		enum := definition.(*ast.EnumTypeDefinition)
+		Expect(enum.Values).Should(HaveLen(2))
+		Expect(enum.Values[0].Name.Value()).Should(Equal("WO"))
+		Expect(enum.Values[1].Name.Value()).Should(Equal("RLD"))
+	})
+
+	It("rejects reserved names for enum value", func() {
+		for _, name := range []string{"true", "false", "null"} {
+			expectSyntaxError(`enum Hello { `+name+` }`,
+				`Name "`+name+`" is reserved and cannot be used for an enum value`,
+				graphql.ErrorLocation{Line: 1, Column: 14})
+		}
+	})
+
+	It("simple interface", func() {
+		definition := parseDefinition(`
+interface Hello {
+  world: String
+}`)
+
+		Expect(definition).Should(BeAssignableToTypeOf(&ast.InterfaceTypeDefinition{}))
+		iface := definition.(*ast.InterfaceTypeDefinition)
+		Expect(locationOf(iface)).Should(Equal([2]uint{1, 36}))
+		Expect(iface.Name.Value()).Should(Equal("Hello"))
+		Expect(iface.Fields).Should(HaveLen(1))
+		Expect(iface.Fields[0].Name.Value()).Should(Equal("world"))
+	})
+
+	It("simple interface inheriting interface", func() {
+		definition := parseDefinition(`interface Hello implements World { field: String }`)
+
+		iface := definition.(*ast.InterfaceTypeDefinition)
+		Expect(locationOf(iface)).Should(Equal([2]uint{0, 50}))
+		Expect(iface.Interfaces).Should(HaveLen(1))
+		Expect(iface.Interfaces[0].Name.Value()).Should(Equal("World"))
+		Expect(locationOf(iface.Interfaces)).Should(Equal([2]uint{27, 32}))
+	})
+
+	It("simple interface inheriting multiple interfaces", func() {
+		definition := parseDefinition(`interface Hello implements & Wo & rld { field: String }`)
+
+		iface := definition.(*ast.InterfaceTypeDefinition)
+		Expect(iface.Interfaces).Should(HaveLen(2))
+		Expect(iface.Interfaces[0].Name.Value()).Should(Equal("Wo"))
+		Expect(iface.Interfaces[1].Name.Value()).Should(Equal("rld"))
+	})
+
+	It("interface extension with only interfaces", func() {
+		definition := parseDefinition(`extend interface Hello implements Greeting`)
+
+		extension := definition.(*ast.InterfaceTypeExtension)
+		Expect(locationOf(extension)).Should(Equal([2]uint{0, 42}))
+		Expect(extension.Interfaces).Should(HaveLen(1))
+		Expect(extension.Interfaces[0].Name.Value()).Should(Equal("Greeting"))
+		Expect(extension.Fields).Should(BeEmpty())
+	})
+
+	It("simple field with arg", func() {
+		definition := parseDefinition(`
+type Hello {
+  world(flag: Boolean): String
+}`)
+
+		field := definition.(*ast.ObjectTypeDefinition).Fields[0]
+		Expect(locationOf(field)).Should(Equal([2]uint{16, 44}))
+		Expect(field.Arguments).Should(HaveLen(1))
+		Expect(locationOf(field.Arguments)).Should(Equal([2]uint{21, 36}))
+
+		arg := field.Arguments[0]
+		Expect(locationOf(arg)).Should(Equal([2]uint{22, 35}))
+		Expect(arg.Name.Value()).Should(Equal("flag"))
+		Expect(ast.Print(arg.Type)).Should(Equal("Boolean"))
+		Expect(arg.DefaultValue).Should(BeNil())
+		Expect(arg.Directives).Should(BeEmpty())
+	})
+
+	It("simple field with arg with default value", func() {
+		definition := parseDefinition(`
+type Hello {
+  world(flag: Boolean = true): String
+}`)
+
+		arg := definition.(*ast.ObjectTypeDefinition).Fields[0].Arguments[0]
+		Expect(locationOf(arg)).Should(Equal([2]uint{22, 42}))
+		Expect(arg.DefaultValue).Should(BeAssignableToTypeOf(ast.BooleanValue{}))
+		Expect(arg.DefaultValue.Interface()).Should(Equal(true))
+	})
+
+	It("simple field with list arg", func() {
+		definition := parseDefinition(`
+type Hello {
+  world(things: [String]): String
+}`)
+
+		arg := definition.(*ast.ObjectTypeDefinition).Fields[0].Arguments[0]
+		Expect(arg.Type).Should(BeAssignableToTypeOf(ast.ListType{}))
+		Expect(ast.Print(arg.Type)).Should(Equal("[String]"))
+	})
+
+	It("simple field with two args", func() {
+		definition := parseDefinition(`
+type Hello {
+  world(argOne: Boolean, argTwo: Int): String
+}`)
+
+		args := definition.(*ast.ObjectTypeDefinition).Fields[0].Arguments
+		Expect(args).Should(HaveLen(2))
+		Expect(args[0].Name.Value()).Should(Equal("argOne"))
+		Expect(args[1].Name.Value()).Should(Equal("argTwo"))
+	})
+
+	It("simple union", func() {
+		definition := parseDefinition(`union Hello = World`)
+
+		Expect(definition).Should(BeAssignableToTypeOf(&ast.UnionTypeDefinition{}))
+		union := definition.(*ast.UnionTypeDefinition)
+		Expect(locationOf(union)).Should(Equal([2]uint{0, 19}))
+		Expect(union.Name.Value()).Should(Equal("Hello"))
+		Expect(union.Types).Should(HaveLen(1))
+		Expect(union.Types[0].Name.Value()).Should(Equal("World"))
+	})
+
+	It("union with two types", func() {
+		definition := parseDefinition(`union Hello = Wo | Rld`)
+
+		union := definition.(*ast.UnionTypeDefinition)
+		Expect(union.Types).Should(HaveLen(2))
+		Expect(union.Types[0].Name.Value()).Should(Equal("Wo"))
+		Expect(union.Types[1].Name.Value()).Should(Equal("Rld"))
+	})
+
+	It("union with two types and leading pipe", func() {
+		definition := parseDefinition(`union Hello = | Wo | Rld`)
+
+		union := definition.(*ast.UnionTypeDefinition)
+		Expect(union.Types).Should(HaveLen(2))
+		Expect(union.Types[0].Name.Value()).Should(Equal("Wo"))
+		Expect(union.Types[1].Name.Value()).Should(Equal("Rld"))
+	})
+
+	It("union fails with no types", func() {
+		expectSyntaxError(`union Hello = |`, "Expected Name, found <EOF>", graphql.ErrorLocation{
+			Line:   1,
+			Column: 16,
+		})
+	})
+
+	It("union fails with leading double pipe", func() {
+		expectSyntaxError(`union Hello = || Wo | Rld`, "Expected Name, found |", graphql.ErrorLocation{
+			Line:   1,
+			Column: 16,
+		})
+	})
+
+	It("union fails with double pipe", func() {
+		expectSyntaxError(`union Hello = Wo || Rld`, "Expected Name, found |", graphql.ErrorLocation{
+			Line:   1,
+			Column: 19,
+		})
+	})
+
+	It("union fails with trailing pipe", func() {
+		expectSyntaxError(`union Hello = | Wo | Rld |`, "Expected Name, found <EOF>", graphql.ErrorLocation{
+			Line:   1,
+			Column: 27,
+		})
+	})
+
+	It("scalar", func() {
+		definition := parseDefinition(`scalar Hello`)
+
+		Expect(definition).Should(BeAssignableToTypeOf(&ast.ScalarTypeDefinition{}))
+		scalar := definition.(*ast.ScalarTypeDefinition)
+		Expect(locationOf(scalar)).Should(Equal([2]uint{0, 12}))
+		Expect(scalar.Name.Value()).Should(Equal("Hello"))
+		Expect(scalar.Directives).Should(BeEmpty())
+	})
+
+	It("simple input object", func() {
+		definition := parseDefinition(`
+input Hello {
+  world: String
+}`)
+
+		Expect(definition).Should(BeAssignableToTypeOf(&ast.InputObjectTypeDefinition{}))
+		inputObject := definition.(*ast.InputObjectTypeDefinition)
+		Expect(locationOf(inputObject)).Should(Equal([2]uint{1, 32}))
+		Expect(inputObject.Name.Value()).Should(Equal("Hello"))
+		Expect(inputObject.Fields).Should(HaveLen(1))
+		Expect(locationOf(inputObject.Fields[0])).Should(Equal([2]uint{17, 30}))
+		Expect(inputObject.Fields[0].Name.Value()).Should(Equal("world"))
+	})
+
+	It("simple input object with args should fail", func() {
+		expectSyntaxError(`
+input Hello {
+  world(foo: Int): String
+}`, "Expected :, found (", graphql.ErrorLocation{
+			Line:   3,
+			Column: 8,
+		})
+	})
+
+	It("directive with incorrect locations", func() {
+		expectSyntaxError(`
+directive @foo on FIELD | INCORRECT_LOCATION`, `Unexpected Name "INCORRECT_LOCATION"`,
+			graphql.ErrorLocation{
+				Line:   2,
+				Column: 27,
+			})
+	})
+
+	It("simple directive", func() {
+		definition := parseDefinition(`directive @foo(arg: Int = 1) on FIELD | FRAGMENT_SPREAD`)
+
+		Expect(definition).Should(BeAssignableToTypeOf(&ast.DirectiveDefinition{}))
+		directive := definition.(*ast.DirectiveDefinition)
+		Expect(locationOf(directive)).Should(Equal([2]uint{0, 55}))
+		Expect(directive.Name.Value()).Should(Equal("foo"))
+		Expect(directive.Arguments).Should(HaveLen(1))
+		Expect(directive.Locations).Should(HaveLen(2))
+		Expect(directive.Locations[0].Value()).Should(Equal("FIELD"))
+		Expect(directive.Locations[1].Value()).Should(Equal("FRAGMENT_SPREAD"))
+		Expect(directive.IsRepeatable()).Should(BeFalse())
+	})
+
+	It("repeatable directive", func() {
+		definition := parseDefinition(`directive @foo repeatable on OBJECT | INTERFACE`)
+
+		Expect(definition).Should(BeAssignableToTypeOf(&ast.DirectiveDefinition{}))
+		directive := definition.(*ast.DirectiveDefinition)
+		Expect(locationOf(directive)).Should(Equal([2]uint{0, 47}))
+		Expect(directive.Name.Value()).Should(Equal("foo"))
+		Expect(directive.Arguments).Should(BeEmpty())
+		Expect(directive.IsRepeatable()).Should(BeTrue())
+		Expect(directive.Repeatable.Value).Should(Equal("repeatable"))
+		Expect(directive.Locations).Should(HaveLen(2))
+	})
+
+	It("simple schema", func() {
+		definition := parseDefinition(`
+schema @onSchema {
+  query: Query
+  mutation: Mutation
+}`)
+
+		Expect(definition).Should(BeAssignableToTypeOf(&ast.SchemaDefinition{}))
+		schema := definition.(*ast.SchemaDefinition)
+		Expect(locationOf(schema)).Should(Equal([2]uint{1, 57}))
+		Expect(schema.Directives).Should(HaveLen(1))
+		Expect(schema.OperationTypes).Should(HaveLen(2))
+		Expect(schema.OperationTypes[0].OperationType()).Should(Equal(ast.OperationTypeQuery))
+		Expect(schema.OperationTypes[0].Type.Name.Value()).Should(Equal("Query"))
+		Expect(schema.OperationTypes[1].OperationType()).Should(Equal(ast.OperationType(ast.OperationTypeMutation)))
+		Expect(schema.OperationTypes[1].Type.Name.Value()).Should(Equal("Mutation"))
+	})
+
+	It("schema with description", func() {
+		definition := parseDefinition(`
+"""Description"""
+schema {
+  query: Query
+}`)
+
+		Expect(definition).Should(BeAssignableToTypeOf(&ast.SchemaDefinition{}))
+		schema := definition.(*ast.SchemaDefinition)
+		Expect(locationOf(schema)).Should(Equal([2]uint{1, 44}))
+		Expect(schema.Description.Value()).Should(Equal("Description"))
+		Expect(schema.Directives).Should(BeEmpty())
+		Expect(schema.OperationTypes).Should(HaveLen(1))
+		Expect(schema.OperationTypes[0].Type.Name.Value()).Should(Equal("Query"))
+	})
+
+	It("rejects unknown operation type in schema", func() {
+		expectSyntaxError(`schema { query: Query, unknown: Unknown }`, `Unexpected Name "unknown"`,
+			graphql.ErrorLocation{
+				Line:   1,
+				Column: 24,
+			})
+	})
+
+	It("rejects description on non-type-system definition", func() {
+		expectSyntaxError(`"Description" query { field }`, `Unexpected Name "query"`,
+			graphql.ErrorLocation{
+				Line:   1,
+				Column: 15,
+			})
+	})
+
+	It("parses schema kitchen sink", func() {
+		kitchenSink, err := ioutil.ReadFile("./schema-kitchen-sink.graphql")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		parser.MustParse(token.NewSourceFromBytes(kitchenSink))
+	})
+})
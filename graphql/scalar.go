@@ -48,6 +48,12 @@ type ScalarConfig struct {
 	// Description of the scalar type
 	Description string
 
+	// URL to the specification that defines the behavior of the scalar type (optional)
+	SpecifiedByURL string
+
+	// Directives applied to the type
+	Directives AppliedDirectives
+
 	// ResultCoercer serializes value for return in execution result
 	ResultCoercer ScalarResultCoercer
 
@@ -63,8 +69,10 @@ var (
 // TypeData implements ScalarTypeDefinition.
 func (config *ScalarConfig) TypeData() ScalarTypeData {
 	return ScalarTypeData{
-		Name:        config.Name,
-		Description: config.Description,
+		Name:           config.Name,
+		Description:    config.Description,
+		SpecifiedByURL: config.SpecifiedByURL,
+		Directives:     config.Directives,
 	}
 }
 
@@ -182,6 +190,16 @@ func (s *scalar) Description() string {
 	return s.data.Description
 }
 
+// SpecifiedByURL implements Scalar.
+func (s *scalar) SpecifiedByURL() string {
+	return s.data.SpecifiedByURL
+}
+
+// Directives implements TypeWithDirectives.
+func (s *scalar) Directives() AppliedDirectives {
+	return s.data.Directives
+}
+
 // CoerceResultValue implmenets LeafType.
 func (s *scalar) CoerceResultValue(value interface{}) (interface{}, error) {
 	return s.resultCoercer.CoerceResultValue(value)
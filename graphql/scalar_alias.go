@@ -148,6 +148,11 @@ func (a *scalarAlias) AliasFor() Scalar {
 	return a.Scalar
 }
 
+// Directives implements TypeWithDirectives. It returns the directives applied to the aliased Scalar.
+func (a *scalarAlias) Directives() AppliedDirectives {
+	return DirectivesOf(a.Scalar)
+}
+
 // CoerceResultValue implmenets LeafType.
 func (a *scalarAlias) CoerceResultValue(value interface{}) (interface{}, error) {
 	if a.resultCoercer == nil {
@@ -222,6 +222,16 @@ func (i *intType) Description() string {
 		"values. Int can represent values between -(2^31) and 2^31 - 1."
 }
 
+// SpecifiedByURL implements Scalar.
+func (i *intType) SpecifiedByURL() string {
+	return ""
+}
+
+// Directives implements TypeWithDirectives.
+func (i *intType) Directives() AppliedDirectives {
+	return nil
+}
+
 // CoerceResultValue implmenets LeafType.
 func (i *intType) CoerceResultValue(value interface{}) (interface{}, error) {
 	return i.coercer.CoerceResultValue(value)
@@ -414,6 +424,16 @@ func (f *floatType) Description() string {
 		"values as specified by [IEEE 754](http://en.wikipedia.org/wiki/IEEE_floating_point). "
 }
 
+// SpecifiedByURL implements Scalar.
+func (f *floatType) SpecifiedByURL() string {
+	return ""
+}
+
+// Directives implements TypeWithDirectives.
+func (f *floatType) Directives() AppliedDirectives {
+	return nil
+}
+
 // CoerceResultValue implmenets LeafType.
 func (f *floatType) CoerceResultValue(value interface{}) (interface{}, error) {
 	return f.coercer.CoerceResultValue(value)
@@ -553,6 +573,16 @@ func (s *stringType) Description() string {
 		"readable text."
 }
 
+// SpecifiedByURL implements Scalar.
+func (s *stringType) SpecifiedByURL() string {
+	return ""
+}
+
+// Directives implements TypeWithDirectives.
+func (s *stringType) Directives() AppliedDirectives {
+	return nil
+}
+
 // CoerceResultValue implmenets LeafType.
 func (s *stringType) CoerceResultValue(value interface{}) (interface{}, error) {
 	return s.coercer.CoerceResultValue(value)
@@ -676,6 +706,16 @@ func (b *booleanType) Description() string {
 	return "The `Boolean` scalar type represents `true` or `false`."
 }
 
+// SpecifiedByURL implements Scalar.
+func (b *booleanType) SpecifiedByURL() string {
+	return ""
+}
+
+// Directives implements TypeWithDirectives.
+func (b *booleanType) Directives() AppliedDirectives {
+	return nil
+}
+
 // CoerceResultValue implmenets LeafType.
 func (b *booleanType) CoerceResultValue(value interface{}) (interface{}, error) {
 	return b.coercer.CoerceResultValue(value)
@@ -784,6 +824,16 @@ func (id *idType) Description() string {
 		"(such as `4`) input value will be accepted as an ID."
 }
 
+// SpecifiedByURL implements Scalar.
+func (id *idType) SpecifiedByURL() string {
+	return ""
+}
+
+// Directives implements TypeWithDirectives.
+func (id *idType) Directives() AppliedDirectives {
+	return nil
+}
+
 // CoerceResultValue implmenets LeafType.
 func (id *idType) CoerceResultValue(value interface{}) (interface{}, error) {
 	return id.coercer.CoerceResultValue(value)
@@ -0,0 +1,92 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package scalars
+
+import (
+	"fmt"
+
+	"github.com/botobag/artemis/graphql"
+	"github.com/botobag/artemis/graphql/ast"
+	"github.com/botobag/artemis/graphql/typeutil"
+)
+
+// coercerBase is built on top of typeutil.CoercionHelperBase as a shared base to the coercers for
+// scalars in this package.
+type coercerBase struct {
+	typeutil.CoercionHelperBase
+	typeName string
+}
+
+// coercerBase is a CoercionHelper implementation.
+var _ typeutil.CoercionHelper = (*coercerBase)(nil)
+
+// RaiseError overrides typeutil.CoercionHelperBase.
+func (coercer *coercerBase) RaiseError(value interface{}, ctx *typeutil.CoercionContext, format string, a ...interface{}) error {
+	return graphql.NewCoercionError("%s cannot represent %s: %s",
+		coercer.typeName, graphql.Inspect(value), fmt.Sprintf(format, a...))
+}
+
+// RaiseInvalidArgumentTypeError returns an error indicating an unexpected type in input argument
+// coercion.
+func (coercer *coercerBase) RaiseInvalidArgumentTypeError(value ast.Value) error {
+	v := value.Interface()
+	return graphql.NewCoercionError("%s cannot represent %s: unexpected argument node type `%T`",
+		coercer.typeName, graphql.Inspect(v), value)
+}
+
+func (coercer *coercerBase) init(typeName string, impl typeutil.CoercionHelper) {
+	coercer.CoercionHelperBase.SetImpl(impl)
+	coercer.typeName = typeName
+}
+
+// coerceResult runs result coercion for the given value with the helper.
+func (coercer *coercerBase) coerceResult(value interface{}) (interface{}, error) {
+	return coercer.Coerce(value, typeutil.CoercionContext{
+		Mode: typeutil.ResultCoercionMode,
+	})
+}
+
+// coerceVariable runs input coercion for the given variable value with the helper.
+func (coercer *coercerBase) coerceVariable(value interface{}) (interface{}, error) {
+	return coercer.Coerce(value, typeutil.CoercionContext{
+		Mode: typeutil.InputCoercionMode,
+	})
+}
+
+// inputCoercionContext returns a context for coercing input values.
+func inputCoercionContext() *typeutil.CoercionContext {
+	return &typeutil.CoercionContext{
+		Mode: typeutil.InputCoercionMode,
+	}
+}
+
+// scalarCoercer is implemented by all coercers in this package.
+type scalarCoercer interface {
+	graphql.ScalarResultCoercer
+	graphql.ScalarInputCoercer
+}
+
+// newScalar creates a Scalar with the given coercer for both result and input coercion.
+func newScalar(name string, description string, specifiedByURL string, coercer scalarCoercer) graphql.Scalar {
+	return graphql.MustNewScalar(&graphql.ScalarConfig{
+		Name:           name,
+		Description:    description,
+		SpecifiedByURL: specifiedByURL,
+		ResultCoercer:  coercer,
+		InputCoercer:   coercer,
+	})
+}
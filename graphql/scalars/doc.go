@@ -0,0 +1,51 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+// Package scalars provides custom scalar types that are commonly used in GraphQL schemas but not
+// included in the specification. They are built with typeutil.CoercionHelper and report coercion
+// errors in the same manner as the built-in scalars in graphql package.
+//
+// The Go types that are used to represent the internal value of each scalar (i.e., the type of value
+// returned from CoerceVariableValue and CoerceLiteralValue) are listed as follows,
+//
+// +--------------+---------------------------------+
+// | GraphQL Type | Go Type ("internal value type") |
+// +--------------+---------------------------------+
+// | DateTime     | time.Time                       |
+// | Date         | time.Time                       |
+// | Time         | time.Time                       |
+// | Duration     | time.Duration                   |
+// | JSON         | interface{}                     |
+// | Int64        | int64                           |
+// | BigInt       | *big.Int                        |
+// | Decimal      | *big.Float                      |
+// | UUID         | string                          |
+// | URL          | *url.URL                        |
+// | Email        | string                          |
+// | Base64       | []byte                          |
+// +--------------+---------------------------------+
+//
+// Each scalar is exposed as a function that returns a shared instance (like graphql.Int()) which
+// can be added to schema directly:
+//
+//	schema, err := graphql.NewSchema(&graphql.SchemaConfig{
+//		Query: query,
+//		Types: []graphql.Type{
+//			scalars.DateTime(),
+//			scalars.JSON(),
+//		},
+//	})
+package scalars
@@ -0,0 +1,139 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package scalars
+
+import (
+	"strconv"
+
+	"github.com/botobag/artemis/graphql"
+	"github.com/botobag/artemis/graphql/ast"
+	"github.com/botobag/artemis/graphql/typeutil"
+)
+
+//===-----------------------------------------------------------------------------------------===//
+// JSON
+//===-----------------------------------------------------------------------------------------===//
+// JSON represents an arbitrary JSON value as specified by ECMA-404. Results are serialized with the
+// JSON encoder in executor as is. The internal value for inputs is composed of
+// map[string]interface{}, []interface{}, string, bool, int (for integer literals), float64 and nil.
+//
+// Reference: http://www.ecma-international.org/publications/files/ECMA-ST/ECMA-404.pdf
+
+// jsonCoercer implements input coercion and result coercion for JSON type.
+type jsonCoercer struct {
+	coercerBase
+}
+
+var (
+	_ graphql.ScalarResultCoercer = (*jsonCoercer)(nil)
+	_ graphql.ScalarInputCoercer  = (*jsonCoercer)(nil)
+)
+
+func (coercer *jsonCoercer) init() {
+	coercer.coercerBase.init("JSON", coercer)
+}
+
+// CoerceFloat overrides typeutil.CoercionHelperBase.
+func (coercer *jsonCoercer) CoerceFloat(value float64, ctx *typeutil.CoercionContext) (interface{}, error) {
+	// NaN and Inf have been rejected by CoercionHelper.
+	return value, nil
+}
+
+// CoerceResultValue implements ScalarResultCoercer.
+func (coercer *jsonCoercer) CoerceResultValue(value interface{}) (interface{}, error) {
+	switch value.(type) {
+	case float32, float64, *float32, *float64:
+		// JSON cannot represent NaN and Infinity.
+		return coercer.coerceResult(value)
+	}
+	return value, nil
+}
+
+// CoerceVariableValue implements ScalarInputCoercer.
+func (coercer *jsonCoercer) CoerceVariableValue(value interface{}) (interface{}, error) {
+	// Variables are decoded from JSON so they're already valid values.
+	return value, nil
+}
+
+// CoerceLiteralValue implements ScalarInputCoercer.
+func (coercer *jsonCoercer) CoerceLiteralValue(value ast.Value) (interface{}, error) {
+	switch value := value.(type) {
+	case ast.IntValue:
+		if v, err := strconv.ParseInt(value.String(), 10, 0); err == nil {
+			return int(v), nil
+		}
+		// Fall back to float64 for integers that are too large.
+		return strconv.ParseFloat(value.String(), 64)
+
+	case ast.FloatValue:
+		return value.FloatValue()
+
+	case ast.StringValue:
+		return value.Value(), nil
+
+	case ast.BooleanValue:
+		return value.Value(), nil
+
+	case ast.NullValue:
+		return nil, nil
+
+	case ast.EnumValue:
+		return value.Value(), nil
+
+	case ast.ListValue:
+		values := value.Values()
+		result := make([]interface{}, len(values))
+		for i := range values {
+			v, err := coercer.CoerceLiteralValue(values[i])
+			if err != nil {
+				return nil, err
+			}
+			result[i] = v
+		}
+		return result, nil
+
+	case ast.ObjectValue:
+		fields := value.Fields()
+		result := make(map[string]interface{}, len(fields))
+		for _, field := range fields {
+			v, err := coercer.CoerceLiteralValue(field.Value)
+			if err != nil {
+				return nil, err
+			}
+			result[field.Name.Value()] = v
+		}
+		return result, nil
+	}
+
+	// Variables within a JSON literal are not supported.
+	return nil, coercer.RaiseInvalidArgumentTypeError(value)
+}
+
+var jsonTypeInstance = func() graphql.Scalar {
+	coercer := &jsonCoercer{}
+	coercer.init()
+	return newScalar(
+		"JSON",
+		"The `JSON` scalar type represents an arbitrary JSON value.",
+		"http://www.ecma-international.org/publications/files/ECMA-ST/ECMA-404.pdf",
+		coercer)
+}()
+
+// JSON returns the JSON scalar type definition.
+func JSON() graphql.Scalar {
+	return jsonTypeInstance
+}
@@ -0,0 +1,456 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package scalars
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/botobag/artemis/graphql"
+	"github.com/botobag/artemis/graphql/ast"
+	"github.com/botobag/artemis/graphql/typeutil"
+)
+
+// Reasons for the error when coercing numeric scalars
+const (
+	coercionErrorNonInteger        string = "not an integer"
+	coercionErrorIntegerTooLarge          = "value too large for 64-bit signed integer"
+	coercionErrorIntegerTooSmall          = "value too small for 64-bit signed integer"
+	coercionErrorInvalidDecimal           = "not a decimal number"
+	coercionErrorDecimalOutOfRange        = "decimal number out of range"
+	coercionErrorNonNumeric               = "not a numeric value"
+)
+
+// Range of float64 values that can be converted into int64 without overflow
+const (
+	minFloat64ForInt64 float64 = -(1 << 63)
+	maxFloat64ForInt64 float64 = 1 << 63
+)
+
+//===-----------------------------------------------------------------------------------------===//
+// Int64
+//===-----------------------------------------------------------------------------------------===//
+// Int64 represents a signed 64-bit integer. Results are serialized as JSON numbers. Inputs can be
+// given in either integer or string. Note that clients that store numbers in IEEE 754 double (e.g.,
+// JavaScript) cannot represent integers beyond 2^53 precisely; consider BigInt for such cases.
+
+// int64Coercer implements input coercion and result coercion for Int64 type.
+type int64Coercer struct {
+	coercerBase
+}
+
+var (
+	_ graphql.ScalarResultCoercer = (*int64Coercer)(nil)
+	_ graphql.ScalarInputCoercer  = (*int64Coercer)(nil)
+)
+
+func (coercer *int64Coercer) init() {
+	coercer.coercerBase.init("Int64", coercer)
+}
+
+// RaiseNonValue implements typeutil.CoercionHelper.
+func (coercer *int64Coercer) RaiseNonValue(value interface{}, ctx *typeutil.CoercionContext) error {
+	// Use coercionErrorNonInteger for non-value.
+	return coercer.RaiseError(value, ctx, coercionErrorNonInteger)
+}
+
+// CoerceBool overrides typeutil.CoercionHelperBase.
+func (coercer *int64Coercer) CoerceBool(value bool, ctx *typeutil.CoercionContext) (interface{}, error) {
+	// Input mode only accepts integer and string values.
+	if ctx.Mode == typeutil.InputCoercionMode {
+		return nil, coercer.RaiseInvalidTypeError(value, ctx)
+	}
+
+	if value {
+		return int64(1), nil
+	}
+	return int64(0), nil
+}
+
+// CoerceSignedInteger overrides typeutil.CoercionHelperBase.
+func (coercer *int64Coercer) CoerceSignedInteger(value int64, ctx *typeutil.CoercionContext) (interface{}, error) {
+	return value, nil
+}
+
+// CoerceUnsignedInteger overrides typeutil.CoercionHelperBase.
+func (coercer *int64Coercer) CoerceUnsignedInteger(value uint64, ctx *typeutil.CoercionContext) (interface{}, error) {
+	if value > uint64(math.MaxInt64) {
+		return nil, coercer.RaiseError(value, ctx, coercionErrorIntegerTooLarge)
+	}
+	return int64(value), nil
+}
+
+// CoerceFloat overrides typeutil.CoercionHelperBase.
+func (coercer *int64Coercer) CoerceFloat(value float64, ctx *typeutil.CoercionContext) (interface{}, error) {
+	// Variables decoded from JSON are float64. Accept the value in both modes as long as it is an
+	// integer.
+	if value != math.Trunc(value) {
+		return nil, coercer.RaiseError(value, ctx, coercionErrorNonInteger)
+	} else if value >= maxFloat64ForInt64 {
+		return nil, coercer.RaiseError(value, ctx, coercionErrorIntegerTooLarge)
+	} else if value < minFloat64ForInt64 {
+		return nil, coercer.RaiseError(value, ctx, coercionErrorIntegerTooSmall)
+	}
+	return int64(value), nil
+}
+
+// CoerceString overrides typeutil.CoercionHelperBase.
+func (coercer *int64Coercer) CoerceString(value string, ctx *typeutil.CoercionContext) (interface{}, error) {
+	val, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		if err.(*strconv.NumError).Err == strconv.ErrRange {
+			if len(value) > 0 && value[0] == '-' {
+				return nil, coercer.RaiseError(value, ctx, coercionErrorIntegerTooSmall)
+			}
+			return nil, coercer.RaiseError(value, ctx, coercionErrorIntegerTooLarge)
+		}
+		return nil, coercer.RaiseError(value, ctx, coercionErrorNonInteger)
+	}
+	return val, nil
+}
+
+// CoerceResultValue implements ScalarResultCoercer.
+func (coercer *int64Coercer) CoerceResultValue(value interface{}) (interface{}, error) {
+	return coercer.coerceResult(value)
+}
+
+// CoerceVariableValue implements ScalarInputCoercer.
+func (coercer *int64Coercer) CoerceVariableValue(value interface{}) (interface{}, error) {
+	return coercer.coerceVariable(value)
+}
+
+// CoerceLiteralValue implements ScalarInputCoercer.
+func (coercer *int64Coercer) CoerceLiteralValue(value ast.Value) (interface{}, error) {
+	switch value := value.(type) {
+	case ast.IntValue:
+		return coercer.CoerceString(value.String(), inputCoercionContext())
+
+	case ast.StringValue:
+		return coercer.CoerceString(value.Value(), inputCoercionContext())
+	}
+
+	return nil, coercer.RaiseInvalidArgumentTypeError(value)
+}
+
+var int64TypeInstance = func() graphql.Scalar {
+	coercer := &int64Coercer{}
+	coercer.init()
+	return newScalar(
+		"Int64",
+		"The `Int64` scalar type represents non-fractional signed whole numeric values. Int64 can "+
+			"represent values between -(2^63) and 2^63 - 1.",
+		"",
+		coercer)
+}()
+
+// Int64 returns the Int64 scalar type definition.
+func Int64() graphql.Scalar {
+	return int64TypeInstance
+}
+
+//===-----------------------------------------------------------------------------------------===//
+// BigInt
+//===-----------------------------------------------------------------------------------------===//
+// BigInt represents an arbitrary-precision integer. Results are serialized as strings to prevent
+// precision loss in clients. Inputs can be given in either integer or string.
+
+// bigIntCoercer implements input coercion and result coercion for BigInt type.
+type bigIntCoercer struct {
+	coercerBase
+}
+
+var (
+	_ graphql.ScalarResultCoercer = (*bigIntCoercer)(nil)
+	_ graphql.ScalarInputCoercer  = (*bigIntCoercer)(nil)
+)
+
+func (coercer *bigIntCoercer) init() {
+	coercer.coercerBase.init("BigInt", coercer)
+}
+
+// output returns the value in the representation for the mode of coercion.
+func (coercer *bigIntCoercer) output(value *big.Int, ctx *typeutil.CoercionContext) (interface{}, error) {
+	if ctx.Mode == typeutil.ResultCoercionMode {
+		return value.String(), nil
+	}
+	return value, nil
+}
+
+// RaiseNonValue implements typeutil.CoercionHelper.
+func (coercer *bigIntCoercer) RaiseNonValue(value interface{}, ctx *typeutil.CoercionContext) error {
+	// Use coercionErrorNonInteger for non-value.
+	return coercer.RaiseError(value, ctx, coercionErrorNonInteger)
+}
+
+// CoerceSignedInteger overrides typeutil.CoercionHelperBase.
+func (coercer *bigIntCoercer) CoerceSignedInteger(value int64, ctx *typeutil.CoercionContext) (interface{}, error) {
+	return coercer.output(big.NewInt(value), ctx)
+}
+
+// CoerceUnsignedInteger overrides typeutil.CoercionHelperBase.
+func (coercer *bigIntCoercer) CoerceUnsignedInteger(value uint64, ctx *typeutil.CoercionContext) (interface{}, error) {
+	return coercer.output(new(big.Int).SetUint64(value), ctx)
+}
+
+// CoerceFloat overrides typeutil.CoercionHelperBase.
+func (coercer *bigIntCoercer) CoerceFloat(value float64, ctx *typeutil.CoercionContext) (interface{}, error) {
+	// Variables decoded from JSON are float64. Accept the value in both modes as long as it is an
+	// integer.
+	if value != math.Trunc(value) {
+		return nil, coercer.RaiseError(value, ctx, coercionErrorNonInteger)
+	}
+	i, _ := big.NewFloat(value).Int(nil)
+	return coercer.output(i, ctx)
+}
+
+// CoerceString overrides typeutil.CoercionHelperBase.
+func (coercer *bigIntCoercer) CoerceString(value string, ctx *typeutil.CoercionContext) (interface{}, error) {
+	i, ok := new(big.Int).SetString(value, 10)
+	if !ok {
+		return nil, coercer.RaiseError(value, ctx, coercionErrorNonInteger)
+	}
+	return coercer.output(i, ctx)
+}
+
+// CoerceResultValue implements ScalarResultCoercer.
+func (coercer *bigIntCoercer) CoerceResultValue(value interface{}) (interface{}, error) {
+	if value, ok := value.(*big.Int); ok {
+		if value == nil {
+			return nil, nil
+		}
+		return value.String(), nil
+	}
+	return coercer.coerceResult(value)
+}
+
+// CoerceVariableValue implements ScalarInputCoercer.
+func (coercer *bigIntCoercer) CoerceVariableValue(value interface{}) (interface{}, error) {
+	// Accept *big.Int that is given programmatically.
+	if value, ok := value.(*big.Int); ok && value != nil {
+		return value, nil
+	}
+	return coercer.coerceVariable(value)
+}
+
+// CoerceLiteralValue implements ScalarInputCoercer.
+func (coercer *bigIntCoercer) CoerceLiteralValue(value ast.Value) (interface{}, error) {
+	switch value := value.(type) {
+	case ast.IntValue:
+		return coercer.CoerceString(value.String(), inputCoercionContext())
+
+	case ast.StringValue:
+		return coercer.CoerceString(value.Value(), inputCoercionContext())
+	}
+
+	return nil, coercer.RaiseInvalidArgumentTypeError(value)
+}
+
+var bigIntTypeInstance = func() graphql.Scalar {
+	coercer := &bigIntCoercer{}
+	coercer.init()
+	return newScalar(
+		"BigInt",
+		"The `BigInt` scalar type represents non-fractional signed whole numeric values of "+
+			"arbitrary precision. BigInt values are serialized as strings.",
+		"",
+		coercer)
+}()
+
+// BigInt returns the BigInt scalar type definition.
+func BigInt() graphql.Scalar {
+	return bigIntTypeInstance
+}
+
+//===-----------------------------------------------------------------------------------------===//
+// Decimal
+//===-----------------------------------------------------------------------------------------===//
+// Decimal represents an arbitrary-precision decimal number. Results are serialized as strings in
+// decimal notation to prevent precision loss in clients. Inputs can be given in integer, float or
+// string.
+
+// decimalCoercer implements input coercion and result coercion for Decimal type.
+type decimalCoercer struct {
+	coercerBase
+}
+
+var (
+	_ graphql.ScalarResultCoercer = (*decimalCoercer)(nil)
+	_ graphql.ScalarInputCoercer  = (*decimalCoercer)(nil)
+)
+
+func (coercer *decimalCoercer) init() {
+	coercer.coercerBase.init("Decimal", coercer)
+}
+
+// Limits on the number of digits and the magnitude of the exponent in the strings accepted by
+// Decimal. big.ParseFloat accepts any exponent and formatting such values in decimal notation
+// takes time and memory proportional to the exponent. The limits still allow every float64 in its
+// shortest decimal notation (which has at most 325 digits).
+const (
+	maxDecimalDigits   = 1000
+	maxDecimalExponent = 1000
+)
+
+// isDecimalStringInRange returns false if the number of digits or the exponent in the decimal
+// string exceeds the limits. Strings in invalid syntax are left to big.ParseFloat to report.
+func isDecimalStringInRange(value string) bool {
+	mantissa, exponent := value, ""
+	if i := strings.IndexAny(value, "eE"); i >= 0 {
+		mantissa, exponent = value[:i], value[i+1:]
+	}
+
+	numDigits := 0
+	for i := 0; i < len(mantissa); i++ {
+		if c := mantissa[i]; c >= '0' && c <= '9' {
+			numDigits++
+		}
+	}
+	if numDigits > maxDecimalDigits {
+		return false
+	}
+
+	if len(exponent) > 0 {
+		exp, err := strconv.Atoi(exponent)
+		if err != nil {
+			return err.(*strconv.NumError).Err != strconv.ErrRange
+		}
+		if exp > maxDecimalExponent || exp < -maxDecimalExponent {
+			return false
+		}
+	}
+
+	return true
+}
+
+// formatDecimal formats the big.Float in decimal notation with the minimal number of digits
+// necessary to represent the value uniquely.
+func formatDecimal(value *big.Float) string {
+	return value.Text('f', -1)
+}
+
+// output returns the value in the representation for the mode of coercion.
+func (coercer *decimalCoercer) output(value *big.Float, ctx *typeutil.CoercionContext) (interface{}, error) {
+	if ctx.Mode == typeutil.ResultCoercionMode {
+		return formatDecimal(value), nil
+	}
+	return value, nil
+}
+
+// RaiseNonValue implements typeutil.CoercionHelper.
+func (coercer *decimalCoercer) RaiseNonValue(value interface{}, ctx *typeutil.CoercionContext) error {
+	// Use coercionErrorNonNumeric for non-value.
+	return coercer.RaiseError(value, ctx, coercionErrorNonNumeric)
+}
+
+// CoerceSignedInteger overrides typeutil.CoercionHelperBase.
+func (coercer *decimalCoercer) CoerceSignedInteger(value int64, ctx *typeutil.CoercionContext) (interface{}, error) {
+	return coercer.output(new(big.Float).SetInt64(value), ctx)
+}
+
+// CoerceUnsignedInteger overrides typeutil.CoercionHelperBase.
+func (coercer *decimalCoercer) CoerceUnsignedInteger(value uint64, ctx *typeutil.CoercionContext) (interface{}, error) {
+	return coercer.output(new(big.Float).SetUint64(value), ctx)
+}
+
+// CoerceFloat overrides typeutil.CoercionHelperBase.
+func (coercer *decimalCoercer) CoerceFloat(value float64, ctx *typeutil.CoercionContext) (interface{}, error) {
+	// Go through the shortest decimal representation of the float64 to avoid the exposure of binary
+	// approximation (e.g., 0.1 becomes 0.1000000000000000055511151231257827).
+	return coercer.CoerceString(strconv.FormatFloat(value, 'f', -1, 64), ctx)
+}
+
+// CoerceString overrides typeutil.CoercionHelperBase.
+func (coercer *decimalCoercer) CoerceString(value string, ctx *typeutil.CoercionContext) (interface{}, error) {
+	if !isDecimalStringInRange(value) {
+		return nil, coercer.RaiseError(value, ctx, coercionErrorDecimalOutOfRange)
+	}
+
+	// Reserve enough precision for the given digits (each decimal digit requires log2(10) ≈ 3.32
+	// bits).
+	prec := uint(len(value))*4 + 64
+	f, _, err := big.ParseFloat(value, 10, prec, big.ToNearestEven)
+	if err != nil || f.IsInf() {
+		return nil, coercer.RaiseError(value, ctx, coercionErrorInvalidDecimal)
+	}
+	return coercer.output(f, ctx)
+}
+
+// CoerceResultValue implements ScalarResultCoercer.
+func (coercer *decimalCoercer) CoerceResultValue(value interface{}) (interface{}, error) {
+	switch value := value.(type) {
+	case *big.Float:
+		if value == nil {
+			return nil, nil
+		} else if value.IsInf() {
+			return nil, coercer.RaiseNonValue(value, &typeutil.CoercionContext{
+				Mode: typeutil.ResultCoercionMode,
+			})
+		}
+		return formatDecimal(value), nil
+
+	case *big.Int:
+		if value == nil {
+			return nil, nil
+		}
+		return value.String(), nil
+	}
+
+	return coercer.coerceResult(value)
+}
+
+// CoerceVariableValue implements ScalarInputCoercer.
+func (coercer *decimalCoercer) CoerceVariableValue(value interface{}) (interface{}, error) {
+	// Accept *big.Float that is given programmatically.
+	if value, ok := value.(*big.Float); ok && value != nil && !value.IsInf() {
+		return value, nil
+	}
+	return coercer.coerceVariable(value)
+}
+
+// CoerceLiteralValue implements ScalarInputCoercer.
+func (coercer *decimalCoercer) CoerceLiteralValue(value ast.Value) (interface{}, error) {
+	switch value := value.(type) {
+	case ast.IntValue:
+		return coercer.CoerceString(value.String(), inputCoercionContext())
+
+	case ast.FloatValue:
+		return coercer.CoerceString(value.String(), inputCoercionContext())
+
+	case ast.StringValue:
+		return coercer.CoerceString(value.Value(), inputCoercionContext())
+	}
+
+	return nil, coercer.RaiseInvalidArgumentTypeError(value)
+}
+
+var decimalTypeInstance = func() graphql.Scalar {
+	coercer := &decimalCoercer{}
+	coercer.init()
+	return newScalar(
+		"Decimal",
+		"The `Decimal` scalar type represents signed decimal numbers of arbitrary precision. Decimal "+
+			"values are serialized as strings.",
+		"",
+		coercer)
+}()
+
+// Decimal returns the Decimal scalar type definition.
+func Decimal() graphql.Scalar {
+	return decimalTypeInstance
+}
@@ -0,0 +1,458 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package scalars_test
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/botobag/artemis/graphql"
+	"github.com/botobag/artemis/graphql/ast"
+	"github.com/botobag/artemis/graphql/parser"
+	"github.com/botobag/artemis/graphql/scalars"
+	"github.com/botobag/artemis/graphql/token"
+	"github.com/botobag/artemis/internal/testutil"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+)
+
+func MatchCoercionError(message string) types.GomegaMatcher {
+	return testutil.MatchGraphQLError(
+		testutil.MessageEqual(message),
+		testutil.KindIs(graphql.ErrKindCoercion),
+	)
+}
+
+func parseValue(s string) ast.Value {
+	return parser.MustParseValue(token.NewSource(s))
+}
+
+var _ = Describe("Scalars", func() {
+	It("provides specifiedByURL", func() {
+		Expect(scalars.DateTime().SpecifiedByURL()).Should(Equal("https://scalars.graphql.org/andimarek/date-time"))
+		Expect(scalars.Date().SpecifiedByURL()).Should(Equal("https://tools.ietf.org/html/rfc3339#section-5.6"))
+		Expect(scalars.Time().SpecifiedByURL()).Should(Equal("https://tools.ietf.org/html/rfc3339#section-5.6"))
+		Expect(scalars.Duration().SpecifiedByURL()).Should(BeEmpty())
+		Expect(scalars.JSON().SpecifiedByURL()).Should(Equal("http://www.ecma-international.org/publications/files/ECMA-ST/ECMA-404.pdf"))
+		Expect(scalars.Int64().SpecifiedByURL()).Should(BeEmpty())
+		Expect(scalars.BigInt().SpecifiedByURL()).Should(BeEmpty())
+		Expect(scalars.Decimal().SpecifiedByURL()).Should(BeEmpty())
+		Expect(scalars.UUID().SpecifiedByURL()).Should(Equal("https://tools.ietf.org/html/rfc4122"))
+		Expect(scalars.URL().SpecifiedByURL()).Should(Equal("https://tools.ietf.org/html/rfc3986"))
+		Expect(scalars.Email().SpecifiedByURL()).Should(Equal("https://tools.ietf.org/html/rfc5322#section-3.4.1"))
+		Expect(scalars.Base64().SpecifiedByURL()).Should(Equal("https://tools.ietf.org/html/rfc4648#section-4"))
+	})
+
+	It("can be used in schema", func() {
+		schema, err := graphql.NewSchema(&graphql.SchemaConfig{
+			Query: graphql.MustNewObject(&graphql.ObjectConfig{
+				Name: "Query",
+				Fields: graphql.Fields{
+					"now": {
+						Type: graphql.T(scalars.DateTime()),
+					},
+				},
+			}),
+			Types: []graphql.Type{
+				scalars.DateTime(),
+				scalars.Date(),
+				scalars.Time(),
+				scalars.Duration(),
+				scalars.JSON(),
+				scalars.Int64(),
+				scalars.BigInt(),
+				scalars.Decimal(),
+				scalars.UUID(),
+				scalars.URL(),
+				scalars.Email(),
+				scalars.Base64(),
+			},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(schema.TypeMap().Lookup("DateTime")).Should(Equal(scalars.DateTime()))
+		Expect(schema.TypeMap().Lookup("Base64")).Should(Equal(scalars.Base64()))
+	})
+
+	Describe("DateTime", func() {
+		t := time.Date(2019, 6, 1, 16, 30, 0, 500000000, time.FixedZone("", 8*60*60))
+
+		It("serializes output", func() {
+			Expect(scalars.DateTime().CoerceResultValue(t)).Should(Equal("2019-06-01T16:30:00.5+08:00"))
+			Expect(scalars.DateTime().CoerceResultValue(&t)).Should(Equal("2019-06-01T16:30:00.5+08:00"))
+			Expect(scalars.DateTime().CoerceResultValue(t.UTC())).Should(Equal("2019-06-01T08:30:00.5Z"))
+			Expect(scalars.DateTime().CoerceResultValue("2019-06-01T08:30:00.500Z")).Should(Equal("2019-06-01T08:30:00.5Z"))
+			Expect(scalars.DateTime().CoerceResultValue((*time.Time)(nil))).Should(BeNil())
+
+			_, err := scalars.DateTime().CoerceResultValue("2019-06-01")
+			Expect(err).Should(MatchCoercionError(`DateTime cannot represent "2019-06-01": not a valid RFC 3339 date-time string`))
+
+			_, err = scalars.DateTime().CoerceResultValue(1)
+			Expect(err).Should(MatchCoercionError("DateTime cannot represent 1: unexpected result type `int64`"))
+		})
+
+		It("parses input", func() {
+			value, err := scalars.DateTime().CoerceVariableValue("2019-06-01T16:30:00.5+08:00")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(value.(time.Time).Equal(t)).Should(BeTrue())
+
+			value, err = scalars.DateTime().CoerceLiteralValue(parseValue(`"2019-06-01T08:30:00.5Z"`))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(value.(time.Time).Equal(t)).Should(BeTrue())
+
+			Expect(scalars.DateTime().CoerceVariableValue(t)).Should(Equal(t))
+
+			_, err = scalars.DateTime().CoerceVariableValue("2019-06-01 08:30:00")
+			Expect(err).Should(MatchCoercionError(`DateTime cannot represent "2019-06-01 08:30:00": not a valid RFC 3339 date-time string`))
+
+			_, err = scalars.DateTime().CoerceVariableValue(1559377800)
+			Expect(err).Should(MatchCoercionError("DateTime cannot represent 1559377800: invalid variable type `int64`"))
+
+			_, err = scalars.DateTime().CoerceLiteralValue(parseValue("1559377800"))
+			Expect(err).Should(MatchCoercionError("DateTime cannot represent 1559377800: unexpected argument node type `ast.IntValue`"))
+		})
+	})
+
+	Describe("Date", func() {
+		It("serializes output", func() {
+			t := time.Date(2019, 6, 1, 16, 30, 0, 0, time.UTC)
+			Expect(scalars.Date().CoerceResultValue(t)).Should(Equal("2019-06-01"))
+			Expect(scalars.Date().CoerceResultValue("2019-06-01")).Should(Equal("2019-06-01"))
+
+			_, err := scalars.Date().CoerceResultValue("2019-06-31")
+			Expect(err).Should(MatchCoercionError(`Date cannot represent "2019-06-31": not a valid RFC 3339 full-date string`))
+		})
+
+		It("parses input", func() {
+			Expect(scalars.Date().CoerceVariableValue("2019-06-01")).Should(Equal(time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC)))
+			Expect(scalars.Date().CoerceLiteralValue(parseValue(`"2019-06-01"`))).Should(Equal(time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC)))
+
+			_, err := scalars.Date().CoerceVariableValue("2019-06-01T08:30:00Z")
+			Expect(err).Should(MatchCoercionError(`Date cannot represent "2019-06-01T08:30:00Z": not a valid RFC 3339 full-date string`))
+		})
+	})
+
+	Describe("Time", func() {
+		It("serializes output", func() {
+			t := time.Date(2019, 6, 1, 8, 30, 0, 500000000, time.UTC)
+			Expect(scalars.Time().CoerceResultValue(t)).Should(Equal("08:30:00.5"))
+			Expect(scalars.Time().CoerceResultValue("08:30:00")).Should(Equal("08:30:00"))
+
+			_, err := scalars.Time().CoerceResultValue("25:00:00")
+			Expect(err).Should(MatchCoercionError(`Time cannot represent "25:00:00": not a valid RFC 3339 partial-time string`))
+		})
+
+		It("parses input", func() {
+			Expect(scalars.Time().CoerceVariableValue("08:30:00.5")).Should(Equal(time.Date(0, 1, 1, 8, 30, 0, 500000000, time.UTC)))
+			Expect(scalars.Time().CoerceLiteralValue(parseValue(`"08:30:00"`))).Should(Equal(time.Date(0, 1, 1, 8, 30, 0, 0, time.UTC)))
+
+			_, err := scalars.Time().CoerceVariableValue("8:30")
+			Expect(err).Should(MatchCoercionError(`Time cannot represent "8:30": not a valid RFC 3339 partial-time string`))
+		})
+	})
+
+	Describe("Duration", func() {
+		It("serializes output", func() {
+			d := 90 * time.Minute
+			Expect(scalars.Duration().CoerceResultValue(d)).Should(Equal("1h30m0s"))
+			Expect(scalars.Duration().CoerceResultValue(&d)).Should(Equal("1h30m0s"))
+			Expect(scalars.Duration().CoerceResultValue("90m")).Should(Equal("1h30m0s"))
+
+			_, err := scalars.Duration().CoerceResultValue("1 hour")
+			Expect(err).Should(MatchCoercionError(`Duration cannot represent "1 hour": not a valid duration string`))
+
+			_, err = scalars.Duration().CoerceResultValue(int64(d))
+			Expect(err).Should(MatchCoercionError("Duration cannot represent 5400000000000: unexpected result type `int64`"))
+		})
+
+		It("parses input", func() {
+			Expect(scalars.Duration().CoerceVariableValue("250ms")).Should(Equal(250 * time.Millisecond))
+			Expect(scalars.Duration().CoerceLiteralValue(parseValue(`"1h30m"`))).Should(Equal(90 * time.Minute))
+
+			_, err := scalars.Duration().CoerceLiteralValue(parseValue("250"))
+			Expect(err).Should(MatchCoercionError("Duration cannot represent 250: unexpected argument node type `ast.IntValue`"))
+		})
+	})
+
+	Describe("JSON", func() {
+		It("serializes output", func() {
+			value := map[string]interface{}{
+				"a": []interface{}{1, "b", true, nil},
+			}
+			Expect(scalars.JSON().CoerceResultValue(value)).Should(Equal(value))
+			Expect(scalars.JSON().CoerceResultValue("string")).Should(Equal("string"))
+			Expect(scalars.JSON().CoerceResultValue(1.5)).Should(Equal(1.5))
+			Expect(scalars.JSON().CoerceResultValue(nil)).Should(BeNil())
+
+			_, err := scalars.JSON().CoerceResultValue(math.NaN())
+			Expect(err).Should(MatchCoercionError("JSON cannot represent NaN: not a value"))
+		})
+
+		It("parses input", func() {
+			value := map[string]interface{}{
+				"a": []interface{}{1.0, "b", true, nil},
+			}
+			Expect(scalars.JSON().CoerceVariableValue(value)).Should(Equal(value))
+
+			Expect(scalars.JSON().CoerceLiteralValue(parseValue(
+				`{ a: [1, 2.5, "b", true, null, ENUM], b: { c: 12345678901234567890 } }`,
+			))).Should(Equal(map[string]interface{}{
+				"a": []interface{}{1, 2.5, "b", true, nil, "ENUM"},
+				"b": map[string]interface{}{
+					"c": 12345678901234567890.0,
+				},
+			}))
+
+			_, err := scalars.JSON().CoerceLiteralValue(parseValue(`{ a: $var }`))
+			Expect(err).Should(MatchCoercionError("JSON cannot represent \"var\": unexpected argument node type `ast.Variable`"))
+		})
+	})
+
+	Describe("Int64", func() {
+		It("serializes output", func() {
+			Expect(scalars.Int64().CoerceResultValue(1)).Should(Equal(int64(1)))
+			Expect(scalars.Int64().CoerceResultValue(int64(math.MaxInt64))).Should(Equal(int64(math.MaxInt64)))
+			Expect(scalars.Int64().CoerceResultValue(uint32(math.MaxUint32))).Should(Equal(int64(math.MaxUint32)))
+			Expect(scalars.Int64().CoerceResultValue(1e10)).Should(Equal(int64(10000000000)))
+			Expect(scalars.Int64().CoerceResultValue("-9223372036854775808")).Should(Equal(int64(math.MinInt64)))
+			Expect(scalars.Int64().CoerceResultValue(true)).Should(Equal(int64(1)))
+
+			var err error
+			_, err = scalars.Int64().CoerceResultValue(uint64(math.MaxUint64))
+			Expect(err).Should(MatchCoercionError("Int64 cannot represent 18446744073709551615: value too large for 64-bit signed integer"))
+
+			_, err = scalars.Int64().CoerceResultValue(1.5)
+			Expect(err).Should(MatchCoercionError("Int64 cannot represent 1.5: not an integer"))
+
+			_, err = scalars.Int64().CoerceResultValue(1e100)
+			Expect(err).Should(MatchCoercionError("Int64 cannot represent 1e+100: value too large for 64-bit signed integer"))
+
+			_, err = scalars.Int64().CoerceResultValue("-9223372036854775809")
+			Expect(err).Should(MatchCoercionError(`Int64 cannot represent "-9223372036854775809": value too small for 64-bit signed integer`))
+
+			_, err = scalars.Int64().CoerceResultValue("one")
+			Expect(err).Should(MatchCoercionError(`Int64 cannot represent "one": not an integer`))
+
+			_, err = scalars.Int64().CoerceResultValue(math.Inf(1))
+			Expect(err).Should(MatchCoercionError("Int64 cannot represent +Inf: not an integer"))
+		})
+
+		It("parses input", func() {
+			Expect(scalars.Int64().CoerceVariableValue(float64(1 << 53))).Should(Equal(int64(1 << 53)))
+			Expect(scalars.Int64().CoerceVariableValue("9223372036854775807")).Should(Equal(int64(math.MaxInt64)))
+			Expect(scalars.Int64().CoerceLiteralValue(parseValue("9223372036854775807"))).Should(Equal(int64(math.MaxInt64)))
+			Expect(scalars.Int64().CoerceLiteralValue(parseValue(`"-1"`))).Should(Equal(int64(-1)))
+
+			var err error
+			_, err = scalars.Int64().CoerceVariableValue(true)
+			Expect(err).Should(MatchCoercionError("Int64 cannot represent true: invalid variable type `bool`"))
+
+			_, err = scalars.Int64().CoerceLiteralValue(parseValue("1.5"))
+			Expect(err).Should(MatchCoercionError("Int64 cannot represent 1.5: unexpected argument node type `ast.FloatValue`"))
+
+			_, err = scalars.Int64().CoerceLiteralValue(parseValue("9223372036854775808"))
+			Expect(err).Should(MatchCoercionError(`Int64 cannot represent "9223372036854775808": value too large for 64-bit signed integer`))
+		})
+	})
+
+	Describe("BigInt", func() {
+		It("serializes output", func() {
+			i, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+			Expect(scalars.BigInt().CoerceResultValue(i)).Should(Equal("123456789012345678901234567890"))
+			Expect(scalars.BigInt().CoerceResultValue(-1)).Should(Equal("-1"))
+			Expect(scalars.BigInt().CoerceResultValue(uint64(math.MaxUint64))).Should(Equal("18446744073709551615"))
+			Expect(scalars.BigInt().CoerceResultValue(1e20)).Should(Equal("100000000000000000000"))
+			Expect(scalars.BigInt().CoerceResultValue("0123")).Should(Equal("123"))
+			Expect(scalars.BigInt().CoerceResultValue((*big.Int)(nil))).Should(BeNil())
+
+			var err error
+			_, err = scalars.BigInt().CoerceResultValue(1.5)
+			Expect(err).Should(MatchCoercionError("BigInt cannot represent 1.5: not an integer"))
+
+			_, err = scalars.BigInt().CoerceResultValue("1e3")
+			Expect(err).Should(MatchCoercionError(`BigInt cannot represent "1e3": not an integer`))
+
+			_, err = scalars.BigInt().CoerceResultValue(true)
+			Expect(err).Should(MatchCoercionError("BigInt cannot represent true: unexpected result type `bool`"))
+		})
+
+		It("parses input", func() {
+			i, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+			Expect(scalars.BigInt().CoerceVariableValue("123456789012345678901234567890")).Should(Equal(i))
+			Expect(scalars.BigInt().CoerceVariableValue(i)).Should(Equal(i))
+			Expect(scalars.BigInt().CoerceVariableValue(float64(42))).Should(Equal(big.NewInt(42)))
+			Expect(scalars.BigInt().CoerceLiteralValue(parseValue("123456789012345678901234567890"))).Should(Equal(i))
+			Expect(scalars.BigInt().CoerceLiteralValue(parseValue(`"123456789012345678901234567890"`))).Should(Equal(i))
+
+			_, err := scalars.BigInt().CoerceLiteralValue(parseValue("1.0"))
+			Expect(err).Should(MatchCoercionError("BigInt cannot represent 1: unexpected argument node type `ast.FloatValue`"))
+		})
+	})
+
+	Describe("Decimal", func() {
+		It("serializes output", func() {
+			f, _, _ := big.ParseFloat("12345678901234567890.123456789", 10, 256, big.ToNearestEven)
+			Expect(scalars.Decimal().CoerceResultValue(f)).Should(Equal("12345678901234567890.123456789"))
+			Expect(scalars.Decimal().CoerceResultValue(0.1)).Should(Equal("0.1"))
+			Expect(scalars.Decimal().CoerceResultValue(-12)).Should(Equal("-12"))
+			Expect(scalars.Decimal().CoerceResultValue("1.50")).Should(Equal("1.5"))
+			Expect(scalars.Decimal().CoerceResultValue(big.NewInt(7))).Should(Equal("7"))
+
+			var err error
+			_, err = scalars.Decimal().CoerceResultValue("one")
+			Expect(err).Should(MatchCoercionError(`Decimal cannot represent "one": not a decimal number`))
+
+			_, err = scalars.Decimal().CoerceResultValue(math.NaN())
+			Expect(err).Should(MatchCoercionError("Decimal cannot represent NaN: not a numeric value"))
+		})
+
+		It("parses input", func() {
+			value, err := scalars.Decimal().CoerceVariableValue("12345678901234567890.123456789")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(value.(*big.Float).Text('f', -1)).Should(Equal("12345678901234567890.123456789"))
+
+			value, err = scalars.Decimal().CoerceLiteralValue(parseValue("0.1"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(value.(*big.Float).Text('f', -1)).Should(Equal("0.1"))
+
+			value, err = scalars.Decimal().CoerceVariableValue(0.1)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(value.(*big.Float).Text('f', -1)).Should(Equal("0.1"))
+
+			_, err = scalars.Decimal().CoerceLiteralValue(parseValue("true"))
+			Expect(err).Should(MatchCoercionError("Decimal cannot represent true: unexpected argument node type `ast.BooleanValue`"))
+		})
+
+		It("limits the number of digits and the exponent", func() {
+			for _, value := range []string{
+				"1e1000",
+				"1E+1000",
+				"-1e-1000",
+				"0." + strings.Repeat("9", 999),
+				strings.Repeat("9", 1000) + "e-1000",
+			} {
+				_, err := scalars.Decimal().CoerceVariableValue(value)
+				Expect(err).ShouldNot(HaveOccurred(), "value: %s", value)
+			}
+
+			for _, value := range []float64{math.MaxFloat64, -math.SmallestNonzeroFloat64} {
+				_, err := scalars.Decimal().CoerceVariableValue(value)
+				Expect(err).ShouldNot(HaveOccurred(), "value: %v", value)
+			}
+
+			for _, value := range []string{
+				"1e1001",
+				"1E+1001",
+				"-1e-1001",
+				"1e99999999",
+				"1e99999999999999999999",
+				"0." + strings.Repeat("9", 1000),
+				strings.Repeat("9", 1001) + "e-1000",
+			} {
+				_, err := scalars.Decimal().CoerceVariableValue(value)
+				Expect(err).Should(MatchCoercionError(
+					fmt.Sprintf("Decimal cannot represent %q: decimal number out of range", value)))
+			}
+
+			_, err := scalars.Decimal().CoerceLiteralValue(parseValue("1e99999999"))
+			Expect(err).Should(MatchCoercionError(
+				`Decimal cannot represent "1e99999999": decimal number out of range`))
+		})
+	})
+
+	Describe("UUID", func() {
+		It("serializes output", func() {
+			Expect(scalars.UUID().CoerceResultValue("F81D4FAE-7DEC-11D0-A765-00A0C91E6BF6")).Should(Equal("f81d4fae-7dec-11d0-a765-00a0c91e6bf6"))
+			Expect(scalars.UUID().CoerceResultValue([16]byte{
+				0xf8, 0x1d, 0x4f, 0xae, 0x7d, 0xec, 0x11, 0xd0, 0xa7, 0x65, 0x00, 0xa0, 0xc9, 0x1e, 0x6b, 0xf6,
+			})).Should(Equal("f81d4fae-7dec-11d0-a765-00a0c91e6bf6"))
+
+			_, err := scalars.UUID().CoerceResultValue("f81d4fae7dec11d0a76500a0c91e6bf6")
+			Expect(err).Should(MatchCoercionError(`UUID cannot represent "f81d4fae7dec11d0a76500a0c91e6bf6": not a valid UUID string`))
+		})
+
+		It("parses input", func() {
+			Expect(scalars.UUID().CoerceVariableValue("f81d4fae-7dec-11d0-a765-00a0c91e6bf6")).Should(Equal("f81d4fae-7dec-11d0-a765-00a0c91e6bf6"))
+			Expect(scalars.UUID().CoerceLiteralValue(parseValue(`"F81D4FAE-7DEC-11D0-A765-00A0C91E6BF6"`))).Should(Equal("f81d4fae-7dec-11d0-a765-00a0c91e6bf6"))
+
+			_, err := scalars.UUID().CoerceLiteralValue(parseValue(`"f81d4fae-7dec-11d0-a765-00a0c91e6bfg"`))
+			Expect(err).Should(MatchCoercionError(`UUID cannot represent "f81d4fae-7dec-11d0-a765-00a0c91e6bfg": not a valid UUID string`))
+		})
+	})
+
+	Describe("URL", func() {
+		It("serializes output", func() {
+			u, _ := url.Parse("https://example.com/path?q=1")
+			Expect(scalars.URL().CoerceResultValue(u)).Should(Equal("https://example.com/path?q=1"))
+			Expect(scalars.URL().CoerceResultValue(*u)).Should(Equal("https://example.com/path?q=1"))
+			Expect(scalars.URL().CoerceResultValue("https://example.com")).Should(Equal("https://example.com"))
+
+			_, err := scalars.URL().CoerceResultValue("/path")
+			Expect(err).Should(MatchCoercionError(`URL cannot represent "/path": not an absolute URL`))
+		})
+
+		It("parses input", func() {
+			u, _ := url.Parse("https://example.com/path?q=1")
+			Expect(scalars.URL().CoerceVariableValue("https://example.com/path?q=1")).Should(Equal(u))
+			Expect(scalars.URL().CoerceLiteralValue(parseValue(`"https://example.com/path?q=1"`))).Should(Equal(u))
+
+			_, err := scalars.URL().CoerceVariableValue("http://[::1")
+			Expect(err).Should(MatchCoercionError(`URL cannot represent "http://[::1": not an absolute URL`))
+		})
+	})
+
+	Describe("Email", func() {
+		It("serializes output", func() {
+			Expect(scalars.Email().CoerceResultValue("user@example.com")).Should(Equal("user@example.com"))
+
+			_, err := scalars.Email().CoerceResultValue("user at example.com")
+			Expect(err).Should(MatchCoercionError(`Email cannot represent "user at example.com": not a valid email address`))
+		})
+
+		It("parses input", func() {
+			Expect(scalars.Email().CoerceVariableValue("user@example.com")).Should(Equal("user@example.com"))
+			Expect(scalars.Email().CoerceLiteralValue(parseValue(`"user+tag@example.com"`))).Should(Equal("user+tag@example.com"))
+
+			_, err := scalars.Email().CoerceLiteralValue(parseValue(`"example.com"`))
+			Expect(err).Should(MatchCoercionError(`Email cannot represent "example.com": not a valid email address`))
+		})
+	})
+
+	Describe("Base64", func() {
+		It("serializes output", func() {
+			Expect(scalars.Base64().CoerceResultValue([]byte("artemis"))).Should(Equal("YXJ0ZW1pcw=="))
+			Expect(scalars.Base64().CoerceResultValue("YXJ0ZW1pcw==")).Should(Equal("YXJ0ZW1pcw=="))
+			Expect(scalars.Base64().CoerceResultValue([]byte(nil))).Should(BeNil())
+
+			_, err := scalars.Base64().CoerceResultValue("artemis")
+			Expect(err).Should(MatchCoercionError(`Base64 cannot represent "artemis": not a valid base64 string`))
+		})
+
+		It("parses input", func() {
+			Expect(scalars.Base64().CoerceVariableValue("YXJ0ZW1pcw==")).Should(Equal([]byte("artemis")))
+			Expect(scalars.Base64().CoerceLiteralValue(parseValue(`"YXJ0ZW1pcw=="`))).Should(Equal([]byte("artemis")))
+
+			_, err := scalars.Base64().CoerceLiteralValue(parseValue(`"YXJ0ZW1pcw"`))
+			Expect(err).Should(MatchCoercionError(`Base64 cannot represent "YXJ0ZW1pcw": not a valid base64 string`))
+		})
+	})
+})
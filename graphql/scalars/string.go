@@ -0,0 +1,369 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package scalars
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"net/mail"
+	"net/url"
+	"strings"
+
+	"github.com/botobag/artemis/graphql"
+	"github.com/botobag/artemis/graphql/ast"
+	"github.com/botobag/artemis/graphql/typeutil"
+)
+
+// Reasons for the error when coercing string-based scalars
+const (
+	coercionErrorInvalidUUID   string = "not a valid UUID string"
+	coercionErrorInvalidURL           = "not an absolute URL"
+	coercionErrorInvalidEmail         = "not a valid email address"
+	coercionErrorInvalidBase64        = "not a valid base64 string"
+)
+
+//===-----------------------------------------------------------------------------------------===//
+// UUID
+//===-----------------------------------------------------------------------------------------===//
+// UUID represents a universally unique identifier in the string representation defined in RFC 4122
+// (e.g., "f81d4fae-7dec-11d0-a765-00a0c91e6bf6"). Values are normalized to lower case.
+//
+// Reference: https://tools.ietf.org/html/rfc4122#section-3
+
+// uuidCoercer implements input coercion and result coercion for UUID type.
+type uuidCoercer struct {
+	coercerBase
+}
+
+var (
+	_ graphql.ScalarResultCoercer = (*uuidCoercer)(nil)
+	_ graphql.ScalarInputCoercer  = (*uuidCoercer)(nil)
+)
+
+func (coercer *uuidCoercer) init() {
+	coercer.coercerBase.init("UUID", coercer)
+}
+
+// isUUID returns true if the given string is in the form of 8-4-4-4-12 hexadecimal digits.
+func isUUID(value string) bool {
+	if len(value) != 36 {
+		return false
+	}
+
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch i {
+		case 8, 13, 18, 23:
+			if c != '-' {
+				return false
+			}
+		default:
+			if !('0' <= c && c <= '9') && !('a' <= c && c <= 'f') && !('A' <= c && c <= 'F') {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// formatUUID formats 16 bytes into the string representation of UUID.
+func formatUUID(value [16]byte) string {
+	s := hex.EncodeToString(value[:])
+	return s[0:8] + "-" + s[8:12] + "-" + s[12:16] + "-" + s[16:20] + "-" + s[20:32]
+}
+
+// CoerceString overrides typeutil.CoercionHelperBase.
+func (coercer *uuidCoercer) CoerceString(value string, ctx *typeutil.CoercionContext) (interface{}, error) {
+	if !isUUID(value) {
+		return nil, coercer.RaiseError(value, ctx, coercionErrorInvalidUUID)
+	}
+	return strings.ToLower(value), nil
+}
+
+// CoerceResultValue implements ScalarResultCoercer.
+func (coercer *uuidCoercer) CoerceResultValue(value interface{}) (interface{}, error) {
+	switch value := value.(type) {
+	case [16]byte:
+		return formatUUID(value), nil
+
+	case *[16]byte:
+		if value == nil {
+			return nil, nil
+		}
+		return formatUUID(*value), nil
+	}
+
+	return coercer.coerceResult(value)
+}
+
+// CoerceVariableValue implements ScalarInputCoercer.
+func (coercer *uuidCoercer) CoerceVariableValue(value interface{}) (interface{}, error) {
+	return coercer.coerceVariable(value)
+}
+
+// CoerceLiteralValue implements ScalarInputCoercer.
+func (coercer *uuidCoercer) CoerceLiteralValue(value ast.Value) (interface{}, error) {
+	if value, ok := value.(ast.StringValue); ok {
+		return coercer.CoerceString(value.Value(), inputCoercionContext())
+	}
+
+	return nil, coercer.RaiseInvalidArgumentTypeError(value)
+}
+
+var uuidTypeInstance = func() graphql.Scalar {
+	coercer := &uuidCoercer{}
+	coercer.init()
+	return newScalar(
+		"UUID",
+		"The `UUID` scalar type represents a universally unique identifier as a string defined in "+
+			"RFC 4122 (e.g., `f81d4fae-7dec-11d0-a765-00a0c91e6bf6`).",
+		"https://tools.ietf.org/html/rfc4122",
+		coercer)
+}()
+
+// UUID returns the UUID scalar type definition.
+func UUID() graphql.Scalar {
+	return uuidTypeInstance
+}
+
+//===-----------------------------------------------------------------------------------------===//
+// URL
+//===-----------------------------------------------------------------------------------------===//
+// URL represents an absolute URL as specified by RFC 3986 (e.g., "https://example.com/path").
+//
+// Reference: https://tools.ietf.org/html/rfc3986
+
+// urlCoercer implements input coercion and result coercion for URL type.
+type urlCoercer struct {
+	coercerBase
+}
+
+var (
+	_ graphql.ScalarResultCoercer = (*urlCoercer)(nil)
+	_ graphql.ScalarInputCoercer  = (*urlCoercer)(nil)
+)
+
+func (coercer *urlCoercer) init() {
+	coercer.coercerBase.init("URL", coercer)
+}
+
+// CoerceString overrides typeutil.CoercionHelperBase.
+func (coercer *urlCoercer) CoerceString(value string, ctx *typeutil.CoercionContext) (interface{}, error) {
+	u, err := url.Parse(value)
+	if err != nil || !u.IsAbs() {
+		return nil, coercer.RaiseError(value, ctx, coercionErrorInvalidURL)
+	}
+
+	if ctx.Mode == typeutil.ResultCoercionMode {
+		return u.String(), nil
+	}
+	return u, nil
+}
+
+// CoerceResultValue implements ScalarResultCoercer.
+func (coercer *urlCoercer) CoerceResultValue(value interface{}) (interface{}, error) {
+	switch value := value.(type) {
+	case url.URL:
+		return value.String(), nil
+
+	case *url.URL:
+		if value == nil {
+			return nil, nil
+		}
+		return value.String(), nil
+	}
+
+	return coercer.coerceResult(value)
+}
+
+// CoerceVariableValue implements ScalarInputCoercer.
+func (coercer *urlCoercer) CoerceVariableValue(value interface{}) (interface{}, error) {
+	// Accept *url.URL that is given programmatically.
+	if value, ok := value.(*url.URL); ok && value != nil {
+		return value, nil
+	}
+	return coercer.coerceVariable(value)
+}
+
+// CoerceLiteralValue implements ScalarInputCoercer.
+func (coercer *urlCoercer) CoerceLiteralValue(value ast.Value) (interface{}, error) {
+	if value, ok := value.(ast.StringValue); ok {
+		return coercer.CoerceString(value.Value(), inputCoercionContext())
+	}
+
+	return nil, coercer.RaiseInvalidArgumentTypeError(value)
+}
+
+var urlTypeInstance = func() graphql.Scalar {
+	coercer := &urlCoercer{}
+	coercer.init()
+	return newScalar(
+		"URL",
+		"The `URL` scalar type represents an absolute URL as specified by RFC 3986 (e.g., "+
+			"`https://example.com/path`).",
+		"https://tools.ietf.org/html/rfc3986",
+		coercer)
+}()
+
+// URL returns the URL scalar type definition.
+func URL() graphql.Scalar {
+	return urlTypeInstance
+}
+
+//===-----------------------------------------------------------------------------------------===//
+// Email
+//===-----------------------------------------------------------------------------------------===//
+// Email represents an email address in the addr-spec form defined in RFC 5322 (e.g.,
+// "user@example.com"). Display names (e.g., "User <user@example.com>") are not accepted.
+//
+// Reference: https://tools.ietf.org/html/rfc5322#section-3.4.1
+
+// emailCoercer implements input coercion and result coercion for Email type.
+type emailCoercer struct {
+	coercerBase
+}
+
+var (
+	_ graphql.ScalarResultCoercer = (*emailCoercer)(nil)
+	_ graphql.ScalarInputCoercer  = (*emailCoercer)(nil)
+)
+
+func (coercer *emailCoercer) init() {
+	coercer.coercerBase.init("Email", coercer)
+}
+
+// CoerceString overrides typeutil.CoercionHelperBase.
+func (coercer *emailCoercer) CoerceString(value string, ctx *typeutil.CoercionContext) (interface{}, error) {
+	address, err := mail.ParseAddress(value)
+	if err != nil || address.Name != "" || address.Address != value {
+		return nil, coercer.RaiseError(value, ctx, coercionErrorInvalidEmail)
+	}
+	return value, nil
+}
+
+// CoerceResultValue implements ScalarResultCoercer.
+func (coercer *emailCoercer) CoerceResultValue(value interface{}) (interface{}, error) {
+	return coercer.coerceResult(value)
+}
+
+// CoerceVariableValue implements ScalarInputCoercer.
+func (coercer *emailCoercer) CoerceVariableValue(value interface{}) (interface{}, error) {
+	return coercer.coerceVariable(value)
+}
+
+// CoerceLiteralValue implements ScalarInputCoercer.
+func (coercer *emailCoercer) CoerceLiteralValue(value ast.Value) (interface{}, error) {
+	if value, ok := value.(ast.StringValue); ok {
+		return coercer.CoerceString(value.Value(), inputCoercionContext())
+	}
+
+	return nil, coercer.RaiseInvalidArgumentTypeError(value)
+}
+
+var emailTypeInstance = func() graphql.Scalar {
+	coercer := &emailCoercer{}
+	coercer.init()
+	return newScalar(
+		"Email",
+		"The `Email` scalar type represents an email address as specified by RFC 5322 (e.g., "+
+			"`user@example.com`).",
+		"https://tools.ietf.org/html/rfc5322#section-3.4.1",
+		coercer)
+}()
+
+// Email returns the Email scalar type definition.
+func Email() graphql.Scalar {
+	return emailTypeInstance
+}
+
+//===-----------------------------------------------------------------------------------------===//
+// Base64
+//===-----------------------------------------------------------------------------------------===//
+// Base64 represents binary data with the standard base64 encoding defined in RFC 4648 (with
+// padding). Strings returned from field resolvers are considered already encoded and are only
+// validated.
+//
+// Reference: https://tools.ietf.org/html/rfc4648#section-4
+
+// base64Coercer implements input coercion and result coercion for Base64 type.
+type base64Coercer struct {
+	coercerBase
+}
+
+var (
+	_ graphql.ScalarResultCoercer = (*base64Coercer)(nil)
+	_ graphql.ScalarInputCoercer  = (*base64Coercer)(nil)
+)
+
+func (coercer *base64Coercer) init() {
+	coercer.coercerBase.init("Base64", coercer)
+}
+
+// CoerceString overrides typeutil.CoercionHelperBase.
+func (coercer *base64Coercer) CoerceString(value string, ctx *typeutil.CoercionContext) (interface{}, error) {
+	data, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return nil, coercer.RaiseError(value, ctx, coercionErrorInvalidBase64)
+	}
+
+	if ctx.Mode == typeutil.ResultCoercionMode {
+		return value, nil
+	}
+	return data, nil
+}
+
+// CoerceResultValue implements ScalarResultCoercer.
+func (coercer *base64Coercer) CoerceResultValue(value interface{}) (interface{}, error) {
+	if value, ok := value.([]byte); ok {
+		if value == nil {
+			return nil, nil
+		}
+		return base64.StdEncoding.EncodeToString(value), nil
+	}
+	return coercer.coerceResult(value)
+}
+
+// CoerceVariableValue implements ScalarInputCoercer.
+func (coercer *base64Coercer) CoerceVariableValue(value interface{}) (interface{}, error) {
+	return coercer.coerceVariable(value)
+}
+
+// CoerceLiteralValue implements ScalarInputCoercer.
+func (coercer *base64Coercer) CoerceLiteralValue(value ast.Value) (interface{}, error) {
+	if value, ok := value.(ast.StringValue); ok {
+		return coercer.CoerceString(value.Value(), inputCoercionContext())
+	}
+
+	return nil, coercer.RaiseInvalidArgumentTypeError(value)
+}
+
+var base64TypeInstance = func() graphql.Scalar {
+	coercer := &base64Coercer{}
+	coercer.init()
+	return newScalar(
+		"Base64",
+		"The `Base64` scalar type represents binary data as a string encoded with the standard "+
+			"base64 encoding defined in RFC 4648.",
+		"https://tools.ietf.org/html/rfc4648#section-4",
+		coercer)
+}()
+
+// Base64 returns the Base64 scalar type definition.
+func Base64() graphql.Scalar {
+	return base64TypeInstance
+}
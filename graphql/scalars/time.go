@@ -0,0 +1,262 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package scalars
+
+import (
+	"time"
+
+	"github.com/botobag/artemis/graphql"
+	"github.com/botobag/artemis/graphql/ast"
+	"github.com/botobag/artemis/graphql/typeutil"
+)
+
+// Reasons for the error when coercing date and time scalars
+const (
+	coercionErrorInvalidDateTime string = "not a valid RFC 3339 date-time string"
+	coercionErrorInvalidDate            = "not a valid RFC 3339 full-date string"
+	coercionErrorInvalidTime            = "not a valid RFC 3339 partial-time string"
+	coercionErrorInvalidDuration        = "not a valid duration string"
+)
+
+// timeCoercer implements input coercion and result coercion for scalars whose internal values are
+// time.Time. The values are serialized in string with the given layout.
+type timeCoercer struct {
+	coercerBase
+
+	// layout is used to format and parse the string representation of time.Time.
+	layout string
+
+	// reason is used in the error message when a string cannot be parsed with layout.
+	reason string
+}
+
+var (
+	_ graphql.ScalarResultCoercer = (*timeCoercer)(nil)
+	_ graphql.ScalarInputCoercer  = (*timeCoercer)(nil)
+)
+
+func (coercer *timeCoercer) init(typeName string, layout string, reason string) {
+	coercer.coercerBase.init(typeName, coercer)
+	coercer.layout = layout
+	coercer.reason = reason
+}
+
+// CoerceString overrides typeutil.CoercionHelperBase.
+func (coercer *timeCoercer) CoerceString(value string, ctx *typeutil.CoercionContext) (interface{}, error) {
+	// Note that time.Parse accepts fractional seconds even if layout doesn't specify them.
+	t, err := time.Parse(coercer.layout, value)
+	if err != nil {
+		return nil, coercer.RaiseError(value, ctx, "%s", coercer.reason)
+	}
+
+	if ctx.Mode == typeutil.ResultCoercionMode {
+		// Normalize the string.
+		return t.Format(coercer.layout), nil
+	}
+	return t, nil
+}
+
+// CoerceResultValue implements ScalarResultCoercer.
+func (coercer *timeCoercer) CoerceResultValue(value interface{}) (interface{}, error) {
+	switch value := value.(type) {
+	case time.Time:
+		return value.Format(coercer.layout), nil
+
+	case *time.Time:
+		if value == nil {
+			return nil, nil
+		}
+		return value.Format(coercer.layout), nil
+	}
+
+	return coercer.coerceResult(value)
+}
+
+// CoerceVariableValue implements ScalarInputCoercer.
+func (coercer *timeCoercer) CoerceVariableValue(value interface{}) (interface{}, error) {
+	// Accept time.Time that is given programmatically.
+	if value, ok := value.(time.Time); ok {
+		return value, nil
+	}
+	return coercer.coerceVariable(value)
+}
+
+// CoerceLiteralValue implements ScalarInputCoercer.
+func (coercer *timeCoercer) CoerceLiteralValue(value ast.Value) (interface{}, error) {
+	if value, ok := value.(ast.StringValue); ok {
+		return coercer.CoerceString(value.Value(), inputCoercionContext())
+	}
+
+	return nil, coercer.RaiseInvalidArgumentTypeError(value)
+}
+
+//===-----------------------------------------------------------------------------------------===//
+// DateTime
+//===-----------------------------------------------------------------------------------------===//
+// DateTime represents an instant in time with a date-time string defined in RFC 3339 (e.g.,
+// "2019-06-01T08:30:00Z" or "2019-06-01T16:30:00.5+08:00").
+//
+// Reference: https://tools.ietf.org/html/rfc3339#section-5.6
+
+var dateTimeTypeInstance = func() graphql.Scalar {
+	coercer := &timeCoercer{}
+	coercer.init("DateTime", time.RFC3339Nano, coercionErrorInvalidDateTime)
+	return newScalar(
+		"DateTime",
+		"The `DateTime` scalar type represents an instant in time as a date-time string defined "+
+			"in RFC 3339 (e.g., `2019-06-01T08:30:00Z`).",
+		"https://scalars.graphql.org/andimarek/date-time",
+		coercer)
+}()
+
+// DateTime returns the DateTime scalar type definition.
+func DateTime() graphql.Scalar {
+	return dateTimeTypeInstance
+}
+
+//===-----------------------------------------------------------------------------------------===//
+// Date
+//===-----------------------------------------------------------------------------------------===//
+// Date represents a calendar date with a full-date string defined in RFC 3339 (e.g.,
+// "2019-06-01"). The time.Time that represents the internal value has zero time in UTC.
+//
+// Reference: https://tools.ietf.org/html/rfc3339#section-5.6
+
+var dateTypeInstance = func() graphql.Scalar {
+	coercer := &timeCoercer{}
+	coercer.init("Date", "2006-01-02", coercionErrorInvalidDate)
+	return newScalar(
+		"Date",
+		"The `Date` scalar type represents a calendar date as a full-date string defined in RFC 3339 "+
+			"(e.g., `2019-06-01`).",
+		"https://tools.ietf.org/html/rfc3339#section-5.6",
+		coercer)
+}()
+
+// Date returns the Date scalar type definition.
+func Date() graphql.Scalar {
+	return dateTypeInstance
+}
+
+//===-----------------------------------------------------------------------------------------===//
+// Time
+//===-----------------------------------------------------------------------------------------===//
+// Time represents a time of day without time zone with a partial-time string defined in RFC 3339
+// (e.g., "08:30:00" or "08:30:00.5"). The time.Time that represents the internal value is on
+// January 1, year 0 in UTC.
+//
+// Reference: https://tools.ietf.org/html/rfc3339#section-5.6
+
+var timeTypeInstance = func() graphql.Scalar {
+	coercer := &timeCoercer{}
+	coercer.init("Time", "15:04:05.999999999", coercionErrorInvalidTime)
+	return newScalar(
+		"Time",
+		"The `Time` scalar type represents a time of day without time zone as a partial-time string "+
+			"defined in RFC 3339 (e.g., `08:30:00`).",
+		"https://tools.ietf.org/html/rfc3339#section-5.6",
+		coercer)
+}()
+
+// Time returns the Time scalar type definition.
+func Time() graphql.Scalar {
+	return timeTypeInstance
+}
+
+//===-----------------------------------------------------------------------------------------===//
+// Duration
+//===-----------------------------------------------------------------------------------------===//
+// Duration represents an elapsed time with a string accepted by time.ParseDuration (e.g., "1h30m"
+// or "250ms").
+
+// durationCoercer implements input coercion and result coercion for Duration type.
+type durationCoercer struct {
+	coercerBase
+}
+
+var (
+	_ graphql.ScalarResultCoercer = (*durationCoercer)(nil)
+	_ graphql.ScalarInputCoercer  = (*durationCoercer)(nil)
+)
+
+func (coercer *durationCoercer) init() {
+	coercer.coercerBase.init("Duration", coercer)
+}
+
+// CoerceString overrides typeutil.CoercionHelperBase.
+func (coercer *durationCoercer) CoerceString(value string, ctx *typeutil.CoercionContext) (interface{}, error) {
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return nil, coercer.RaiseError(value, ctx, coercionErrorInvalidDuration)
+	}
+
+	if ctx.Mode == typeutil.ResultCoercionMode {
+		// Normalize the string.
+		return d.String(), nil
+	}
+	return d, nil
+}
+
+// CoerceResultValue implements ScalarResultCoercer.
+func (coercer *durationCoercer) CoerceResultValue(value interface{}) (interface{}, error) {
+	switch value := value.(type) {
+	case time.Duration:
+		return value.String(), nil
+
+	case *time.Duration:
+		if value == nil {
+			return nil, nil
+		}
+		return value.String(), nil
+	}
+
+	return coercer.coerceResult(value)
+}
+
+// CoerceVariableValue implements ScalarInputCoercer.
+func (coercer *durationCoercer) CoerceVariableValue(value interface{}) (interface{}, error) {
+	// Accept time.Duration that is given programmatically.
+	if value, ok := value.(time.Duration); ok {
+		return value, nil
+	}
+	return coercer.coerceVariable(value)
+}
+
+// CoerceLiteralValue implements ScalarInputCoercer.
+func (coercer *durationCoercer) CoerceLiteralValue(value ast.Value) (interface{}, error) {
+	if value, ok := value.(ast.StringValue); ok {
+		return coercer.CoerceString(value.Value(), inputCoercionContext())
+	}
+
+	return nil, coercer.RaiseInvalidArgumentTypeError(value)
+}
+
+var durationTypeInstance = func() graphql.Scalar {
+	coercer := &durationCoercer{}
+	coercer.init()
+	return newScalar(
+		"Duration",
+		"The `Duration` scalar type represents an elapsed time as a string that consists of decimal "+
+			"numbers with unit suffixes (e.g., `1h30m` or `250ms`).",
+		"",
+		coercer)
+}()
+
+// Duration returns the Duration scalar type definition.
+func Duration() graphql.Scalar {
+	return durationTypeInstance
+}
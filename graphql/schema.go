@@ -88,6 +88,11 @@ func (typeMap TypeMap) add(t Type) error {
 			}
 
 		case Interface:
+			// Add interfaces.
+			for _, iface := range t.Interfaces() {
+				stack = append(stack, iface)
+			}
+
 			// Add field type and arg type.
 			for _, field := range t.Fields() {
 				stack = append(stack, field.Type())
@@ -234,7 +239,9 @@ type schema struct {
 	possibleTypeSets map[AbstractType]PossibleTypeSet
 }
 
-// NewSchema initializes a Schema from the given config.
+// NewSchema initializes a Schema from the given config. The schema is validated with the type
+// system rules (see ValidateSchema.) On failure, the returned error is a graphql.Errors which
+// contains every violation found in the schema.
 func NewSchema(config *SchemaConfig) (Schema, error) {
 	schema := &schema{
 		query:            config.Query,
@@ -304,8 +311,9 @@ func NewSchema(config *SchemaConfig) (Schema, error) {
 	for _, t := range typeMap.types {
 		switch t := t.(type) {
 		case Object:
-			// Create a reverse link from Object's implementing Interface to the Object.
-			for _, iface := range t.Interfaces() {
+			// Create a reverse link from Object's implementing Interface to the Object. This includes the
+			// Interfaces that are transitively implemented through other Interfaces.
+			for _, iface := range transitiveInterfaces(t.Interfaces()) {
 				set, exists := possibleTypeSets[iface]
 				if !exists {
 					// Create a new PossibleTypeSet for iface.
@@ -321,9 +329,39 @@ func NewSchema(config *SchemaConfig) (Schema, error) {
 		}
 	}
 
+	// Validate the schema against the type system rules. Note that unlike ValidateSchema, a missing
+	// Query root type is accepted for compatibility with the schemas that are not meant to be
+	// executed (e.g., a schema built from an introspection result without query type.)
+	if errs := validateSchema(schema, false /* requireQuery */); errs.HaveOccurred() {
+		return nil, errs
+	}
+
 	return schema, nil
 }
 
+// transitiveInterfaces returns the given interfaces and the interfaces implemented by them
+// transitively. Each Interface appears in the result once.
+func transitiveInterfaces(interfaces []Interface) []Interface {
+	var (
+		result  []Interface
+		visited = map[Interface]bool{}
+		stack   = interfaces
+		iface   Interface
+	)
+
+	for len(stack) > 0 {
+		iface, stack = stack[0], stack[1:]
+		if iface == nil || visited[iface] {
+			continue
+		}
+		visited[iface] = true
+		result = append(result, iface)
+		stack = append(stack, iface.Interfaces()...)
+	}
+
+	return result
+}
+
 // MustNewSchema is a convenience function equivalent to NewSchema but panics on failure instead of
 // returning an error.
 func MustNewSchema(config *SchemaConfig) Schema {
@@ -28,12 +28,21 @@ var _ = Describe("Type System: Schema", func() {
 	Describe("Type Map", func() {
 		It("includes interface possible types in the type map", func() {
 			SomeInterface := graphql.MustNewInterface(&graphql.InterfaceConfig{
-				Name:   "SomeInterface",
-				Fields: graphql.Fields{},
+				Name: "SomeInterface",
+				Fields: graphql.Fields{
+					"f": {
+						Type: graphql.T(graphql.Int()),
+					},
+				},
 			})
 
 			SomeSubType := graphql.MustNewObject(&graphql.ObjectConfig{
 				Name: "SomeSubType",
+				Fields: graphql.Fields{
+					"f": {
+						Type: graphql.T(graphql.Int()),
+					},
+				},
 				Interfaces: []graphql.InterfaceTypeDefinition{
 					graphql.I(SomeInterface),
 				},
@@ -42,8 +51,10 @@ var _ = Describe("Type System: Schema", func() {
 			Schema := graphql.MustNewSchema(&graphql.SchemaConfig{
 				Query: graphql.MustNewObject(&graphql.ObjectConfig{
 					Name: "Query",
-					Interfaces: []graphql.InterfaceTypeDefinition{
-						graphql.I(SomeInterface),
+					Fields: graphql.Fields{
+						"iface": {
+							Type: graphql.T(SomeInterface),
+						},
 					},
 				}),
 				Types: []graphql.Type{SomeSubType},
@@ -56,6 +67,11 @@ var _ = Describe("Type System: Schema", func() {
 		It("includes nested input objects in the map", func() {
 			NestedInputObject := graphql.MustNewInputObject(&graphql.InputObjectConfig{
 				Name: "NestedInputObject",
+				Fields: graphql.InputFields{
+					"value": {
+						Type: graphql.T(graphql.String()),
+					},
+				},
 			})
 
 			SomeInputObject := graphql.MustNewInputObject(&graphql.InputObjectConfig{
@@ -97,11 +113,21 @@ var _ = Describe("Type System: Schema", func() {
 					"arg": {
 						Type: &graphql.InputObjectConfig{
 							Name: "Foo",
+							Fields: graphql.InputFields{
+								"value": {
+									Type: graphql.T(graphql.String()),
+								},
+							},
 						},
 					},
 					"argList": {
 						Type: &graphql.InputObjectConfig{
 							Name: "Bar",
+							Fields: graphql.InputFields{
+								"value": {
+									Type: graphql.T(graphql.String()),
+								},
+							},
 						},
 					},
 				},
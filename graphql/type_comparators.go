@@ -42,8 +42,17 @@ func IsTypeSubTypeOf(schema Schema, maybeSubType Type, superType Type) bool {
 	case AbstractType:
 		// If superType type is an abstract type, maybeSubType type may be a currently possible object
 		// type.
-		if maybeSubType, ok := maybeSubType.(Object); ok {
+		switch maybeSubType := maybeSubType.(type) {
+		case Object:
 			return schema.PossibleTypes(superType).Contains(maybeSubType)
+
+		case Interface:
+			// Or maybeSubType may be an Interface type that implements superType.
+			for _, iface := range transitiveInterfaces(maybeSubType.Interfaces()) {
+				if iface == superType {
+					return true
+				}
+			}
 		}
 		return false
 
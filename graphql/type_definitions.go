@@ -126,6 +126,12 @@ type ScalarTypeData struct {
 
 	// Description of the Scalar type
 	Description string
+
+	// URL to the specification that defines the behavior of the Scalar type
+	SpecifiedByURL string
+
+	// Directives applied to the type
+	Directives AppliedDirectives
 }
 
 // ScalarTypeDefinition provides data accessors that are required for defining a Scalar.
@@ -199,6 +205,9 @@ type EnumValueDefinition struct {
 
 	// Deprecation is non-nil when the value is tagged as deprecated.
 	Deprecation *Deprecation
+
+	// Directives applied to the enum value
+	Directives AppliedDirectives
 }
 
 // EnumTypeData contains type data for Enum.
@@ -209,6 +218,9 @@ type EnumTypeData struct {
 	// Description of the Enum type
 	Description string
 
+	// Directives applied to the type
+	Directives AppliedDirectives
+
 	// Values to be defined in the Enum type
 	Values EnumValueDefinitionMap
 }
@@ -252,11 +264,18 @@ type ObjectTypeData struct {
 	// Description of the Object type
 	Description string
 
+	// Directives applied to the type
+	Directives AppliedDirectives
+
 	// Interfaces that implemented by the defining Object
 	Interfaces []InterfaceTypeDefinition
 
 	// Fields in the Object Type
 	Fields Fields
+
+	// IsTypeOf determines whether a value belongs to the defining Object (optional). It is used to
+	// resolve the concrete Object type for an Interface or a Union that doesn't provide TypeResolver.
+	IsTypeOf TypeChecker
 }
 
 // ObjectTypeDefinition provides data accessors that are required for defining a Object.
@@ -279,6 +298,12 @@ type InterfaceTypeData struct {
 	// Description of the Interface type
 	Description string
 
+	// Directives applied to the type
+	Directives AppliedDirectives
+
+	// Interfaces that implemented by the defining Interface
+	Interfaces []InterfaceTypeDefinition
+
 	// Fields in the Interface Type
 	Fields Fields
 }
@@ -307,6 +332,33 @@ func (f TypeResolverFunc) Resolve(ctx context.Context, value interface{}, info R
 // TypeResolverFunc implements TypeResolver.
 var _ TypeResolver = TypeResolverFunc(nil)
 
+// TypeChecker determines whether a value belongs to an Object type. When an Interface or a Union
+// doesn't have a TypeResolver, the executor calls the TypeChecker of each possible type in order
+// and resolves the value to the first Object type that accepts it.
+type TypeChecker interface {
+	// Context carries deadlines and cancelation signals.
+	//
+	// Value is the value returning from the field resolver of the field with abstract type that is
+	// being resolved.
+	//
+	// Info contains a collection of information about the current execution state.
+	//
+	// The result is either a bool or a future.Future that resolves to a bool when the check requires
+	// an asynchronous computation.
+	IsTypeOf(ctx context.Context, value interface{}, info ResolveInfo) (interface{}, error)
+}
+
+// TypeCheckerFunc is an adapter to allow the use of ordinary functions as TypeChecker.
+type TypeCheckerFunc func(ctx context.Context, value interface{}, info ResolveInfo) (interface{}, error)
+
+// IsTypeOf calls f(ctx, value, info).
+func (f TypeCheckerFunc) IsTypeOf(ctx context.Context, value interface{}, info ResolveInfo) (interface{}, error) {
+	return f(ctx, value, info)
+}
+
+// TypeCheckerFunc implements TypeChecker.
+var _ TypeChecker = TypeCheckerFunc(nil)
+
 // InterfaceTypeDefinition provides data accessors that are required for defining a Interface.
 type InterfaceTypeDefinition interface {
 	TypeDefinition
@@ -331,6 +383,9 @@ type UnionTypeData struct {
 	// Description of the Union type
 	Description string
 
+	// Directives applied to the type
+	Directives AppliedDirectives
+
 	// PossibleTypes describes which Object types can be represented by the defining union.
 	PossibleTypes []ObjectTypeDefinition
 }
@@ -359,8 +414,15 @@ type InputObjectTypeData struct {
 	// Description of the Input Object type
 	Description string
 
+	// Directives applied to the type
+	Directives AppliedDirectives
+
 	// Fields in the InputObject Type
 	Fields InputFields
+
+	// IsOneOf indicates whether the Input Object is a OneOf Input Object which requires exactly one
+	// of its fields to be provided with a non-null value.
+	IsOneOf bool
 }
 
 // InputObjectTypeDefinition provides data accessors that are required for defining a InputObject.
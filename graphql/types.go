@@ -78,7 +78,8 @@ type WrappingType interface {
 	graphqlWrappingType()
 }
 
-// Deprecation contains information about deprecation for a field or an enum value.
+// Deprecation contains information about deprecation for a field, an argument, an input field or an
+// enum value.
 //
 // See https://graphql.github.io/graphql-spec/June2018/#sec-Deprecation.
 type Deprecation struct {
@@ -107,6 +108,21 @@ type TypeWithDescription interface {
 	Description() string
 }
 
+// TypeWithDirectives is implemented by the types that can be applied directives.
+type TypeWithDirectives interface {
+	// Directives returns the directives applied to the type.
+	Directives() AppliedDirectives
+}
+
+// DirectivesOf returns the directives applied to the given type or nil if the type doesn't support
+// applied directives.
+func DirectivesOf(t Type) AppliedDirectives {
+	if t, ok := t.(TypeWithDirectives); ok {
+		return t.Directives()
+	}
+	return nil
+}
+
 //===----------------------------------------------------------------------------------------====//
 // Scalar
 //===----------------------------------------------------------------------------------------====//
@@ -128,6 +144,10 @@ type Scalar interface {
 	// the scalar.
 	CoerceLiteralValue(value ast.Value) (interface{}, error)
 
+	// SpecifiedByURL returns the URL to the specification that defines the behavior of the scalar or
+	// an empty string if there's none.
+	SpecifiedByURL() string
+
 	// graphqlScalarType puts a special mark for scalar type.
 	graphqlScalarType()
 }
@@ -251,6 +271,10 @@ type Object interface {
 	// Interfaces includes interfaces that implemented by the Object type.
 	Interfaces() []Interface
 
+	// TypeChecker returns the checker that determines whether a value belongs to the Object type or
+	// nil if the Object type doesn't provide one.
+	TypeChecker() TypeChecker
+
 	// graphqlObjectType puts a special mark for an Object type.
 	graphqlObjectType()
 }
@@ -281,6 +305,9 @@ type Interface interface {
 	// Fields returns set of fields that needs to be provided when implementing this interface.
 	Fields() FieldMap
 
+	// Interfaces includes interfaces that implemented by the Interface type.
+	Interfaces() []Interface
+
 	// graphqlInterfaceType puts a special mark for an Interface type.
 	graphqlInterfaceType()
 }
@@ -432,6 +459,9 @@ type EnumValue interface {
 
 	// Deprecation is non-nil when the value is tagged as deprecated.
 	Deprecation() *Deprecation
+
+	// Directives returns the directives applied to the enum value.
+	Directives() AppliedDirectives
 }
 
 //===------------------------------------------------------------------------------------------===//
@@ -456,6 +486,9 @@ type InputObject interface {
 
 	Fields() InputFieldMap
 
+	// IsOneOf returns true if exactly one of the fields must be provided with a non-null value.
+	IsOneOf() bool
+
 	// graphqlInputObjectType puts a special mark for an Input Object type.
 	graphqlInputObjectType()
 }
@@ -487,6 +520,12 @@ type InputField interface {
 
 	// DefaultValue specified the value to be assigned to the field when no input is provided.
 	DefaultValue() interface{}
+
+	// Deprecation is non-nil when the field is tagged as deprecated.
+	Deprecation() *Deprecation
+
+	// Directives returns the directives applied to the field.
+	Directives() AppliedDirectives
 }
 
 // IsRequiredInputField returns true if value must be provided to the input field for execution.
@@ -27,6 +27,9 @@ type UnionConfig struct {
 	// Description for the Union type
 	Description string
 
+	// Directives applied to the type
+	Directives AppliedDirectives
+
 	// PossibleTypes describes which Object types can be represented by the defining union.
 	PossibleTypes []ObjectTypeDefinition
 
@@ -45,6 +48,7 @@ func (config *UnionConfig) TypeData() UnionTypeData {
 	return UnionTypeData{
 		Name:          config.Name,
 		Description:   config.Description,
+		Directives:    config.Directives,
 		PossibleTypes: config.PossibleTypes,
 	}
 }
@@ -154,6 +158,11 @@ func (u *union) Description() string {
 	return u.data.Description
 }
 
+// Directives implements TypeWithDirectives.
+func (u *union) Directives() AppliedDirectives {
+	return u.data.Directives
+}
+
 // PossibleTypes implements Union.
 func (u *union) PossibleTypes() PossibleTypeSet {
 	return u.possibleTypes
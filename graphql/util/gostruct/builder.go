@@ -0,0 +1,695 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package gostruct
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/url"
+	"reflect"
+	"time"
+
+	"github.com/botobag/artemis/graphql"
+	"github.com/botobag/artemis/graphql/scalars"
+)
+
+var (
+	contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+	errorType   = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// TypeConfig overrides the name and description of the GraphQL type derived from a Go type.
+type TypeConfig struct {
+	// Name of the GraphQL type; Go type name is used if not specified.
+	Name string
+
+	// Description of the GraphQL type
+	Description string
+}
+
+// abstractType stores the Interface or Union registered for a Go interface type.
+type abstractType struct {
+	// The Go interface type
+	goType reflect.Type
+
+	// Either iface or union is set.
+	iface *graphql.InterfaceConfig
+	union *graphql.UnionConfig
+
+	// Go types of the members of union
+	members []reflect.Type
+
+	// True if the fields of iface or possible types of union have been built.
+	built bool
+}
+
+// Builder derives GraphQL type definitions from Go types. Mappings for custom leaf types and
+// abstract types should be registered before building any types that depend on them. Builder is not
+// safe for concurrent use.
+type Builder struct {
+	// Go types that are mapped to Scalars or Enums
+	leafTypes map[reflect.Type]graphql.TypeDefinition
+
+	// Custom names and descriptions for Go types
+	typeConfigs map[reflect.Type]TypeConfig
+
+	// Go interface types that are mapped to Interfaces or Unions in the order of registration
+	abstractTypes    []*abstractType
+	abstractTypesMap map[reflect.Type]*abstractType
+
+	// Object types derived from Go struct types in the order of creation
+	objects     []*graphql.ObjectConfig
+	objectsMap  map[reflect.Type]*graphql.ObjectConfig
+	inputsMap   map[reflect.Type]*graphql.InputObjectConfig
+	typeNameMap map[string]reflect.Type
+}
+
+// NewBuilder creates a Builder with the default type mappings.
+func NewBuilder() *Builder {
+	b := &Builder{
+		leafTypes:        map[reflect.Type]graphql.TypeDefinition{},
+		typeConfigs:      map[reflect.Type]TypeConfig{},
+		abstractTypesMap: map[reflect.Type]*abstractType{},
+		objectsMap:       map[reflect.Type]*graphql.ObjectConfig{},
+		inputsMap:        map[reflect.Type]*graphql.InputObjectConfig{},
+		typeNameMap:      map[string]reflect.Type{},
+	}
+
+	for _, mapping := range []struct {
+		t      reflect.Type
+		scalar graphql.Scalar
+	}{
+		{reflect.TypeOf(time.Time{}), scalars.DateTime()},
+		{reflect.TypeOf(time.Duration(0)), scalars.Duration()},
+		{reflect.TypeOf([]byte(nil)), scalars.Base64()},
+		{reflect.TypeOf((*big.Int)(nil)), scalars.BigInt()},
+		{reflect.TypeOf((*big.Float)(nil)), scalars.Decimal()},
+		{reflect.TypeOf((*url.URL)(nil)), scalars.URL()},
+		{reflect.TypeOf(map[string]interface{}(nil)), scalars.JSON()},
+	} {
+		b.leafTypes[mapping.t] = graphql.T(mapping.scalar)
+	}
+
+	return b
+}
+
+// RegisterScalar maps the Go type of v to the given Scalar. Note that if v is a pointer, only the
+// pointer type is mapped.
+func (b *Builder) RegisterScalar(v interface{}, scalar graphql.Scalar) {
+	b.leafTypes[reflect.TypeOf(v)] = graphql.T(scalar)
+}
+
+// RegisterEnum maps the Go type of v to an Enum defined by config. Internal values of enum values
+// in config should be of the Go type so they can be assigned to the fields in argument structs. If
+// ResultCoercerFactory is not provided in config, results are coerced by looking up the enum value
+// whose internal value matches.
+func (b *Builder) RegisterEnum(v interface{}, config *graphql.EnumConfig) {
+	if config.ResultCoercerFactory == nil {
+		config.ResultCoercerFactory = graphql.DefaultEnumResultCoercerFactory(
+			graphql.DefaultEnumResultCoercerLookupByValue)
+	}
+	b.leafTypes[reflect.TypeOf(v)] = config
+}
+
+// RegisterType specifies the name and description for the Object or InputObject derived from the
+// Go struct type of v.
+func (b *Builder) RegisterType(v interface{}, config TypeConfig) {
+	b.typeConfigs[structTypeOf(reflect.TypeOf(v))] = config
+}
+
+// interfaceTypeOf returns the Go interface type pointed by v which should be in the form of
+// (*SomeInterface)(nil).
+func interfaceTypeOf(v interface{}) (reflect.Type, error) {
+	t := reflect.TypeOf(v)
+	if t == nil || t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Interface {
+		return nil, graphql.NewError(fmt.Sprintf(
+			"expect a pointer to an interface type (e.g., (*Node)(nil)), but got %T", v))
+	}
+	return t.Elem(), nil
+}
+
+// RegisterInterface maps the Go interface type to the Interface defined by config. v must be a nil
+// pointer to the interface type (e.g., (*Node)(nil)). If Fields in config is not given, they're
+// derived from the methods of the Go interface type that have the signature of resolvers. Object
+// types derived from the Go types that implement the Go interface type implement the Interface.
+func (b *Builder) RegisterInterface(v interface{}, config *graphql.InterfaceConfig) error {
+	t, err := interfaceTypeOf(v)
+	if err != nil {
+		return err
+	}
+
+	abstract := &abstractType{
+		goType: t,
+		iface:  config,
+	}
+	b.abstractTypes = append(b.abstractTypes, abstract)
+	b.abstractTypesMap[t] = abstract
+
+	return nil
+}
+
+// RegisterUnion maps the Go interface type to the Union defined by config. v must be a nil pointer
+// to the interface type (e.g., (*SearchResult)(nil)). If PossibleTypes in config is not given, they
+// are derived from the Go struct types of members.
+func (b *Builder) RegisterUnion(v interface{}, config *graphql.UnionConfig, members ...interface{}) error {
+	t, err := interfaceTypeOf(v)
+	if err != nil {
+		return err
+	}
+
+	abstract := &abstractType{
+		goType: t,
+		union:  config,
+	}
+	for _, member := range members {
+		memberType := structTypeOf(reflect.TypeOf(member))
+		if memberType.Kind() != reflect.Struct {
+			return graphql.NewError(fmt.Sprintf("member of Union %s must be a struct, but got %T",
+				config.Name, member))
+		}
+		abstract.members = append(abstract.members, memberType)
+	}
+
+	b.abstractTypes = append(b.abstractTypes, abstract)
+	b.abstractTypesMap[t] = abstract
+
+	return nil
+}
+
+// structTypeOf removes the pointer from t if t is a pointer to a struct.
+func structTypeOf(t reflect.Type) reflect.Type {
+	if t != nil && t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Struct {
+		return t.Elem()
+	}
+	return t
+}
+
+// Object returns the Object type derived from the Go struct type of v which is either a struct or a
+// pointer to a struct.
+func (b *Builder) Object(v interface{}) (*graphql.ObjectConfig, error) {
+	t := structTypeOf(reflect.TypeOf(v))
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, graphql.NewError(fmt.Sprintf("expect a struct to derive Object, but got %T", v))
+	}
+	return b.objectOf(t)
+}
+
+// InputObject returns the InputObject type derived from the Go struct type of v which is either a
+// struct or a pointer to a struct.
+func (b *Builder) InputObject(v interface{}) (*graphql.InputObjectConfig, error) {
+	t := structTypeOf(reflect.TypeOf(v))
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, graphql.NewError(fmt.Sprintf("expect a struct to derive InputObject, but got %T", v))
+	}
+	return b.inputObjectOf(t)
+}
+
+// SchemaConfig specifies the Go types for creating a schema with BuildSchema.
+type SchemaConfig struct {
+	// Query is a struct (or a pointer to a struct) for the query root type.
+	Query interface{}
+
+	// Mutation is a struct (or a pointer to a struct) for the mutation root type (optional).
+	Mutation interface{}
+
+	// Subscription is a struct (or a pointer to a struct) for the subscription root type
+	// (optional).
+	Subscription interface{}
+
+	// Types contains additional struct types that cannot be found by traversing from root types
+	// (e.g., implementations of an Interface).
+	Types []interface{}
+
+	// Directives to be included in the schema
+	Directives []graphql.Directive
+}
+
+// BuildSchema creates a schema from the Go types given in config. All Object types derived by the
+// Builder are added to the schema.
+func (b *Builder) BuildSchema(config *SchemaConfig) (graphql.Schema, error) {
+	var (
+		schemaConfig = &graphql.SchemaConfig{
+			Directives: config.Directives,
+		}
+		err error
+	)
+
+	rootTypes := []struct {
+		v   interface{}
+		dst *graphql.Object
+	}{
+		{config.Query, &schemaConfig.Query},
+		{config.Mutation, &schemaConfig.Mutation},
+		{config.Subscription, &schemaConfig.Subscription},
+	}
+
+	rootTypeDefs := make([]*graphql.ObjectConfig, len(rootTypes))
+	for i, rootType := range rootTypes {
+		if rootType.v == nil {
+			continue
+		}
+		rootTypeDefs[i], err = b.Object(rootType.v)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, t := range config.Types {
+		if _, err := b.Object(t); err != nil {
+			return nil, err
+		}
+	}
+
+	for i, rootTypeDef := range rootTypeDefs {
+		if rootTypeDef == nil {
+			continue
+		}
+		object, err := graphql.NewObject(rootTypeDef)
+		if err != nil {
+			return nil, err
+		}
+		*rootTypes[i].dst = object
+	}
+
+	for _, objectDef := range b.objects {
+		object, err := graphql.NewObject(objectDef)
+		if err != nil {
+			return nil, err
+		}
+		schemaConfig.Types = append(schemaConfig.Types, object)
+	}
+
+	return graphql.NewSchema(schemaConfig)
+}
+
+// typeNameOf returns the name and description of the GraphQL type derived from the Go struct type.
+func (b *Builder) typeNameOf(t reflect.Type, defaultName string) (name string, description string, err error) {
+	config := b.typeConfigs[t]
+	name = config.Name
+	if len(name) == 0 {
+		name = defaultName
+	}
+	if len(name) == 0 {
+		return "", "", graphql.NewError(fmt.Sprintf("cannot derive type name for unnamed type %s", t))
+	}
+
+	// Make sure that different Go types don't derive the same GraphQL type name.
+	if existing, exists := b.typeNameMap[name]; exists && existing != t {
+		return "", "", graphql.NewError(fmt.Sprintf(`type name "%s" derived from %s has been used by %s`,
+			name, t, existing))
+	}
+	b.typeNameMap[name] = t
+
+	return name, config.Description, nil
+}
+
+// objectOf returns the Object type derived from the given Go struct type.
+func (b *Builder) objectOf(t reflect.Type) (*graphql.ObjectConfig, error) {
+	if config, exists := b.objectsMap[t]; exists {
+		return config, nil
+	}
+
+	name, description, err := b.typeNameOf(t, t.Name())
+	if err != nil {
+		return nil, err
+	}
+
+	config := &graphql.ObjectConfig{
+		Name:        name,
+		Description: description,
+		IsTypeOf:    typeChecker{t},
+	}
+	// Register the type before building fields to handle types that reference to themselves.
+	b.objectsMap[t] = config
+	b.objects = append(b.objects, config)
+
+	// Find Interfaces implemented by the type.
+	ptrType := reflect.PtrTo(t)
+	for _, abstract := range b.abstractTypes {
+		if abstract.iface != nil && ptrType.Implements(abstract.goType) {
+			iface, err := b.interfaceOf(abstract)
+			if err != nil {
+				return nil, err
+			}
+			config.Interfaces = append(config.Interfaces, iface)
+		}
+	}
+
+	config.Fields = graphql.Fields{}
+	err = visitStructFields(t, func(field reflect.StructField, index []int, tag fieldTag) error {
+		fieldType, err := b.outputTypeOf(field.Type)
+		if err != nil {
+			return fmt.Errorf("field %s: %s", field.Name, err)
+		}
+		config.Fields[fieldNameOf(field.Name, tag)] = graphql.FieldConfig{
+			Description: tag.Description,
+			Type:        fieldType,
+			Deprecation: tag.Deprecation,
+			Resolver:    structFieldResolver{index},
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, graphql.NewError(fmt.Sprintf("%s: %s", t, err))
+	}
+
+	// Add fields from the methods.
+	for i, numMethods := 0, ptrType.NumMethod(); i < numMethods; i++ {
+		method := ptrType.Method(i)
+		signature, ok := resolverSignatureOf(method.Type, 1)
+		if !ok {
+			continue
+		}
+
+		name := fieldNameOf(method.Name, fieldTag{})
+		if _, exists := config.Fields[name]; exists {
+			// Struct field takes precedence.
+			continue
+		}
+
+		fieldConfig, err := b.methodFieldOf(signature)
+		if err != nil {
+			return nil, graphql.NewError(fmt.Sprintf("%s.%s: %s", t, method.Name, err))
+		}
+		fieldConfig.Resolver = &methodResolver{
+			name:      method.Name,
+			signature: signature,
+		}
+		config.Fields[name] = fieldConfig
+	}
+
+	return config, nil
+}
+
+// interfaceOf returns the Interface registered for the abstract type and builds its fields if not
+// given.
+func (b *Builder) interfaceOf(abstract *abstractType) (*graphql.InterfaceConfig, error) {
+	config := abstract.iface
+	if abstract.built || config.Fields != nil {
+		return config, nil
+	}
+	abstract.built = true
+
+	t := abstract.goType
+	config.Fields = graphql.Fields{}
+	for i, numMethods := 0, t.NumMethod(); i < numMethods; i++ {
+		method := t.Method(i)
+		signature, ok := resolverSignatureOf(method.Type, 0)
+		if !ok {
+			continue
+		}
+
+		fieldConfig, err := b.methodFieldOf(signature)
+		if err != nil {
+			return nil, graphql.NewError(fmt.Sprintf("%s.%s: %s", t, method.Name, err))
+		}
+		config.Fields[fieldNameOf(method.Name, fieldTag{})] = fieldConfig
+	}
+
+	return config, nil
+}
+
+// unionOf returns the Union registered for the abstract type and builds its possible types if not
+// given.
+func (b *Builder) unionOf(abstract *abstractType) (*graphql.UnionConfig, error) {
+	config := abstract.union
+	if abstract.built || config.PossibleTypes != nil {
+		return config, nil
+	}
+	abstract.built = true
+
+	for _, member := range abstract.members {
+		object, err := b.objectOf(member)
+		if err != nil {
+			return nil, err
+		}
+		config.PossibleTypes = append(config.PossibleTypes, object)
+	}
+
+	return config, nil
+}
+
+// resolverSignature describes a method that can be used as a field resolver.
+type resolverSignature struct {
+	// Type of the argument struct; nil if the method doesn't take arguments.
+	argsType reflect.Type
+
+	// Type of the value resolved by the method
+	resultType reflect.Type
+}
+
+// resolverSignatureOf checks whether the function type has one of the following signatures
+// (excluding the first numReceivers parameters):
+//
+//	func (ctx context.Context) (T, error)
+//	func (ctx context.Context, args A) (T, error)
+func resolverSignatureOf(t reflect.Type, numReceivers int) (resolverSignature, bool) {
+	numIn := t.NumIn() - numReceivers
+	if numIn < 1 || numIn > 2 || t.In(numReceivers) != contextType {
+		return resolverSignature{}, false
+	}
+
+	if t.NumOut() != 2 || t.Out(1) != errorType {
+		return resolverSignature{}, false
+	}
+
+	signature := resolverSignature{
+		resultType: t.Out(0),
+	}
+
+	if numIn == 2 {
+		argsType := t.In(numReceivers + 1)
+		if structTypeOf(argsType).Kind() != reflect.Struct {
+			return resolverSignature{}, false
+		}
+		signature.argsType = argsType
+	}
+
+	return signature, true
+}
+
+// methodFieldOf returns a FieldConfig for a method with the given signature.
+func (b *Builder) methodFieldOf(signature resolverSignature) (graphql.FieldConfig, error) {
+	fieldType, err := b.outputTypeOf(signature.resultType)
+	if err != nil {
+		return graphql.FieldConfig{}, err
+	}
+
+	fieldConfig := graphql.FieldConfig{
+		Type: fieldType,
+	}
+
+	if signature.argsType != nil {
+		fieldConfig.Args = graphql.ArgumentConfigMap{}
+		err := visitStructFields(structTypeOf(signature.argsType), func(field reflect.StructField, index []int, tag fieldTag) error {
+			argType, err := b.inputTypeOf(field.Type)
+			if err != nil {
+				return fmt.Errorf("argument %s: %s", field.Name, err)
+			}
+			fieldConfig.Args[fieldNameOf(field.Name, tag)] = graphql.ArgumentConfig{
+				Description: tag.Description,
+				Type:        argType,
+				Deprecation: tag.Deprecation,
+			}
+			return nil
+		})
+		if err != nil {
+			return graphql.FieldConfig{}, err
+		}
+	}
+
+	return fieldConfig, nil
+}
+
+// inputObjectOf returns the InputObject type derived from the given Go struct type.
+func (b *Builder) inputObjectOf(t reflect.Type) (*graphql.InputObjectConfig, error) {
+	if config, exists := b.inputsMap[t]; exists {
+		return config, nil
+	}
+
+	// Append "Input" to the Go type name by default to avoid conflicts with the Object derived from
+	// the same type.
+	defaultName := t.Name()
+	if len(defaultName) > 0 && !hasInputSuffix(defaultName) {
+		defaultName += "Input"
+	}
+	name, description, err := b.typeNameOf(t, defaultName)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &graphql.InputObjectConfig{
+		Name:        name,
+		Description: description,
+		Fields:      graphql.InputFields{},
+	}
+	// Register the type before building fields to handle types that reference to themselves.
+	b.inputsMap[t] = config
+
+	err = visitStructFields(t, func(field reflect.StructField, index []int, tag fieldTag) error {
+		fieldType, err := b.inputTypeOf(field.Type)
+		if err != nil {
+			return fmt.Errorf("field %s: %s", field.Name, err)
+		}
+		config.Fields[fieldNameOf(field.Name, tag)] = graphql.InputFieldDefinition{
+			Description: tag.Description,
+			Type:        fieldType,
+			Deprecation: tag.Deprecation,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, graphql.NewError(fmt.Sprintf("%s: %s", t, err))
+	}
+
+	return config, nil
+}
+
+func hasInputSuffix(name string) bool {
+	const suffix = "Input"
+	return len(name) >= len(suffix) && name[len(name)-len(suffix):] == suffix
+}
+
+// visitStructFields calls visit for each exported field in the struct type including the ones
+// promoted from anonymous embedded structs. Errors returned from visit are passed through as is.
+func visitStructFields(
+	t reflect.Type,
+	visit func(field reflect.StructField, index []int, tag fieldTag) error) error {
+
+	for i, numFields := 0, t.NumField(); i < numFields; i++ {
+		field := t.Field(i)
+
+		tag, err := parseFieldTag(field.Tag.Get(tagName))
+		if err != nil {
+			return fmt.Errorf("field %s: %s", field.Name, err)
+		} else if tag.Skip {
+			continue
+		}
+
+		// Skip unexported fields. This includes embedded structs of unexported types whose fields
+		// cannot be accessed with reflection.
+		if len(field.PkgPath) > 0 {
+			continue
+		}
+
+		// Promote fields in anonymous embedded structs.
+		if field.Anonymous && len(tag.Name) == 0 && structTypeOf(field.Type).Kind() == reflect.Struct {
+			err := visitStructFields(structTypeOf(field.Type), func(f reflect.StructField, index []int, tag fieldTag) error {
+				return visit(f, append([]int{i}, index...), tag)
+			})
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := visit(field, []int{i}, tag); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// outputTypeOf returns the GraphQL output type for the Go type.
+func (b *Builder) outputTypeOf(t reflect.Type) (graphql.TypeDefinition, error) {
+	return b.typeOf(t, false)
+}
+
+// inputTypeOf returns the GraphQL input type for the Go type.
+func (b *Builder) inputTypeOf(t reflect.Type) (graphql.TypeDefinition, error) {
+	return b.typeOf(t, true)
+}
+
+// typeOf returns the GraphQL type for the Go type. A pointer becomes a nullable type while other
+// types are wrapped with NonNull.
+func (b *Builder) typeOf(t reflect.Type, input bool) (graphql.TypeDefinition, error) {
+	nullable := false
+	if t.Kind() == reflect.Ptr {
+		nullable = true
+		if typeDef, exists := b.leafTypes[t]; exists {
+			return typeDef, nil
+		}
+		t = t.Elem()
+	}
+
+	typeDef, err := b.namedTypeOf(t, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.Kind() == reflect.Interface {
+		// Interface values can be nil.
+		nullable = true
+	}
+
+	if nullable {
+		return typeDef, nil
+	}
+	return graphql.NonNullOf(typeDef), nil
+}
+
+// namedTypeOf returns the nullable GraphQL type for the given Go type that is not a pointer.
+func (b *Builder) namedTypeOf(t reflect.Type, input bool) (graphql.TypeDefinition, error) {
+	if typeDef, exists := b.leafTypes[t]; exists {
+		return typeDef, nil
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return graphql.T(graphql.String()), nil
+
+	case reflect.Bool:
+		return graphql.T(graphql.Boolean()), nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		return graphql.T(graphql.Int()), nil
+
+	case reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return graphql.T(scalars.Int64()), nil
+
+	case reflect.Float32, reflect.Float64:
+		return graphql.T(graphql.Float()), nil
+
+	case reflect.Slice, reflect.Array:
+		elementType, err := b.typeOf(t.Elem(), input)
+		if err != nil {
+			return nil, err
+		}
+		return graphql.ListOf(elementType), nil
+
+	case reflect.Struct:
+		if input {
+			return b.inputObjectOf(t)
+		}
+		return b.objectOf(t)
+
+	case reflect.Interface:
+		abstract, exists := b.abstractTypesMap[t]
+		if !exists {
+			break
+		} else if input {
+			return nil, fmt.Errorf("%s is mapped to an abstract type which cannot be used as input", t)
+		} else if abstract.iface != nil {
+			return b.interfaceOf(abstract)
+		}
+		return b.unionOf(abstract)
+	}
+
+	return nil, fmt.Errorf("cannot map %s to a GraphQL type", t)
+}
@@ -0,0 +1,407 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package gostruct_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/botobag/artemis/graphql"
+	"github.com/botobag/artemis/graphql/executor"
+	"github.com/botobag/artemis/graphql/parser"
+	"github.com/botobag/artemis/graphql/token"
+	"github.com/botobag/artemis/graphql/util/gostruct"
+	"github.com/botobag/artemis/graphql/util/sdl"
+	"github.com/botobag/artemis/internal/util"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type Episode int
+
+const (
+	NewHope Episode = iota + 4
+	Empire
+	Jedi
+)
+
+type Node interface {
+	ID(ctx context.Context) (string, error)
+}
+
+type SearchResult interface{}
+
+type Timestamps struct {
+	CreatedAt time.Time
+}
+
+type Human struct {
+	Timestamps
+	HumanID    string `graphql:"-"`
+	Name       string `graphql:",description=The name of the human, as known in the galaxy."`
+	Height     *float64
+	AppearsIn  []Episode
+	Friends    []*Human
+	homePlanet string
+}
+
+func (human *Human) ID(ctx context.Context) (string, error) {
+	return "human:" + human.HumanID, nil
+}
+
+type Droid struct {
+	DroidID         string `graphql:"-"`
+	Name            string
+	PrimaryFunction string `graphql:"function,deprecated=Use functions."`
+}
+
+func (droid Droid) ID(ctx context.Context) (string, error) {
+	return "droid:" + droid.DroidID, nil
+}
+
+type HumanArgs struct {
+	ID string
+}
+
+type SearchFilter struct {
+	MinHeight *float64
+	Episodes  []Episode
+}
+
+type SearchArgs struct {
+	Text   string
+	Filter *SearchFilter
+}
+
+type Query struct {
+	Humans []*Human
+	Droids []Droid
+}
+
+func (query *Query) Human(ctx context.Context, args HumanArgs) (*Human, error) {
+	for _, human := range query.Humans {
+		if human.HumanID == args.ID {
+			return human, nil
+		}
+	}
+	return nil, nil
+}
+
+func (query *Query) Hero(ctx context.Context) (Node, error) {
+	return query.Droids[0], nil
+}
+
+func (query *Query) Search(ctx context.Context, args *SearchArgs) ([]SearchResult, error) {
+	var results []SearchResult
+	for _, human := range query.Humans {
+		if !strings.Contains(human.Name, args.Text) {
+			continue
+		}
+		if filter := args.Filter; filter != nil {
+			if filter.MinHeight != nil && (human.Height == nil || *human.Height < *filter.MinHeight) {
+				continue
+			}
+		}
+		results = append(results, human)
+	}
+	for _, droid := range query.Droids {
+		if strings.Contains(droid.Name, args.Text) {
+			results = append(results, droid)
+		}
+	}
+	return results, nil
+}
+
+func (query *Query) Fail(ctx context.Context) (*string, error) {
+	return nil, fmt.Errorf("failed to resolve")
+}
+
+// String is not a resolver and shouldn't be included in the fields.
+func (query *Query) String() string {
+	return "Query"
+}
+
+func newBuilder() *gostruct.Builder {
+	builder := gostruct.NewBuilder()
+
+	builder.RegisterEnum(Episode(0), &graphql.EnumConfig{
+		Name: "Episode",
+		Values: graphql.EnumValueDefinitionMap{
+			"NEWHOPE": {Value: NewHope},
+			"EMPIRE":  {Value: Empire},
+			"JEDI":    {Value: Jedi},
+		},
+	})
+
+	Expect(builder.RegisterInterface((*Node)(nil), &graphql.InterfaceConfig{
+		Name: "Node",
+	})).Should(Succeed())
+
+	Expect(builder.RegisterUnion((*SearchResult)(nil), &graphql.UnionConfig{
+		Name: "SearchResult",
+	}, Human{}, Droid{})).Should(Succeed())
+
+	builder.RegisterType(Human{}, gostruct.TypeConfig{
+		Description: "A humanoid creature in the Star Wars universe.",
+	})
+
+	return builder
+}
+
+func executeQuery(schema graphql.Schema, query string, rootValue interface{}) string {
+	document := parser.MustParse(token.NewSource(query))
+
+	operation, errs := executor.Prepare(schema, document)
+	Expect(errs).Should(Equal(graphql.NoErrors()))
+
+	result := operation.Execute(context.Background(), executor.RootValue(rootValue))
+	resultJSON, err := json.Marshal(result)
+	Expect(err).ShouldNot(HaveOccurred())
+	return string(resultJSON)
+}
+
+var _ = Describe("Builder", func() {
+	var (
+		builder *gostruct.Builder
+		schema  graphql.Schema
+		root    *Query
+	)
+
+	BeforeEach(func() {
+		var err error
+		builder = newBuilder()
+		schema, err = builder.BuildSchema(&gostruct.SchemaConfig{
+			Query: &Query{},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		luke := &Human{
+			Timestamps: Timestamps{
+				CreatedAt: time.Date(1977, time.May, 25, 0, 0, 0, 0, time.UTC),
+			},
+			HumanID:   "1000",
+			Name:      "Luke Skywalker",
+			AppearsIn: []Episode{NewHope, Empire, Jedi},
+		}
+		height := 1.98
+		vader := &Human{
+			HumanID:   "1001",
+			Name:      "Darth Vader",
+			Height:    &height,
+			AppearsIn: []Episode{NewHope},
+			Friends:   []*Human{luke},
+		}
+		root = &Query{
+			Humans: []*Human{luke, vader},
+			Droids: []Droid{
+				{DroidID: "2001", Name: "R2-D2", PrimaryFunction: "Astromech"},
+			},
+		}
+	})
+
+	It("derives types from Go structs", func() {
+		Expect(sdl.PrintSchema(schema)).Should(Equal(util.Dedent(`
+      """
+      The ` + "`DateTime`" + ` scalar type represents an instant in time as a date-time string defined in RFC 3339 (e.g., ` + "`2019-06-01T08:30:00Z`" + `).
+      """
+      scalar DateTime @specifiedBy(url: "https://scalars.graphql.org/andimarek/date-time")
+
+      type Droid implements Node {
+        function: String! @deprecated(reason: "Use functions.")
+        id: String!
+        name: String!
+      }
+
+      enum Episode {
+        EMPIRE
+        JEDI
+        NEWHOPE
+      }
+
+      """A humanoid creature in the Star Wars universe."""
+      type Human implements Node {
+        appearsIn: [Episode!]!
+        createdAt: DateTime!
+        friends: [Human]!
+        height: Float
+        id: String!
+
+        """The name of the human, as known in the galaxy."""
+        name: String!
+      }
+
+      interface Node {
+        id: String!
+      }
+
+      type Query {
+        droids: [Droid!]!
+        fail: String
+        hero: Node
+        human(id: String!): Human
+        humans: [Human]!
+        search(filter: SearchFilterInput, text: String!): [SearchResult]!
+      }
+
+      input SearchFilterInput {
+        episodes: [Episode!]!
+        minHeight: Float
+      }
+
+      union SearchResult = Droid | Human
+    `)))
+	})
+
+	It("resolves fields from struct fields and methods", func() {
+		Expect(executeQuery(schema, `{
+      human(id: "1001") {
+        id
+        name
+        height
+        appearsIn
+        friends {
+          name
+          createdAt
+        }
+      }
+      hero {
+        id
+        ... on Droid {
+          function
+        }
+      }
+    }`, root)).Should(MatchJSON(`{
+			"data": {
+				"human": {
+					"id": "human:1001",
+					"name": "Darth Vader",
+					"height": 1.98,
+					"appearsIn": ["NEWHOPE"],
+					"friends": [{
+						"name": "Luke Skywalker",
+						"createdAt": "1977-05-25T00:00:00Z"
+					}]
+				},
+				"hero": {
+					"id": "droid:2001",
+					"function": "Astromech"
+				}
+			}
+		}`))
+	})
+
+	It("resolves missing object as null", func() {
+		Expect(executeQuery(schema, `{
+      human(id: "3000") {
+        name
+      }
+    }`, root)).Should(MatchJSON(`{
+			"data": {
+				"human": null
+			}
+		}`))
+	})
+
+	It("resolves fields from methods as null when there's no source value", func() {
+		Expect(executeQuery(schema, `{
+      hero {
+        id
+      }
+      fail
+    }`, nil)).Should(MatchJSON(`{
+			"data": {
+				"hero": null,
+				"fail": null
+			}
+		}`))
+	})
+
+	It("decodes input objects into argument structs", func() {
+		Expect(executeQuery(schema, `{
+      search(text: "D", filter: { minHeight: 1, episodes: [JEDI] }) {
+        __typename
+        ... on Human {
+          name
+        }
+        ... on Droid {
+          name
+        }
+      }
+    }`, root)).Should(MatchJSON(`{
+			"data": {
+				"search": [
+					{ "__typename": "Human", "name": "Darth Vader" },
+					{ "__typename": "Droid", "name": "R2-D2" }
+				]
+			}
+		}`))
+	})
+
+	It("reports errors returned from methods", func() {
+		Expect(executeQuery(schema, `{ fail }`, root)).Should(MatchJSON(`{
+			"errors": [{
+				"message": "failed to resolve",
+				"locations": [{ "line": 1, "column": 3 }],
+				"path": ["fail"]
+			}],
+			"data": {
+				"fail": null
+			}
+		}`))
+	})
+
+	It("rejects registering abstract type with non-interface type", func() {
+		Expect(builder.RegisterInterface(Human{}, &graphql.InterfaceConfig{
+			Name: "Bad",
+		})).Should(MatchError(
+			"expect a pointer to an interface type (e.g., (*Node)(nil)), but got gostruct_test.Human"))
+	})
+
+	It("rejects Go types that cannot be mapped", func() {
+		_, err := builder.Object(struct {
+			Ch chan int
+		}{})
+		Expect(err).Should(MatchError("cannot derive type name for unnamed type struct { Ch chan int }"))
+
+		type Bad struct {
+			Ch chan int
+		}
+		_, err = builder.Object(&Bad{})
+		Expect(err).Should(MatchError("gostruct_test.Bad: field Ch: cannot map chan int to a GraphQL type"))
+	})
+
+	It("rejects invalid tags", func() {
+		type Bad struct {
+			Name string `graphql:"name,required"`
+		}
+		_, err := builder.InputObject(Bad{})
+		Expect(err).Should(MatchError(`gostruct_test.Bad: field Name: unknown option "required" in tag "name,required"`))
+	})
+
+	It("rejects abstract types in input", func() {
+		type BadInput struct {
+			Node Node
+		}
+		_, err := builder.InputObject(BadInput{})
+		Expect(err).Should(MatchError(
+			"gostruct_test.BadInput: field Node: gostruct_test.Node is mapped to an abstract type which cannot be used as input"))
+	})
+})
@@ -0,0 +1,71 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+// Package gostruct derives GraphQL type definitions from Go types with reflection. It lets the Go
+// models be the single source of truth of a schema ("code-first") instead of writing ObjectConfig,
+// InputObjectConfig and EnumConfig by hand.
+//
+// # Struct Types
+//
+// A Go struct type becomes an Object when it is used as an output type or an InputObject when it is
+// used as an input type (e.g., a field in an argument struct). Exported fields become fields in
+// the GraphQL type. Options can be specified with the struct field tag:
+//
+//	type User struct {
+//		ID       string  `graphql:"id,description=Unique identifier of the user"`
+//		Name     string  `graphql:",description=Full name, in any format"`
+//		Nickname *string `graphql:",deprecated=Use name instead"`
+//		Password string  `graphql:"-"`
+//	}
+//
+// The first option is the field name which defaults to the Go field name in lower camel case (e.g.,
+// "firstName" for FirstName and "id" for ID). "-" excludes the field. The rest options are:
+//
+//	description=<text>: description of the field; It may contain commas.
+//	deprecated[=<reason>]: mark the field as deprecated with the given reason (or the default one).
+//
+// Fields in anonymous embedded structs of exported types are promoted to the embedding struct.
+//
+// Exported methods of a struct (defined on either the struct or its pointer) with one of the
+// following signatures also become fields in the Object. The arguments of the field are derived
+// from the args struct whose fields are specified in the same way as the input fields.
+//
+//	func (ctx context.Context) (T, error)
+//	func (ctx context.Context, args A) (T, error)  // A is a struct or a pointer to a struct
+//
+// # Type Mapping
+//
+// A pointer or an interface indicates a nullable type. Other types are non-nullable. A slice or an
+// array is mapped to a List of its element type. Built-in Go types are mapped as follows:
+//
+//	string                                   String
+//	bool                                     Boolean
+//	int, int8, int16, int32, uint8, uint16   Int
+//	int64, uint, uint32, uint64              Int64 (scalars.Int64)
+//	float32, float64                         Float
+//	time.Time                                DateTime (scalars.DateTime)
+//	time.Duration                            Duration (scalars.Duration)
+//	[]byte                                   Base64 (scalars.Base64)
+//	*big.Int                                 BigInt (scalars.BigInt)
+//	*big.Float                               Decimal (scalars.Decimal)
+//	*url.URL                                 URL (scalars.URL)
+//	map[string]interface{}                   JSON (scalars.JSON)
+//
+// Custom mappings can be added with RegisterScalar and RegisterEnum. Go interface types are mapped
+// to Interfaces and Unions that are registered with RegisterInterface and RegisterUnion. The Object
+// types derived by Builder provide IsTypeOf checks that compare the Go type of the value so
+// Interfaces and Unions don't need a TypeResolver.
+package gostruct
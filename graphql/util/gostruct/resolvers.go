@@ -0,0 +1,153 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package gostruct
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/botobag/artemis/graphql"
+)
+
+// indirect dereferences pointers and interfaces in v. It returns an invalid reflect.Value if nil
+// is encountered.
+func indirect(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+// typeChecker implements graphql.TypeChecker to match values of the Go struct type (or pointers to
+// it) for the Object derived from the type.
+type typeChecker struct {
+	t reflect.Type
+}
+
+var _ graphql.TypeChecker = typeChecker{}
+
+// IsTypeOf implements graphql.TypeChecker.
+func (checker typeChecker) IsTypeOf(ctx context.Context, value interface{}, info graphql.ResolveInfo) (interface{}, error) {
+	v := indirect(reflect.ValueOf(value))
+	return v.IsValid() && v.Type() == checker.t, nil
+}
+
+// structFieldResolver implements graphql.FieldResolver to resolve a field value from a struct field
+// specified by its index sequence (as in reflect.Value.FieldByIndex).
+type structFieldResolver struct {
+	index []int
+}
+
+var _ graphql.FieldResolver = structFieldResolver{}
+
+// Resolve implements graphql.FieldResolver.
+func (resolver structFieldResolver) Resolve(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+	v := indirect(reflect.ValueOf(source))
+	if !v.IsValid() {
+		return nil, nil
+	}
+
+	for i, index := range resolver.index {
+		if i > 0 {
+			// Embedded struct could be a nil pointer.
+			v = indirect(v)
+			if !v.IsValid() {
+				return nil, nil
+			}
+		}
+		v = v.Field(index)
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Map:
+		// Convert typed nil into untyped nil so it can be resolved as null.
+		if v.IsNil() {
+			return nil, nil
+		}
+	}
+
+	return v.Interface(), nil
+}
+
+// methodResolver implements graphql.FieldResolver to resolve a field value by calling a method on
+// the source value.
+type methodResolver struct {
+	// Name of the method
+	name string
+
+	// Signature of the method
+	signature resolverSignature
+}
+
+var _ graphql.FieldResolver = (*methodResolver)(nil)
+
+// Resolve implements graphql.FieldResolver.
+func (resolver *methodResolver) Resolve(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+	v := reflect.ValueOf(source)
+	if v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+
+	if !v.IsValid() {
+		// There's no source value (e.g., executing an operation without a root value.)
+		return nil, nil
+	} else if v.Kind() != reflect.Ptr {
+		// Make an addressable copy for calling methods with pointer receiver.
+		p := reflect.New(v.Type())
+		p.Elem().Set(v)
+		v = p
+	} else if v.IsNil() {
+		return nil, nil
+	}
+
+	method := v.MethodByName(resolver.name)
+	if !method.IsValid() {
+		return nil, graphql.NewError(fmt.Sprintf("%s does not have method %s", v.Type(), resolver.name))
+	}
+
+	in := []reflect.Value{reflect.ValueOf(ctx)}
+	if argsType := resolver.signature.argsType; argsType != nil {
+		args := reflect.New(structTypeOf(argsType))
+		if err := info.Args().Decode(args.Interface()); err != nil {
+			return nil, err
+		}
+		if argsType.Kind() == reflect.Ptr {
+			in = append(in, args)
+		} else {
+			in = append(in, args.Elem())
+		}
+	}
+
+	out := method.Call(in)
+	if err := out[1].Interface(); err != nil {
+		return nil, err.(error)
+	}
+
+	result := out[0]
+	switch result.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Map:
+		// Convert typed nil into untyped nil so it can be resolved as null.
+		if result.IsNil() {
+			return nil, nil
+		}
+	}
+	return result.Interface(), nil
+}
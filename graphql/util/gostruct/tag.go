@@ -0,0 +1,107 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package gostruct
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/botobag/artemis/graphql"
+	"github.com/botobag/artemis/internal/util"
+)
+
+// tagName is the key of struct field tag for specifying options. It shares the same key with
+// graphql.ArgumentValues.Decode so argument structs are decoded with the names in their tags.
+const tagName = graphql.DecodeTagName
+
+// fieldTag contains options specified in struct field tag.
+type fieldTag struct {
+	// Name of the field; Empty if not specified.
+	Name string
+
+	// Description of the field
+	Description string
+
+	// Deprecation is non-nil when the field is tagged as deprecated.
+	Deprecation *graphql.Deprecation
+
+	// Skip is true when the field is excluded with "-".
+	Skip bool
+}
+
+// isTagOption returns true if the given segment starts an option in the tag.
+func isTagOption(segment string) bool {
+	return strings.HasPrefix(segment, "description=") ||
+		segment == "deprecated" ||
+		strings.HasPrefix(segment, "deprecated=")
+}
+
+// parseFieldTag parses the value of struct field tag in the form of
+// "name,description=...,deprecated=...". Description and deprecation reason may contain commas. A
+// segment separated by a comma only starts a new option when it begins with a known option key.
+func parseFieldTag(tag string) (fieldTag, error) {
+	if tag == "-" {
+		return fieldTag{Skip: true}, nil
+	}
+
+	segments := strings.Split(tag, ",")
+	result := fieldTag{
+		Name: segments[0],
+	}
+
+	var options []string
+	for _, segment := range segments[1:] {
+		if len(options) == 0 || isTagOption(segment) {
+			options = append(options, segment)
+		} else {
+			options[len(options)-1] += "," + segment
+		}
+	}
+
+	for _, option := range options {
+		key, value := option, ""
+		if i := strings.IndexByte(option, '='); i >= 0 {
+			key, value = option[:i], option[i+1:]
+		}
+
+		switch key {
+		case "description":
+			result.Description = value
+
+		case "deprecated":
+			if len(value) == 0 {
+				value = graphql.DefaultDeprecationReason
+			}
+			result.Deprecation = &graphql.Deprecation{
+				Reason: value,
+			}
+
+		default:
+			return result, graphql.NewError(fmt.Sprintf(`unknown option "%s" in tag "%s"`, option, tag))
+		}
+	}
+
+	return result, nil
+}
+
+// fieldNameOf returns the GraphQL field name for a Go struct field or method.
+func fieldNameOf(goName string, tag fieldTag) string {
+	if len(tag.Name) > 0 {
+		return tag.Name
+	}
+	return util.LowerCamelCase(goName)
+}
@@ -0,0 +1,756 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package introspection
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/botobag/artemis/graphql"
+	"github.com/botobag/artemis/graphql/ast"
+	"github.com/botobag/artemis/graphql/internal/value"
+	"github.com/botobag/artemis/graphql/parser"
+	"github.com/botobag/artemis/graphql/token"
+)
+
+// Kinds of types in introspection result as represented by the __TypeKind enum.
+const (
+	scalarKind      = "SCALAR"
+	objectKind      = "OBJECT"
+	interfaceKind   = "INTERFACE"
+	unionKind       = "UNION"
+	enumKind        = "ENUM"
+	inputObjectKind = "INPUT_OBJECT"
+	listKind        = "LIST"
+	nonNullKind     = "NON_NULL"
+)
+
+// errCannotExecuteClientSchema is returned from resolvers in the schema built by BuildClientSchema.
+var errCannotExecuteClientSchema = graphql.NewError("Client schema cannot be used for execution.")
+
+// clientFieldResolver is set to every field in a client schema.
+var clientFieldResolver = graphql.FieldResolverFunc(
+	func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+		return nil, errCannotExecuteClientSchema
+	})
+
+// clientTypeResolver is set to every Interface and Union type in a client schema.
+var clientTypeResolver = graphql.TypeResolverFunc(
+	func(ctx context.Context, value interface{}, info graphql.ResolveInfo) (graphql.Object, error) {
+		return nil, errCannotExecuteClientSchema
+	})
+
+// clientScalarResultCoercer is used for custom scalars in a client schema. It returns the value
+// as-is.
+var clientScalarResultCoercer = graphql.ScalarResultCoercerFunc(
+	func(value interface{}) (interface{}, error) {
+		return value, nil
+	})
+
+// clientScalarInputCoercer is used for custom scalars in a client schema. It accepts any input
+// because the client has no knowledge about the format of the values.
+var clientScalarInputCoercer = graphql.ScalarInputCoercerFuncs{
+	CoerceVariableValueFunc: func(value interface{}) (interface{}, error) {
+		return value, nil
+	},
+	CoerceLiteralValueFunc: func(value ast.Value) (interface{}, error) {
+		return value.Interface(), nil
+	},
+}
+
+// BuildClientSchema builds a graphql.Schema from the result of the query constructed by Query.
+//
+// The schema is intended for tools that need type information about a remote GraphQL service such
+// as validating operations. Because it is built without the Go types that implement the service,
+// the schema cannot be used for execution: resolvers for fields and abstract types return an error
+// if invoked and custom scalars pass values through without coercion.
+func BuildClientSchema(result *Result) (graphql.Schema, error) {
+	if result == nil || result.Schema == nil {
+		return nil, graphql.NewError(`Invalid or incomplete introspection result. Ensure that you are ` +
+			`passing the "data" property of introspection response and no "errors" was returned alongside.`)
+	}
+
+	b := &clientSchemaBuilder{
+		typeDefs:          map[string]graphql.TypeDefinition{},
+		types:             map[string]*Type{},
+		inputObjectStates: map[string]inputObjectState{},
+	}
+	return b.build(result.Schema)
+}
+
+// inputObjectState tracks the progress of resolving default values in an Input Object.
+type inputObjectState int
+
+const (
+	inputObjectPending inputObjectState = iota
+	inputObjectFinishing
+	inputObjectFinished
+)
+
+// pendingArgumentDefaultValue records an argument whose default value is resolved after all Input
+// Objects are finished.
+type pendingArgumentDefaultValue struct {
+	args       graphql.ArgumentConfigMap
+	name       string
+	coordinate string
+	value      *InputValue
+}
+
+// clientSchemaBuilder builds a graphql.Schema from introspection result.
+type clientSchemaBuilder struct {
+	// TypeDefinition for each type by name
+	typeDefs map[string]graphql.TypeDefinition
+
+	// Introspection result for each type to be built by name
+	types map[string]*Type
+
+	// Names of the types to be built in the order of their appearance
+	typeNames []string
+
+	// State of resolving default values for the fields in each Input Object
+	inputObjectStates map[string]inputObjectState
+
+	// Arguments with default values to be resolved
+	pendingArgDefaults []pendingArgumentDefaultValue
+}
+
+func (b *clientSchemaBuilder) build(schema *Schema) (graphql.Schema, error) {
+	if err := b.createTypeDefs(schema.Types); err != nil {
+		return nil, err
+	}
+
+	// Fill type definitions.
+	for _, name := range b.typeNames {
+		if err := b.buildTypeDef(b.types[name]); err != nil {
+			return nil, err
+		}
+	}
+
+	directiveConfigs := make([]*graphql.DirectiveConfig, 0, len(schema.Directives))
+	for _, directive := range schema.Directives {
+		if isStandardDirective(directive.Name) {
+			continue
+		}
+		config, err := b.buildDirectiveConfig(directive)
+		if err != nil {
+			return nil, err
+		}
+		directiveConfigs = append(directiveConfigs, config)
+	}
+
+	// Resolve default values which requires creating the types for the values.
+	for _, name := range b.typeNames {
+		if _, isInputObject := b.typeDefs[name].(*graphql.InputObjectConfig); isInputObject {
+			if err := b.finishInputObject(name); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := b.resolvePendingArgDefaults(); err != nil {
+		return nil, err
+	}
+
+	// Create types.
+	types := make([]graphql.Type, 0, len(b.typeNames))
+	for _, name := range b.typeNames {
+		t, err := graphql.NewType(b.typeDefs[name])
+		if err != nil {
+			return nil, err
+		}
+		types = append(types, t)
+	}
+
+	config := &graphql.SchemaConfig{
+		Types: types,
+	}
+
+	// Create directives. Use the standard ones if they present in the result. Include the standard
+	// directives if the result doesn't include directives (e.g., from an old server).
+	if schema.Directives != nil {
+		config.ExcludeStandardDirectives = true
+		for _, directive := range graphql.StandardDirectives() {
+			for _, d := range schema.Directives {
+				if d.Name == directive.Name() {
+					config.Directives = append(config.Directives, directive)
+					break
+				}
+			}
+		}
+	}
+	for _, directiveConfig := range directiveConfigs {
+		directive, err := graphql.NewDirective(directiveConfig)
+		if err != nil {
+			return nil, err
+		}
+		config.Directives = append(config.Directives, directive)
+	}
+
+	// Determine root operation types.
+	var err error
+	if config.Query, err = b.rootOperationType("query", schema.QueryType); err != nil {
+		return nil, err
+	}
+	if config.Mutation, err = b.rootOperationType("mutation", schema.MutationType); err != nil {
+		return nil, err
+	}
+	if config.Subscription, err = b.rootOperationType("subscription", schema.SubscriptionType); err != nil {
+		return nil, err
+	}
+
+	return graphql.NewSchema(config)
+}
+
+// isStandardDirective returns true if the given name is one of the standard directives.
+func isStandardDirective(name string) bool {
+	for _, directive := range graphql.StandardDirectives() {
+		if directive.Name() == name {
+			return true
+		}
+	}
+	return false
+}
+
+// standardScalar returns the built-in Scalar type with the given name or nil if there's no such
+// one.
+func standardScalar(name string) graphql.Scalar {
+	switch name {
+	case "Int":
+		return graphql.Int()
+	case "Float":
+		return graphql.Float()
+	case "String":
+		return graphql.String()
+	case "Boolean":
+		return graphql.Boolean()
+	case "ID":
+		return graphql.ID()
+	}
+	return nil
+}
+
+// createTypeDefs creates a TypeDefinition for every type in the result such that type references
+// can be resolved when building fields. Standard scalars and introspection types are not built from
+// the result.
+func (b *clientSchemaBuilder) createTypeDefs(types []*Type) error {
+	for _, name := range []string{"Int", "Float", "String", "Boolean", "ID"} {
+		b.typeDefs[name] = graphql.T(standardScalar(name))
+	}
+	b.typeDefs["__Schema"] = graphql.T(graphql.IntrospectionTypes.Schema())
+	b.typeDefs["__Directive"] = graphql.T(graphql.IntrospectionTypes.Directive())
+	b.typeDefs["__Type"] = graphql.T(graphql.IntrospectionTypes.Type())
+	b.typeDefs["__Field"] = graphql.T(graphql.IntrospectionTypes.Field())
+	b.typeDefs["__InputValue"] = graphql.T(graphql.IntrospectionTypes.InputValue())
+	b.typeDefs["__EnumValue"] = graphql.T(graphql.IntrospectionTypes.EnumValue())
+
+	for _, t := range types {
+		name := t.Name
+		if len(name) == 0 {
+			return graphql.NewError(fmt.Sprintf(
+				"Invalid or incomplete introspection result. Missing name in type of kind %s.", t.Kind))
+		}
+
+		if standardScalar(name) != nil || strings.HasPrefix(name, "__") {
+			continue
+		}
+
+		if _, exists := b.types[name]; exists {
+			return graphql.NewError(fmt.Sprintf(
+				`Invalid or incomplete introspection result. Type "%s" is given more than once.`, name))
+		}
+
+		switch t.Kind {
+		case scalarKind:
+			b.typeDefs[name] = &graphql.ScalarConfig{
+				Name:           name,
+				Description:    t.Description,
+				SpecifiedByURL: t.SpecifiedByURL,
+				ResultCoercer:  clientScalarResultCoercer,
+				InputCoercer:   clientScalarInputCoercer,
+			}
+
+		case objectKind:
+			b.typeDefs[name] = &graphql.ObjectConfig{
+				Name:        name,
+				Description: t.Description,
+			}
+
+		case interfaceKind:
+			b.typeDefs[name] = &graphql.InterfaceConfig{
+				Name:         name,
+				Description:  t.Description,
+				TypeResolver: clientTypeResolver,
+			}
+
+		case unionKind:
+			b.typeDefs[name] = &graphql.UnionConfig{
+				Name:         name,
+				Description:  t.Description,
+				TypeResolver: clientTypeResolver,
+			}
+
+		case enumKind:
+			b.typeDefs[name] = &graphql.EnumConfig{
+				Name:        name,
+				Description: t.Description,
+			}
+
+		case inputObjectKind:
+			b.typeDefs[name] = &graphql.InputObjectConfig{
+				Name:        name,
+				Description: t.Description,
+			}
+
+		default:
+			return graphql.NewError(fmt.Sprintf(
+				`Invalid or incomplete introspection result. Unknown kind "%s" for type "%s".`, t.Kind, name))
+		}
+
+		b.types[name] = t
+		b.typeNames = append(b.typeNames, name)
+	}
+
+	return nil
+}
+
+// typeDefOf returns the TypeDefinition for the given type reference.
+func (b *clientSchemaBuilder) typeDefOf(ref *TypeRef) (graphql.TypeDefinition, error) {
+	if ref == nil {
+		return nil, graphql.NewError("Decorated type deeper than introspection query.")
+	}
+
+	switch ref.Kind {
+	case listKind:
+		elementTypeDef, err := b.typeDefOf(ref.OfType)
+		if err != nil {
+			return nil, err
+		}
+		return graphql.ListOf(elementTypeDef), nil
+
+	case nonNullKind:
+		innerTypeDef, err := b.typeDefOf(ref.OfType)
+		if err != nil {
+			return nil, err
+		}
+		return graphql.NonNullOf(innerTypeDef), nil
+	}
+
+	if len(ref.Name) == 0 {
+		return nil, graphql.NewError(fmt.Sprintf("Unknown type reference of kind %s.", ref.Kind))
+	}
+
+	typeDef, exists := b.typeDefs[ref.Name]
+	if !exists {
+		return nil, graphql.NewError(fmt.Sprintf("Invalid or incomplete schema, unknown type: %s. Ensure "+
+			"that a full introspection query is used in order to build a client schema.", ref.Name))
+	}
+
+	return typeDef, nil
+}
+
+// namedTypeOf returns the name of the innermost named type in the given type reference.
+func namedTypeOf(ref *TypeRef) string {
+	for ref != nil && (ref.Kind == listKind || ref.Kind == nonNullKind) {
+		ref = ref.OfType
+	}
+	if ref == nil {
+		return ""
+	}
+	return ref.Name
+}
+
+// deprecationOf returns a Deprecation if isDeprecated is true.
+func deprecationOf(isDeprecated bool, reason string) *graphql.Deprecation {
+	if !isDeprecated {
+		return nil
+	}
+	return &graphql.Deprecation{
+		Reason: reason,
+	}
+}
+
+// buildTypeDef fills the TypeDefinition created for the given type.
+func (b *clientSchemaBuilder) buildTypeDef(t *Type) error {
+	switch config := b.typeDefs[t.Name].(type) {
+	case *graphql.ObjectConfig:
+		return b.buildObject(config, t)
+
+	case *graphql.InterfaceConfig:
+		return b.buildInterface(config, t)
+
+	case *graphql.UnionConfig:
+		return b.buildUnion(config, t)
+
+	case *graphql.EnumConfig:
+		return b.buildEnum(config, t)
+
+	case *graphql.InputObjectConfig:
+		return b.buildInputObject(config, t)
+	}
+
+	return nil
+}
+
+func (b *clientSchemaBuilder) buildObject(config *graphql.ObjectConfig, t *Type) error {
+	if t.Interfaces == nil {
+		return graphql.NewError(fmt.Sprintf("Introspection result missing interfaces: %s.", t.Name))
+	}
+
+	interfaces, err := b.buildInterfaces(t)
+	if err != nil {
+		return err
+	}
+	config.Interfaces = interfaces
+
+	fields, err := b.buildFields(t)
+	if err != nil {
+		return err
+	}
+	config.Fields = fields
+
+	return nil
+}
+
+func (b *clientSchemaBuilder) buildInterface(config *graphql.InterfaceConfig, t *Type) error {
+	// Interfaces for an Interface type may be missing in the results from the servers that don't
+	// support interfaces implementing interfaces.
+	interfaces, err := b.buildInterfaces(t)
+	if err != nil {
+		return err
+	}
+	config.Interfaces = interfaces
+
+	fields, err := b.buildFields(t)
+	if err != nil {
+		return err
+	}
+	config.Fields = fields
+
+	return nil
+}
+
+// buildInterfaces returns the definitions of the interfaces implemented by the given Object or
+// Interface type.
+func (b *clientSchemaBuilder) buildInterfaces(t *Type) ([]graphql.InterfaceTypeDefinition, error) {
+	var interfaces []graphql.InterfaceTypeDefinition
+	for _, ref := range t.Interfaces {
+		typeDef, err := b.typeDefOf(ref)
+		if err != nil {
+			return nil, err
+		}
+
+		interfaceTypeDef, ok := typeDef.(*graphql.InterfaceConfig)
+		if !ok {
+			return nil, graphql.NewError(fmt.Sprintf(
+				"Type %s must only implement Interface types, it cannot implement %s.", t.Name, ref.Name))
+		}
+		interfaces = append(interfaces, interfaceTypeDef)
+	}
+	return interfaces, nil
+}
+
+func (b *clientSchemaBuilder) buildUnion(config *graphql.UnionConfig, t *Type) error {
+	if t.PossibleTypes == nil {
+		return graphql.NewError(fmt.Sprintf("Introspection result missing possibleTypes: %s.", t.Name))
+	}
+
+	for _, ref := range t.PossibleTypes {
+		typeDef, err := b.typeDefOf(ref)
+		if err != nil {
+			return err
+		}
+
+		objectTypeDef, ok := typeDef.(*graphql.ObjectConfig)
+		if !ok {
+			return graphql.NewError(fmt.Sprintf(
+				"Union type %s can only include Object types, it cannot include %s.", t.Name, ref.Name))
+		}
+		config.PossibleTypes = append(config.PossibleTypes, objectTypeDef)
+	}
+
+	return nil
+}
+
+func (b *clientSchemaBuilder) buildEnum(config *graphql.EnumConfig, t *Type) error {
+	if t.EnumValues == nil {
+		return graphql.NewError(fmt.Sprintf("Introspection result missing enumValues: %s.", t.Name))
+	}
+
+	config.Values = make(graphql.EnumValueDefinitionMap, len(t.EnumValues))
+	for _, value := range t.EnumValues {
+		config.Values[value.Name] = graphql.EnumValueDefinition{
+			Description: value.Description,
+			Deprecation: deprecationOf(value.IsDeprecated, value.DeprecationReason),
+		}
+	}
+
+	return nil
+}
+
+func (b *clientSchemaBuilder) buildInputObject(config *graphql.InputObjectConfig, t *Type) error {
+	if t.InputFields == nil {
+		return graphql.NewError(fmt.Sprintf("Introspection result missing inputFields: %s.", t.Name))
+	}
+
+	config.IsOneOf = t.IsOneOf
+
+	// Default values are resolved later in finishInputObject.
+	config.Fields = make(graphql.InputFields, len(t.InputFields))
+	for _, field := range t.InputFields {
+		typeDef, err := b.typeDefOf(field.Type)
+		if err != nil {
+			return err
+		}
+
+		config.Fields[field.Name] = graphql.InputFieldDefinition{
+			Description: field.Description,
+			Type:        typeDef,
+			Deprecation: deprecationOf(field.IsDeprecated, field.DeprecationReason),
+		}
+	}
+
+	return nil
+}
+
+// buildFields builds field configs for the given Object or Interface type.
+func (b *clientSchemaBuilder) buildFields(t *Type) (graphql.Fields, error) {
+	if t.Fields == nil {
+		return nil, graphql.NewError(fmt.Sprintf("Introspection result missing fields: %s.", t.Name))
+	}
+
+	fields := make(graphql.Fields, len(t.Fields))
+	for _, field := range t.Fields {
+		typeDef, err := b.typeDefOf(field.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		args, err := b.buildArgs(t.Name+"."+field.Name, field.Args)
+		if err != nil {
+			return nil, err
+		}
+
+		fields[field.Name] = graphql.FieldConfig{
+			Description: field.Description,
+			Type:        typeDef,
+			Args:        args,
+			Resolver:    clientFieldResolver,
+			Deprecation: deprecationOf(field.IsDeprecated, field.DeprecationReason),
+		}
+	}
+
+	return fields, nil
+}
+
+// buildArgs builds argument configs for the field or directive specified by owner (e.g.,
+// "Query.field" or "@directive"). Default values are coerced later in resolvePendingArgDefaults.
+func (b *clientSchemaBuilder) buildArgs(owner string, values []*InputValue) (graphql.ArgumentConfigMap, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	args := make(graphql.ArgumentConfigMap, len(values))
+	for _, arg := range values {
+		typeDef, err := b.typeDefOf(arg.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		args[arg.Name] = graphql.ArgumentConfig{
+			Description: arg.Description,
+			Type:        typeDef,
+			Deprecation: deprecationOf(arg.IsDeprecated, arg.DeprecationReason),
+		}
+
+		if arg.DefaultValue != nil {
+			b.pendingArgDefaults = append(b.pendingArgDefaults, pendingArgumentDefaultValue{
+				args:       args,
+				name:       arg.Name,
+				coordinate: fmt.Sprintf("%s(%s:)", owner, arg.Name),
+				value:      arg,
+			})
+		}
+	}
+
+	return args, nil
+}
+
+func (b *clientSchemaBuilder) buildDirectiveConfig(directive *Directive) (*graphql.DirectiveConfig, error) {
+	if directive.Locations == nil {
+		return nil, graphql.NewError(fmt.Sprintf("Introspection result missing directive locations: @%s.",
+			directive.Name))
+	}
+
+	locations := make([]graphql.DirectiveLocation, len(directive.Locations))
+	for i, location := range directive.Locations {
+		locations[i] = graphql.DirectiveLocation(location)
+	}
+
+	args, err := b.buildArgs("@"+directive.Name, directive.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &graphql.DirectiveConfig{
+		Name:         directive.Name,
+		Description:  directive.Description,
+		Locations:    locations,
+		Args:         args,
+		IsRepeatable: directive.IsRepeatable,
+	}, nil
+}
+
+// coerceDefaultValue parses the default value given in the introspection result and coerces it
+// into an internal value for the given type.
+func (b *clientSchemaBuilder) coerceDefaultValue(
+	coordinate string,
+	defaultValue string,
+	typeDef graphql.TypeDefinition) (interface{}, error) {
+
+	valueNode, err := parser.ParseValue(token.NewSource(defaultValue))
+	if err != nil {
+		return nil, graphql.NewError(fmt.Sprintf(`Invalid default value %s for "%s".`,
+			defaultValue, coordinate), err)
+	}
+
+	t, err := graphql.NewType(typeDef)
+	if err != nil {
+		return nil, err
+	}
+
+	coerced, err := value.CoerceFromAST(valueNode, t, graphql.NoVariableValues())
+	if err != nil {
+		return nil, graphql.NewError(fmt.Sprintf(`Invalid default value %s for "%s": %s`,
+			defaultValue, coordinate, err.Error()))
+	}
+
+	return coerced, nil
+}
+
+// reachableInputObjects returns names of the Input Objects that can be reached from the type with
+// the given name.
+func (b *clientSchemaBuilder) reachableInputObjects(name string) []string {
+	var (
+		result  []string
+		visited = map[string]bool{}
+		queue   = []string{name}
+	)
+
+	for len(queue) > 0 {
+		var name string
+		name, queue = queue[0], queue[1:]
+		if visited[name] {
+			continue
+		}
+		visited[name] = true
+
+		if _, isInputObject := b.typeDefs[name].(*graphql.InputObjectConfig); !isInputObject {
+			continue
+		}
+
+		result = append(result, name)
+		for _, field := range b.types[name].InputFields {
+			queue = append(queue, namedTypeOf(field.Type))
+		}
+	}
+
+	return result
+}
+
+// finishInputObject coerces default values for the fields in the Input Object with given name.
+//
+// Coercing a default value requires creating the type of the field which in turn creates every
+// Input Object reachable from it. Because types are immutable after creation, default values in
+// those Input Objects must be resolved before that. Default values whose types refer back to the
+// Input Object that is being finished cannot be resolved and are reported as errors.
+func (b *clientSchemaBuilder) finishInputObject(name string) error {
+	if b.inputObjectStates[name] != inputObjectPending {
+		return nil
+	}
+	b.inputObjectStates[name] = inputObjectFinishing
+
+	config := b.typeDefs[name].(*graphql.InputObjectConfig)
+
+	for _, fieldValue := range b.types[name].InputFields {
+		if fieldValue.DefaultValue == nil {
+			continue
+		}
+
+		coordinate := name + "." + fieldValue.Name
+		for _, dependency := range b.reachableInputObjects(namedTypeOf(fieldValue.Type)) {
+			if b.inputObjectStates[dependency] == inputObjectFinishing {
+				return graphql.NewError(fmt.Sprintf(`Cannot coerce default value for "%s" because it refers `+
+					`to "%s" recursively.`, coordinate, dependency))
+			}
+			if err := b.finishInputObject(dependency); err != nil {
+				return err
+			}
+		}
+
+		field := config.Fields[fieldValue.Name]
+		defaultValue, err := b.coerceDefaultValue(coordinate, *fieldValue.DefaultValue, field.Type)
+		if err != nil {
+			return err
+		}
+		if defaultValue == nil {
+			defaultValue = graphql.NilInputFieldDefaultValue
+		}
+
+		field.DefaultValue = defaultValue
+		config.Fields[fieldValue.Name] = field
+	}
+
+	b.inputObjectStates[name] = inputObjectFinished
+	return nil
+}
+
+// resolvePendingArgDefaults coerces default values for arguments. This must be called after all
+// Input Objects are finished.
+func (b *clientSchemaBuilder) resolvePendingArgDefaults() error {
+	for _, pending := range b.pendingArgDefaults {
+		arg := pending.args[pending.name]
+
+		defaultValue, err := b.coerceDefaultValue(pending.coordinate, *pending.value.DefaultValue, arg.Type)
+		if err != nil {
+			return err
+		}
+		if defaultValue == nil {
+			defaultValue = graphql.NilArgumentDefaultValue
+		}
+
+		arg.DefaultValue = defaultValue
+		pending.args[pending.name] = arg
+	}
+
+	return nil
+}
+
+// rootOperationType returns the Object type for the given root operation type.
+func (b *clientSchemaBuilder) rootOperationType(operation string, typeName *TypeName) (graphql.Object, error) {
+	if typeName == nil {
+		return nil, nil
+	}
+
+	objectTypeDef, ok := b.typeDefs[typeName.Name].(*graphql.ObjectConfig)
+	if !ok {
+		return nil, graphql.NewError(fmt.Sprintf(
+			`Invalid or incomplete introspection result. Specified %s type "%s" is not an Object type `+
+				`defined in the result.`, operation, typeName.Name))
+	}
+
+	return graphql.NewObject(objectTypeDef)
+}
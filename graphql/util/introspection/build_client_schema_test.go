@@ -0,0 +1,777 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package introspection_test
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/botobag/artemis/graphql"
+	"github.com/botobag/artemis/graphql/ast"
+	"github.com/botobag/artemis/graphql/executor"
+	"github.com/botobag/artemis/graphql/parser"
+	"github.com/botobag/artemis/graphql/token"
+	"github.com/botobag/artemis/graphql/util/introspection"
+	"github.com/botobag/artemis/graphql/util/sdl"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// introspectionResultOf executes introspection query on the given schema and returns the result
+// decoded from JSON.
+func introspectionResultOf(schema graphql.Schema, options ...introspection.QueryOption) *introspection.Result {
+	document := parser.MustParse(token.NewSource(introspection.Query(options...)))
+
+	operation, errs := executor.Prepare(schema, document)
+	Expect(errs).Should(Equal(graphql.NoErrors()))
+
+	resultJSON, err := json.Marshal(operation.Execute(context.Background()))
+	Expect(err).ShouldNot(HaveOccurred())
+
+	var response struct {
+		Data   *introspection.Result `json:"data"`
+		Errors []interface{}         `json:"errors"`
+	}
+	Expect(json.Unmarshal(resultJSON, &response)).Should(Succeed())
+	Expect(response.Errors).Should(BeEmpty())
+
+	return response.Data
+}
+
+func printSchema(schema graphql.Schema) string {
+	output, err := sdl.PrintSchema(schema)
+	Expect(err).ShouldNot(HaveOccurred())
+	return output
+}
+
+// cycleIntrospection builds a schema from the given SDL, introspects it, builds a client schema
+// from the result and returns the client schema printed in SDL. It also checks that the client
+// schema produces the same introspection result as the original one.
+func cycleIntrospection(source string, resolvers ...*sdl.Resolvers) string {
+	var r *sdl.Resolvers
+	if len(resolvers) > 0 {
+		r = resolvers[0]
+	}
+
+	serverSchema, errs := sdl.BuildSchema(token.NewSource(source), r)
+	Expect(errs).Should(Equal(graphql.NoErrors()))
+
+	initialIntrospection := introspectionResultOf(
+		serverSchema,
+		introspection.DirectiveIsRepeatable(),
+		introspection.InputObjectIsOneOf(),
+		introspection.ScalarSpecifiedByURL(),
+		introspection.InputValueDeprecation())
+	clientSchema, err := introspection.BuildClientSchema(initialIntrospection)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	Expect(printSchema(clientSchema)).Should(Equal(printSchema(serverSchema)))
+
+	return printSchema(clientSchema)
+}
+
+// customScalarResolvers provides coercers for the custom scalars with the given names.
+func customScalarResolvers(names ...string) *sdl.Resolvers {
+	resolvers := &sdl.Resolvers{
+		Scalars: map[string]sdl.ScalarCoercers{},
+	}
+	for _, name := range names {
+		resolvers.Scalars[name] = sdl.ScalarCoercers{
+			ResultCoercer: graphql.String(),
+			InputCoercer: graphql.ScalarInputCoercerFuncs{
+				CoerceVariableValueFunc: func(value interface{}) (interface{}, error) {
+					return value, nil
+				},
+				CoerceLiteralValueFunc: func(value ast.Value) (interface{}, error) {
+					return value.Interface(), nil
+				},
+			},
+		}
+	}
+	return resolvers
+}
+
+// graphql-js/src/utilities/__tests__/buildClientSchema-test.js@f529809
+var _ = Describe("BuildClientSchema", func() {
+	It("builds a simple schema", func() {
+		source := `
+			schema {
+				query: Simple
+			}
+
+			"""This is simple type"""
+			type Simple {
+				"""This is a string field"""
+				string: String
+			}
+		`
+		Expect(cycleIntrospection(source)).Should(ContainSubstring(`"""This is a string field"""`))
+	})
+
+	It("builds a schema without the query type", func() {
+		serverSchema, errs := sdl.BuildSchema(token.NewSource(`
+			type Query {
+				foo: String
+			}
+		`), nil)
+		Expect(errs).Should(Equal(graphql.NoErrors()))
+
+		result := introspectionResultOf(serverSchema)
+		result.Schema.QueryType = nil
+
+		clientSchema, err := introspection.BuildClientSchema(result)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(clientSchema.Query()).Should(BeNil())
+		Expect(clientSchema.TypeMap().Lookup("Query")).ShouldNot(BeNil())
+	})
+
+	It("builds a simple schema with all operation types", func() {
+		cycleIntrospection(`
+			schema {
+				query: QueryType
+				mutation: MutationType
+				subscription: SubscriptionType
+			}
+
+			"""This is a simple mutation type"""
+			type MutationType {
+				"""Set the string field"""
+				string: String
+			}
+
+			"""This is a simple query type"""
+			type QueryType {
+				"""This is a string field"""
+				string: String
+			}
+
+			"""This is a simple subscription type"""
+			type SubscriptionType {
+				"""This is a string field"""
+				string: String
+			}
+		`)
+	})
+
+	It("uses built-in scalars when possible", func() {
+		serverSchema, errs := sdl.BuildSchema(token.NewSource(`
+			scalar CustomScalar
+
+			type Query {
+				int: Int
+				float: Float
+				string: String
+				boolean: Boolean
+				id: ID
+				custom: CustomScalar
+			}
+		`), customScalarResolvers("CustomScalar"))
+		Expect(errs).Should(Equal(graphql.NoErrors()))
+
+		clientSchema, err := introspection.BuildClientSchema(introspectionResultOf(serverSchema))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(printSchema(clientSchema)).Should(Equal(printSchema(serverSchema)))
+
+		// Built-ins are used.
+		Expect(clientSchema.TypeMap().Lookup("Int")).Should(Equal(graphql.Int()))
+		Expect(clientSchema.TypeMap().Lookup("Float")).Should(Equal(graphql.Float()))
+		Expect(clientSchema.TypeMap().Lookup("String")).Should(Equal(graphql.String()))
+		Expect(clientSchema.TypeMap().Lookup("Boolean")).Should(Equal(graphql.Boolean()))
+		Expect(clientSchema.TypeMap().Lookup("ID")).Should(Equal(graphql.ID()))
+
+		// Custom are built.
+		Expect(clientSchema.TypeMap().Lookup("CustomScalar")).ShouldNot(
+			BeIdenticalTo(serverSchema.TypeMap().Lookup("CustomScalar")))
+	})
+
+	It("builds a schema with a recursive type reference", func() {
+		cycleIntrospection(`
+			schema {
+				query: Recur
+			}
+
+			type Recur {
+				recur: Recur
+			}
+		`)
+	})
+
+	It("builds a schema with a circular type reference", func() {
+		cycleIntrospection(`
+			type Dog {
+				bestFriend: Human
+			}
+
+			type Human {
+				bestFriend: Dog
+			}
+
+			type Query {
+				dog: Dog
+				human: Human
+			}
+		`)
+	})
+
+	It("builds a schema with an interface", func() {
+		cycleIntrospection(`
+			type Dog implements Friendly {
+				bestFriend: Friendly
+			}
+
+			interface Friendly {
+				"""The best friend of this friendly thing"""
+				bestFriend: Friendly
+			}
+
+			type Human implements Friendly {
+				bestFriend: Friendly
+			}
+
+			type Query {
+				friendly: Friendly
+			}
+		`)
+	})
+
+	It("builds a schema with an interface hierarchy", func() {
+		cycleIntrospection(`
+			type Dog implements Friendly & Named {
+				bestFriend: Friendly
+				name: String
+			}
+
+			interface Friendly implements Named {
+				"""The best friend of this friendly thing"""
+				bestFriend: Friendly
+				name: String
+			}
+
+			type Human implements Friendly & Named {
+				bestFriend: Friendly
+				name: String
+			}
+
+			interface Named {
+				name: String
+			}
+
+			type Query {
+				friendly: Friendly
+			}
+		`)
+	})
+
+	It("builds a schema with a union", func() {
+		cycleIntrospection(`
+			type Dog {
+				bestFriend: Friendly
+			}
+
+			union Friendly = Dog | Human
+
+			type Human {
+				bestFriend: Friendly
+			}
+
+			type Query {
+				friendly: Friendly
+			}
+		`)
+	})
+
+	It("builds a schema with complex field values", func() {
+		cycleIntrospection(`
+			type Query {
+				string: String
+				listOfString: [String]
+				nonNullString: String!
+				nonNullListOfString: [String]!
+				nonNullListOfNonNullString: [String!]!
+			}
+		`)
+	})
+
+	It("builds a schema with field arguments", func() {
+		cycleIntrospection(`
+			type Query {
+				"""A field with a single arg"""
+				one(
+					"""This is an int arg"""
+					intArg: Int
+				): String
+
+				"""A field with a two args"""
+				two(
+					"""This is an list of int arg"""
+					listArg: [Int]
+
+					"""This is a required arg"""
+					requiredArg: Boolean!
+				): String
+			}
+		`)
+	})
+
+	It("builds a schema with default value on custom scalar field", func() {
+		cycleIntrospection(`
+			scalar CustomScalar
+
+			type Query {
+				testField(testArg: CustomScalar = "default"): String
+			}
+		`, customScalarResolvers("CustomScalar"))
+	})
+
+	It("builds a schema with an enum", func() {
+		output := cycleIntrospection(`
+			enum Color {
+				"""So rosy"""
+				RED
+
+				"""So grassy"""
+				GREEN
+
+				"""So calming"""
+				BLUE
+			}
+
+			type Query {
+				colorEnum: Color
+			}
+		`)
+		Expect(output).Should(ContainSubstring(`"""So calming"""`))
+	})
+
+	It("builds a schema with an input object", func() {
+		cycleIntrospection(`
+			"""An input address"""
+			input Address {
+				"""What street is this address?"""
+				street: String!
+
+				"""The city the address is within?"""
+				city: String!
+
+				"""The country (blank will assume USA)."""
+				country: String = "USA"
+			}
+
+			type Query {
+				"""Get a geocode from an address"""
+				geocode(
+					"""The address to lookup"""
+					address: Address
+				): String
+			}
+		`)
+	})
+
+	It("builds a schema with field arguments with default values", func() {
+		cycleIntrospection(`
+			input Geo {
+				lat: Float
+				lon: Float
+			}
+
+			enum Unit {
+				METRIC
+				IMPERIAL
+			}
+
+			type Query {
+				defaultInt(intArg: Int = 30): String
+				defaultList(listArg: [Int] = [1, 2, 3]): String
+				defaultObject(objArg: Geo = {lat: 37.485, lon: -122.148}): String
+				defaultNull(intArg: Int = null): String
+				defaultEnum(unitArg: Unit = IMPERIAL): String
+				noDefault(intArg: Int): String
+			}
+		`)
+	})
+
+	It("builds a schema with custom directives", func() {
+		output := cycleIntrospection(`
+			"""This is a custom directive"""
+			directive @customDirective(arg: Int = 1) on FIELD | QUERY
+
+			type Query {
+				string: String
+			}
+		`)
+		Expect(output).Should(ContainSubstring("directive @customDirective(arg: Int = 1) on FIELD | QUERY"))
+	})
+
+	It("builds a schema with repeatable directives", func() {
+		output := cycleIntrospection(`
+			directive @tag(name: String!) repeatable on FIELD_DEFINITION | OBJECT
+
+			type Query {
+				string: String
+			}
+		`)
+		Expect(output).Should(ContainSubstring(
+			"directive @tag(name: String!) repeatable on FIELD_DEFINITION | OBJECT"))
+	})
+
+	It("treats directives as non-repeatable when isRepeatable is not requested", func() {
+		serverSchema, errs := sdl.BuildSchema(token.NewSource(`
+			directive @tag(name: String!) repeatable on FIELD_DEFINITION | OBJECT
+
+			type Query {
+				string: String
+			}
+		`), nil)
+		Expect(errs).Should(Equal(graphql.NoErrors()))
+
+		clientSchema, err := introspection.BuildClientSchema(introspectionResultOf(serverSchema))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(clientSchema.Directives().Lookup("tag").IsRepeatable()).Should(BeFalse())
+	})
+
+	It("builds a schema with @oneOf input objects", func() {
+		output := cycleIntrospection(`
+			input Search @oneOf {
+				id: ID
+				name: String
+			}
+
+			type Query {
+				find(by: Search): String
+			}
+		`)
+		Expect(output).Should(ContainSubstring("input Search @oneOf {"))
+	})
+
+	It("builds a schema with specifiedBy URL on custom scalars", func() {
+		output := cycleIntrospection(`
+			scalar Foo @specifiedBy(url: "https://example.com/foo_spec")
+
+			type Query {
+				foo: Foo
+			}
+		`, &sdl.Resolvers{
+			Scalars: map[string]sdl.ScalarCoercers{
+				"Foo": {
+					ResultCoercer: graphql.String(),
+				},
+			},
+		})
+		Expect(output).Should(ContainSubstring(`scalar Foo @specifiedBy(url: "https://example.com/foo_spec")`))
+	})
+
+	It("builds a schema aware of deprecation", func() {
+		cycleIntrospection(`
+			enum Color {
+				"""So rosy"""
+				RED
+
+				"""So grassy"""
+				GREEN @deprecated
+
+				"""So calming"""
+				BLUE
+
+				"""So sickening"""
+				MAUVE @deprecated(reason: "No longer in fashion")
+			}
+
+			type Query {
+				"""This is a shiny string field"""
+				shinyString: String
+
+				"""This is a deprecated string field"""
+				deprecatedString: String @deprecated(reason: "Use shinyString")
+				color: Color
+			}
+		`)
+	})
+
+	It("builds a schema with deprecated arguments and input fields", func() {
+		output := cycleIntrospection(`
+			input Filter {
+				name: String
+				oldName: String @deprecated(reason: "Use name")
+			}
+
+			type Query {
+				search(filter: Filter, query: String @deprecated): String
+			}
+		`)
+		Expect(output).Should(ContainSubstring(`oldName: String @deprecated(reason: "Use name")`))
+		Expect(output).Should(ContainSubstring(`search(filter: Filter, query: String @deprecated): String`))
+	})
+
+	It("omits deprecated arguments and input fields when their deprecation is not requested", func() {
+		serverSchema, errs := sdl.BuildSchema(token.NewSource(`
+			input Filter {
+				name: String
+				oldName: String @deprecated
+			}
+
+			type Query {
+				search(filter: Filter, query: String @deprecated): String
+			}
+		`), nil)
+		Expect(errs).Should(Equal(graphql.NoErrors()))
+
+		clientSchema, err := introspection.BuildClientSchema(introspectionResultOf(serverSchema))
+		Expect(err).ShouldNot(HaveOccurred())
+
+		filter := clientSchema.TypeMap().Lookup("Filter").(graphql.InputObject)
+		Expect(filter.Fields()).Should(HaveLen(1))
+		Expect(filter.Fields()).Should(HaveKey("name"))
+
+		args := clientSchema.Query().Fields()["search"].Args()
+		Expect(args).Should(HaveLen(1))
+		Expect(args[0].Name()).Should(Equal("filter"))
+	})
+
+	It("includes standard directives given in the result", func() {
+		serverSchema, errs := sdl.BuildSchema(token.NewSource(`
+			type Query {
+				foo: String
+			}
+		`), nil)
+		Expect(errs).Should(Equal(graphql.NoErrors()))
+
+		clientSchema, err := introspection.BuildClientSchema(introspectionResultOf(serverSchema))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(clientSchema.Directives()).Should(ConsistOf(graphql.StandardDirectives()))
+	})
+
+	It("includes standard directives when the result has no directives", func() {
+		serverSchema, errs := sdl.BuildSchema(token.NewSource(`
+			type Query {
+				foo: String
+			}
+		`), nil)
+		Expect(errs).Should(Equal(graphql.NoErrors()))
+
+		result := introspectionResultOf(serverSchema)
+		result.Schema.Directives = nil
+
+		clientSchema, err := introspection.BuildClientSchema(result)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(clientSchema.Directives()).Should(ConsistOf(graphql.StandardDirectives()))
+	})
+
+	It("can validate operations with client schema", func() {
+		serverSchema, errs := sdl.BuildSchema(token.NewSource(`
+			scalar Date
+
+			type Query {
+				foo(date: Date): String
+			}
+		`), customScalarResolvers("Date"))
+		Expect(errs).Should(Equal(graphql.NoErrors()))
+
+		clientSchema, err := introspection.BuildClientSchema(introspectionResultOf(serverSchema))
+		Expect(err).ShouldNot(HaveOccurred())
+
+		_, errs = executor.Prepare(clientSchema, parser.MustParse(token.NewSource(`
+			{ foo(date: "2019-01-01") }
+		`)))
+		Expect(errs).Should(Equal(graphql.NoErrors()))
+
+		_, errs = executor.Prepare(clientSchema, parser.MustParse(token.NewSource(`
+			{ bar }
+		`)))
+		Expect(errs.HaveOccurred()).Should(BeTrue())
+	})
+
+	It("cannot use client schema for general execution", func() {
+		serverSchema, errs := sdl.BuildSchema(token.NewSource(`
+			type Query {
+				foo(custom1: Int, custom2: String): String
+			}
+		`), nil)
+		Expect(errs).Should(Equal(graphql.NoErrors()))
+
+		clientSchema, err := introspection.BuildClientSchema(introspectionResultOf(serverSchema))
+		Expect(err).ShouldNot(HaveOccurred())
+
+		operation, errs := executor.Prepare(clientSchema, parser.MustParse(token.NewSource(`
+			query NoNo($v: Int) { foo(custom1: 123, custom2: $v) }
+		`)))
+		Expect(errs.HaveOccurred()).Should(BeTrue())
+		Expect(operation).Should(BeNil())
+
+		operation, errs = executor.Prepare(clientSchema, parser.MustParse(token.NewSource(`
+			{ foo(custom1: 123) }
+		`)))
+		Expect(errs).Should(Equal(graphql.NoErrors()))
+
+		resultJSON, err := json.Marshal(operation.Execute(context.Background()))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(resultJSON)).Should(MatchJSON(`{
+			"errors": [{
+				"message": "Client schema cannot be used for execution.",
+				"locations": [{"line": 2, "column": 6}],
+				"path": ["foo"]
+			}],
+			"data": {"foo": null}
+		}`))
+	})
+
+	Describe("throws when given incomplete introspection", func() {
+		var result *introspection.Result
+
+		BeforeEach(func() {
+			serverSchema, errs := sdl.BuildSchema(token.NewSource(`
+				interface SomeInterface {
+					foo: String
+				}
+
+				type Query implements SomeInterface {
+					foo: String
+					bar(arg: String): SomeUnion
+				}
+
+				union SomeUnion = Query
+
+				enum SomeEnum {
+					FOO
+				}
+
+				input SomeInputObject {
+					foo: String
+				}
+
+				directive @SomeDirective on QUERY
+			`), nil)
+			Expect(errs).Should(Equal(graphql.NoErrors()))
+			result = introspectionResultOf(serverSchema)
+		})
+
+		findType := func(name string) *introspection.Type {
+			for _, t := range result.Schema.Types {
+				if t.Name == name {
+					return t
+				}
+			}
+			return nil
+		}
+
+		It("throws when given empty result", func() {
+			_, err := introspection.BuildClientSchema(&introspection.Result{})
+			Expect(err).Should(MatchError(`Invalid or incomplete introspection result. Ensure that you ` +
+				`are passing the "data" property of introspection response and no "errors" was returned ` +
+				`alongside.`))
+		})
+
+		It("throws when referenced unknown type", func() {
+			types := result.Schema.Types[:0]
+			for _, t := range result.Schema.Types {
+				if t.Name != "SomeUnion" {
+					types = append(types, t)
+				}
+			}
+			result.Schema.Types = types
+
+			_, err := introspection.BuildClientSchema(result)
+			Expect(err).Should(MatchError("Invalid or incomplete schema, unknown type: SomeUnion. Ensure " +
+				"that a full introspection query is used in order to build a client schema."))
+		})
+
+		It("throws when type reference is missing name", func() {
+			result.Schema.QueryType.Name = ""
+			_, err := introspection.BuildClientSchema(result)
+			Expect(err).Should(MatchError(`Invalid or incomplete introspection result. Specified query ` +
+				`type "" is not an Object type defined in the result.`))
+		})
+
+		It("throws when missing kind", func() {
+			findType("Query").Kind = ""
+			_, err := introspection.BuildClientSchema(result)
+			Expect(err).Should(MatchError(`Invalid or incomplete introspection result. Unknown kind "" ` +
+				`for type "Query".`))
+		})
+
+		It("throws when missing interfaces", func() {
+			findType("Query").Interfaces = nil
+			_, err := introspection.BuildClientSchema(result)
+			Expect(err).Should(MatchError("Introspection result missing interfaces: Query."))
+		})
+
+		It("throws when missing fields", func() {
+			findType("Query").Fields = nil
+			_, err := introspection.BuildClientSchema(result)
+			Expect(err).Should(MatchError("Introspection result missing fields: Query."))
+		})
+
+		It("throws when missing possibleTypes", func() {
+			findType("SomeUnion").PossibleTypes = nil
+			_, err := introspection.BuildClientSchema(result)
+			Expect(err).Should(MatchError("Introspection result missing possibleTypes: SomeUnion."))
+		})
+
+		It("throws when missing enumValues", func() {
+			findType("SomeEnum").EnumValues = nil
+			_, err := introspection.BuildClientSchema(result)
+			Expect(err).Should(MatchError("Introspection result missing enumValues: SomeEnum."))
+		})
+
+		It("throws when missing inputFields", func() {
+			findType("SomeInputObject").InputFields = nil
+			_, err := introspection.BuildClientSchema(result)
+			Expect(err).Should(MatchError("Introspection result missing inputFields: SomeInputObject."))
+		})
+
+		It("throws when missing directive locations", func() {
+			for _, directive := range result.Schema.Directives {
+				if directive.Name == "SomeDirective" {
+					directive.Locations = nil
+				}
+			}
+			_, err := introspection.BuildClientSchema(result)
+			Expect(err).Should(MatchError("Introspection result missing directive locations: @SomeDirective."))
+		})
+
+		It("throws when output type is used as an interface", func() {
+			findType("Query").Interfaces[0].Name = "SomeUnion"
+			_, err := introspection.BuildClientSchema(result)
+			Expect(err).Should(MatchError(
+				"Type Query must only implement Interface types, it cannot implement SomeUnion."))
+		})
+
+		It("throws when given invalid default value", func() {
+			for _, field := range findType("Query").Fields {
+				if field.Name == "bar" {
+					defaultValue := "{"
+					field.Args[0].DefaultValue = &defaultValue
+				}
+			}
+			_, err := introspection.BuildClientSchema(result)
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(HavePrefix(`Invalid default value { for "Query.bar(arg:)".`))
+		})
+	})
+
+	It("throws when type reference is deeper than the query", func() {
+		serverSchema, errs := sdl.BuildSchema(token.NewSource(`
+			type Query {
+				foo: [[[[[[[[String]]]]]]]]
+			}
+		`), nil)
+		Expect(errs).Should(Equal(graphql.NoErrors()))
+
+		_, err := introspection.BuildClientSchema(introspectionResultOf(serverSchema))
+		Expect(err).Should(MatchError("Decorated type deeper than introspection query."))
+	})
+})
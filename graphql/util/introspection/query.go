@@ -27,6 +27,19 @@ import (
 type queryOptions struct {
 	// Whether to include descriptions in the introspection result
 	OmitDescriptions bool
+
+	// Whether to include "isRepeatable" field on directives in the introspection result
+	DirectiveIsRepeatable bool
+
+	// Whether to include "isOneOf" field on types in the introspection result
+	InputObjectIsOneOf bool
+
+	// Whether to include "specifiedByURL" field on types in the introspection result
+	ScalarSpecifiedByURL bool
+
+	// Whether to include deprecated arguments and input fields and their deprecation in the
+	// introspection result
+	InputValueDeprecation bool
 }
 
 // QueryOption provides an option to Query.
@@ -39,8 +52,37 @@ func OmitDescriptions() QueryOption {
 	}
 }
 
+// DirectiveIsRepeatable sets options.DirectiveIsRepeatable.
+func DirectiveIsRepeatable() QueryOption {
+	return func(options *queryOptions) {
+		options.DirectiveIsRepeatable = true
+	}
+}
+
+// InputObjectIsOneOf sets options.InputObjectIsOneOf.
+func InputObjectIsOneOf() QueryOption {
+	return func(options *queryOptions) {
+		options.InputObjectIsOneOf = true
+	}
+}
+
+// ScalarSpecifiedByURL sets options.ScalarSpecifiedByURL.
+func ScalarSpecifiedByURL() QueryOption {
+	return func(options *queryOptions) {
+		options.ScalarSpecifiedByURL = true
+	}
+}
+
+// InputValueDeprecation sets options.InputValueDeprecation.
+func InputValueDeprecation() QueryOption {
+	return func(options *queryOptions) {
+		options.InputValueDeprecation = true
+	}
+}
+
 var queryTemplate = template.Must(template.New("IntrospectionQuery").Parse(`
 		{{define "description"}}{{if not .OmitDescriptions}}description{{end}}{{end}}
+		{{define "includeDeprecated"}}{{if .InputValueDeprecation}}(includeDeprecated: true){{end}}{{end}}
     query IntrospectionQuery {
       __schema {
         queryType { name }
@@ -52,8 +94,9 @@ var queryTemplate = template.Must(template.New("IntrospectionQuery").Parse(`
         directives {
           name
           {{template "description" .}}
+          {{if .DirectiveIsRepeatable}}isRepeatable{{end}}
           locations
-          args {
+          args{{template "includeDeprecated" .}} {
             ...InputValue
           }
         }
@@ -64,10 +107,11 @@ var queryTemplate = template.Must(template.New("IntrospectionQuery").Parse(`
       kind
       name
       {{template "description" .}}
+      {{if .ScalarSpecifiedByURL}}specifiedByURL{{end}}
       fields(includeDeprecated: true) {
         name
         {{template "description" .}}
-        args {
+        args{{template "includeDeprecated" .}} {
           ...InputValue
         }
         type {
@@ -76,9 +120,10 @@ var queryTemplate = template.Must(template.New("IntrospectionQuery").Parse(`
         isDeprecated
         deprecationReason
       }
-      inputFields {
+      inputFields{{template "includeDeprecated" .}} {
         ...InputValue
       }
+      {{if .InputObjectIsOneOf}}isOneOf{{end}}
       interfaces {
         ...TypeRef
       }
@@ -98,6 +143,10 @@ var queryTemplate = template.Must(template.New("IntrospectionQuery").Parse(`
       {{template "description" .}}
       type { ...TypeRef }
       defaultValue
+      {{if .InputValueDeprecation}}
+      isDeprecated
+      deprecationReason
+      {{end}}
     }
 
     fragment TypeRef on __Type {
@@ -0,0 +1,101 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package introspection
+
+// Result contains the data in the response to the query constructed by Query. It can be decoded
+// from the "data" entry of the response in JSON with encoding/json.
+type Result struct {
+	Schema *Schema `json:"__schema"`
+}
+
+// Schema describes a GraphQL schema in introspection result.
+type Schema struct {
+	QueryType        *TypeName    `json:"queryType"`
+	MutationType     *TypeName    `json:"mutationType"`
+	SubscriptionType *TypeName    `json:"subscriptionType"`
+	Types            []*Type      `json:"types"`
+	Directives       []*Directive `json:"directives"`
+}
+
+// TypeName specifies the name of a named type.
+type TypeName struct {
+	Name string `json:"name"`
+}
+
+// Type describes a named type in introspection result. Fields that are not applicable to the kind
+// of the type are nil.
+type Type struct {
+	Kind           string        `json:"kind"`
+	Name           string        `json:"name"`
+	Description    string        `json:"description"`
+	Fields         []*Field      `json:"fields"`
+	InputFields    []*InputValue `json:"inputFields"`
+	Interfaces     []*TypeRef    `json:"interfaces"`
+	EnumValues     []*EnumValue  `json:"enumValues"`
+	PossibleTypes  []*TypeRef    `json:"possibleTypes"`
+	IsOneOf        bool          `json:"isOneOf"`
+	SpecifiedByURL string        `json:"specifiedByURL"`
+}
+
+// TypeRef refers to a type. OfType is given for List and Non-Null types to specify the wrapped
+// type.
+type TypeRef struct {
+	Kind   string   `json:"kind"`
+	Name   string   `json:"name"`
+	OfType *TypeRef `json:"ofType"`
+}
+
+// Field describes a field in an Object or an Interface type.
+type Field struct {
+	Name              string        `json:"name"`
+	Description       string        `json:"description"`
+	Args              []*InputValue `json:"args"`
+	Type              *TypeRef      `json:"type"`
+	IsDeprecated      bool          `json:"isDeprecated"`
+	DeprecationReason string        `json:"deprecationReason"`
+}
+
+// InputValue describes an argument or a field in an Input Object type.
+type InputValue struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Type        *TypeRef `json:"type"`
+
+	// DefaultValue is the default value printed in GraphQL language or nil if there's no default
+	// value.
+	DefaultValue *string `json:"defaultValue"`
+
+	IsDeprecated      bool   `json:"isDeprecated"`
+	DeprecationReason string `json:"deprecationReason"`
+}
+
+// EnumValue describes a value in an Enum type.
+type EnumValue struct {
+	Name              string `json:"name"`
+	Description       string `json:"description"`
+	IsDeprecated      bool   `json:"isDeprecated"`
+	DeprecationReason string `json:"deprecationReason"`
+}
+
+// Directive describes a directive.
+type Directive struct {
+	Name         string        `json:"name"`
+	Description  string        `json:"description"`
+	Locations    []string      `json:"locations"`
+	Args         []*InputValue `json:"args"`
+	IsRepeatable bool          `json:"isRepeatable"`
+}
@@ -0,0 +1,751 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+// Package schemadiff compares two GraphQL schemas and finds the changes that are breaking or
+// dangerous to the clients of the old schema.
+package schemadiff
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/botobag/artemis/graphql"
+	"github.com/botobag/artemis/graphql/ast"
+	"github.com/botobag/artemis/iterator"
+)
+
+// ChangeKind classifies a Change.
+type ChangeKind string
+
+// Enumeration of ChangeKind for breaking changes
+const (
+	FieldChangedKind           ChangeKind = "FIELD_CHANGED_KIND"
+	FieldRemoved               ChangeKind = "FIELD_REMOVED"
+	TypeChangedKind            ChangeKind = "TYPE_CHANGED_KIND"
+	TypeRemoved                ChangeKind = "TYPE_REMOVED"
+	TypeRemovedFromUnion       ChangeKind = "TYPE_REMOVED_FROM_UNION"
+	ValueRemovedFromEnum       ChangeKind = "VALUE_REMOVED_FROM_ENUM"
+	ArgRemoved                 ChangeKind = "ARG_REMOVED"
+	ArgChangedKind             ChangeKind = "ARG_CHANGED_KIND"
+	RequiredArgAdded           ChangeKind = "REQUIRED_ARG_ADDED"
+	RequiredInputFieldAdded    ChangeKind = "REQUIRED_INPUT_FIELD_ADDED"
+	InterfaceRemovedFromObject ChangeKind = "INTERFACE_REMOVED_FROM_OBJECT"
+	DirectiveRemoved           ChangeKind = "DIRECTIVE_REMOVED"
+	DirectiveArgRemoved        ChangeKind = "DIRECTIVE_ARG_REMOVED"
+	RequiredDirectiveArgAdded  ChangeKind = "REQUIRED_DIRECTIVE_ARG_ADDED"
+	DirectiveLocationRemoved   ChangeKind = "DIRECTIVE_LOCATION_REMOVED"
+	InputObjectBecameOneOf     ChangeKind = "INPUT_OBJECT_BECAME_ONE_OF"
+)
+
+// Enumeration of ChangeKind for dangerous changes
+const (
+	ArgDefaultValueChange   ChangeKind = "ARG_DEFAULT_VALUE_CHANGE"
+	ValueAddedToEnum        ChangeKind = "VALUE_ADDED_TO_ENUM"
+	InterfaceAddedToObject  ChangeKind = "INTERFACE_ADDED_TO_OBJECT"
+	TypeAddedToUnion        ChangeKind = "TYPE_ADDED_TO_UNION"
+	OptionalInputFieldAdded ChangeKind = "OPTIONAL_INPUT_FIELD_ADDED"
+	OptionalArgAdded        ChangeKind = "OPTIONAL_ARG_ADDED"
+)
+
+// Change describes a difference between two schemas.
+type Change struct {
+	Kind        ChangeKind
+	Description string
+}
+
+// FindBreakingChanges returns the changes in newSchema that break the clients of oldSchema. For
+// example, a field that was removed or an argument that becomes required. Changes are sorted by
+// the names of the types and directives where they are found.
+//
+// Note that deprecating (or undeprecating) a field, an argument, an input field or an enum value is
+// neither breaking nor dangerous because the deprecated element remains usable. Such changes are
+// not reported.
+func FindBreakingChanges(oldSchema graphql.Schema, newSchema graphql.Schema) []Change {
+	differ := newSchemaDiffer(oldSchema, newSchema)
+	differ.findRemovedTypes()
+	differ.findTypesThatChangedKind()
+	differ.findFieldsThatChangedTypeOnObjectOrInterfaceTypes()
+	differ.findFieldsThatChangedTypeOnInputObjectTypes()
+	differ.findTypesRemovedFromUnions()
+	differ.findValuesRemovedFromEnums()
+	differ.findArgChanges()
+	differ.findInterfacesRemovedFromObjectOrInterfaceTypes()
+	differ.findRemovedDirectives()
+	differ.findRemovedDirectiveArgs()
+	differ.findAddedNonNullDirectiveArgs()
+	differ.findRemovedDirectiveLocations()
+	differ.findInputObjectsThatBecameOneOf()
+	return differ.breakingChanges
+}
+
+// FindDangerousChanges returns the changes in newSchema that are not breaking but may cause
+// clients of oldSchema to behave differently. For example, a value added to an Enum that the
+// clients may not handle.
+func FindDangerousChanges(oldSchema graphql.Schema, newSchema graphql.Schema) []Change {
+	differ := newSchemaDiffer(oldSchema, newSchema)
+	differ.findArgChanges()
+	differ.findValuesAddedToEnums()
+	differ.findInterfacesAddedToObjectOrInterfaceTypes()
+	differ.findTypesAddedToUnions()
+	differ.findFieldsThatChangedTypeOnInputObjectTypes()
+	return differ.dangerousChanges
+}
+
+// schemaDiffer compares two schemas and collects the changes.
+type schemaDiffer struct {
+	oldTypeNames []string
+	oldTypeMap   graphql.TypeMap
+	newTypeMap   graphql.TypeMap
+
+	oldDirectives graphql.DirectiveList
+	newDirectives graphql.DirectiveList
+
+	breakingChanges  []Change
+	dangerousChanges []Change
+}
+
+func newSchemaDiffer(oldSchema graphql.Schema, newSchema graphql.Schema) *schemaDiffer {
+	oldTypeMap := oldSchema.TypeMap()
+
+	// Sort names of types in old schema to produce changes in a deterministic order.
+	var oldTypeNames []string
+	iter := oldTypeMap.KeyIterator()
+	for {
+		name, err := iter.Next()
+		if err != nil {
+			break
+		}
+		oldTypeNames = append(oldTypeNames, name.(string))
+	}
+	sort.Strings(oldTypeNames)
+
+	return &schemaDiffer{
+		oldTypeNames:  oldTypeNames,
+		oldTypeMap:    oldTypeMap,
+		newTypeMap:    newSchema.TypeMap(),
+		oldDirectives: oldSchema.Directives(),
+		newDirectives: newSchema.Directives(),
+	}
+}
+
+func (differ *schemaDiffer) addBreakingChange(kind ChangeKind, format string, a ...interface{}) {
+	differ.breakingChanges = append(differ.breakingChanges, Change{
+		Kind:        kind,
+		Description: fmt.Sprintf(format, a...),
+	})
+}
+
+func (differ *schemaDiffer) addDangerousChange(kind ChangeKind, format string, a ...interface{}) {
+	differ.dangerousChanges = append(differ.dangerousChanges, Change{
+		Kind:        kind,
+		Description: fmt.Sprintf(format, a...),
+	})
+}
+
+// typePair contains a type in old schema and the type with the same name in new schema.
+type typePair struct {
+	name    string
+	oldType graphql.Type
+	newType graphql.Type
+}
+
+// commonTypes returns the types that present in both schemas.
+func (differ *schemaDiffer) commonTypes() []typePair {
+	var result []typePair
+	for _, name := range differ.oldTypeNames {
+		newType := differ.newTypeMap.Lookup(name)
+		if newType == nil {
+			continue
+		}
+		result = append(result, typePair{
+			name:    name,
+			oldType: differ.oldTypeMap.Lookup(name),
+			newType: newType,
+		})
+	}
+	return result
+}
+
+// findRemovedTypes finds types that were removed.
+func (differ *schemaDiffer) findRemovedTypes() {
+	for _, name := range differ.oldTypeNames {
+		if differ.newTypeMap.Lookup(name) == nil {
+			differ.addBreakingChange(TypeRemoved, "%s was removed.", name)
+		}
+	}
+}
+
+// findTypesThatChangedKind finds types that changed kind (e.g., from Object to Interface).
+func (differ *schemaDiffer) findTypesThatChangedKind() {
+	for _, pair := range differ.commonTypes() {
+		oldKind, newKind := typeKindName(pair.oldType), typeKindName(pair.newType)
+		if oldKind != newKind {
+			differ.addBreakingChange(TypeChangedKind, "%s changed from %s to %s.", pair.name, oldKind, newKind)
+		}
+	}
+}
+
+// typeKindName returns the kind of the given type with indefinite article for descriptions.
+func typeKindName(t graphql.Type) string {
+	switch t.(type) {
+	case graphql.Scalar:
+		return "a Scalar type"
+	case graphql.Object:
+		return "an Object type"
+	case graphql.Interface:
+		return "an Interface type"
+	case graphql.Union:
+		return "a Union type"
+	case graphql.Enum:
+		return "an Enum type"
+	case graphql.InputObject:
+		return "an Input type"
+	}
+	panic(fmt.Sprintf("unexpected type %T", t))
+}
+
+// fieldsOf returns the fields in the given Object or Interface type or nil if the type is neither.
+func fieldsOf(t graphql.Type) graphql.FieldMap {
+	switch t := t.(type) {
+	case graphql.Object:
+		return t.Fields()
+	case graphql.Interface:
+		return t.Fields()
+	}
+	return nil
+}
+
+// sortedFieldNames returns names of the fields in sorted order.
+func sortedFieldNames(fields graphql.FieldMap) []string {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// sortedArgs returns the given arguments sorted by name.
+func sortedArgs(args []graphql.Argument) []*graphql.Argument {
+	result := make([]*graphql.Argument, len(args))
+	for i := range args {
+		result[i] = &args[i]
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name() < result[j].Name()
+	})
+	return result
+}
+
+// findArg finds the argument with the given name.
+func findArg(args []graphql.Argument, name string) *graphql.Argument {
+	for i := range args {
+		if args[i].Name() == name {
+			return &args[i]
+		}
+	}
+	return nil
+}
+
+// isSameKind returns true if both types are Object types or Interface types.
+func isSameObjectOrInterfaceKind(oldType graphql.Type, newType graphql.Type) bool {
+	switch oldType.(type) {
+	case graphql.Object:
+		return graphql.IsObjectType(newType)
+	case graphql.Interface:
+		return graphql.IsInterfaceType(newType)
+	}
+	return false
+}
+
+func (differ *schemaDiffer) findFieldsThatChangedTypeOnObjectOrInterfaceTypes() {
+	for _, pair := range differ.commonTypes() {
+		if !isSameObjectOrInterfaceKind(pair.oldType, pair.newType) {
+			continue
+		}
+
+		oldFields, newFields := fieldsOf(pair.oldType), fieldsOf(pair.newType)
+		for _, fieldName := range sortedFieldNames(oldFields) {
+			newField := newFields[fieldName]
+			// Check if the field is missing on the type in the new schema.
+			if newField == nil {
+				differ.addBreakingChange(FieldRemoved, "%s.%s was removed.", pair.name, fieldName)
+				continue
+			}
+
+			oldFieldType, newFieldType := oldFields[fieldName].Type(), newField.Type()
+			if !isChangeSafeForObjectOrInterfaceField(oldFieldType, newFieldType) {
+				differ.addBreakingChange(FieldChangedKind, "%s.%s changed type from %s to %s.",
+					pair.name, fieldName, graphql.Inspect(oldFieldType), graphql.Inspect(newFieldType))
+			}
+		}
+	}
+}
+
+func (differ *schemaDiffer) findFieldsThatChangedTypeOnInputObjectTypes() {
+	for _, pair := range differ.commonTypes() {
+		oldType, ok := pair.oldType.(graphql.InputObject)
+		if !ok {
+			continue
+		}
+		newType, ok := pair.newType.(graphql.InputObject)
+		if !ok {
+			continue
+		}
+
+		oldFields, newFields := oldType.Fields(), newType.Fields()
+
+		oldFieldNames := make([]string, 0, len(oldFields))
+		for name := range oldFields {
+			oldFieldNames = append(oldFieldNames, name)
+		}
+		sort.Strings(oldFieldNames)
+
+		for _, fieldName := range oldFieldNames {
+			newField := newFields[fieldName]
+			// Check if the field is missing on the type in the new schema.
+			if newField == nil {
+				differ.addBreakingChange(FieldRemoved, "%s.%s was removed.", pair.name, fieldName)
+				continue
+			}
+
+			oldFieldType, newFieldType := oldFields[fieldName].Type(), newField.Type()
+			if !isChangeSafeForInputObjectFieldOrFieldArg(oldFieldType, newFieldType) {
+				differ.addBreakingChange(FieldChangedKind, "%s.%s changed type from %s to %s.",
+					pair.name, fieldName, graphql.Inspect(oldFieldType), graphql.Inspect(newFieldType))
+			}
+		}
+
+		// Check if a field was added to the input object type.
+		newFieldNames := make([]string, 0, len(newFields))
+		for name := range newFields {
+			newFieldNames = append(newFieldNames, name)
+		}
+		sort.Strings(newFieldNames)
+
+		for _, fieldName := range newFieldNames {
+			if oldFields[fieldName] != nil {
+				continue
+			}
+			if graphql.IsRequiredInputField(newFields[fieldName]) {
+				differ.addBreakingChange(RequiredInputFieldAdded,
+					"A required field %s on input type %s was added.", fieldName, pair.name)
+			} else {
+				differ.addDangerousChange(OptionalInputFieldAdded,
+					"An optional field %s on input type %s was added.", fieldName, pair.name)
+			}
+		}
+	}
+}
+
+// isChangeSafeForObjectOrInterfaceField returns true if a field in an Object or an Interface type
+// can change from oldType to newType without breaking clients. Output types can become more strict
+// (e.g., nullable to non-null).
+func isChangeSafeForObjectOrInterfaceField(oldType graphql.Type, newType graphql.Type) bool {
+	switch oldType := oldType.(type) {
+	case graphql.List:
+		// If old type is a list, new type must also be a list or a non-null version of it.
+		if newType, ok := newType.(graphql.List); ok {
+			return isChangeSafeForObjectOrInterfaceField(oldType.ElementType(), newType.ElementType())
+		}
+		if newType, ok := newType.(graphql.NonNull); ok {
+			return isChangeSafeForObjectOrInterfaceField(oldType, newType.InnerType())
+		}
+		return false
+
+	case graphql.NonNull:
+		// If old type is non-null, new type must also be non-null.
+		if newType, ok := newType.(graphql.NonNull); ok {
+			return isChangeSafeForObjectOrInterfaceField(oldType.InnerType(), newType.InnerType())
+		}
+		return false
+
+	default:
+		// If old type is named, new type must be the type with the same name or a non-null version of
+		// it.
+		if newType, ok := newType.(graphql.NonNull); ok {
+			return isChangeSafeForObjectOrInterfaceField(oldType, newType.InnerType())
+		}
+		return graphql.IsNamedType(newType) && isSameNamedType(oldType, newType)
+	}
+}
+
+// isChangeSafeForInputObjectFieldOrFieldArg returns true if an input field or an argument can
+// change from oldType to newType without breaking clients. Input types can become less strict
+// (e.g., non-null to nullable).
+func isChangeSafeForInputObjectFieldOrFieldArg(oldType graphql.Type, newType graphql.Type) bool {
+	switch oldType := oldType.(type) {
+	case graphql.List:
+		// If old type is a list, new type must also be a list.
+		if newType, ok := newType.(graphql.List); ok {
+			return isChangeSafeForInputObjectFieldOrFieldArg(oldType.ElementType(), newType.ElementType())
+		}
+		return false
+
+	case graphql.NonNull:
+		// If old type is non-null, new type can be non-null or nullable.
+		if newType, ok := newType.(graphql.NonNull); ok {
+			return isChangeSafeForInputObjectFieldOrFieldArg(oldType.InnerType(), newType.InnerType())
+		}
+		return isChangeSafeForInputObjectFieldOrFieldArg(oldType.InnerType(), newType)
+
+	default:
+		// If old type is named, new type must be the type with the same name.
+		return graphql.IsNamedType(newType) && isSameNamedType(oldType, newType)
+	}
+}
+
+// isSameNamedType returns true if the two named types have the same name.
+func isSameNamedType(oldType graphql.Type, newType graphql.Type) bool {
+	return oldType.(graphql.TypeWithName).Name() == newType.(graphql.TypeWithName).Name()
+}
+
+func (differ *schemaDiffer) findTypesRemovedFromUnions() {
+	for _, pair := range differ.commonTypes() {
+		oldType, ok := pair.oldType.(graphql.Union)
+		if !ok {
+			continue
+		}
+		newType, ok := pair.newType.(graphql.Union)
+		if !ok {
+			continue
+		}
+
+		newNames := possibleTypeNames(newType)
+		for _, name := range sortedKeys(possibleTypeNames(oldType)) {
+			if !newNames[name] {
+				differ.addBreakingChange(TypeRemovedFromUnion, "%s was removed from union type %s.",
+					name, pair.name)
+			}
+		}
+	}
+}
+
+func (differ *schemaDiffer) findTypesAddedToUnions() {
+	for _, pair := range differ.commonTypes() {
+		oldType, ok := pair.oldType.(graphql.Union)
+		if !ok {
+			continue
+		}
+		newType, ok := pair.newType.(graphql.Union)
+		if !ok {
+			continue
+		}
+
+		oldNames := possibleTypeNames(oldType)
+		for _, name := range sortedKeys(possibleTypeNames(newType)) {
+			if !oldNames[name] {
+				differ.addDangerousChange(TypeAddedToUnion, "%s was added to union type %s.", name, pair.name)
+			}
+		}
+	}
+}
+
+// possibleTypeNames returns the set of names of the member types in the given Union.
+func possibleTypeNames(union graphql.Union) map[string]bool {
+	names := map[string]bool{}
+	iter := union.PossibleTypes().Iterator()
+	for {
+		possibleType, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		names[possibleType.(graphql.Object).Name()] = true
+	}
+	return names
+}
+
+// sortedKeys returns keys in the given set in sorted order.
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// enumValueNames returns the set of names of the values in the given Enum.
+func enumValueNames(enum graphql.Enum) map[string]bool {
+	names := map[string]bool{}
+	for name := range enum.Values() {
+		names[name] = true
+	}
+	return names
+}
+
+func (differ *schemaDiffer) findValuesRemovedFromEnums() {
+	for _, pair := range differ.commonTypes() {
+		oldType, ok := pair.oldType.(graphql.Enum)
+		if !ok {
+			continue
+		}
+		newType, ok := pair.newType.(graphql.Enum)
+		if !ok {
+			continue
+		}
+
+		newValues := newType.Values()
+		for _, name := range sortedKeys(enumValueNames(oldType)) {
+			if newValues.Lookup(name) == nil {
+				differ.addBreakingChange(ValueRemovedFromEnum, "%s was removed from enum type %s.",
+					name, pair.name)
+			}
+		}
+	}
+}
+
+func (differ *schemaDiffer) findValuesAddedToEnums() {
+	for _, pair := range differ.commonTypes() {
+		oldType, ok := pair.oldType.(graphql.Enum)
+		if !ok {
+			continue
+		}
+		newType, ok := pair.newType.(graphql.Enum)
+		if !ok {
+			continue
+		}
+
+		oldValues := oldType.Values()
+		for _, name := range sortedKeys(enumValueNames(newType)) {
+			if oldValues.Lookup(name) == nil {
+				differ.addDangerousChange(ValueAddedToEnum, "%s was added to enum type %s.", name, pair.name)
+			}
+		}
+	}
+}
+
+// findArgChanges finds changes to the arguments of fields in Object and Interface types. Breaking
+// changes include arguments being removed, changing type and required arguments being added.
+// Dangerous changes include optional arguments being added and default values being changed.
+func (differ *schemaDiffer) findArgChanges() {
+	for _, pair := range differ.commonTypes() {
+		if !isSameObjectOrInterfaceKind(pair.oldType, pair.newType) {
+			continue
+		}
+
+		oldFields, newFields := fieldsOf(pair.oldType), fieldsOf(pair.newType)
+		for _, fieldName := range sortedFieldNames(oldFields) {
+			newField := newFields[fieldName]
+			if newField == nil {
+				continue
+			}
+
+			oldArgs, newArgs := oldFields[fieldName].Args(), newField.Args()
+			for _, oldArg := range sortedArgs(oldArgs) {
+				newArg := findArg(newArgs, oldArg.Name())
+
+				// Arg not present.
+				if newArg == nil {
+					differ.addBreakingChange(ArgRemoved, "%s.%s arg %s was removed.",
+						pair.name, fieldName, oldArg.Name())
+					continue
+				}
+
+				if !isChangeSafeForInputObjectFieldOrFieldArg(oldArg.Type(), newArg.Type()) {
+					differ.addBreakingChange(ArgChangedKind, "%s.%s arg %s has changed type from %s to %s.",
+						pair.name, fieldName, oldArg.Name(),
+						graphql.Inspect(oldArg.Type()), graphql.Inspect(newArg.Type()))
+				} else if oldArg.HasDefaultValue() {
+					oldValue := printDefaultValue(oldArg)
+					newValue := printDefaultValue(newArg)
+					if oldValue != newValue {
+						if newArg.HasDefaultValue() {
+							differ.addDangerousChange(ArgDefaultValueChange,
+								"%s.%s arg %s has changed defaultValue from %s to %s.",
+								pair.name, fieldName, oldArg.Name(), oldValue, newValue)
+						} else {
+							differ.addDangerousChange(ArgDefaultValueChange,
+								"%s.%s arg %s defaultValue was removed.", pair.name, fieldName, oldArg.Name())
+						}
+					}
+				}
+			}
+
+			// Check if arg was added to the field.
+			for _, newArg := range sortedArgs(newArgs) {
+				if findArg(oldArgs, newArg.Name()) != nil {
+					continue
+				}
+				if graphql.IsRequiredArgument(newArg) {
+					differ.addBreakingChange(RequiredArgAdded, "A required arg %s on %s.%s was added.",
+						newArg.Name(), pair.name, fieldName)
+				} else {
+					differ.addDangerousChange(OptionalArgAdded, "An optional arg %s on %s.%s was added.",
+						newArg.Name(), pair.name, fieldName)
+				}
+			}
+		}
+	}
+}
+
+// printDefaultValue prints the default value of the given argument in GraphQL language. It returns
+// an empty string if the argument doesn't have a default value.
+func printDefaultValue(arg *graphql.Argument) string {
+	if !arg.HasDefaultValue() {
+		return ""
+	}
+
+	valueAST, err := graphql.ASTFromValue(arg.DefaultValue(), arg.Type())
+	if err != nil || valueAST == nil {
+		// Fallback to Inspect the value if it cannot be printed.
+		return graphql.Inspect(arg.DefaultValue())
+	}
+	return ast.Print(valueAST)
+}
+
+// interfaceNames returns the set of names of the interfaces implemented by the given Object or
+// Interface type.
+func interfaceNames(t graphql.Type) map[string]bool {
+	var interfaces []graphql.Interface
+	switch t := t.(type) {
+	case graphql.Object:
+		interfaces = t.Interfaces()
+	case graphql.Interface:
+		interfaces = t.Interfaces()
+	}
+
+	names := map[string]bool{}
+	for _, iface := range interfaces {
+		names[iface.Name()] = true
+	}
+	return names
+}
+
+func (differ *schemaDiffer) findInterfacesRemovedFromObjectOrInterfaceTypes() {
+	for _, pair := range differ.commonTypes() {
+		if !isSameObjectOrInterfaceKind(pair.oldType, pair.newType) {
+			continue
+		}
+
+		newNames := interfaceNames(pair.newType)
+		for _, name := range sortedKeys(interfaceNames(pair.oldType)) {
+			if !newNames[name] {
+				differ.addBreakingChange(InterfaceRemovedFromObject, "%s no longer implements interface %s.",
+					pair.name, name)
+			}
+		}
+	}
+}
+
+func (differ *schemaDiffer) findInterfacesAddedToObjectOrInterfaceTypes() {
+	for _, pair := range differ.commonTypes() {
+		if !isSameObjectOrInterfaceKind(pair.oldType, pair.newType) {
+			continue
+		}
+
+		oldNames := interfaceNames(pair.oldType)
+		for _, name := range sortedKeys(interfaceNames(pair.newType)) {
+			if !oldNames[name] {
+				differ.addDangerousChange(InterfaceAddedToObject, "%s added to interfaces implemented by %s.",
+					name, pair.name)
+			}
+		}
+	}
+}
+
+// sortedDirectives returns the given directives sorted by name.
+func sortedDirectives(directives graphql.DirectiveList) graphql.DirectiveList {
+	result := make(graphql.DirectiveList, len(directives))
+	copy(result, directives)
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name() < result[j].Name()
+	})
+	return result
+}
+
+func (differ *schemaDiffer) findRemovedDirectives() {
+	for _, directive := range sortedDirectives(differ.oldDirectives) {
+		if differ.newDirectives.Lookup(directive.Name()) == nil {
+			differ.addBreakingChange(DirectiveRemoved, "%s was removed.", directive.Name())
+		}
+	}
+}
+
+func (differ *schemaDiffer) findRemovedDirectiveArgs() {
+	for _, oldDirective := range sortedDirectives(differ.oldDirectives) {
+		newDirective := differ.newDirectives.Lookup(oldDirective.Name())
+		if newDirective == nil {
+			continue
+		}
+
+		newArgs := newDirective.Args()
+		for _, oldArg := range sortedArgs(oldDirective.Args()) {
+			if findArg(newArgs, oldArg.Name()) == nil {
+				differ.addBreakingChange(DirectiveArgRemoved, "%s was removed from %s.",
+					oldArg.Name(), oldDirective.Name())
+			}
+		}
+	}
+}
+
+func (differ *schemaDiffer) findAddedNonNullDirectiveArgs() {
+	for _, oldDirective := range sortedDirectives(differ.oldDirectives) {
+		newDirective := differ.newDirectives.Lookup(oldDirective.Name())
+		if newDirective == nil {
+			continue
+		}
+
+		oldArgs := oldDirective.Args()
+		for _, newArg := range sortedArgs(newDirective.Args()) {
+			if findArg(oldArgs, newArg.Name()) == nil && graphql.IsRequiredArgument(newArg) {
+				differ.addBreakingChange(RequiredDirectiveArgAdded, "A required arg %s on directive %s was added.",
+					newArg.Name(), newDirective.Name())
+			}
+		}
+	}
+}
+
+func (differ *schemaDiffer) findRemovedDirectiveLocations() {
+	for _, oldDirective := range sortedDirectives(differ.oldDirectives) {
+		newDirective := differ.newDirectives.Lookup(oldDirective.Name())
+		if newDirective == nil {
+			continue
+		}
+
+		newLocations := map[graphql.DirectiveLocation]bool{}
+		for _, location := range newDirective.Locations() {
+			newLocations[location] = true
+		}
+
+		for _, location := range oldDirective.Locations() {
+			if !newLocations[location] {
+				differ.addBreakingChange(DirectiveLocationRemoved, "%s was removed from %s.",
+					location, oldDirective.Name())
+			}
+		}
+	}
+}
+
+// findInputObjectsThatBecameOneOf finds Input Objects that were changed to OneOf Input Objects.
+// Clients that specify more or less than one field of such Input Objects are broken. The opposite
+// change is safe because it only relaxes the input constraint.
+func (differ *schemaDiffer) findInputObjectsThatBecameOneOf() {
+	for _, pair := range differ.commonTypes() {
+		oldType, ok := pair.oldType.(graphql.InputObject)
+		if !ok {
+			continue
+		}
+		newType, ok := pair.newType.(graphql.InputObject)
+		if !ok {
+			continue
+		}
+
+		if !oldType.IsOneOf() && newType.IsOneOf() {
+			differ.addBreakingChange(InputObjectBecameOneOf, "%s became a OneOf Input Object.", pair.name)
+		}
+	}
+}
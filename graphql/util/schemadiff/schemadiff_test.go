@@ -0,0 +1,1246 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package schemadiff_test
+
+import (
+	"github.com/botobag/artemis/graphql"
+	"github.com/botobag/artemis/graphql/token"
+	"github.com/botobag/artemis/graphql/util/schemadiff"
+	"github.com/botobag/artemis/graphql/util/sdl"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func buildSchema(source string) graphql.Schema {
+	schema, errs := sdl.BuildSchema(token.NewSource(source), &sdl.Resolvers{})
+	Expect(errs).Should(Equal(graphql.NoErrors()))
+	return schema
+}
+
+// graphql-js/src/utilities/__tests__/findBreakingChanges-test.js@f529809
+var _ = Describe("FindBreakingChanges", func() {
+	It("should detect if a type was removed or not", func() {
+		oldSchema := buildSchema(`
+      type Type1 {
+        field1: String
+      }
+
+      type Type2 {
+        field1: String
+      }
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		newSchema := buildSchema(`
+      type Type2 {
+        field1: String
+      }
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		Expect(schemadiff.FindBreakingChanges(oldSchema, newSchema)).Should(Equal([]schemadiff.Change{
+			{
+				Kind:        schemadiff.TypeRemoved,
+				Description: "Type1 was removed.",
+			},
+		}))
+		Expect(schemadiff.FindBreakingChanges(oldSchema, oldSchema)).Should(BeEmpty())
+	})
+
+	It("should detect if a type changed its type", func() {
+		oldSchema := buildSchema(`
+      interface Type1 {
+        field1: String
+      }
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		newSchema := buildSchema(`
+      type ObjectType {
+        field1: String
+      }
+
+      union Type1 = ObjectType
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		Expect(schemadiff.FindBreakingChanges(oldSchema, newSchema)).Should(Equal([]schemadiff.Change{
+			{
+				Kind:        schemadiff.TypeChangedKind,
+				Description: "Type1 changed from an Interface type to a Union type.",
+			},
+		}))
+	})
+
+	It("should detect if a field on a type was deleted or changed type", func() {
+		oldSchema := buildSchema(`
+      type TypeA {
+        field1: String
+      }
+
+      interface Type1 {
+        field1: TypeA
+        field2: String
+        field3: String
+        field4: TypeA
+        field6: String
+        field7: [String]
+        field8: Int
+        field9: Int!
+        field10: [Int]!
+        field11: Int
+        field12: [Int]
+        field13: [Int!]
+        field14: [Int]
+        field15: [[Int]]
+        field16: Int!
+        field17: [Int]
+        field18: [[Int!]!]
+      }
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		newSchema := buildSchema(`
+      type TypeA {
+        field1: String
+      }
+
+      type TypeB {
+        field1: String
+      }
+
+      interface Type1 {
+        field1: TypeA
+        field3: Boolean
+        field4: TypeB
+        field5: String
+        field6: [String]
+        field7: String
+        field8: Int!
+        field9: Int
+        field10: [Int]
+        field11: [Int]!
+        field12: [Int!]
+        field13: [Int]
+        field14: [[Int]]
+        field15: [Int]
+        field16: [Int]!
+        field17: [Int]!
+        field18: [[Int!]]
+      }
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		Expect(schemadiff.FindBreakingChanges(oldSchema, newSchema)).Should(Equal([]schemadiff.Change{
+			{
+				Kind:        schemadiff.FieldChangedKind,
+				Description: "Type1.field10 changed type from [Int]! to [Int].",
+			},
+			{
+				Kind:        schemadiff.FieldChangedKind,
+				Description: "Type1.field11 changed type from Int to [Int]!.",
+			},
+			{
+				Kind:        schemadiff.FieldChangedKind,
+				Description: "Type1.field13 changed type from [Int!] to [Int].",
+			},
+			{
+				Kind:        schemadiff.FieldChangedKind,
+				Description: "Type1.field14 changed type from [Int] to [[Int]].",
+			},
+			{
+				Kind:        schemadiff.FieldChangedKind,
+				Description: "Type1.field15 changed type from [[Int]] to [Int].",
+			},
+			{
+				Kind:        schemadiff.FieldChangedKind,
+				Description: "Type1.field16 changed type from Int! to [Int]!.",
+			},
+			{
+				Kind:        schemadiff.FieldChangedKind,
+				Description: "Type1.field18 changed type from [[Int!]!] to [[Int!]].",
+			},
+			{
+				Kind:        schemadiff.FieldRemoved,
+				Description: "Type1.field2 was removed.",
+			},
+			{
+				Kind:        schemadiff.FieldChangedKind,
+				Description: "Type1.field3 changed type from String to Boolean.",
+			},
+			{
+				Kind:        schemadiff.FieldChangedKind,
+				Description: "Type1.field4 changed type from TypeA to TypeB.",
+			},
+			{
+				Kind:        schemadiff.FieldChangedKind,
+				Description: "Type1.field6 changed type from String to [String].",
+			},
+			{
+				Kind:        schemadiff.FieldChangedKind,
+				Description: "Type1.field7 changed type from [String] to String.",
+			},
+			{
+				Kind:        schemadiff.FieldChangedKind,
+				Description: "Type1.field9 changed type from Int! to Int.",
+			},
+		}))
+	})
+
+	It("should detect if fields on input types changed kind or were removed", func() {
+		oldSchema := buildSchema(`
+      input InputType1 {
+        field1: String
+        field2: Boolean
+        field3: [String]
+        field4: String!
+        field5: String
+        field6: [Int]
+        field7: [Int]!
+        field8: Int
+        field9: [Int]
+        field10: [Int!]
+        field11: [Int]
+        field12: [[Int]]
+        field13: Int!
+        field14: [[Int]!]
+        field15: [[Int]!]
+      }
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		newSchema := buildSchema(`
+      input InputType1 {
+        field1: Int
+        field3: String
+        field4: String
+        field5: String!
+        field6: [Int]!
+        field7: [Int]
+        field8: [Int]!
+        field9: [Int!]
+        field10: [Int]
+        field11: [[Int]]
+        field12: [Int]
+        field13: [Int]!
+        field14: [[Int]]
+        field15: [[Int!]!]
+      }
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		Expect(schemadiff.FindBreakingChanges(oldSchema, newSchema)).Should(Equal([]schemadiff.Change{
+			{
+				Kind:        schemadiff.FieldChangedKind,
+				Description: "InputType1.field1 changed type from String to Int.",
+			},
+			{
+				Kind:        schemadiff.FieldChangedKind,
+				Description: "InputType1.field11 changed type from [Int] to [[Int]].",
+			},
+			{
+				Kind:        schemadiff.FieldChangedKind,
+				Description: "InputType1.field12 changed type from [[Int]] to [Int].",
+			},
+			{
+				Kind:        schemadiff.FieldChangedKind,
+				Description: "InputType1.field13 changed type from Int! to [Int]!.",
+			},
+			{
+				Kind:        schemadiff.FieldChangedKind,
+				Description: "InputType1.field15 changed type from [[Int]!] to [[Int!]!].",
+			},
+			{
+				Kind:        schemadiff.FieldRemoved,
+				Description: "InputType1.field2 was removed.",
+			},
+			{
+				Kind:        schemadiff.FieldChangedKind,
+				Description: "InputType1.field3 changed type from [String] to String.",
+			},
+			{
+				Kind:        schemadiff.FieldChangedKind,
+				Description: "InputType1.field5 changed type from String to String!.",
+			},
+			{
+				Kind:        schemadiff.FieldChangedKind,
+				Description: "InputType1.field6 changed type from [Int] to [Int]!.",
+			},
+			{
+				Kind:        schemadiff.FieldChangedKind,
+				Description: "InputType1.field8 changed type from Int to [Int]!.",
+			},
+			{
+				Kind:        schemadiff.FieldChangedKind,
+				Description: "InputType1.field9 changed type from [Int] to [Int!].",
+			},
+		}))
+	})
+
+	It("should detect if a required field is added to an input type", func() {
+		oldSchema := buildSchema(`
+      input InputType1 {
+        field1: String
+      }
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		newSchema := buildSchema(`
+      input InputType1 {
+        field1: String
+        requiredField: Int!
+        optionalField1: Boolean
+        optionalField2: Boolean! = false
+      }
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		Expect(schemadiff.FindBreakingChanges(oldSchema, newSchema)).Should(Equal([]schemadiff.Change{
+			{
+				Kind:        schemadiff.RequiredInputFieldAdded,
+				Description: "A required field requiredField on input type InputType1 was added.",
+			},
+		}))
+	})
+
+	It("should detect if a type was removed from a union type", func() {
+		oldSchema := buildSchema(`
+      type Type1 {
+        field1: String
+      }
+
+      type Type2 {
+        field1: String
+      }
+
+      union UnionType1 = Type1 | Type2
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		newSchema := buildSchema(`
+      type Type1 {
+        field1: String
+      }
+
+      type Type3 {
+        field1: String
+      }
+
+      union UnionType1 = Type1 | Type3
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		Expect(schemadiff.FindBreakingChanges(oldSchema, newSchema)).Should(Equal([]schemadiff.Change{
+			{
+				Kind:        schemadiff.TypeRemoved,
+				Description: "Type2 was removed.",
+			},
+			{
+				Kind:        schemadiff.TypeRemovedFromUnion,
+				Description: "Type2 was removed from union type UnionType1.",
+			},
+		}))
+	})
+
+	It("should detect if a value was removed from an enum type", func() {
+		oldSchema := buildSchema(`
+      enum EnumType1 {
+        VALUE0
+        VALUE1
+        VALUE2
+      }
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		newSchema := buildSchema(`
+      enum EnumType1 {
+        VALUE0
+        VALUE2
+        VALUE3
+      }
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		Expect(schemadiff.FindBreakingChanges(oldSchema, newSchema)).Should(Equal([]schemadiff.Change{
+			{
+				Kind:        schemadiff.ValueRemovedFromEnum,
+				Description: "VALUE1 was removed from enum type EnumType1.",
+			},
+		}))
+	})
+
+	It("should detect if a field argument was removed", func() {
+		oldSchema := buildSchema(`
+      interface Interface1 {
+        field1(arg1: Boolean, objectArg: String): String
+      }
+
+      type Type1 {
+        field1(name: String): String
+      }
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		newSchema := buildSchema(`
+      interface Interface1 {
+        field1: String
+      }
+
+      type Type1 {
+        field1: String
+      }
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		Expect(schemadiff.FindBreakingChanges(oldSchema, newSchema)).Should(Equal([]schemadiff.Change{
+			{
+				Kind:        schemadiff.ArgRemoved,
+				Description: "Interface1.field1 arg arg1 was removed.",
+			},
+			{
+				Kind:        schemadiff.ArgRemoved,
+				Description: "Interface1.field1 arg objectArg was removed.",
+			},
+			{
+				Kind:        schemadiff.ArgRemoved,
+				Description: "Type1.field1 arg name was removed.",
+			},
+		}))
+	})
+
+	It("should detect if a field argument has changed type", func() {
+		oldSchema := buildSchema(`
+      type Type1 {
+        field1(
+          arg1: String
+          arg2: String
+          arg3: [String]
+          arg4: String
+          arg5: String!
+          arg6: String!
+          arg7: [Int]!
+          arg8: Int
+          arg9: [Int]
+          arg10: [Int!]
+          arg11: [Int]
+          arg12: [[Int]]
+          arg13: Int!
+          arg14: [[Int]!]
+          arg15: [[Int]!]
+        ): String
+      }
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		newSchema := buildSchema(`
+      type Type1 {
+        field1(
+          arg1: Int
+          arg2: [String]
+          arg3: String
+          arg4: String!
+          arg5: Int
+          arg6: Int!
+          arg7: [Int]
+          arg8: [Int]!
+          arg9: [Int!]
+          arg10: [Int]
+          arg11: [[Int]]
+          arg12: [Int]
+          arg13: [Int]!
+          arg14: [[Int]]
+          arg15: [[Int!]!]
+        ): String
+      }
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		Expect(schemadiff.FindBreakingChanges(oldSchema, newSchema)).Should(Equal([]schemadiff.Change{
+			{
+				Kind:        schemadiff.ArgChangedKind,
+				Description: "Type1.field1 arg arg1 has changed type from String to Int.",
+			},
+			{
+				Kind:        schemadiff.ArgChangedKind,
+				Description: "Type1.field1 arg arg11 has changed type from [Int] to [[Int]].",
+			},
+			{
+				Kind:        schemadiff.ArgChangedKind,
+				Description: "Type1.field1 arg arg12 has changed type from [[Int]] to [Int].",
+			},
+			{
+				Kind:        schemadiff.ArgChangedKind,
+				Description: "Type1.field1 arg arg13 has changed type from Int! to [Int]!.",
+			},
+			{
+				Kind:        schemadiff.ArgChangedKind,
+				Description: "Type1.field1 arg arg15 has changed type from [[Int]!] to [[Int!]!].",
+			},
+			{
+				Kind:        schemadiff.ArgChangedKind,
+				Description: "Type1.field1 arg arg2 has changed type from String to [String].",
+			},
+			{
+				Kind:        schemadiff.ArgChangedKind,
+				Description: "Type1.field1 arg arg3 has changed type from [String] to String.",
+			},
+			{
+				Kind:        schemadiff.ArgChangedKind,
+				Description: "Type1.field1 arg arg4 has changed type from String to String!.",
+			},
+			{
+				Kind:        schemadiff.ArgChangedKind,
+				Description: "Type1.field1 arg arg5 has changed type from String! to Int.",
+			},
+			{
+				Kind:        schemadiff.ArgChangedKind,
+				Description: "Type1.field1 arg arg6 has changed type from String! to Int!.",
+			},
+			{
+				Kind:        schemadiff.ArgChangedKind,
+				Description: "Type1.field1 arg arg8 has changed type from Int to [Int]!.",
+			},
+			{
+				Kind:        schemadiff.ArgChangedKind,
+				Description: "Type1.field1 arg arg9 has changed type from [Int] to [Int!].",
+			},
+		}))
+	})
+
+	It("should detect if a required field argument was added", func() {
+		oldSchema := buildSchema(`
+      type Type1 {
+        field1(arg1: String): String
+      }
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		newSchema := buildSchema(`
+      type Type1 {
+        field1(
+          arg1: String,
+          newRequiredArg: String!
+          newOptionalArg1: Int
+          newOptionalArg2: Int! = 0
+        ): String
+      }
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		Expect(schemadiff.FindBreakingChanges(oldSchema, newSchema)).Should(Equal([]schemadiff.Change{
+			{
+				Kind:        schemadiff.RequiredArgAdded,
+				Description: "A required arg newRequiredArg on Type1.field1 was added.",
+			},
+		}))
+	})
+
+	It("should not flag args with the same type signature as breaking", func() {
+		oldSchema := buildSchema(`
+      input InputType1 {
+        field1: String
+      }
+
+      type Type1 {
+        field1(arg1: Int!, arg2: InputType1): Int
+      }
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		newSchema := buildSchema(`
+      input InputType1 {
+        field1: String
+      }
+
+      type Type1 {
+        field1(arg1: Int!, arg2: InputType1): Int
+      }
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		Expect(schemadiff.FindBreakingChanges(oldSchema, newSchema)).Should(BeEmpty())
+	})
+
+	It("should consider args that move away from NonNull as non-breaking", func() {
+		oldSchema := buildSchema(`
+      type Type1 {
+        field1(name: String!): String
+      }
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		newSchema := buildSchema(`
+      type Type1 {
+        field1(name: String): String
+      }
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		Expect(schemadiff.FindBreakingChanges(oldSchema, newSchema)).Should(BeEmpty())
+	})
+
+	It("should detect interfaces removed from types", func() {
+		oldSchema := buildSchema(`
+      interface Interface1 {
+        field1: String
+      }
+
+      type Type1 implements Interface1 {
+        field1: String
+      }
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		newSchema := buildSchema(`
+      interface Interface1 {
+        field1: String
+      }
+
+      type Type1 {
+        field1: String
+      }
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		Expect(schemadiff.FindBreakingChanges(oldSchema, newSchema)).Should(Equal([]schemadiff.Change{
+			{
+				Kind:        schemadiff.InterfaceRemovedFromObject,
+				Description: "Type1 no longer implements interface Interface1.",
+			},
+		}))
+	})
+
+	It("should detect interfaces removed from interfaces", func() {
+		oldSchema := buildSchema(`
+      interface Interface1 {
+        field1: String
+      }
+
+      interface Interface2 implements Interface1 {
+        field1: String
+      }
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		newSchema := buildSchema(`
+      interface Interface1 {
+        field1: String
+      }
+
+      interface Interface2 {
+        field1: String
+      }
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		Expect(schemadiff.FindBreakingChanges(oldSchema, newSchema)).Should(Equal([]schemadiff.Change{
+			{
+				Kind:        schemadiff.InterfaceRemovedFromObject,
+				Description: "Interface2 no longer implements interface Interface1.",
+			},
+		}))
+	})
+
+	It("should detect if an input type became a OneOf Input Object", func() {
+		oldSchema := buildSchema(`
+      input InputType1 {
+        field1: String
+        field2: Int
+      }
+
+      input InputType2 @oneOf {
+        field1: String
+        field2: Int
+      }
+
+      type Query {
+        field1(arg1: InputType1, arg2: InputType2): String
+      }
+    `)
+
+		newSchema := buildSchema(`
+      input InputType1 @oneOf {
+        field1: String
+        field2: Int
+      }
+
+      input InputType2 {
+        field1: String
+        field2: Int
+      }
+
+      type Query {
+        field1(arg1: InputType1, arg2: InputType2): String
+      }
+    `)
+
+		Expect(schemadiff.FindBreakingChanges(oldSchema, newSchema)).Should(Equal([]schemadiff.Change{
+			{
+				Kind:        schemadiff.InputObjectBecameOneOf,
+				Description: "InputType1 became a OneOf Input Object.",
+			},
+		}))
+		Expect(schemadiff.FindDangerousChanges(oldSchema, newSchema)).Should(BeEmpty())
+	})
+
+	It("should not report deprecation of arguments and input fields", func() {
+		oldSchema := buildSchema(`
+      input InputType1 {
+        field1: String
+        field2: Int @deprecated
+      }
+
+      type Query {
+        field1(arg1: InputType1, arg2: String, arg3: Int @deprecated): String
+      }
+    `)
+
+		newSchema := buildSchema(`
+      input InputType1 {
+        field1: String @deprecated(reason: "Use field2.")
+        field2: Int
+      }
+
+      type Query {
+        field1(arg1: InputType1, arg2: String @deprecated, arg3: Int): String
+      }
+    `)
+
+		Expect(schemadiff.FindBreakingChanges(oldSchema, newSchema)).Should(BeEmpty())
+		Expect(schemadiff.FindDangerousChanges(oldSchema, newSchema)).Should(BeEmpty())
+	})
+
+	It("should detect if a directive was explicitly removed", func() {
+		oldSchema := buildSchema(`
+      directive @DirectiveThatIsRemoved on FIELD_DEFINITION
+      directive @DirectiveThatStays on FIELD_DEFINITION
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		newSchema := buildSchema(`
+      directive @DirectiveThatStays on FIELD_DEFINITION
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		Expect(schemadiff.FindBreakingChanges(oldSchema, newSchema)).Should(Equal([]schemadiff.Change{
+			{
+				Kind:        schemadiff.DirectiveRemoved,
+				Description: "DirectiveThatIsRemoved was removed.",
+			},
+		}))
+	})
+
+	It("should detect if a directive argument was removed", func() {
+		oldSchema := buildSchema(`
+      directive @DirectiveWithArg(arg1: String) on FIELD_DEFINITION
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		newSchema := buildSchema(`
+      directive @DirectiveWithArg on FIELD_DEFINITION
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		Expect(schemadiff.FindBreakingChanges(oldSchema, newSchema)).Should(Equal([]schemadiff.Change{
+			{
+				Kind:        schemadiff.DirectiveArgRemoved,
+				Description: "arg1 was removed from DirectiveWithArg.",
+			},
+		}))
+	})
+
+	It("should detect if an optional directive argument was added", func() {
+		oldSchema := buildSchema(`
+      directive @DirectiveName on FIELD_DEFINITION
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		newSchema := buildSchema(`
+      directive @DirectiveName(
+        newRequiredArg: Boolean!
+        newOptionalArg1: Int
+        newOptionalArg2: Int! = 0
+      ) on FIELD_DEFINITION
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		Expect(schemadiff.FindBreakingChanges(oldSchema, newSchema)).Should(Equal([]schemadiff.Change{
+			{
+				Kind:        schemadiff.RequiredDirectiveArgAdded,
+				Description: "A required arg newRequiredArg on directive DirectiveName was added.",
+			},
+		}))
+	})
+
+	It("should detect locations removed from a directive", func() {
+		oldSchema := buildSchema(`
+      directive @DirectiveName on FIELD_DEFINITION | QUERY
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		newSchema := buildSchema(`
+      directive @DirectiveName on FIELD_DEFINITION
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		Expect(schemadiff.FindBreakingChanges(oldSchema, newSchema)).Should(Equal([]schemadiff.Change{
+			{
+				Kind:        schemadiff.DirectiveLocationRemoved,
+				Description: "QUERY was removed from DirectiveName.",
+			},
+		}))
+	})
+})
+
+// graphql-js/src/utilities/__tests__/findBreakingChanges-test.js@f529809
+var _ = Describe("FindDangerousChanges", func() {
+	It("should detect if a defaultValue changed on an argument", func() {
+		oldSchema := buildSchema(`
+      input Input1 {
+        innerInputArray: [Input2]
+      }
+
+      input Input2 {
+        arrayField: [Int]
+      }
+
+      type Type1 {
+        field1(
+          withDefaultValue: String = "TO BE DELETED"
+          stringArg: String = "test"
+          emptyArray: [Int!] = []
+          valueArray: [[String]] = [["a", "b"], ["c"]]
+          complexObject: Input1 = {
+            innerInputArray: [{ arrayField: [1, 2, 3] }]
+          }
+        ): String
+      }
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		newSchema := buildSchema(`
+      input Input1 {
+        innerInputArray: [Input2]
+      }
+
+      input Input2 {
+        arrayField: [Int]
+      }
+
+      type Type1 {
+        field1(
+          withDefaultValue: String
+          stringArg: String = "Test"
+          emptyArray: [Int!] = [7]
+          valueArray: [[String]] = [["b", "a"], ["d"]]
+          complexObject: Input1 = {
+            innerInputArray: [{ arrayField: [3, 2, 1] }]
+          }
+        ): String
+      }
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		Expect(schemadiff.FindDangerousChanges(oldSchema, newSchema)).Should(Equal([]schemadiff.Change{
+			{
+				Kind:        schemadiff.ArgDefaultValueChange,
+				Description: `Type1.field1 arg complexObject has changed defaultValue from {innerInputArray: [{arrayField: [1, 2, 3]}]} to {innerInputArray: [{arrayField: [3, 2, 1]}]}.`,
+			},
+			{
+				Kind:        schemadiff.ArgDefaultValueChange,
+				Description: "Type1.field1 arg emptyArray has changed defaultValue from [] to [7].",
+			},
+			{
+				Kind:        schemadiff.ArgDefaultValueChange,
+				Description: `Type1.field1 arg stringArg has changed defaultValue from "test" to "Test".`,
+			},
+			{
+				Kind:        schemadiff.ArgDefaultValueChange,
+				Description: `Type1.field1 arg valueArray has changed defaultValue from [["a", "b"], ["c"]] to [["b", "a"], ["d"]].`,
+			},
+			{
+				Kind:        schemadiff.ArgDefaultValueChange,
+				Description: "Type1.field1 arg withDefaultValue defaultValue was removed.",
+			},
+		}))
+	})
+
+	It("should ignore changes in field order of defaultValue", func() {
+		oldSchema := buildSchema(`
+      input Input1 {
+        a: String
+        b: String
+        c: String
+      }
+
+      type Query {
+        field1(
+          arg1: Input1 = { a: "a", b: "b", c: "c" }
+        ): String
+      }
+    `)
+
+		newSchema := buildSchema(`
+      input Input1 {
+        a: String
+        b: String
+        c: String
+      }
+
+      type Query {
+        field1(
+          arg1: Input1 = { c: "c", b: "b", a: "a" }
+        ): String
+      }
+    `)
+
+		Expect(schemadiff.FindDangerousChanges(oldSchema, newSchema)).Should(BeEmpty())
+	})
+
+	It("should detect if a value was added to an enum type", func() {
+		oldSchema := buildSchema(`
+      enum EnumType1 {
+        VALUE0
+        VALUE1
+      }
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		newSchema := buildSchema(`
+      enum EnumType1 {
+        VALUE0
+        VALUE1
+        VALUE2
+      }
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		Expect(schemadiff.FindDangerousChanges(oldSchema, newSchema)).Should(Equal([]schemadiff.Change{
+			{
+				Kind:        schemadiff.ValueAddedToEnum,
+				Description: "VALUE2 was added to enum type EnumType1.",
+			},
+		}))
+	})
+
+	It("should detect interfaces added to types", func() {
+		oldSchema := buildSchema(`
+      interface OldInterface {
+        field1: String
+      }
+
+      interface NewInterface {
+        field1: String
+      }
+
+      type Type1 implements OldInterface {
+        field1: String
+      }
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		newSchema := buildSchema(`
+      interface OldInterface {
+        field1: String
+      }
+
+      interface NewInterface {
+        field1: String
+      }
+
+      type Type1 implements OldInterface & NewInterface {
+        field1: String
+      }
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		Expect(schemadiff.FindDangerousChanges(oldSchema, newSchema)).Should(Equal([]schemadiff.Change{
+			{
+				Kind:        schemadiff.InterfaceAddedToObject,
+				Description: "NewInterface added to interfaces implemented by Type1.",
+			},
+		}))
+	})
+
+	It("should detect interfaces added to interfaces", func() {
+		oldSchema := buildSchema(`
+      interface OldInterface {
+        field1: String
+      }
+
+      interface NewInterface {
+        field1: String
+      }
+
+      interface Interface1 implements OldInterface {
+        field1: String
+      }
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		newSchema := buildSchema(`
+      interface OldInterface {
+        field1: String
+      }
+
+      interface NewInterface {
+        field1: String
+      }
+
+      interface Interface1 implements OldInterface & NewInterface {
+        field1: String
+      }
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		Expect(schemadiff.FindDangerousChanges(oldSchema, newSchema)).Should(Equal([]schemadiff.Change{
+			{
+				Kind:        schemadiff.InterfaceAddedToObject,
+				Description: "NewInterface added to interfaces implemented by Interface1.",
+			},
+		}))
+	})
+
+	It("should detect if a type was added to a union type", func() {
+		oldSchema := buildSchema(`
+      type Type1 {
+        field1: String
+      }
+
+      union UnionType1 = Type1
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		newSchema := buildSchema(`
+      type Type1 {
+        field1: String
+      }
+
+      type Type2 {
+        field1: String
+      }
+
+      union UnionType1 = Type1 | Type2
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		Expect(schemadiff.FindDangerousChanges(oldSchema, newSchema)).Should(Equal([]schemadiff.Change{
+			{
+				Kind:        schemadiff.TypeAddedToUnion,
+				Description: "Type2 was added to union type UnionType1.",
+			},
+		}))
+	})
+
+	It("should detect if an optional field was added to an input", func() {
+		oldSchema := buildSchema(`
+      input InputType1 {
+        field1: String
+      }
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		newSchema := buildSchema(`
+      input InputType1 {
+        field1: String
+        field2: Int
+      }
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		Expect(schemadiff.FindDangerousChanges(oldSchema, newSchema)).Should(Equal([]schemadiff.Change{
+			{
+				Kind:        schemadiff.OptionalInputFieldAdded,
+				Description: "An optional field field2 on input type InputType1 was added.",
+			},
+		}))
+	})
+
+	It("should detect if an optional field argument was added", func() {
+		oldSchema := buildSchema(`
+      type Type1 {
+        field1(arg1: String): String
+      }
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		newSchema := buildSchema(`
+      type Type1 {
+        field1(arg1: String, arg2: String): String
+      }
+
+      type Query {
+        field1: String
+      }
+    `)
+
+		Expect(schemadiff.FindDangerousChanges(oldSchema, newSchema)).Should(Equal([]schemadiff.Change{
+			{
+				Kind:        schemadiff.OptionalArgAdded,
+				Description: "An optional arg arg2 on Type1.field1 was added.",
+			},
+		}))
+	})
+})
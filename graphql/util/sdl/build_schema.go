@@ -0,0 +1,1373 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package sdl
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/botobag/artemis/graphql"
+	"github.com/botobag/artemis/graphql/ast"
+	"github.com/botobag/artemis/graphql/internal/value"
+	"github.com/botobag/artemis/graphql/parser"
+	"github.com/botobag/artemis/graphql/token"
+)
+
+// buildOptions configures the schema built by BuildSchema.
+type buildOptions struct {
+	// If true, every field in Object types must be given a resolver and every Interface and Union
+	// type must be given a type resolver.
+	RequireResolvers bool
+
+	// Transformers for the types and fields where the directives are applied; see
+	// TransformDirective.
+	DirectiveTransformers map[string]DirectiveTransformer
+}
+
+// BuildOption provides an option to BuildSchema.
+type BuildOption func(options *buildOptions)
+
+// RequireResolvers sets options.RequireResolvers. It reports an error for every field in Object
+// types that isn't given a resolver (or a subscriber) and every Interface or Union type that isn't given a type
+// resolver.
+func RequireResolvers() BuildOption {
+	return func(options *buildOptions) {
+		options.RequireResolvers = true
+	}
+}
+
+// BuildSchema parses the SDL in the given source and builds a schema from it. The resolvers and
+// subscribers for fields, type resolvers for abstract types and coercers for custom scalars are
+// taken from the given resolvers which may be nil if the SDL doesn't require any.
+func BuildSchema(source *token.Source, resolvers *Resolvers, opts ...BuildOption) (graphql.Schema, graphql.Errors) {
+	document, err := parser.Parse(source)
+	if err != nil {
+		return nil, graphql.ErrorsOf(err)
+	}
+	return BuildASTSchema(document, resolvers, opts...)
+}
+
+// BuildASTSchema takes an ast.Document that contains type system definitions and extensions and
+// builds a schema from it. It is similar to BuildSchema but works on a parsed document.
+//
+// If no schema definition is provided, then it will look for types named Query, Mutation and
+// Subscription for root operation types. Executable definitions (i.e., operations and fragments) in
+// the document are ignored.
+func BuildASTSchema(document ast.Document, resolvers *Resolvers, opts ...BuildOption) (graphql.Schema, graphql.Errors) {
+	var options buildOptions
+	for _, applyOption := range opts {
+		applyOption(&options)
+	}
+
+	if resolvers == nil {
+		resolvers = &Resolvers{}
+	}
+
+	return newSchemaBuilder(nil, resolvers, &options).build(document)
+}
+
+// standardScalar returns the built-in Scalar type with the given name or nil if there's no such
+// one.
+func standardScalar(name string) graphql.Scalar {
+	switch name {
+	case "Int":
+		return graphql.Int()
+	case "Float":
+		return graphql.Float()
+	case "String":
+		return graphql.String()
+	case "Boolean":
+		return graphql.Boolean()
+	case "ID":
+		return graphql.ID()
+	}
+	return nil
+}
+
+// inputObjectState tracks the progress of resolving default values in an Input Object.
+type inputObjectState int
+
+// Enumeration of inputObjectState
+const (
+	inputObjectPending inputObjectState = iota
+	inputObjectFinishing
+	inputObjectFinished
+)
+
+// pendingArgumentDefaultValue records an argument whose default value will be coerced after all
+// Input Objects are defined.
+type pendingArgumentDefaultValue struct {
+	// The map that contains the argument
+	args graphql.ArgumentConfigMap
+
+	// Name of the argument
+	name string
+
+	// Coordinate of the argument (e.g., "Query.field(arg:)") for reporting error
+	coordinate string
+
+	// The AST node that defines the argument
+	node *ast.InputValueDefinition
+}
+
+// pendingAppliedDirective records a directive applied to a type system element whose arguments will
+// be coerced after all Input Objects are defined.
+type pendingAppliedDirective struct {
+	// The AppliedDirective to be filled with the argument values
+	directive *graphql.AppliedDirective
+
+	// The AST node that applies the directive
+	node *ast.Directive
+}
+
+// schemaBuilder holds internal state during building a schema.
+type schemaBuilder struct {
+	resolvers *Resolvers
+	options   *buildOptions
+
+	// The schema being extended by the document or nil when building a new schema
+	schema graphql.Schema
+
+	// Names of the types in the schema being extended that will be recreated in the new schema
+	existingTypeNames []string
+
+	// Errors that have been found so far
+	errs graphql.Errors
+
+	// AST nodes collected from the document
+	schemaDef         *ast.SchemaDefinition
+	schemaExtensions  []*ast.SchemaExtension
+	typeNames         []string
+	typeDefNodes      map[string]ast.TypeDefinition
+	typeExtNodes      map[string][]ast.TypeExtension
+	directiveDefNodes []*ast.DirectiveDefinition
+
+	// typeDefs maps type name to the TypeDefinition for creating the type. The TypeDefinition is one
+	// of config structs provided by graphql package (e.g., *graphql.ObjectConfig) for the types
+	// defined in the document or a wrapper for the built-in scalars.
+	typeDefs map[string]graphql.TypeDefinition
+
+	// Configs for the directives defined in the document
+	directiveConfigs []*graphql.DirectiveConfig
+
+	// Input field definitions (including the ones from extensions) for each Input Object
+	inputFieldNodes map[string]ast.InputValueDefinitions
+
+	// States for resolving default values in Input Objects
+	inputObjectStates map[string]inputObjectState
+
+	// Arguments whose default values are waiting to be coerced
+	pendingArgDefaults []pendingArgumentDefaultValue
+
+	// Applied directives whose arguments are waiting to be coerced
+	pendingAppliedDirectives []pendingAppliedDirective
+}
+
+func newSchemaBuilder(schema graphql.Schema, resolvers *Resolvers, options *buildOptions) *schemaBuilder {
+	return &schemaBuilder{
+		resolvers:         resolvers,
+		options:           options,
+		schema:            schema,
+		typeDefNodes:      map[string]ast.TypeDefinition{},
+		typeExtNodes:      map[string][]ast.TypeExtension{},
+		typeDefs:          map[string]graphql.TypeDefinition{},
+		inputFieldNodes:   map[string]ast.InputValueDefinitions{},
+		inputObjectStates: map[string]inputObjectState{},
+	}
+}
+
+// reportError adds an error with the locations of the given nodes.
+func (b *schemaBuilder) reportError(message string, nodes ...ast.Node) {
+	if len(nodes) == 0 {
+		b.errs.Emplace(message)
+		return
+	}
+
+	locations := make([]graphql.ErrorLocation, len(nodes))
+	for i, node := range nodes {
+		locations[i] = graphql.ErrorLocationOfASTNode(node)
+	}
+	b.errs.Emplace(message, locations)
+}
+
+func (b *schemaBuilder) build(document ast.Document) (graphql.Schema, graphql.Errors) {
+	b.collectDefinitions(document)
+	b.checkTypeExtensions()
+	if b.errs.HaveOccurred() {
+		return nil, b.errs
+	}
+
+	// Nothing to extend or transform.
+	if b.schema != nil && b.isEmptyExtension() && len(b.options.DirectiveTransformers) == 0 {
+		return b.schema, graphql.NoErrors()
+	}
+
+	// Create a TypeDefinition for every type defined in the document such that type references can be
+	// resolved when building fields.
+	b.createTypeDefs()
+
+	// Fill type definitions.
+	for _, name := range b.typeNames {
+		b.buildTypeDef(name)
+	}
+	for _, name := range b.existingTypeNames {
+		b.extendTypeDef(name)
+	}
+	b.buildExistingDirectiveConfigs()
+	for _, node := range b.directiveDefNodes {
+		b.buildDirectiveConfig(node)
+	}
+	b.checkResolvers()
+	if b.errs.HaveOccurred() {
+		return nil, b.errs
+	}
+
+	// Resolve default values which requires creating the types for the values.
+	for _, names := range [][]string{b.existingTypeNames, b.typeNames} {
+		for _, name := range names {
+			if _, isInputObject := b.typeDefs[name].(*graphql.InputObjectConfig); isInputObject {
+				b.finishInputObject(name)
+			}
+		}
+	}
+	b.resolvePendingArgDefaults()
+	b.resolvePendingAppliedDirectives()
+	if b.errs.HaveOccurred() {
+		return nil, b.errs
+	}
+
+	// Apply directive transformers which requires the arguments of the applied directives.
+	b.transformDirectives()
+	if b.errs.HaveOccurred() {
+		return nil, b.errs
+	}
+
+	// Determine root operation types.
+	query, mutation, subscription := b.rootOperationTypes()
+	if b.errs.HaveOccurred() {
+		return nil, b.errs
+	}
+
+	// Create types.
+	types := make([]graphql.Type, 0, len(b.existingTypeNames)+len(b.typeNames))
+	for _, names := range [][]string{b.existingTypeNames, b.typeNames} {
+		for _, name := range names {
+			t, err := graphql.NewType(b.typeDefs[name])
+			if err != nil {
+				b.errs.Append(err)
+				continue
+			}
+			types = append(types, t)
+		}
+	}
+
+	// Create directives.
+	directives := graphql.DirectiveList{}
+	for _, config := range b.directiveConfigs {
+		directive, err := graphql.NewDirective(config)
+		if err != nil {
+			b.errs.Append(err)
+			continue
+		}
+		directives = append(directives, directive)
+	}
+
+	if b.schema != nil {
+		// Keep the standard directives in the schema being extended.
+		for _, directive := range b.schema.Directives() {
+			if isStandardDirective(directive) {
+				directives = append(directives, directive)
+			}
+		}
+	} else {
+		// Include the standard directives unless they're redefined in the document.
+		for _, directive := range graphql.StandardDirectives() {
+			if directives.Lookup(directive.Name()) == nil {
+				directives = append(directives, directive)
+			}
+		}
+	}
+
+	if b.errs.HaveOccurred() {
+		return nil, b.errs
+	}
+
+	config := &graphql.SchemaConfig{
+		Types:                     types,
+		Directives:                directives,
+		ExcludeStandardDirectives: true,
+	}
+
+	var err error
+	if config.Query, err = b.rootOperationType(query); err != nil {
+		return nil, graphql.ErrorsOf(err)
+	}
+	if config.Mutation, err = b.rootOperationType(mutation); err != nil {
+		return nil, graphql.ErrorsOf(err)
+	}
+	if config.Subscription, err = b.rootOperationType(subscription); err != nil {
+		return nil, graphql.ErrorsOf(err)
+	}
+
+	schema, err := graphql.NewSchema(config)
+	if err != nil {
+		return nil, graphql.ErrorsOf(err)
+	}
+
+	return schema, graphql.NoErrors()
+}
+
+// collectDefinitions collects type system definitions and extensions from the document.
+func (b *schemaBuilder) collectDefinitions(document ast.Document) {
+	directiveDefNodes := map[string]*ast.DirectiveDefinition{}
+
+	for _, definition := range document.Definitions {
+		switch definition := definition.(type) {
+		case *ast.SchemaDefinition:
+			if b.schema != nil {
+				b.reportError("Cannot define a new schema within a schema extension.", definition)
+			} else if b.schemaDef != nil {
+				b.reportError("Must provide only one schema definition.", definition)
+			} else {
+				b.schemaDef = definition
+			}
+
+		case *ast.SchemaExtension:
+			b.schemaExtensions = append(b.schemaExtensions, definition)
+
+		case ast.TypeDefinition:
+			name := definition.GetName()
+			if prev, exists := b.typeDefNodes[name.Value()]; exists {
+				b.reportError(fmt.Sprintf(`There can be only one type named "%s".`, name.Value()),
+					prev.GetName(), name)
+				continue
+			}
+			if b.existingType(name.Value()) != nil {
+				b.reportError(fmt.Sprintf(`Type "%s" already exists in the schema. It cannot also be defined `+
+					`in this type definition.`, name.Value()), name)
+				continue
+			}
+			b.typeDefNodes[name.Value()] = definition
+			// Built-in scalars are always represented by the ones provided by graphql package.
+			if standardScalar(name.Value()) == nil {
+				b.typeNames = append(b.typeNames, name.Value())
+			}
+
+		case ast.TypeExtension:
+			name := definition.GetName().Value()
+			b.typeExtNodes[name] = append(b.typeExtNodes[name], definition)
+
+		case *ast.DirectiveDefinition:
+			name := definition.Name
+			if prev, exists := directiveDefNodes[name.Value()]; exists {
+				b.reportError(fmt.Sprintf(`There can be only one directive named "%s".`, name.Value()),
+					prev.Name, name)
+				continue
+			}
+			if b.schema != nil && b.schema.Directives().Lookup(name.Value()) != nil {
+				b.reportError(fmt.Sprintf(`Directive "%s" already exists in the schema. It cannot be `+
+					`redefined.`, name.Value()), name)
+				continue
+			}
+			directiveDefNodes[name.Value()] = definition
+			b.directiveDefNodes = append(b.directiveDefNodes, definition)
+		}
+	}
+}
+
+// typeKindName returns the kind of the type defined by the given node for use in error messages.
+func typeKindName(node ast.TypeDefinition) string {
+	switch node.(type) {
+	case *ast.ScalarTypeDefinition:
+		return "scalar"
+	case *ast.ObjectTypeDefinition:
+		return "object"
+	case *ast.InterfaceTypeDefinition:
+		return "interface"
+	case *ast.UnionTypeDefinition:
+		return "union"
+	case *ast.EnumTypeDefinition:
+		return "enum"
+	case *ast.InputObjectTypeDefinition:
+		return "input object"
+	}
+	return ""
+}
+
+// extensionKindName returns the kind of the type extended by the given node for use in error
+// messages.
+func extensionKindName(node ast.TypeExtension) string {
+	switch node.(type) {
+	case *ast.ScalarTypeExtension:
+		return "scalar"
+	case *ast.ObjectTypeExtension:
+		return "object"
+	case *ast.InterfaceTypeExtension:
+		return "interface"
+	case *ast.UnionTypeExtension:
+		return "union"
+	case *ast.EnumTypeExtension:
+		return "enum"
+	case *ast.InputObjectTypeExtension:
+		return "input object"
+	}
+	return ""
+}
+
+// checkTypeExtensions verifies that every type extension extends a type of the same kind defined in
+// the document or the schema being extended.
+func (b *schemaBuilder) checkTypeExtensions() {
+	// Sort names for deterministic error order.
+	names := make([]string, 0, len(b.typeExtNodes))
+	for name := range b.typeExtNodes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		for _, extension := range b.typeExtNodes[name] {
+			if existingType := b.existingType(name); existingType != nil {
+				if kind := extensionKindName(extension); kind != existingTypeKindName(existingType) {
+					b.reportError(fmt.Sprintf(`Cannot extend non-%s type "%s".`, kind, name), extension)
+				}
+				continue
+			}
+
+			definition, exists := b.typeDefNodes[name]
+			if !exists || standardScalar(name) != nil {
+				b.reportError(fmt.Sprintf(`Cannot extend type "%s" because it is not defined.`, name),
+					extension.GetName())
+			} else if kind := extensionKindName(extension); kind != typeKindName(definition) {
+				b.reportError(fmt.Sprintf(`Cannot extend non-%s type "%s".`, kind, name), definition, extension)
+			}
+		}
+	}
+}
+
+// createTypeDefs creates a TypeDefinition for every type defined in the document. The created
+// TypeDefinition's are empty and will be filled by buildTypeDef.
+func (b *schemaBuilder) createTypeDefs() {
+	for _, name := range []string{"Int", "Float", "String", "Boolean", "ID"} {
+		b.typeDefs[name] = graphql.T(standardScalar(name))
+	}
+	b.createExistingTypeDefs()
+
+	for _, name := range b.typeNames {
+		node := b.typeDefNodes[name]
+		description := descriptionOf(node.GetDescription())
+
+		switch node.(type) {
+		case *ast.ScalarTypeDefinition:
+			b.typeDefs[name] = &graphql.ScalarConfig{
+				Name:        name,
+				Description: description,
+			}
+
+		case *ast.ObjectTypeDefinition:
+			b.typeDefs[name] = &graphql.ObjectConfig{
+				Name:        name,
+				Description: description,
+			}
+
+		case *ast.InterfaceTypeDefinition:
+			b.typeDefs[name] = &graphql.InterfaceConfig{
+				Name:        name,
+				Description: description,
+			}
+
+		case *ast.UnionTypeDefinition:
+			b.typeDefs[name] = &graphql.UnionConfig{
+				Name:        name,
+				Description: description,
+			}
+
+		case *ast.EnumTypeDefinition:
+			b.typeDefs[name] = &graphql.EnumConfig{
+				Name:        name,
+				Description: description,
+			}
+
+		case *ast.InputObjectTypeDefinition:
+			b.typeDefs[name] = &graphql.InputObjectConfig{
+				Name:        name,
+				Description: description,
+			}
+		}
+	}
+}
+
+// descriptionOf returns the string in the description node or an empty string if the node is nil.
+func descriptionOf(description ast.StringValue) string {
+	if description.IsNil() {
+		return ""
+	}
+	return description.Value()
+}
+
+// deprecationOf returns a Deprecation if the @deprecated is presented in the given directives.
+func (b *schemaBuilder) deprecationOf(directives ast.Directives) *graphql.Deprecation {
+	deprecatedDirective := graphql.DeprecatedDirective()
+	for _, directive := range directives {
+		if directive.Name.Value() != deprecatedDirective.Name() {
+			continue
+		}
+
+		args, err := value.DirectiveValues(deprecatedDirective, directives, graphql.NoVariableValues())
+		if err != nil {
+			b.errs.Emplace(err.Error(), graphql.ErrorLocationOfASTNode(directive))
+			return nil
+		}
+
+		reason, _ := args.Get("reason").(string)
+		return &graphql.Deprecation{
+			Reason: reason,
+		}
+	}
+	return nil
+}
+
+// specifiedByURLOf returns the URL given to @specifiedBy in the given lists of directives or an
+// empty string if there's none.
+func (b *schemaBuilder) specifiedByURLOf(directiveLists ...ast.Directives) string {
+	specifiedByDirective := graphql.SpecifiedByDirective()
+	for _, directives := range directiveLists {
+		for _, directive := range directives {
+			if directive.Name.Value() != specifiedByDirective.Name() {
+				continue
+			}
+
+			args, err := value.DirectiveValues(specifiedByDirective, directives, graphql.NoVariableValues())
+			if err != nil {
+				b.errs.Emplace(err.Error(), graphql.ErrorLocationOfASTNode(directive))
+				return ""
+			}
+
+			url, _ := args.Get("url").(string)
+			return url
+		}
+	}
+	return ""
+}
+
+// appliedDirectivesOf returns the directives applied in the given lists of directives other than
+// @deprecated, @oneOf and @specifiedBy which are exposed via Deprecation, IsOneOf and SpecifiedByURL
+// instead. Their arguments are coerced later in resolvePendingAppliedDirectives.
+func (b *schemaBuilder) appliedDirectivesOf(directiveLists ...ast.Directives) graphql.AppliedDirectives {
+	var result graphql.AppliedDirectives
+	for _, directives := range directiveLists {
+		for _, node := range directives {
+			name := node.Name.Value()
+			switch name {
+			case graphql.DeprecatedDirective().Name(),
+				graphql.OneOfDirective().Name(),
+				graphql.SpecifiedByDirective().Name():
+				continue
+			}
+
+			directive := &graphql.AppliedDirective{
+				Name: name,
+			}
+			result = append(result, directive)
+			b.pendingAppliedDirectives = append(b.pendingAppliedDirectives, pendingAppliedDirective{
+				directive: directive,
+				node:      node,
+			})
+		}
+	}
+	return result
+}
+
+// typeDirectiveListsOf returns the lists of directives applied to the type with the given name in
+// its definition and extensions.
+func (b *schemaBuilder) typeDirectiveListsOf(name string) []ast.Directives {
+	var directiveLists []ast.Directives
+	if node, exists := b.typeDefNodes[name]; exists {
+		directiveLists = append(directiveLists, node.GetDirectives())
+	}
+	for _, extension := range b.typeExtNodes[name] {
+		directiveLists = append(directiveLists, extension.GetDirectives())
+	}
+	return directiveLists
+}
+
+// typeDirectivesOf returns the directives applied to the type with the given name in its definition
+// and extensions.
+func (b *schemaBuilder) typeDirectivesOf(name string) graphql.AppliedDirectives {
+	return b.appliedDirectivesOf(b.typeDirectiveListsOf(name)...)
+}
+
+// hasTypeDirective returns true if the directive with the given name is applied to the type with the
+// given name in its definition or extensions.
+func (b *schemaBuilder) hasTypeDirective(typeName string, directiveName string) bool {
+	for _, directives := range b.typeDirectiveListsOf(typeName) {
+		for _, directive := range directives {
+			if directive.Name.Value() == directiveName {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// namedTypeDef returns the TypeDefinition for the type with the given name.
+func (b *schemaBuilder) namedTypeDef(namedType ast.NamedType) graphql.TypeDefinition {
+	name := namedType.Name.Value()
+	typeDef, exists := b.typeDefs[name]
+	if !exists {
+		b.reportError(fmt.Sprintf(`Type "%s" not found in document.`, name), namedType)
+		return nil
+	}
+	return typeDef
+}
+
+// typeDefOf returns the TypeDefinition for the given type reference.
+func (b *schemaBuilder) typeDefOf(t ast.Type) graphql.TypeDefinition {
+	switch t := t.(type) {
+	case ast.NamedType:
+		return b.namedTypeDef(t)
+
+	case ast.ListType:
+		elementTypeDef := b.typeDefOf(t.ItemType)
+		if elementTypeDef == nil {
+			return nil
+		}
+		return graphql.ListOf(elementTypeDef)
+
+	case ast.NonNullType:
+		innerTypeDef := b.typeDefOf(t.Type)
+		if innerTypeDef == nil {
+			return nil
+		}
+		return graphql.NonNullOf(innerTypeDef)
+	}
+
+	return nil
+}
+
+// namedTypeOf returns the innermost named type in the given type reference.
+func namedTypeOf(t ast.Type) ast.NamedType {
+	for {
+		switch ttype := t.(type) {
+		case ast.NamedType:
+			return ttype
+		case ast.ListType:
+			t = ttype.ItemType
+		case ast.NonNullType:
+			t = ttype.Type
+		}
+	}
+}
+
+// buildTypeDef fills the TypeDefinition created for the type with given name.
+func (b *schemaBuilder) buildTypeDef(name string) {
+	switch node := b.typeDefNodes[name].(type) {
+	case *ast.ScalarTypeDefinition:
+		b.buildScalar(b.typeDefs[name].(*graphql.ScalarConfig), node)
+
+	case *ast.ObjectTypeDefinition:
+		b.buildObject(b.typeDefs[name].(*graphql.ObjectConfig), node)
+
+	case *ast.InterfaceTypeDefinition:
+		b.buildInterface(b.typeDefs[name].(*graphql.InterfaceConfig), node)
+
+	case *ast.UnionTypeDefinition:
+		b.buildUnion(b.typeDefs[name].(*graphql.UnionConfig), node)
+
+	case *ast.EnumTypeDefinition:
+		b.buildEnum(b.typeDefs[name].(*graphql.EnumConfig), node)
+
+	case *ast.InputObjectTypeDefinition:
+		b.buildInputObject(b.typeDefs[name].(*graphql.InputObjectConfig), node)
+	}
+}
+
+func (b *schemaBuilder) buildScalar(config *graphql.ScalarConfig, node *ast.ScalarTypeDefinition) {
+	config.Directives = b.typeDirectivesOf(config.Name)
+	config.SpecifiedByURL = b.specifiedByURLOf(b.typeDirectiveListsOf(config.Name)...)
+
+	coercers, exists := b.resolvers.Scalars[config.Name]
+	if !exists || coercers.ResultCoercer == nil {
+		b.reportError(fmt.Sprintf(`Missing coercers for custom scalar "%s".`, config.Name), node.Name)
+		return
+	}
+	config.ResultCoercer = coercers.ResultCoercer
+	config.InputCoercer = coercers.InputCoercer
+}
+
+func (b *schemaBuilder) buildObject(config *graphql.ObjectConfig, node *ast.ObjectTypeDefinition) {
+	config.Directives = b.typeDirectivesOf(config.Name)
+
+	var (
+		interfaces = node.Interfaces
+		fields     = node.Fields
+	)
+	for _, extension := range b.typeExtNodes[config.Name] {
+		extension := extension.(*ast.ObjectTypeExtension)
+		interfaces = append(interfaces, extension.Interfaces...)
+		fields = append(fields, extension.Fields...)
+	}
+
+	config.Interfaces = b.buildInterfaces(config.Name, interfaces)
+	config.Fields = b.buildFields(config.Name, fields,
+		b.resolvers.Fields[config.Name], b.resolvers.Subscribers[config.Name], true)
+}
+
+// buildInterfaces returns the definitions of the interfaces implemented by the Object or Interface
+// type with the given name.
+func (b *schemaBuilder) buildInterfaces(typeName string, nodes ast.NamedTypes) []graphql.InterfaceTypeDefinition {
+	var interfaces []graphql.InterfaceTypeDefinition
+	for _, iface := range nodes {
+		typeDef := b.namedTypeDef(iface)
+		if typeDef == nil {
+			continue
+		}
+
+		ifaceTypeDef, ok := typeDef.(graphql.InterfaceTypeDefinition)
+		if !ok {
+			b.reportError(fmt.Sprintf(`Type %s must only implement Interface types, it cannot implement %s.`,
+				typeName, iface.Name.Value()), iface)
+			continue
+		}
+		interfaces = append(interfaces, ifaceTypeDef)
+	}
+	return interfaces
+}
+
+func (b *schemaBuilder) buildInterface(config *graphql.InterfaceConfig, node *ast.InterfaceTypeDefinition) {
+	config.Directives = b.typeDirectivesOf(config.Name)
+
+	var (
+		interfaces = node.Interfaces
+		fields     = node.Fields
+	)
+	for _, extension := range b.typeExtNodes[config.Name] {
+		extension := extension.(*ast.InterfaceTypeExtension)
+		interfaces = append(interfaces, extension.Interfaces...)
+		fields = append(fields, extension.Fields...)
+	}
+
+	config.Interfaces = b.buildInterfaces(config.Name, interfaces)
+	config.Fields = b.buildFields(config.Name, fields, nil, nil, false)
+	config.TypeResolver = b.typeResolverFor(config.Name, node.Name)
+}
+
+func (b *schemaBuilder) buildUnion(config *graphql.UnionConfig, node *ast.UnionTypeDefinition) {
+	config.Directives = b.typeDirectivesOf(config.Name)
+
+	types := node.Types
+	for _, extension := range b.typeExtNodes[config.Name] {
+		types = append(types, extension.(*ast.UnionTypeExtension).Types...)
+	}
+
+	config.PossibleTypes = b.buildPossibleTypes(config.Name, types)
+	config.TypeResolver = b.typeResolverFor(config.Name, node.Name)
+}
+
+// buildPossibleTypes returns the definitions of the member types of the Union type with the given
+// name.
+func (b *schemaBuilder) buildPossibleTypes(typeName string, nodes ast.NamedTypes) []graphql.ObjectTypeDefinition {
+	var possibleTypes []graphql.ObjectTypeDefinition
+	for _, t := range nodes {
+		typeDef := b.namedTypeDef(t)
+		if typeDef == nil {
+			continue
+		}
+
+		objectTypeDef, ok := typeDef.(graphql.ObjectTypeDefinition)
+		if !ok {
+			b.reportError(fmt.Sprintf(`Union type %s can only include Object types, it cannot include %s.`,
+				typeName, t.Name.Value()), t)
+			continue
+		}
+		possibleTypes = append(possibleTypes, objectTypeDef)
+	}
+	return possibleTypes
+}
+
+// typeResolverFor returns the type resolver given for the Interface or Union type with the given
+// name.
+func (b *schemaBuilder) typeResolverFor(name string, node ast.Name) graphql.TypeResolver {
+	typeResolver := b.resolvers.Types[name]
+	if typeResolver == nil && b.options.RequireResolvers {
+		b.reportError(fmt.Sprintf(`Missing type resolver for "%s".`, name), node)
+	}
+	return typeResolver
+}
+
+func (b *schemaBuilder) buildEnum(config *graphql.EnumConfig, node *ast.EnumTypeDefinition) {
+	config.Directives = b.typeDirectivesOf(config.Name)
+
+	values := node.Values
+	for _, extension := range b.typeExtNodes[config.Name] {
+		values = append(values, extension.(*ast.EnumTypeExtension).Values...)
+	}
+
+	// Resolvers return internal values for the enum if they are given. Look up enum values by them
+	// when coercing results.
+	internalValues := b.resolvers.Enums[config.Name]
+	if len(internalValues) > 0 {
+		config.ResultCoercerFactory = graphql.DefaultEnumResultCoercerFactory(
+			graphql.DefaultEnumResultCoercerLookupByValue)
+	}
+
+	config.Values = make(graphql.EnumValueDefinitionMap, len(values))
+	for _, value := range values {
+		name := value.Name.Value()
+		if _, exists := config.Values[name]; exists {
+			b.reportError(fmt.Sprintf(`Enum value "%s.%s" can only be defined once.`, config.Name, name),
+				value.Name)
+			continue
+		}
+
+		config.Values[name] = graphql.EnumValueDefinition{
+			Description: descriptionOf(value.Description),
+			Value:       internalValues[name],
+			Deprecation: b.deprecationOf(value.Directives),
+			Directives:  b.appliedDirectivesOf(value.Directives),
+		}
+	}
+}
+
+func (b *schemaBuilder) buildInputObject(config *graphql.InputObjectConfig, node *ast.InputObjectTypeDefinition) {
+	config.Directives = b.typeDirectivesOf(config.Name)
+	config.IsOneOf = b.hasTypeDirective(config.Name, graphql.OneOfDirective().Name())
+
+	fields := node.Fields
+	for _, extension := range b.typeExtNodes[config.Name] {
+		fields = append(fields, extension.(*ast.InputObjectTypeExtension).Fields...)
+	}
+
+	// Default values are resolved later in finishInputObject.
+	var fieldNodes ast.InputValueDefinitions
+	config.Fields = make(graphql.InputFields, len(fields))
+	for _, field := range fields {
+		name := field.Name.Value()
+		if _, exists := config.Fields[name]; exists {
+			b.reportError(fmt.Sprintf(`Field "%s.%s" can only be defined once.`, config.Name, name),
+				field.Name)
+			continue
+		}
+
+		config.Fields[name] = graphql.InputFieldDefinition{
+			Description: descriptionOf(field.Description),
+			Type:        b.typeDefOf(field.Type),
+			Deprecation: b.deprecationOf(field.Directives),
+			Directives:  b.appliedDirectivesOf(field.Directives),
+		}
+		fieldNodes = append(fieldNodes, field)
+	}
+	b.inputFieldNodes[config.Name] = fieldNodes
+}
+
+// buildFields builds field configs from their definitions for the Object or Interface type with the
+// given name.
+func (b *schemaBuilder) buildFields(
+	typeName string,
+	nodes ast.FieldDefinitions,
+	resolvers FieldResolvers,
+	subscribers FieldSubscribers,
+	requireResolvers bool) graphql.Fields {
+
+	fields := make(graphql.Fields, len(nodes))
+	for _, node := range nodes {
+		name := node.Name.Value()
+		if _, exists := fields[name]; exists {
+			b.reportError(fmt.Sprintf(`Field "%s.%s" can only be defined once.`, typeName, name), node.Name)
+			continue
+		}
+
+		// A field that has a subscriber may be resolved with the default field resolver which extracts
+		// the field value from the event.
+		resolver, subscriber := resolvers[name], subscribers[name]
+		if resolver == nil && subscriber == nil && requireResolvers && b.options.RequireResolvers {
+			b.reportError(fmt.Sprintf(`Missing resolver for "%s.%s".`, typeName, name), node.Name)
+		}
+
+		fields[name] = graphql.FieldConfig{
+			Description: descriptionOf(node.Description),
+			Type:        b.typeDefOf(node.Type),
+			Args:        b.buildArgs(typeName+"."+name, node.Arguments),
+			Resolver:    resolver,
+			Subscriber:  subscriber,
+			Deprecation: b.deprecationOf(node.Directives),
+			Directives:  b.appliedDirectivesOf(node.Directives),
+		}
+	}
+
+	return fields
+}
+
+// buildArgs builds argument configs from their definitions for the field or directive specified by
+// owner (e.g., "Query.field" or "@directive"). Default values are coerced later in
+// resolvePendingArgDefaults.
+func (b *schemaBuilder) buildArgs(owner string, nodes ast.InputValueDefinitions) graphql.ArgumentConfigMap {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	args := make(graphql.ArgumentConfigMap, len(nodes))
+	for _, node := range nodes {
+		name := node.Name.Value()
+		coordinate := fmt.Sprintf("%s(%s:)", owner, name)
+		if _, exists := args[name]; exists {
+			b.reportError(fmt.Sprintf(`Argument "%s" can only be defined once.`, coordinate), node.Name)
+			continue
+		}
+
+		typeDef := b.typeDefOf(node.Type)
+		args[name] = graphql.ArgumentConfig{
+			Description: descriptionOf(node.Description),
+			Type:        typeDef,
+			Deprecation: b.deprecationOf(node.Directives),
+			Directives:  b.appliedDirectivesOf(node.Directives),
+		}
+
+		if node.DefaultValue != nil && typeDef != nil {
+			b.pendingArgDefaults = append(b.pendingArgDefaults, pendingArgumentDefaultValue{
+				args:       args,
+				name:       name,
+				coordinate: coordinate,
+				node:       node,
+			})
+		}
+	}
+
+	return args
+}
+
+func (b *schemaBuilder) buildDirectiveConfig(node *ast.DirectiveDefinition) {
+	name := node.Name.Value()
+
+	locations := make([]graphql.DirectiveLocation, len(node.Locations))
+	for i, location := range node.Locations {
+		locations[i] = graphql.DirectiveLocation(location.Value())
+	}
+
+	b.directiveConfigs = append(b.directiveConfigs, &graphql.DirectiveConfig{
+		Name:         name,
+		Description:  descriptionOf(node.Description),
+		Locations:    locations,
+		Args:         b.buildArgs("@"+name, node.Arguments),
+		IsRepeatable: node.IsRepeatable(),
+	})
+}
+
+// checkResolvers reports the entries in resolvers that don't match any definition in the document.
+func (b *schemaBuilder) checkResolvers() {
+	resolvers := b.resolvers
+
+	// Sort names for deterministic error order.
+	sortedKeys := func(keys []string) []string {
+		sort.Strings(keys)
+		return keys
+	}
+
+	// Check field resolvers.
+	typeNames := make([]string, 0, len(resolvers.Fields))
+	for name := range resolvers.Fields {
+		typeNames = append(typeNames, name)
+	}
+	for _, typeName := range sortedKeys(typeNames) {
+		config, ok := b.typeDefs[typeName].(*graphql.ObjectConfig)
+		if !ok {
+			b.reportUnmatchedResolver("resolvers", typeName, "an Object")
+			continue
+		}
+
+		fieldNames := make([]string, 0, len(resolvers.Fields[typeName]))
+		for name := range resolvers.Fields[typeName] {
+			fieldNames = append(fieldNames, name)
+		}
+		for _, fieldName := range sortedKeys(fieldNames) {
+			if _, exists := config.Fields[fieldName]; !exists {
+				b.reportError(fmt.Sprintf(`"%s.%s" defined in resolvers, but not in schema.`, typeName, fieldName))
+			}
+		}
+	}
+
+	// Check field subscribers.
+	typeNames = make([]string, 0, len(resolvers.Subscribers))
+	for name := range resolvers.Subscribers {
+		typeNames = append(typeNames, name)
+	}
+	for _, typeName := range sortedKeys(typeNames) {
+		config, ok := b.typeDefs[typeName].(*graphql.ObjectConfig)
+		if !ok {
+			b.reportUnmatchedResolver("subscribers", typeName, "an Object")
+			continue
+		}
+
+		fieldNames := make([]string, 0, len(resolvers.Subscribers[typeName]))
+		for name := range resolvers.Subscribers[typeName] {
+			fieldNames = append(fieldNames, name)
+		}
+		for _, fieldName := range sortedKeys(fieldNames) {
+			if _, exists := config.Fields[fieldName]; !exists {
+				b.reportError(fmt.Sprintf(`"%s.%s" defined in subscribers, but not in schema.`, typeName, fieldName))
+			}
+		}
+	}
+
+	// Check type resolvers.
+	typeNames = make([]string, 0, len(resolvers.Types))
+	for name := range resolvers.Types {
+		typeNames = append(typeNames, name)
+	}
+	for _, typeName := range sortedKeys(typeNames) {
+		switch b.typeDefs[typeName].(type) {
+		case *graphql.InterfaceConfig, *graphql.UnionConfig:
+		default:
+			b.reportUnmatchedResolver("type resolvers", typeName, "an Interface or a Union")
+		}
+	}
+
+	// Check scalar coercers.
+	typeNames = make([]string, 0, len(resolvers.Scalars))
+	for name := range resolvers.Scalars {
+		typeNames = append(typeNames, name)
+	}
+	for _, typeName := range sortedKeys(typeNames) {
+		if _, ok := b.typeDefs[typeName].(*graphql.ScalarConfig); !ok {
+			b.reportUnmatchedResolver("scalar coercers", typeName, "a custom Scalar")
+		}
+	}
+
+	// Check enum values.
+	typeNames = make([]string, 0, len(resolvers.Enums))
+	for name := range resolvers.Enums {
+		typeNames = append(typeNames, name)
+	}
+	for _, typeName := range sortedKeys(typeNames) {
+		config, ok := b.typeDefs[typeName].(*graphql.EnumConfig)
+		if !ok {
+			b.reportUnmatchedResolver("enum values", typeName, "an Enum")
+			continue
+		}
+
+		valueNames := make([]string, 0, len(resolvers.Enums[typeName]))
+		for name := range resolvers.Enums[typeName] {
+			valueNames = append(valueNames, name)
+		}
+		for _, valueName := range sortedKeys(valueNames) {
+			if _, exists := config.Values[valueName]; !exists {
+				b.reportError(fmt.Sprintf(`"%s.%s" defined in enum values, but not in schema.`, typeName, valueName))
+			}
+		}
+	}
+}
+
+// reportUnmatchedResolver reports an entry in resolvers which is either not defined in the document
+// or defined with an unexpected kind.
+func (b *schemaBuilder) reportUnmatchedResolver(registry string, typeName string, expectedKind string) {
+	if _, exists := b.typeDefs[typeName]; !exists {
+		b.reportError(fmt.Sprintf(`"%s" defined in %s, but not in schema.`, typeName, registry))
+	} else {
+		b.reportError(fmt.Sprintf(`"%s" defined in %s, but it is not %s type.`, typeName, registry, expectedKind))
+	}
+}
+
+// coerceDefaultValue coerces the default value specified in the given input value definition. It
+// returns false if the value cannot be coerced.
+func (b *schemaBuilder) coerceDefaultValue(
+	coordinate string,
+	node *ast.InputValueDefinition,
+	typeDef graphql.TypeDefinition) (interface{}, bool) {
+
+	t, err := graphql.NewType(typeDef)
+	if err != nil {
+		b.errs.Append(err)
+		return nil, false
+	}
+
+	defaultValue, err := value.CoerceFromAST(node.DefaultValue, t, graphql.NoVariableValues())
+	if err != nil {
+		b.reportError(fmt.Sprintf(`Invalid default value %s for "%s": %s`,
+			ast.Print(node.DefaultValue), coordinate, err.Error()), node.DefaultValue)
+		return nil, false
+	}
+
+	return defaultValue, true
+}
+
+// reachableInputObjects returns names of the Input Objects that can be reached from the given type.
+func (b *schemaBuilder) reachableInputObjects(t ast.Type) []string {
+	var (
+		result  []string
+		visited = map[string]bool{}
+		queue   = []string{namedTypeOf(t).Name.Value()}
+	)
+
+	for len(queue) > 0 {
+		var name string
+		name, queue = queue[0], queue[1:]
+		if visited[name] {
+			continue
+		}
+		visited[name] = true
+
+		if _, isInputObject := b.typeDefs[name].(*graphql.InputObjectConfig); !isInputObject {
+			continue
+		}
+
+		result = append(result, name)
+		for _, field := range b.inputFieldNodes[name] {
+			queue = append(queue, namedTypeOf(field.Type).Name.Value())
+		}
+		if existingType, ok := b.existingType(name).(graphql.InputObject); ok {
+			for _, field := range existingType.Fields() {
+				queue = append(queue, graphql.NamedTypeOf(field.Type()).(graphql.TypeWithName).Name())
+			}
+		}
+	}
+
+	return result
+}
+
+// finishInputObject coerces default values for the fields in the Input Object with given name.
+//
+// Coercing a default value requires creating the type of the field which in turn creates every
+// Input Object reachable from it. Because types are immutable after creation, default values in
+// those Input Objects must be resolved before that. Default values whose types refer back to the
+// Input Object that is being finished cannot be resolved and are reported as errors.
+func (b *schemaBuilder) finishInputObject(name string) {
+	if b.inputObjectStates[name] != inputObjectPending {
+		return
+	}
+	b.inputObjectStates[name] = inputObjectFinishing
+
+	config := b.typeDefs[name].(*graphql.InputObjectConfig)
+
+field_loop:
+	for _, node := range b.inputFieldNodes[name] {
+		fieldName := node.Name.Value()
+		field := config.Fields[fieldName]
+		if node.DefaultValue == nil || field.Type == nil {
+			continue
+		}
+
+		coordinate := name + "." + fieldName
+		for _, dependency := range b.reachableInputObjects(node.Type) {
+			if b.inputObjectStates[dependency] == inputObjectFinishing {
+				b.reportError(fmt.Sprintf(`Cannot coerce default value for "%s" because it refers to "%s" `+
+					`recursively.`, coordinate, dependency), node.DefaultValue)
+				continue field_loop
+			}
+			b.finishInputObject(dependency)
+		}
+
+		defaultValue, ok := b.coerceDefaultValue(coordinate, node, field.Type)
+		if !ok {
+			continue
+		}
+		if defaultValue == nil {
+			defaultValue = graphql.NilInputFieldDefaultValue
+		}
+
+		field.DefaultValue = defaultValue
+		config.Fields[fieldName] = field
+	}
+
+	b.inputObjectStates[name] = inputObjectFinished
+}
+
+// resolvePendingArgDefaults coerces default values for arguments. This must be called after all
+// Input Objects are finished.
+func (b *schemaBuilder) resolvePendingArgDefaults() {
+	for _, pending := range b.pendingArgDefaults {
+		arg := pending.args[pending.name]
+
+		defaultValue, ok := b.coerceDefaultValue(pending.coordinate, pending.node, arg.Type)
+		if !ok {
+			continue
+		}
+		if defaultValue == nil {
+			defaultValue = graphql.NilArgumentDefaultValue
+		}
+
+		arg.DefaultValue = defaultValue
+		pending.args[pending.name] = arg
+	}
+}
+
+// directiveArgs returns the argument configs of the directive with the given name that will be
+// included in the schema. It returns false if there's no such directive.
+func (b *schemaBuilder) directiveArgs(name string) (graphql.ArgumentConfigMap, bool) {
+	for _, config := range b.directiveConfigs {
+		if config.Name == name {
+			return config.Args, true
+		}
+	}
+
+	standardDirectives := graphql.DirectiveList(graphql.StandardDirectives())
+	if b.schema != nil {
+		standardDirectives = b.schema.Directives()
+	}
+	directive := standardDirectives.Lookup(name)
+	if directive == nil || !isStandardDirective(directive) {
+		return nil, false
+	}
+
+	args := make(graphql.ArgumentConfigMap, len(directive.Args()))
+	for _, arg := range directive.Args() {
+		args[arg.Name()] = graphql.ArgumentConfig{
+			Type: graphql.T(arg.Type()),
+		}
+	}
+	return args, true
+}
+
+// resolvePendingAppliedDirectives coerces argument values for the directives applied to type system
+// elements. This must be called after all Input Objects are finished.
+func (b *schemaBuilder) resolvePendingAppliedDirectives() {
+	for _, pending := range b.pendingAppliedDirectives {
+		name := pending.directive.Name
+		args, exists := b.directiveArgs(name)
+		if !exists {
+			b.reportError(fmt.Sprintf(`Unknown directive "@%s".`, name), pending.node)
+			continue
+		}
+
+		for _, argNode := range pending.node.Arguments {
+			argName := argNode.Name.Value()
+			arg, exists := args[argName]
+			if !exists {
+				b.reportError(fmt.Sprintf(`Unknown argument "%s" on directive "@%s".`, argName, name), argNode)
+				continue
+			} else if arg.Type == nil {
+				// Error should have been reported when building the directive.
+				continue
+			}
+
+			t, err := graphql.NewType(arg.Type)
+			if err != nil {
+				b.errs.Append(err)
+				continue
+			}
+
+			argValue, err := value.CoerceFromAST(argNode.Value, t, graphql.NoVariableValues())
+			if err != nil {
+				b.reportError(fmt.Sprintf(`Invalid value %s for argument "%s" on directive "@%s": %s`,
+					ast.Print(argNode.Value), argName, name, err.Error()), argNode.Value)
+				continue
+			}
+
+			if pending.directive.Args == nil {
+				pending.directive.Args = map[string]interface{}{}
+			}
+			pending.directive.Args[argName] = argValue
+		}
+	}
+}
+
+// rootOperationTypes determines the names of root operation types from the schema definition and
+// extensions. An empty string is returned for the operation that doesn't have a root type.
+func (b *schemaBuilder) rootOperationTypes() (query, mutation, subscription string) {
+	var (
+		operationTypes = map[ast.OperationType]string{}
+		// The nodes that specify the root operation types for reporting errors
+		operationTypeNodes = map[ast.OperationType]ast.Node{}
+	)
+
+	addOperationTypes := func(nodes ast.OperationTypeDefinitions) {
+		for _, node := range nodes {
+			operation := node.OperationType()
+			if _, exists := operationTypes[operation]; exists {
+				b.reportError(fmt.Sprintf(`There can be only one %s type in schema.`, operation), node)
+				continue
+			}
+			operationTypes[operation] = node.Type.Name.Value()
+			operationTypeNodes[operation] = node.Type
+		}
+	}
+
+	if b.schemaDef != nil {
+		addOperationTypes(b.schemaDef.OperationTypes)
+	} else if b.schema != nil {
+		// Use the root operation types in the schema being extended.
+		for operation, rootType := range map[ast.OperationType]graphql.Object{
+			ast.OperationTypeQuery:        b.schema.Query(),
+			ast.OperationTypeMutation:     b.schema.Mutation(),
+			ast.OperationTypeSubscription: b.schema.Subscription(),
+		} {
+			if rootType != nil {
+				operationTypes[operation] = rootType.Name()
+			}
+		}
+	} else {
+		// Look for the types named Query, Mutation and Subscription.
+		for operation, name := range map[ast.OperationType]string{
+			ast.OperationTypeQuery:        "Query",
+			ast.OperationTypeMutation:     "Mutation",
+			ast.OperationTypeSubscription: "Subscription",
+		} {
+			if node, exists := b.typeDefNodes[name]; exists {
+				operationTypes[operation] = name
+				operationTypeNodes[operation] = node.GetName()
+			}
+		}
+	}
+
+	for _, extension := range b.schemaExtensions {
+		addOperationTypes(extension.OperationTypes)
+	}
+
+	for _, operation := range []ast.OperationType{
+		ast.OperationTypeQuery,
+		ast.OperationTypeMutation,
+		ast.OperationTypeSubscription,
+	} {
+		name, exists := operationTypes[operation]
+		if !exists {
+			continue
+		}
+
+		switch b.typeDefs[name].(type) {
+		case *graphql.ObjectConfig:
+		case nil:
+			b.reportError(fmt.Sprintf(`Specified %s type "%s" not found in document.`, operation, name),
+				operationTypeNodes[operation])
+		default:
+			b.reportError(fmt.Sprintf(`%s root type must be Object type, it cannot be %s.`,
+				operationTypeTitle(operation), name), operationTypeNodes[operation])
+		}
+	}
+
+	return operationTypes[ast.OperationTypeQuery],
+		operationTypes[ast.OperationTypeMutation],
+		operationTypes[ast.OperationTypeSubscription]
+}
+
+// operationTypeTitle returns the title-cased name of the operation type.
+func operationTypeTitle(operation ast.OperationType) string {
+	switch operation {
+	case ast.OperationTypeQuery:
+		return "Query"
+	case ast.OperationTypeMutation:
+		return "Mutation"
+	case ast.OperationTypeSubscription:
+		return "Subscription"
+	}
+	return string(operation)
+}
+
+// rootOperationType returns the Object type for the given root operation type name.
+func (b *schemaBuilder) rootOperationType(name string) (graphql.Object, error) {
+	if len(name) == 0 {
+		return nil, nil
+	}
+	return graphql.NewObject(b.typeDefs[name].(*graphql.ObjectConfig))
+}
@@ -0,0 +1,1082 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package sdl_test
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/botobag/artemis/graphql"
+	"github.com/botobag/artemis/graphql/executor"
+	"github.com/botobag/artemis/graphql/parser"
+	"github.com/botobag/artemis/graphql/token"
+	"github.com/botobag/artemis/graphql/util/sdl"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func buildSchema(source string, resolvers *sdl.Resolvers, opts ...sdl.BuildOption) (graphql.Schema, graphql.Errors) {
+	return sdl.BuildSchema(token.NewSource(source), resolvers, opts...)
+}
+
+func mustBuildSchema(source string, resolvers *sdl.Resolvers, opts ...sdl.BuildOption) graphql.Schema {
+	schema, errs := buildSchema(source, resolvers, opts...)
+	Expect(errs).Should(Equal(graphql.NoErrors()))
+	return schema
+}
+
+func executeQuery(schema graphql.Schema, query string, rootValue interface{}) string {
+	document := parser.MustParse(token.NewSource(query))
+
+	operation, errs := executor.Prepare(schema, document)
+	Expect(errs).Should(Equal(graphql.NoErrors()))
+
+	result := operation.Execute(context.Background(), executor.RootValue(rootValue))
+	resultJSON, err := json.Marshal(result)
+	Expect(err).ShouldNot(HaveOccurred())
+	return string(resultJSON)
+}
+
+func errorAt(message string, line uint, column uint) error {
+	return graphql.NewError(message, []graphql.ErrorLocation{{Line: line, Column: column}})
+}
+
+// graphql-js/src/utilities/__tests__/buildASTSchema-test.js@f529809
+var _ = Describe("BuildSchema", func() {
+	It("can use built schema for limited execution", func() {
+		schema := mustBuildSchema(`
+      type Query {
+        str(name: String = "World"): String
+      }
+    `, &sdl.Resolvers{
+			Fields: map[string]sdl.FieldResolvers{
+				"Query": {
+					"str": graphql.FieldResolverFunc(
+						func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+							return "Hello, " + info.Args().Get("name").(string), nil
+						}),
+				},
+			},
+		})
+
+		Expect(executeQuery(schema, `{ str }`, nil)).Should(MatchJSON(`{
+			"data": {
+				"str": "Hello, World"
+			}
+		}`))
+		Expect(executeQuery(schema, `{ str(name: "Artemis") }`, nil)).Should(MatchJSON(`{
+			"data": {
+				"str": "Hello, Artemis"
+			}
+		}`))
+	})
+
+	It("falls back to the default field resolver", func() {
+		schema := mustBuildSchema(`
+      type Query {
+        add(x: Int, y: Int): Int
+      }
+    `, nil)
+
+		Expect(schema.Query().Fields()["add"].Resolver()).Should(BeNil())
+		Expect(executeQuery(schema, `{ add(x: 34, y: 55) }`, map[string]interface{}{
+			"add": 89,
+		})).Should(MatchJSON(`{
+			"data": {
+				"add": 89
+			}
+		}`))
+	})
+
+	It("uses subscribers for subscription root fields", func() {
+		schema := mustBuildSchema(`
+      type Query {
+        str: String
+      }
+
+      type Subscription {
+        counter(to: Int!): Int
+      }
+    `, &sdl.Resolvers{
+			Fields: map[string]sdl.FieldResolvers{
+				"Query": {
+					"str": graphql.FieldResolverFunc(
+						func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+							return nil, nil
+						}),
+				},
+			},
+			Subscribers: map[string]sdl.FieldSubscribers{
+				"Subscription": {
+					"counter": graphql.FieldSubscriberFunc(
+						func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+							events := make(chan interface{})
+							go func() {
+								defer close(events)
+								for i := 1; i <= info.Args().Get("to").(int); i++ {
+									select {
+									case events <- map[string]interface{}{"counter": i}:
+									case <-ctx.Done():
+										return
+									}
+								}
+							}()
+							return events, nil
+						}),
+				},
+			},
+		}, sdl.RequireResolvers())
+
+		// Subscription.counter doesn't need a resolver when it has a subscriber.
+		Expect(schema.Subscription().Fields()["counter"].Resolver()).Should(BeNil())
+		Expect(schema.Subscription().Fields()["counter"].Subscriber()).ShouldNot(BeNil())
+
+		operation, errs := executor.Prepare(schema, parser.MustParse(token.NewSource(`
+      subscription {
+        counter(to: 2)
+      }
+    `)))
+		Expect(errs).Should(Equal(graphql.NoErrors()))
+
+		results, errs := operation.Subscribe(context.Background())
+		Expect(errs).Should(Equal(graphql.NoErrors()))
+
+		var resultsJSON []string
+		for result := range results {
+			resultJSON, err := json.Marshal(result)
+			Expect(err).ShouldNot(HaveOccurred())
+			resultsJSON = append(resultsJSON, string(resultJSON))
+		}
+		Expect(resultsJSON).Should(HaveLen(2))
+		Expect(resultsJSON[0]).Should(MatchJSON(`{"data": {"counter": 1}}`))
+		Expect(resultsJSON[1]).Should(MatchJSON(`{"data": {"counter": 2}}`))
+	})
+
+	It("uses built-in scalars", func() {
+		schema := mustBuildSchema(`
+      type Query {
+        str: String
+        int: Int
+        float: Float
+        id: ID
+        bool: Boolean
+      }
+    `, nil)
+
+		fields := schema.Query().Fields()
+		Expect(fields["str"].Type()).Should(Equal(graphql.String()))
+		Expect(fields["int"].Type()).Should(Equal(graphql.Int()))
+		Expect(fields["float"].Type()).Should(Equal(graphql.Float()))
+		Expect(fields["id"].Type()).Should(Equal(graphql.ID()))
+		Expect(fields["bool"].Type()).Should(Equal(graphql.Boolean()))
+	})
+
+	It("builds descriptions and wrapping types", func() {
+		schema := mustBuildSchema(`
+      """
+      This is a query type.
+      """
+      type Query {
+        "A list of non-null strings"
+        strs: [String!]!
+      }
+    `, nil)
+
+		query := schema.Query()
+		Expect(query.Description()).Should(Equal("This is a query type."))
+
+		field := query.Fields()["strs"]
+		Expect(field.Description()).Should(Equal("A list of non-null strings"))
+		Expect(graphql.Inspect(field.Type())).Should(Equal("[String!]!"))
+	})
+
+	It("uses the root operation types in the schema definition", func() {
+		schema := mustBuildSchema(`
+      schema {
+        query: SomeQuery
+        mutation: SomeMutation
+        subscription: SomeSubscription
+      }
+
+      type SomeQuery {
+        str: String
+      }
+
+      type SomeMutation {
+        str: String
+      }
+
+      type SomeSubscription {
+        str: String
+      }
+    `, nil)
+
+		Expect(schema.Query().Name()).Should(Equal("SomeQuery"))
+		Expect(schema.Mutation().Name()).Should(Equal("SomeMutation"))
+		Expect(schema.Subscription().Name()).Should(Equal("SomeSubscription"))
+	})
+
+	It("uses types named Query, Mutation and Subscription as root operation types by default", func() {
+		schema := mustBuildSchema(`
+      type Query {
+        str: String
+      }
+
+      type Mutation {
+        str: String
+      }
+    `, nil)
+
+		Expect(schema.Query().Name()).Should(Equal("Query"))
+		Expect(schema.Mutation().Name()).Should(Equal("Mutation"))
+		Expect(schema.Subscription()).Should(BeNil())
+	})
+
+	It("supports @deprecated", func() {
+		schema := mustBuildSchema(`
+      enum MyEnum {
+        VALUE
+        OLD_VALUE @deprecated
+        OTHER_VALUE @deprecated(reason: "Terrible reasons")
+      }
+
+      type Query {
+        field1: String @deprecated
+        field2: Int @deprecated(reason: "Because I said so")
+        field3: MyEnum
+      }
+    `, nil)
+
+		enum := schema.TypeMap().Lookup("MyEnum").(graphql.Enum)
+		Expect(enum.Values().Lookup("VALUE").Deprecation()).Should(BeNil())
+		Expect(enum.Values().Lookup("OLD_VALUE").Deprecation()).Should(Equal(&graphql.Deprecation{
+			Reason: graphql.DefaultDeprecationReason,
+		}))
+		Expect(enum.Values().Lookup("OTHER_VALUE").Deprecation()).Should(Equal(&graphql.Deprecation{
+			Reason: "Terrible reasons",
+		}))
+
+		fields := schema.Query().Fields()
+		Expect(fields["field1"].Deprecation()).Should(Equal(&graphql.Deprecation{
+			Reason: graphql.DefaultDeprecationReason,
+		}))
+		Expect(fields["field2"].Deprecation()).Should(Equal(&graphql.Deprecation{
+			Reason: "Because I said so",
+		}))
+		Expect(fields["field3"].Deprecation()).Should(BeNil())
+	})
+
+	It("supports @deprecated on arguments and input fields", func() {
+		schema := mustBuildSchema(`
+      input MyInput {
+        oldInput: String @deprecated
+        otherInput: String @deprecated(reason: "Use newInput")
+        newInput: String
+      }
+
+      type Query {
+        field(
+          oldArg: String @deprecated
+          otherArg: String @deprecated(reason: "Use newArg")
+          newArg: String
+          input: MyInput
+        ): String
+      }
+    `, nil)
+
+		inputFields := schema.TypeMap().Lookup("MyInput").(graphql.InputObject).Fields()
+		Expect(inputFields["oldInput"].Deprecation()).Should(Equal(&graphql.Deprecation{
+			Reason: graphql.DefaultDeprecationReason,
+		}))
+		Expect(inputFields["otherInput"].Deprecation()).Should(Equal(&graphql.Deprecation{
+			Reason: "Use newInput",
+		}))
+		Expect(inputFields["newInput"].Deprecation()).Should(BeNil())
+		Expect(inputFields["oldInput"].Directives()).Should(BeEmpty())
+
+		fieldArgs := schema.Query().Fields()["field"].Args()
+		args := make(map[string]*graphql.Argument, len(fieldArgs))
+		for i := range fieldArgs {
+			args[fieldArgs[i].Name()] = &fieldArgs[i]
+		}
+		Expect(args["oldArg"].Deprecation()).Should(Equal(&graphql.Deprecation{
+			Reason: graphql.DefaultDeprecationReason,
+		}))
+		Expect(args["otherArg"].Deprecation()).Should(Equal(&graphql.Deprecation{
+			Reason: "Use newArg",
+		}))
+		Expect(args["newArg"].Deprecation()).Should(BeNil())
+		Expect(args["oldArg"].Directives()).Should(BeEmpty())
+	})
+
+	It("uses internal values for enum values", func() {
+		schema := mustBuildSchema(`
+      enum Color {
+        RED
+        GREEN
+        BLUE
+      }
+
+      type Query {
+        color(color: Color = GREEN): Color
+      }
+    `, &sdl.Resolvers{
+			Enums: map[string]sdl.EnumValues{
+				"Color": {
+					"RED":   0,
+					"GREEN": 1,
+					"BLUE":  2,
+				},
+			},
+		})
+
+		enum := schema.TypeMap().Lookup("Color").(graphql.Enum)
+		Expect(enum.Values().Lookup("RED").Value()).Should(Equal(0))
+		Expect(enum.Values().Lookup("BLUE").Value()).Should(Equal(2))
+
+		arg := schema.Query().Fields()["color"].Args()[0]
+		Expect(arg.DefaultValue()).Should(Equal(1))
+
+		Expect(executeQuery(schema, `{ color }`, map[string]interface{}{
+			"color": 2,
+		})).Should(MatchJSON(`{
+			"data": {
+				"color": "BLUE"
+			}
+		}`))
+	})
+
+	It("coerces default values of arguments and input fields", func() {
+		schema := mustBuildSchema(`
+      input Point {
+        x: Int = 0
+        y: Int = 0
+        label: String = null
+      }
+
+      input Line {
+        from: Point = { x: 1 }
+        to: Point!
+      }
+
+      type Query {
+        distance(line: Line = { to: { x: 3, y: 4 } }, scale: Float = 1): Float
+        points(points: [Point] = { y: 1 }): Int
+      }
+    `, nil)
+
+		point := schema.TypeMap().Lookup("Point").(graphql.InputObject)
+		Expect(point.Fields()["x"].DefaultValue()).Should(Equal(0))
+		Expect(point.Fields()["label"].HasDefaultValue()).Should(BeTrue())
+		Expect(point.Fields()["label"].DefaultValue()).Should(BeNil())
+
+		line := schema.TypeMap().Lookup("Line").(graphql.InputObject)
+		Expect(line.Fields()["from"].DefaultValue()).Should(Equal(map[string]interface{}{
+			"x":     1,
+			"y":     0,
+			"label": nil,
+		}))
+		Expect(line.Fields()["to"].HasDefaultValue()).Should(BeFalse())
+
+		args := schema.Query().Fields()["distance"].Args()
+		for _, arg := range args {
+			switch arg.Name() {
+			case "line":
+				Expect(arg.DefaultValue()).Should(Equal(map[string]interface{}{
+					"from": map[string]interface{}{
+						"x":     1,
+						"y":     0,
+						"label": nil,
+					},
+					"to": map[string]interface{}{
+						"x":     3,
+						"y":     4,
+						"label": nil,
+					},
+				}))
+			case "scale":
+				Expect(arg.DefaultValue()).Should(Equal(float64(1)))
+			}
+		}
+
+		Expect(schema.Query().Fields()["points"].Args()[0].DefaultValue()).Should(Equal([]interface{}{
+			map[string]interface{}{
+				"x":     0,
+				"y":     1,
+				"label": nil,
+			},
+		}))
+	})
+
+	It("supports recursive input objects", func() {
+		schema := mustBuildSchema(`
+      input Filter {
+        name: String = "*"
+        and: [Filter!]
+        not: Filter
+      }
+
+      type Query {
+        search(filter: Filter = { not: {} }): [String]
+      }
+    `, nil)
+
+		Expect(schema.Query().Fields()["search"].Args()[0].DefaultValue()).Should(Equal(
+			map[string]interface{}{
+				"name": "*",
+				"not": map[string]interface{}{
+					"name": "*",
+				},
+			}))
+	})
+
+	It("resolves abstract types with type resolvers", func() {
+		type Dog struct {
+			Name  string
+			Barks bool
+		}
+
+		type Cat struct {
+			Name  string
+			Meows bool
+		}
+
+		petResolver := graphql.TypeResolverFunc(
+			func(ctx context.Context, value interface{}, info graphql.ResolveInfo) (graphql.Object, error) {
+				switch value.(type) {
+				case Dog:
+					return info.Schema().TypeMap().Lookup("Dog").(graphql.Object), nil
+				case Cat:
+					return info.Schema().TypeMap().Lookup("Cat").(graphql.Object), nil
+				}
+				return nil, nil
+			})
+
+		schema := mustBuildSchema(`
+      interface Pet {
+        name: String
+      }
+
+      type Dog implements Pet {
+        name: String
+        barks: Boolean
+      }
+
+      type Cat implements Pet {
+        name: String
+        meows: Boolean
+      }
+
+      union CatOrDog = Cat | Dog
+
+      type Query {
+        pets: [Pet]
+        catOrDog: [CatOrDog]
+      }
+    `, &sdl.Resolvers{
+			Types: map[string]graphql.TypeResolver{
+				"Pet":      petResolver,
+				"CatOrDog": petResolver,
+			},
+		})
+
+		pets := []interface{}{
+			Dog{Name: "Odie", Barks: true},
+			Cat{Name: "Garfield", Meows: false},
+		}
+
+		Expect(executeQuery(schema, `{
+			pets {
+				name
+				... on Dog {
+					barks
+				}
+				... on Cat {
+					meows
+				}
+			}
+			catOrDog {
+				__typename
+			}
+		}`, map[string]interface{}{
+			"pets":     pets,
+			"catOrDog": pets,
+		})).Should(MatchJSON(`{
+			"data": {
+				"pets": [
+					{ "name": "Odie", "barks": true },
+					{ "name": "Garfield", "meows": false }
+				],
+				"catOrDog": [
+					{ "__typename": "Dog" },
+					{ "__typename": "Cat" }
+				]
+			}
+		}`))
+	})
+
+	It("uses coercers for custom scalars", func() {
+		schema := mustBuildSchema(`
+      scalar Odd
+
+      type Query {
+        odd(value: Odd): Odd
+      }
+    `, &sdl.Resolvers{
+			Scalars: map[string]sdl.ScalarCoercers{
+				"Odd": {
+					ResultCoercer: graphql.Int(),
+					InputCoercer:  graphql.Int(),
+				},
+			},
+		})
+
+		odd := schema.TypeMap().Lookup("Odd").(graphql.Scalar)
+		Expect(odd.CoerceResultValue(3)).Should(Equal(3))
+	})
+
+	It("supports custom directives", func() {
+		schema := mustBuildSchema(`
+      """
+      Marks a field as cached.
+      """
+      directive @cached(ttl: Int = 60) on FIELD_DEFINITION | OBJECT
+
+      type Query {
+        str: String
+      }
+    `, nil)
+
+		directives := schema.Directives()
+		Expect(directives).Should(HaveLen(6))
+
+		cached := directives.Lookup("cached")
+		Expect(cached).ShouldNot(BeNil())
+		Expect(cached.Description()).Should(Equal("Marks a field as cached."))
+		Expect(cached.Locations()).Should(Equal([]graphql.DirectiveLocation{
+			graphql.DirectiveLocationFieldDefinition,
+			graphql.DirectiveLocationObject,
+		}))
+		Expect(cached.Args()[0].DefaultValue()).Should(Equal(60))
+		Expect(cached.IsRepeatable()).Should(BeFalse())
+
+		Expect(directives.Lookup("skip")).Should(Equal(graphql.SkipDirective()))
+		Expect(directives.Lookup("include")).Should(Equal(graphql.IncludeDirective()))
+		Expect(directives.Lookup("deprecated")).Should(Equal(graphql.DeprecatedDirective()))
+		Expect(directives.Lookup("oneOf")).Should(Equal(graphql.OneOfDirective()))
+		Expect(directives.Lookup("specifiedBy")).Should(Equal(graphql.SpecifiedByDirective()))
+	})
+
+	It("supports repeatable directives", func() {
+		schema := mustBuildSchema(`
+      directive @tag(name: String!) repeatable on FIELD_DEFINITION | OBJECT
+
+      type Query @tag(name: "a") @tag(name: "b") {
+        str: String @tag(name: "c") @tag(name: "d")
+      }
+    `, nil)
+
+		Expect(schema.Directives().Lookup("tag").IsRepeatable()).Should(BeTrue())
+		Expect(graphql.DirectivesOf(schema.Query())).Should(Equal(graphql.AppliedDirectives{
+			{Name: "tag", Args: map[string]interface{}{"name": "a"}},
+			{Name: "tag", Args: map[string]interface{}{"name": "b"}},
+		}))
+		Expect(schema.Query().Fields()["str"].Directives()).Should(HaveLen(2))
+	})
+
+	It("supports @oneOf input objects", func() {
+		schema := mustBuildSchema(`
+      input Search @oneOf {
+        id: ID
+        name: String
+      }
+
+      type Query {
+        find(by: Search): String
+      }
+    `, nil)
+
+		search := schema.TypeMap().Lookup("Search").(graphql.InputObject)
+		Expect(search.IsOneOf()).Should(BeTrue())
+		Expect(graphql.DirectivesOf(search)).Should(BeEmpty())
+	})
+
+	It("supports @specifiedBy", func() {
+		schema := mustBuildSchema(`
+      scalar Foo @specifiedBy(url: "https://example.com/foo_spec")
+
+      type Query {
+        foo: Foo
+      }
+    `, &sdl.Resolvers{
+			Scalars: map[string]sdl.ScalarCoercers{
+				"Foo": {
+					ResultCoercer: graphql.String(),
+				},
+			},
+		})
+
+		foo := schema.TypeMap().Lookup("Foo").(graphql.Scalar)
+		Expect(foo.SpecifiedByURL()).Should(Equal("https://example.com/foo_spec"))
+		Expect(graphql.DirectivesOf(foo)).Should(BeEmpty())
+	})
+
+	It("overriding directives excludes specified", func() {
+		schema := mustBuildSchema(`
+      directive @skip on FIELD
+      directive @include on FIELD
+      directive @deprecated on FIELD_DEFINITION
+
+      type Query {
+        str: String
+      }
+    `, nil)
+
+		directives := schema.Directives()
+		Expect(directives).Should(HaveLen(5))
+		Expect(directives.Lookup("skip")).ShouldNot(Equal(graphql.SkipDirective()))
+		Expect(directives.Lookup("include")).ShouldNot(Equal(graphql.IncludeDirective()))
+		Expect(directives.Lookup("deprecated")).ShouldNot(Equal(graphql.DeprecatedDirective()))
+		Expect(directives.Lookup("oneOf")).Should(Equal(graphql.OneOfDirective()))
+		Expect(directives.Lookup("specifiedBy")).Should(Equal(graphql.SpecifiedByDirective()))
+	})
+
+	It("builds applied directives", func() {
+		schema := mustBuildSchema(`
+      directive @cached(ttl: Int = 60, scope: Scope) on FIELD_DEFINITION | OBJECT
+
+      directive @tag(name: String!) on OBJECT | ARGUMENT_DEFINITION | ENUM_VALUE | INPUT_FIELD_DEFINITION
+
+      enum Scope {
+        PUBLIC @tag(name: "default")
+        PRIVATE
+      }
+
+      input Filter {
+        scope: Scope @tag(name: "scope")
+      }
+
+      type Query @cached {
+        str(filter: Filter @tag(name: "filter")): String @cached(ttl: 30, scope: PRIVATE) @deprecated
+      }
+
+      extend type Query @tag(name: "extension")
+    `, nil)
+
+		query := schema.Query()
+		Expect(graphql.DirectivesOf(query)).Should(Equal(graphql.AppliedDirectives{
+			{Name: "cached"},
+			{Name: "tag", Args: map[string]interface{}{"name": "extension"}},
+		}))
+
+		field := query.Fields()["str"]
+		Expect(field.Deprecation().Defined()).Should(BeTrue())
+		Expect(field.Directives()).Should(Equal(graphql.AppliedDirectives{
+			{Name: "cached", Args: map[string]interface{}{"ttl": 30, "scope": "PRIVATE"}},
+		}))
+		Expect(field.Args()[0].Directives()).Should(Equal(graphql.AppliedDirectives{
+			{Name: "tag", Args: map[string]interface{}{"name": "filter"}},
+		}))
+
+		scope := schema.TypeMap().Lookup("Scope").(graphql.Enum)
+		Expect(scope.Values().Lookup("PUBLIC").Directives()).Should(Equal(graphql.AppliedDirectives{
+			{Name: "tag", Args: map[string]interface{}{"name": "default"}},
+		}))
+		Expect(scope.Values().Lookup("PRIVATE").Directives()).Should(BeEmpty())
+
+		filter := schema.TypeMap().Lookup("Filter").(graphql.InputObject)
+		Expect(filter.Fields()["scope"].Directives()).Should(Equal(graphql.AppliedDirectives{
+			{Name: "tag", Args: map[string]interface{}{"name": "scope"}},
+		}))
+	})
+
+	It("applies type extensions", func() {
+		schema := mustBuildSchema(`
+      type Query {
+        str: String
+      }
+
+      extend type Query implements Node {
+        id: ID!
+      }
+
+      interface Node {
+        id: ID!
+      }
+
+      enum Color {
+        RED
+      }
+
+      extend enum Color {
+        GREEN
+      }
+
+      input Point {
+        x: Int
+      }
+
+      extend input Point {
+        y: Int
+      }
+    `, nil)
+
+		query := schema.Query()
+		Expect(query.Fields()).Should(HaveKey("str"))
+		Expect(query.Fields()).Should(HaveKey("id"))
+		Expect(query.Interfaces()).Should(HaveLen(1))
+		Expect(query.Interfaces()[0].Name()).Should(Equal("Node"))
+
+		Expect(schema.TypeMap().Lookup("Color").(graphql.Enum).Values()).Should(HaveLen(2))
+		Expect(schema.TypeMap().Lookup("Point").(graphql.InputObject).Fields()).Should(HaveLen(2))
+	})
+
+	It("supports interfaces implementing interfaces", func() {
+		schema := mustBuildSchema(`
+      type Query {
+        node: Node
+      }
+
+      interface Node {
+        id: ID!
+      }
+
+      interface Resource implements Node {
+        id: ID!
+        url: String
+      }
+
+      extend interface Resource implements Named {
+        name: String
+      }
+
+      interface Named {
+        name: String
+      }
+
+      type Image implements Resource & Node & Named {
+        id: ID!
+        url: String
+        name: String
+      }
+    `, nil)
+
+		resource := schema.TypeMap().Lookup("Resource").(graphql.Interface)
+		Expect(resource.Fields()).Should(HaveLen(3))
+		Expect(resource.Interfaces()).Should(HaveLen(2))
+		Expect(resource.Interfaces()[0].Name()).Should(Equal("Node"))
+		Expect(resource.Interfaces()[1].Name()).Should(Equal("Named"))
+
+		image := schema.TypeMap().Lookup("Image").(graphql.Object)
+		node := schema.TypeMap().Lookup("Node").(graphql.Interface)
+		Expect(schema.PossibleTypes(node).Contains(image)).Should(BeTrue())
+		Expect(schema.PossibleTypes(resource).Contains(image)).Should(BeTrue())
+	})
+
+	It("includes types that are not referenced from root types", func() {
+		schema := mustBuildSchema(`
+      type Query {
+        str: String
+      }
+
+      type Unreferenced {
+        str: String
+      }
+    `, nil)
+
+		Expect(schema.TypeMap().Lookup("Unreferenced")).ShouldNot(BeNil())
+	})
+
+	Describe("Failures", func() {
+		It("reports syntax error", func() {
+			_, errs := buildSchema(`type Query {`, nil)
+			Expect(errs.HaveOccurred()).Should(BeTrue())
+			Expect(errs.Errors[0].Kind).Should(Equal(graphql.ErrKindSyntax))
+		})
+
+		It("reports unknown types", func() {
+			_, errs := buildSchema(`
+      type Query {
+        foo: Foo
+        bar(arg: [Bar!]): String
+      }
+      `, nil)
+			Expect(errs).Should(Equal(graphql.ErrorsOf(
+				errorAt(`Type "Foo" not found in document.`, 3, 14),
+				errorAt(`Type "Bar" not found in document.`, 4, 19),
+			)))
+		})
+
+		It("reports duplicated type names", func() {
+			_, errs := buildSchema(`
+      type Query {
+        str: String
+      }
+
+      scalar Query
+      `, nil)
+			Expect(errs).Should(Equal(graphql.ErrorsOf(
+				graphql.NewError(`There can be only one type named "Query".`, []graphql.ErrorLocation{
+					{Line: 2, Column: 12},
+					{Line: 6, Column: 14},
+				}),
+			)))
+		})
+
+		It("reports missing coercers for custom scalars", func() {
+			_, errs := buildSchema(`
+      scalar DateTime
+
+      type Query {
+        now: DateTime
+      }
+      `, nil)
+			Expect(errs).Should(Equal(graphql.ErrorsOf(
+				errorAt(`Missing coercers for custom scalar "DateTime".`, 2, 14),
+			)))
+		})
+
+		It("reports resolvers that are not defined in the schema", func() {
+			resolver := graphql.FieldResolverFunc(
+				func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+					return nil, nil
+				})
+			subscriber := graphql.FieldSubscriberFunc(
+				func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+					return nil, nil
+				})
+
+			_, errs := buildSchema(`
+      type Query {
+        str: String
+      }
+
+      interface Node {
+        id: ID!
+      }
+      `, &sdl.Resolvers{
+				Fields: map[string]sdl.FieldResolvers{
+					"Query": {
+						"str": resolver,
+						"int": resolver,
+					},
+					"Mutation": {
+						"str": resolver,
+					},
+					"Node": {
+						"id": resolver,
+					},
+				},
+				Subscribers: map[string]sdl.FieldSubscribers{
+					"Query": {
+						"int": subscriber,
+					},
+					"Subscription": {
+						"str": subscriber,
+					},
+				},
+				Types: map[string]graphql.TypeResolver{
+					"Query": graphql.TypeResolverFunc(nil),
+				},
+				Scalars: map[string]sdl.ScalarCoercers{
+					"DateTime": {},
+				},
+				Enums: map[string]sdl.EnumValues{
+					"Node": {},
+				},
+			})
+
+			Expect(errs).Should(Equal(graphql.ErrorsOf(
+				graphql.NewError(`"Mutation" defined in resolvers, but not in schema.`),
+				graphql.NewError(`"Node" defined in resolvers, but it is not an Object type.`),
+				graphql.NewError(`"Query.int" defined in resolvers, but not in schema.`),
+				graphql.NewError(`"Query.int" defined in subscribers, but not in schema.`),
+				graphql.NewError(`"Subscription" defined in subscribers, but not in schema.`),
+				graphql.NewError(`"Query" defined in type resolvers, but it is not an Interface or a Union type.`),
+				graphql.NewError(`"DateTime" defined in scalar coercers, but not in schema.`),
+				graphql.NewError(`"Node" defined in enum values, but it is not an Enum type.`),
+			)))
+		})
+
+		It("reports missing resolvers when they are required", func() {
+			source := `
+      type Query {
+        node: Node
+        search: SearchResult
+      }
+
+      interface Node {
+        id: ID!
+      }
+
+      union SearchResult = Query
+      `
+
+			_, errs := buildSchema(source, nil)
+			Expect(errs).Should(Equal(graphql.NoErrors()))
+
+			_, errs = buildSchema(source, &sdl.Resolvers{
+				Fields: map[string]sdl.FieldResolvers{
+					"Query": {
+						"search": graphql.FieldResolverFunc(
+							func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+								return nil, nil
+							}),
+					},
+				},
+			}, sdl.RequireResolvers())
+			Expect(errs).Should(Equal(graphql.ErrorsOf(
+				errorAt(`Missing resolver for "Query.node".`, 3, 9),
+				errorAt(`Missing type resolver for "Node".`, 7, 17),
+				errorAt(`Missing type resolver for "SearchResult".`, 11, 13),
+			)))
+		})
+
+		It("reports implementing non-interface types and including non-object union members", func() {
+			_, errs := buildSchema(`
+      type Query implements SomeUnion {
+        str: String
+      }
+
+      union SomeUnion = String
+      `, nil)
+			Expect(errs).Should(Equal(graphql.ErrorsOf(
+				errorAt(`Type Query must only implement Interface types, it cannot implement SomeUnion.`, 2, 29),
+				errorAt(`Union type SomeUnion can only include Object types, it cannot include String.`, 6, 25),
+			)))
+		})
+
+		It("reports invalid default values", func() {
+			_, errs := buildSchema(`
+      type Query {
+        str(arg: Int = "one"): String
+      }
+      `, nil)
+			Expect(errs.HaveOccurred()).Should(BeTrue())
+			Expect(errs.Errors).Should(HaveLen(1))
+			Expect(errs.Errors[0].Message).Should(HavePrefix(`Invalid default value "one" for "Query.str(arg:)": `))
+			Expect(errs.Errors[0].Locations).Should(Equal([]graphql.ErrorLocation{{Line: 3, Column: 24}}))
+		})
+
+		It("reports invalid applied directives", func() {
+			_, errs := buildSchema(`
+      directive @tag(name: String!) on FIELD_DEFINITION
+
+      type Query @unknown {
+        str: String @tag(name: 1, unknown: true)
+      }
+      `, nil)
+			Expect(errs.HaveOccurred()).Should(BeTrue())
+			Expect(errs.Errors).Should(HaveLen(3))
+			Expect(errs.Errors[0]).Should(MatchError(errorAt(`Unknown directive "@unknown".`, 4, 18)))
+			Expect(errs.Errors[1].Message).Should(HavePrefix(`Invalid value 1 for argument "name" on directive "@tag": `))
+			Expect(errs.Errors[1].Locations).Should(Equal([]graphql.ErrorLocation{{Line: 5, Column: 32}}))
+			Expect(errs.Errors[2]).Should(MatchError(errorAt(`Unknown argument "unknown" on directive "@tag".`, 5, 35)))
+		})
+
+		It("reports directives applied to invalid locations", func() {
+			_, errs := buildSchema(`
+      directive @tag(name: String!) on FIELD_DEFINITION
+
+      type Query @tag(name: "root") {
+        str: String @tag
+      }
+      `, nil)
+			Expect(errs.Errors).Should(HaveLen(2))
+			Expect(errs.Errors[0]).Should(MatchError(
+				`Directive "@tag" may not be used on OBJECT (applied to Query).`))
+			Expect(errs.Errors[1]).Should(MatchError(
+				`Argument "name" of type "String!" on directive "@tag" applied to Query.str is required, ` +
+					`but it was not provided.`))
+		})
+
+		It("reports default values that cannot be resolved due to recursion", func() {
+			_, errs := buildSchema(`
+      input Filter {
+        not: Filter = {}
+      }
+
+      type Query {
+        search(filter: Filter): [String]
+      }
+      `, nil)
+			Expect(errs).Should(Equal(graphql.ErrorsOf(
+				errorAt(`Cannot coerce default value for "Filter.not" because it refers to "Filter" recursively.`, 3, 23),
+			)))
+		})
+
+		It("reports invalid type extensions", func() {
+			_, errs := buildSchema(`
+      type Query {
+        str: String
+      }
+
+      extend type Unknown {
+        str: String
+      }
+
+      extend interface Query {
+        int: Int
+      }
+      `, nil)
+			Expect(errs).Should(Equal(graphql.ErrorsOf(
+				graphql.NewError(`Cannot extend non-interface type "Query".`, []graphql.ErrorLocation{
+					{Line: 2, Column: 7},
+					{Line: 10, Column: 7},
+				}),
+				errorAt(`Cannot extend type "Unknown" because it is not defined.`, 6, 19),
+			)))
+		})
+
+		It("reports invalid root operation types", func() {
+			_, errs := buildSchema(`
+      schema {
+        query: Query
+        mutation: Mutation
+      }
+
+      input Query {
+        str: String
+      }
+      `, nil)
+			Expect(errs).Should(Equal(graphql.ErrorsOf(
+				errorAt(`Query root type must be Object type, it cannot be Query.`, 3, 16),
+				errorAt(`Specified mutation type "Mutation" not found in document.`, 4, 19),
+			)))
+		})
+
+		It("reports multiple schema definitions", func() {
+			_, errs := buildSchema(`
+      schema {
+        query: Query
+      }
+
+      schema {
+        query: Query
+      }
+
+      type Query {
+        str: String
+      }
+      `, nil)
+			Expect(errs).Should(Equal(graphql.ErrorsOf(
+				errorAt(`Must provide only one schema definition.`, 6, 7),
+			)))
+		})
+	})
+})
@@ -0,0 +1,261 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package sdl
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/botobag/artemis/graphql"
+	"github.com/botobag/artemis/graphql/ast"
+)
+
+// TypeTransformation provides a type where a directive is applied to a TypeTransformer.
+type TypeTransformation struct {
+	// The directive being applied to the type
+	Directive *graphql.AppliedDirective
+
+	// Config for creating the type. It is one of *graphql.ScalarConfig, *graphql.ObjectConfig,
+	// *graphql.InterfaceConfig, *graphql.UnionConfig, *graphql.EnumConfig and
+	// *graphql.InputObjectConfig. Transformer may modify the config (e.g., replace the ResultCoercer
+	// of a Scalar or wrap resolvers for every field in an Object).
+	Config graphql.TypeDefinition
+}
+
+// TypeTransformer transforms a type where a directive is applied.
+type TypeTransformer interface {
+	TransformType(t *TypeTransformation) error
+}
+
+// TypeTransformerFunc is an adapter to allow the use of ordinary functions as TypeTransformer.
+type TypeTransformerFunc func(t *TypeTransformation) error
+
+// TransformType calls f(t).
+func (f TypeTransformerFunc) TransformType(t *TypeTransformation) error {
+	return f(t)
+}
+
+// TypeTransformerFunc implements TypeTransformer.
+var _ TypeTransformer = TypeTransformerFunc(nil)
+
+// FieldTransformation provides a field where a directive is applied to a FieldTransformer.
+type FieldTransformation struct {
+	// The directive being applied to the field
+	Directive *graphql.AppliedDirective
+
+	// Name of the Object or Interface type that contains the field
+	TypeName string
+
+	// Name of the field
+	Name string
+
+	// Config for creating the field. Transformer may modify the config (e.g., wrap the Resolver or
+	// change the Type).
+	Config *graphql.FieldConfig
+
+	// Set to true to remove the field from the type.
+	Hidden bool
+}
+
+// FieldTransformer transforms a field where a directive is applied.
+type FieldTransformer interface {
+	TransformField(field *FieldTransformation) error
+}
+
+// FieldTransformerFunc is an adapter to allow the use of ordinary functions as FieldTransformer.
+type FieldTransformerFunc func(field *FieldTransformation) error
+
+// TransformField calls f(field).
+func (f FieldTransformerFunc) TransformField(field *FieldTransformation) error {
+	return f(field)
+}
+
+// FieldTransformerFunc implements FieldTransformer.
+var _ FieldTransformer = FieldTransformerFunc(nil)
+
+// DirectiveTransformer transforms the types and fields where a directive is applied when building a
+// schema. It is registered for a directive with TransformDirective.
+type DirectiveTransformer struct {
+	// Type transforms the types where the directive is applied (optional)
+	Type TypeTransformer
+
+	// Field transforms the fields where the directive is applied (optional)
+	Field FieldTransformer
+}
+
+// TransformDirective registers a transformer for the directive with the given name. Transformers
+// are invoked after all types are defined and before they're created. Types and then their fields
+// are transformed in the order of their names. The directives applied to a type or a field are
+// processed in the order they are applied.
+//
+// Note that @deprecated is not included in the applied directives of a field. A transformer
+// registered for @deprecated is given a directive with the deprecation reason for every deprecated
+// field.
+//
+// Input types may have been created for coercing default values and directive arguments before
+// transformation. Changes to the configs of those types take no effects.
+func TransformDirective(name string, transformer DirectiveTransformer) BuildOption {
+	return func(options *buildOptions) {
+		if options.DirectiveTransformers == nil {
+			options.DirectiveTransformers = map[string]DirectiveTransformer{}
+		}
+		options.DirectiveTransformers[name] = transformer
+	}
+}
+
+// TransformSchema recreates the given schema with the transformers given in the options. This is
+// useful for applying directive transformers to a schema that is not built from SDL. The given
+// schema is not modified.
+func TransformSchema(schema graphql.Schema, opts ...BuildOption) (graphql.Schema, graphql.Errors) {
+	return ExtendASTSchema(schema, ast.Document{}, nil, opts...)
+}
+
+// hasTypeTransformer returns true if any of the given directives has a transformer for types.
+func (b *schemaBuilder) hasTypeTransformer(directives graphql.AppliedDirectives) bool {
+	for _, directive := range directives {
+		if b.options.DirectiveTransformers[directive.Name].Type != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// transformDirectives applies directive transformers to the types and fields.
+func (b *schemaBuilder) transformDirectives() {
+	if len(b.options.DirectiveTransformers) == 0 {
+		return
+	}
+
+	for _, names := range [][]string{b.existingTypeNames, b.typeNames} {
+		for _, name := range names {
+			typeDef := b.typeDefs[name]
+
+			for _, directive := range typeConfigDirectives(typeDef) {
+				transformer := b.options.DirectiveTransformers[directive.Name].Type
+				if transformer == nil {
+					continue
+				}
+
+				err := transformer.TransformType(&TypeTransformation{
+					Directive: directive,
+					Config:    typeDef,
+				})
+				if err != nil {
+					b.reportTransformError(directive, name, err)
+				}
+			}
+
+			// Obtain fields after transforming the type which may replace them.
+			switch config := typeDef.(type) {
+			case *graphql.ObjectConfig:
+				b.transformFields(name, config.Fields)
+			case *graphql.InterfaceConfig:
+				b.transformFields(name, config.Fields)
+			}
+		}
+	}
+}
+
+// typeConfigDirectives returns the directives applied to the type created with the given type
+// definition. Types that are reused from the schema being extended return nil.
+func typeConfigDirectives(typeDef graphql.TypeDefinition) graphql.AppliedDirectives {
+	switch config := typeDef.(type) {
+	case *graphql.ScalarConfig:
+		return config.Directives
+	case *graphql.ObjectConfig:
+		return config.Directives
+	case *graphql.InterfaceConfig:
+		return config.Directives
+	case *graphql.UnionConfig:
+		return config.Directives
+	case *graphql.EnumConfig:
+		return config.Directives
+	case *graphql.InputObjectConfig:
+		return config.Directives
+	}
+	return nil
+}
+
+// transformFields applies directive transformers to the fields in the Object or Interface type with
+// the given name.
+func (b *schemaBuilder) transformFields(typeName string, fields graphql.Fields) {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		config := fields[name]
+
+		hidden := false
+		for _, directive := range fieldConfigDirectives(&config) {
+			transformer := b.options.DirectiveTransformers[directive.Name].Field
+			if transformer == nil {
+				continue
+			}
+
+			field := &FieldTransformation{
+				Directive: directive,
+				TypeName:  typeName,
+				Name:      name,
+				Config:    &config,
+			}
+			if err := transformer.TransformField(field); err != nil {
+				b.reportTransformError(directive, typeName+"."+name, err)
+			}
+
+			if field.Hidden {
+				hidden = true
+				break
+			}
+		}
+
+		if hidden {
+			delete(fields, name)
+		} else {
+			fields[name] = config
+		}
+	}
+}
+
+// fieldConfigDirectives returns the directives applied to the given field including @deprecated.
+func fieldConfigDirectives(config *graphql.FieldConfig) graphql.AppliedDirectives {
+	if !config.Deprecation.Defined() {
+		return config.Directives
+	}
+
+	reason := config.Deprecation.Reason
+	if len(reason) == 0 {
+		reason = graphql.DefaultDeprecationReason
+	}
+
+	return append(graphql.AppliedDirectives{
+		{
+			Name: graphql.DeprecatedDirective().Name(),
+			Args: map[string]interface{}{
+				"reason": reason,
+			},
+		},
+	}, config.Directives...)
+}
+
+// reportTransformError reports an error returned from a directive transformer.
+func (b *schemaBuilder) reportTransformError(directive *graphql.AppliedDirective, coordinate string, err error) {
+	b.reportError(fmt.Sprintf(`Cannot apply directive "@%s" to %s: %s`, directive.Name, coordinate,
+		err.Error()))
+}
@@ -0,0 +1,253 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package sdl_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/botobag/artemis/graphql"
+	"github.com/botobag/artemis/graphql/token"
+	"github.com/botobag/artemis/graphql/util/sdl"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DirectiveTransformer", func() {
+	// uppercase wraps the resolver of the field to convert its result to upper case.
+	uppercase := sdl.DirectiveTransformer{
+		Field: sdl.FieldTransformerFunc(func(field *sdl.FieldTransformation) error {
+			resolver := field.Config.Resolver
+			field.Config.Resolver = graphql.FieldResolverFunc(
+				func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+					result, err := resolver.Resolve(ctx, source, info)
+					if s, ok := result.(string); ok {
+						return strings.ToUpper(s), err
+					}
+					return result, err
+				})
+			return nil
+		}),
+	}
+
+	// hidden removes the field from the schema.
+	hidden := sdl.DirectiveTransformer{
+		Field: sdl.FieldTransformerFunc(func(field *sdl.FieldTransformation) error {
+			field.Hidden = true
+			return nil
+		}),
+	}
+
+	// auth wraps the resolvers of every field in the Object to deny access.
+	auth := sdl.DirectiveTransformer{
+		Type: sdl.TypeTransformerFunc(func(t *sdl.TypeTransformation) error {
+			config, ok := t.Config.(*graphql.ObjectConfig)
+			if !ok {
+				return errors.New("@auth can only be applied to Object types")
+			}
+
+			requires := t.Directive.Args["requires"]
+			for name, field := range config.Fields {
+				field.Resolver = graphql.FieldResolverFunc(
+					func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+						return nil, graphql.NewError("Requires " + requires.(string) + " role.")
+					})
+				config.Fields[name] = field
+			}
+			return nil
+		}),
+	}
+
+	stringResolver := func(s string) graphql.FieldResolver {
+		return graphql.FieldResolverFunc(
+			func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+				return s, nil
+			})
+	}
+
+	It("transforms fields and types where the directives are applied", func() {
+		schema, errs := sdl.BuildSchema(token.NewSource(`
+      directive @uppercase on FIELD_DEFINITION
+      directive @hidden on FIELD_DEFINITION
+      directive @auth(requires: String!) on OBJECT
+
+      type Query {
+        greeting: String @uppercase
+        internal: String @hidden
+        secret: Secret
+      }
+
+      type Secret @auth(requires: "ADMIN") {
+        value: String
+      }
+    `), &sdl.Resolvers{
+			Fields: map[string]sdl.FieldResolvers{
+				"Query": {
+					"greeting": stringResolver("hello"),
+					"internal": stringResolver("internal"),
+					"secret":   stringResolver("secret"),
+				},
+				"Secret": {
+					"value": stringResolver("42"),
+				},
+			},
+		},
+			sdl.TransformDirective("uppercase", uppercase),
+			sdl.TransformDirective("hidden", hidden),
+			sdl.TransformDirective("auth", auth))
+		Expect(errs).Should(Equal(graphql.NoErrors()))
+
+		Expect(schema.Query().Fields()).ShouldNot(HaveKey("internal"))
+		Expect(executeQuery(schema, `{ greeting secret { value } }`, nil)).Should(MatchJSON(`{
+			"data": {
+				"greeting": "HELLO",
+				"secret": {
+					"value": null
+				}
+			},
+			"errors": [
+				{
+					"message": "Requires ADMIN role.",
+					"locations": [{"line": 1, "column": 21}],
+					"path": ["secret", "value"]
+				}
+			]
+		}`))
+	})
+
+	It("transforms deprecated fields", func() {
+		var deprecations []string
+		_, errs := sdl.BuildSchema(token.NewSource(`
+      type Query {
+        a: String @deprecated
+        b: String @deprecated(reason: "Use a.")
+        c: String
+      }
+    `), nil, sdl.TransformDirective("deprecated", sdl.DirectiveTransformer{
+			Field: sdl.FieldTransformerFunc(func(field *sdl.FieldTransformation) error {
+				deprecations = append(deprecations,
+					field.TypeName+"."+field.Name+": "+field.Directive.Args["reason"].(string))
+				return nil
+			}),
+		}))
+		Expect(errs).Should(Equal(graphql.NoErrors()))
+		Expect(deprecations).Should(Equal([]string{
+			"Query.a: " + graphql.DefaultDeprecationReason,
+			"Query.b: Use a.",
+		}))
+	})
+
+	It("transforms a schema that is not built from SDL", func() {
+		uppercaseDirective := graphql.MustNewDirective(&graphql.DirectiveConfig{
+			Name: "uppercase",
+			Locations: []graphql.DirectiveLocation{
+				graphql.DirectiveLocationFieldDefinition,
+			},
+		})
+
+		schema := graphql.MustNewSchema(&graphql.SchemaConfig{
+			Query: graphql.MustNewObject(&graphql.ObjectConfig{
+				Name: "Query",
+				Fields: graphql.Fields{
+					"greeting": {
+						Type:     graphql.T(graphql.String()),
+						Resolver: stringResolver("hello"),
+						Directives: graphql.AppliedDirectives{
+							{Name: "uppercase"},
+						},
+					},
+				},
+			}),
+			Directives: graphql.DirectiveList{uppercaseDirective},
+		})
+
+		transformedSchema, errs := sdl.TransformSchema(schema, sdl.TransformDirective("uppercase", uppercase))
+		Expect(errs).Should(Equal(graphql.NoErrors()))
+		Expect(executeQuery(transformedSchema, `{ greeting }`, nil)).Should(MatchJSON(`{
+			"data": {
+				"greeting": "HELLO"
+			}
+		}`))
+
+		// The original schema is not modified.
+		Expect(executeQuery(schema, `{ greeting }`, nil)).Should(MatchJSON(`{
+			"data": {
+				"greeting": "hello"
+			}
+		}`))
+	})
+
+	It("transforms the coercion of a type", func() {
+		schema, errs := sdl.BuildSchema(token.NewSource(`
+      directive @trim on SCALAR
+
+      scalar Name @trim
+
+      type Query {
+        name: Name
+      }
+    `), &sdl.Resolvers{
+			Fields: map[string]sdl.FieldResolvers{
+				"Query": {
+					"name": stringResolver("  artemis  "),
+				},
+			},
+			Scalars: map[string]sdl.ScalarCoercers{
+				"Name": {
+					ResultCoercer: graphql.String(),
+				},
+			},
+		}, sdl.TransformDirective("trim", sdl.DirectiveTransformer{
+			Type: sdl.TypeTransformerFunc(func(t *sdl.TypeTransformation) error {
+				config := t.Config.(*graphql.ScalarConfig)
+				resultCoercer := config.ResultCoercer
+				config.ResultCoercer = graphql.ScalarResultCoercerFunc(func(value interface{}) (interface{}, error) {
+					result, err := resultCoercer.CoerceResultValue(value)
+					if s, ok := result.(string); ok {
+						return strings.TrimSpace(s), err
+					}
+					return result, err
+				})
+				return nil
+			}),
+		}))
+		Expect(errs).Should(Equal(graphql.NoErrors()))
+		Expect(executeQuery(schema, `{ name }`, nil)).Should(MatchJSON(`{
+			"data": {
+				"name": "artemis"
+			}
+		}`))
+	})
+
+	It("reports errors returned from transformers", func() {
+		_, errs := sdl.BuildSchema(token.NewSource(`
+      directive @auth(requires: String!) on OBJECT | INTERFACE
+
+      interface Node @auth(requires: "ADMIN") {
+        id: ID
+      }
+
+      type Query {
+        node: Node
+      }
+    `), nil, sdl.TransformDirective("auth", auth))
+		Expect(errs).Should(Equal(graphql.ErrorsOf(
+			`Cannot apply directive "@auth" to Node: @auth can only be applied to Object types`)))
+	})
+})
@@ -0,0 +1,587 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package sdl
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/botobag/artemis/graphql"
+	"github.com/botobag/artemis/graphql/ast"
+	"github.com/botobag/artemis/graphql/parser"
+	"github.com/botobag/artemis/graphql/token"
+	"github.com/botobag/artemis/iterator"
+)
+
+// ExtendSchema parses the SDL in the given source and extends the given schema with the type
+// definitions and extensions in it. Fields, enum values, interfaces and union members can be added
+// to the types in the schema with type extensions and new types and directives can be defined. The
+// given schema is not modified. Instead, a new schema with all types recreated is returned.
+//
+// Resolvers, type resolvers and coercers of the types in the given schema are kept in the new
+// schema. The given resolvers provide the ones for the definitions in the SDL. They may also
+// override resolvers for the existing fields and abstract types.
+func ExtendSchema(
+	schema graphql.Schema,
+	source *token.Source,
+	resolvers *Resolvers,
+	opts ...BuildOption) (graphql.Schema, graphql.Errors) {
+
+	document, err := parser.Parse(source)
+	if err != nil {
+		return nil, graphql.ErrorsOf(err)
+	}
+	return ExtendASTSchema(schema, document, resolvers, opts...)
+}
+
+// ExtendASTSchema is similar to ExtendSchema but takes a parsed document.
+func ExtendASTSchema(
+	schema graphql.Schema,
+	document ast.Document,
+	resolvers *Resolvers,
+	opts ...BuildOption) (graphql.Schema, graphql.Errors) {
+
+	var options buildOptions
+	for _, applyOption := range opts {
+		applyOption(&options)
+	}
+
+	if resolvers == nil {
+		resolvers = &Resolvers{}
+	}
+
+	return newSchemaBuilder(schema, resolvers, &options).build(document)
+}
+
+// isEmptyExtension returns true if the document doesn't contain any type system definition or
+// extension.
+func (b *schemaBuilder) isEmptyExtension() bool {
+	return len(b.typeDefNodes) == 0 &&
+		len(b.typeExtNodes) == 0 &&
+		len(b.directiveDefNodes) == 0 &&
+		len(b.schemaExtensions) == 0
+}
+
+// existingType returns the type with the given name in the schema being extended. It returns nil if
+// there's no such type or the type is a built-in scalar or an introspection type which cannot be
+// extended.
+func (b *schemaBuilder) existingType(name string) graphql.Type {
+	if b.schema == nil {
+		return nil
+	}
+
+	t := b.schema.TypeMap().Lookup(name)
+	if t == nil || !isDefinedType(t.(graphql.TypeWithName)) {
+		return nil
+	}
+	return t
+}
+
+// existingTypeKindName returns the kind of the given type for use in error messages. The result
+// matches the one returned by extensionKindName.
+func existingTypeKindName(t graphql.Type) string {
+	switch t.(type) {
+	case graphql.Scalar:
+		return "scalar"
+	case graphql.Object:
+		return "object"
+	case graphql.Interface:
+		return "interface"
+	case graphql.Union:
+		return "union"
+	case graphql.Enum:
+		return "enum"
+	case graphql.InputObject:
+		return "input object"
+	}
+	return ""
+}
+
+// createExistingTypeDefs creates a TypeDefinition for every type in the schema being extended. Types
+// are recreated because they refer to each other and types are immutable after creation. Scalars
+// and Enums that are neither extended nor transformed are reused as they don't refer to other
+// types. Other Scalars are recreated with the existing ones as their coercers.
+func (b *schemaBuilder) createExistingTypeDefs() {
+	if b.schema == nil {
+		return
+	}
+
+	// Sort names to create types in a deterministic order.
+	iter := b.schema.TypeMap().KeyIterator()
+	for {
+		name, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if b.existingType(name.(string)) != nil {
+			b.existingTypeNames = append(b.existingTypeNames, name.(string))
+		}
+	}
+	sort.Strings(b.existingTypeNames)
+
+	for _, name := range b.existingTypeNames {
+		t := b.schema.TypeMap().Lookup(name)
+		description := t.(graphql.TypeWithDescription).Description()
+
+		switch t := t.(type) {
+		case graphql.Scalar:
+			if len(b.typeExtNodes[name]) == 0 && !b.hasTypeTransformer(graphql.DirectivesOf(t)) {
+				b.typeDefs[name] = graphql.T(t)
+			} else {
+				b.typeDefs[name] = &graphql.ScalarConfig{
+					Name:           name,
+					Description:    description,
+					SpecifiedByURL: t.SpecifiedByURL(),
+					ResultCoercer:  t,
+					InputCoercer:   t,
+				}
+			}
+
+		case graphql.Enum:
+			if len(b.typeExtNodes[name]) == 0 && !b.hasTypeTransformer(graphql.DirectivesOf(t)) {
+				b.typeDefs[name] = graphql.T(t)
+			} else {
+				b.typeDefs[name] = &graphql.EnumConfig{
+					Name:        name,
+					Description: description,
+				}
+			}
+
+		case graphql.Object:
+			b.typeDefs[name] = &graphql.ObjectConfig{
+				Name:        name,
+				Description: description,
+			}
+
+		case graphql.Interface:
+			b.typeDefs[name] = &graphql.InterfaceConfig{
+				Name:        name,
+				Description: description,
+			}
+
+		case graphql.Union:
+			b.typeDefs[name] = &graphql.UnionConfig{
+				Name:        name,
+				Description: description,
+			}
+
+		case graphql.InputObject:
+			b.typeDefs[name] = &graphql.InputObjectConfig{
+				Name:        name,
+				Description: description,
+			}
+		}
+	}
+}
+
+// typeDefOfType returns the TypeDefinition for the given type in the schema being extended.
+func (b *schemaBuilder) typeDefOfType(t graphql.Type) graphql.TypeDefinition {
+	switch t := t.(type) {
+	case graphql.List:
+		return graphql.ListOf(b.typeDefOfType(t.ElementType()))
+
+	case graphql.NonNull:
+		return graphql.NonNullOf(b.typeDefOfType(t.InnerType()))
+
+	case graphql.TypeWithName:
+		return b.typeDefs[t.Name()]
+	}
+
+	return nil
+}
+
+// existingTypeDirectivesOf returns the directives applied to the given type in the schema being
+// extended followed by the ones applied in type extensions.
+func (b *schemaBuilder) existingTypeDirectivesOf(t graphql.Type) graphql.AppliedDirectives {
+	directives := append(graphql.AppliedDirectives{}, graphql.DirectivesOf(t)...)
+	return append(directives, b.typeDirectivesOf(t.(graphql.TypeWithName).Name())...)
+}
+
+// extendTypeDef fills the TypeDefinition created for the type with given name in the schema being
+// extended and applies the type extensions in the document.
+func (b *schemaBuilder) extendTypeDef(name string) {
+	switch t := b.schema.TypeMap().Lookup(name).(type) {
+	case graphql.Scalar:
+		if config, ok := b.typeDefs[name].(*graphql.ScalarConfig); ok {
+			config.Directives = b.existingTypeDirectivesOf(t)
+			if url := b.specifiedByURLOf(b.typeDirectiveListsOf(name)...); len(url) > 0 {
+				config.SpecifiedByURL = url
+			}
+		}
+
+	case graphql.Object:
+		b.extendObject(b.typeDefs[name].(*graphql.ObjectConfig), t)
+
+	case graphql.Interface:
+		b.extendInterface(b.typeDefs[name].(*graphql.InterfaceConfig), t)
+
+	case graphql.Union:
+		b.extendUnion(b.typeDefs[name].(*graphql.UnionConfig), t)
+
+	case graphql.Enum:
+		if config, ok := b.typeDefs[name].(*graphql.EnumConfig); ok {
+			b.extendEnum(config, t)
+		}
+
+	case graphql.InputObject:
+		b.extendInputObject(b.typeDefs[name].(*graphql.InputObjectConfig), t)
+	}
+}
+
+func (b *schemaBuilder) extendObject(config *graphql.ObjectConfig, object graphql.Object) {
+	var (
+		interfaces ast.NamedTypes
+		fields     ast.FieldDefinitions
+	)
+	for _, extension := range b.typeExtNodes[config.Name] {
+		extension := extension.(*ast.ObjectTypeExtension)
+		interfaces = append(interfaces, extension.Interfaces...)
+		fields = append(fields, extension.Fields...)
+	}
+
+	for _, iface := range object.Interfaces() {
+		config.Interfaces = append(config.Interfaces, b.typeDefs[iface.Name()].(*graphql.InterfaceConfig))
+	}
+	config.Interfaces = append(config.Interfaces, b.buildInterfaces(config.Name, interfaces)...)
+
+	var (
+		resolvers   = b.resolvers.Fields[config.Name]
+		subscribers = b.resolvers.Subscribers[config.Name]
+	)
+	config.Fields = b.buildFields(config.Name, fields, resolvers, subscribers, true)
+	b.addExistingFields(config.Name, config.Fields, object.Fields(), fields, resolvers, subscribers)
+	config.Directives = b.existingTypeDirectivesOf(object)
+	config.IsTypeOf = object.TypeChecker()
+}
+
+func (b *schemaBuilder) extendInterface(config *graphql.InterfaceConfig, iface graphql.Interface) {
+	var (
+		interfaces ast.NamedTypes
+		fields     ast.FieldDefinitions
+	)
+	for _, extension := range b.typeExtNodes[config.Name] {
+		extension := extension.(*ast.InterfaceTypeExtension)
+		interfaces = append(interfaces, extension.Interfaces...)
+		fields = append(fields, extension.Fields...)
+	}
+
+	for _, implementedIface := range iface.Interfaces() {
+		config.Interfaces = append(config.Interfaces, b.typeDefs[implementedIface.Name()].(*graphql.InterfaceConfig))
+	}
+	config.Interfaces = append(config.Interfaces, b.buildInterfaces(config.Name, interfaces)...)
+
+	config.Fields = b.buildFields(config.Name, fields, nil, nil, false)
+	b.addExistingFields(config.Name, config.Fields, iface.Fields(), fields, nil, nil)
+	config.TypeResolver = b.existingTypeResolverFor(iface)
+	config.Directives = b.existingTypeDirectivesOf(iface)
+}
+
+// addExistingFields adds the fields in the existing type to the field configs built from the
+// field definitions in type extensions. Field definitions that conflict with the existing fields
+// are reported and dropped.
+func (b *schemaBuilder) addExistingFields(
+	typeName string,
+	fields graphql.Fields,
+	existingFields graphql.FieldMap,
+	nodes ast.FieldDefinitions,
+	resolvers FieldResolvers,
+	subscribers FieldSubscribers) {
+
+	for _, node := range nodes {
+		name := node.Name.Value()
+		if existingFields[name] != nil {
+			b.reportError(fmt.Sprintf(`Field "%s.%s" already exists in the schema. It cannot also be `+
+				`defined in this type extension.`, typeName, name), node.Name)
+			delete(fields, name)
+		}
+	}
+
+	for name, field := range existingFields {
+		resolver := resolvers[name]
+		if resolver == nil {
+			resolver = field.Resolver()
+		}
+
+		subscriber := subscribers[name]
+		if subscriber == nil {
+			subscriber = field.Subscriber()
+		}
+
+		fields[name] = graphql.FieldConfig{
+			Description: field.Description(),
+			Type:        b.typeDefOfType(field.Type()),
+			Args:        b.existingArgs(field.Args()),
+			Resolver:    resolver,
+			Subscriber:  subscriber,
+			Deprecation: field.Deprecation(),
+			Directives:  field.Directives(),
+		}
+	}
+}
+
+// existingArgs returns argument configs for the given arguments from the schema being extended.
+func (b *schemaBuilder) existingArgs(args []graphql.Argument) graphql.ArgumentConfigMap {
+	if len(args) == 0 {
+		return nil
+	}
+
+	configs := make(graphql.ArgumentConfigMap, len(args))
+	for i := range args {
+		arg := &args[i]
+
+		var defaultValue interface{}
+		if arg.HasDefaultValue() {
+			defaultValue = arg.DefaultValue()
+			if defaultValue == nil {
+				defaultValue = graphql.NilArgumentDefaultValue
+			}
+		}
+
+		configs[arg.Name()] = graphql.ArgumentConfig{
+			Description:  arg.Description(),
+			Type:         b.typeDefOfType(arg.Type()),
+			DefaultValue: defaultValue,
+			Deprecation:  arg.Deprecation(),
+			Directives:   arg.Directives(),
+		}
+	}
+	return configs
+}
+
+func (b *schemaBuilder) extendUnion(config *graphql.UnionConfig, union graphql.Union) {
+	var types ast.NamedTypes
+	for _, extension := range b.typeExtNodes[config.Name] {
+		types = append(types, extension.(*ast.UnionTypeExtension).Types...)
+	}
+
+	// Sort member types to keep the order of types deterministic.
+	var existingTypeNames []string
+	iter := union.PossibleTypes().Iterator()
+	for {
+		possibleType, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		existingTypeNames = append(existingTypeNames, possibleType.(graphql.Object).Name())
+	}
+	sort.Strings(existingTypeNames)
+
+	for _, name := range existingTypeNames {
+		config.PossibleTypes = append(config.PossibleTypes, b.typeDefs[name].(*graphql.ObjectConfig))
+	}
+	config.PossibleTypes = append(config.PossibleTypes, b.buildPossibleTypes(config.Name, types)...)
+	config.TypeResolver = b.existingTypeResolverFor(union)
+	config.Directives = b.existingTypeDirectivesOf(union)
+}
+
+// existingTypeResolverFor returns the type resolver for the abstract type in the schema being
+// extended. The one given in resolvers takes precedence over the existing one.
+func (b *schemaBuilder) existingTypeResolverFor(t graphql.AbstractType) graphql.TypeResolver {
+	if typeResolver := b.resolvers.Types[t.Name()]; typeResolver != nil {
+		return typeResolver
+	}
+
+	typeResolver := t.TypeResolver()
+	if typeResolver == nil {
+		return nil
+	}
+	return existingTypeResolver{typeResolver}
+}
+
+// existingTypeResolver wraps a type resolver in the schema being extended. The wrapped resolver may
+// return Object types in the old schema. They are replaced with the types with the same names in
+// the schema being executed.
+type existingTypeResolver struct {
+	typeResolver graphql.TypeResolver
+}
+
+// Resolve implements graphql.TypeResolver.
+func (resolver existingTypeResolver) Resolve(
+	ctx context.Context,
+	value interface{},
+	info graphql.ResolveInfo) (graphql.Object, error) {
+
+	object, err := resolver.typeResolver.Resolve(ctx, value, info)
+	if err != nil || object == nil {
+		return object, err
+	}
+
+	if t, ok := info.Schema().TypeMap().Lookup(object.Name()).(graphql.Object); ok {
+		return t, nil
+	}
+	return object, nil
+}
+
+func (b *schemaBuilder) extendEnum(config *graphql.EnumConfig, enum graphql.Enum) {
+	var values ast.EnumValueDefinitions
+	for _, extension := range b.typeExtNodes[config.Name] {
+		values = append(values, extension.(*ast.EnumTypeExtension).Values...)
+	}
+
+	existingValues := enum.Values()
+	internalValues := b.resolvers.Enums[config.Name]
+
+	config.Values = make(graphql.EnumValueDefinitionMap, len(existingValues)+len(values))
+	for name, value := range existingValues {
+		internalValue := value.Value()
+		if internalValue == nil {
+			internalValue = graphql.NilEnumInternalValue
+		}
+
+		config.Values[name] = graphql.EnumValueDefinition{
+			Description: value.Description(),
+			Value:       internalValue,
+			Deprecation: value.Deprecation(),
+			Directives:  value.Directives(),
+		}
+	}
+
+	for _, value := range values {
+		name := value.Name.Value()
+		if existingValues.Lookup(name) != nil {
+			b.reportError(fmt.Sprintf(`Enum value "%s.%s" already exists in the schema. It cannot also be `+
+				`defined in this type extension.`, config.Name, name), value.Name)
+			continue
+		}
+		if _, exists := config.Values[name]; exists {
+			b.reportError(fmt.Sprintf(`Enum value "%s.%s" can only be defined once.`, config.Name, name),
+				value.Name)
+			continue
+		}
+
+		config.Values[name] = graphql.EnumValueDefinition{
+			Description: descriptionOf(value.Description),
+			Value:       internalValues[name],
+			Deprecation: b.deprecationOf(value.Directives),
+			Directives:  b.appliedDirectivesOf(value.Directives),
+		}
+	}
+	config.Directives = b.existingTypeDirectivesOf(enum)
+
+	fallback := graphql.DefaultEnumResultCoercerFactory(graphql.DefaultEnumResultCoercerLookupByName)
+	if len(internalValues) > 0 {
+		fallback = graphql.DefaultEnumResultCoercerFactory(graphql.DefaultEnumResultCoercerLookupByValue)
+	}
+	config.ResultCoercerFactory = extendedEnumResultCoercerFactory{
+		existing: enum,
+		fallback: fallback,
+	}
+}
+
+// extendedEnumResultCoercerFactory creates result coercer for an Enum extended from an existing
+// one.
+type extendedEnumResultCoercerFactory struct {
+	// The Enum being extended
+	existing graphql.Enum
+
+	// Factory for the coercer of the values added by extensions
+	fallback graphql.EnumResultCoercerFactory
+}
+
+// Create implements graphql.EnumResultCoercerFactory.
+func (factory extendedEnumResultCoercerFactory) Create(enum graphql.Enum) (graphql.EnumResultCoercer, error) {
+	fallback, err := factory.fallback.Create(enum)
+	if err != nil {
+		return nil, err
+	}
+	return extendedEnumResultCoercer{
+		enum:     enum,
+		existing: factory.existing,
+		fallback: fallback,
+	}, nil
+}
+
+// extendedEnumResultCoercer coerces result values with the existing Enum first such that the
+// results that are accepted before extension are coerced in the same way. Otherwise, it falls back
+// to the coercer for the values added by extensions.
+type extendedEnumResultCoercer struct {
+	enum     graphql.Enum
+	existing graphql.Enum
+	fallback graphql.EnumResultCoercer
+}
+
+// Coerce implements graphql.EnumResultCoercer.
+func (coercer extendedEnumResultCoercer) Coerce(value interface{}) (graphql.EnumValue, error) {
+	if name, err := coercer.existing.CoerceResultValue(value); err == nil {
+		if name, ok := name.(string); ok {
+			if enumValue := coercer.enum.Values().Lookup(name); enumValue != nil {
+				return enumValue, nil
+			}
+		}
+	}
+	return coercer.fallback.Coerce(value)
+}
+
+func (b *schemaBuilder) extendInputObject(config *graphql.InputObjectConfig, inputObject graphql.InputObject) {
+	// Build fields from extensions with an empty definition. Their default values are resolved later
+	// in finishInputObject.
+	b.buildInputObject(config, &ast.InputObjectTypeDefinition{})
+
+	existingFields := inputObject.Fields()
+	for _, field := range b.inputFieldNodes[config.Name] {
+		name := field.Name.Value()
+		if existingFields[name] != nil {
+			b.reportError(fmt.Sprintf(`Field "%s.%s" already exists in the schema. It cannot also be `+
+				`defined in this type extension.`, config.Name, name), field.Name)
+			delete(config.Fields, name)
+		}
+	}
+
+	for name, field := range existingFields {
+		var defaultValue interface{}
+		if field.HasDefaultValue() {
+			defaultValue = field.DefaultValue()
+			if defaultValue == nil {
+				defaultValue = graphql.NilInputFieldDefaultValue
+			}
+		}
+
+		config.Fields[name] = graphql.InputFieldDefinition{
+			Description:  field.Description(),
+			Type:         b.typeDefOfType(field.Type()),
+			DefaultValue: defaultValue,
+			Deprecation:  field.Deprecation(),
+			Directives:   field.Directives(),
+		}
+	}
+	// Directives in the extensions have been added by buildInputObject.
+	config.Directives = append(append(graphql.AppliedDirectives{}, graphql.DirectivesOf(inputObject)...),
+		config.Directives...)
+	config.IsOneOf = config.IsOneOf || inputObject.IsOneOf()
+}
+
+// buildExistingDirectiveConfigs recreates the directives other than the standard ones in the schema
+// being extended because their arguments may refer to the types that are recreated.
+func (b *schemaBuilder) buildExistingDirectiveConfigs() {
+	if b.schema == nil {
+		return
+	}
+
+	for _, directive := range b.schema.Directives() {
+		if isStandardDirective(directive) {
+			continue
+		}
+
+		b.directiveConfigs = append(b.directiveConfigs, &graphql.DirectiveConfig{
+			Name:        directive.Name(),
+			Description: directive.Description(),
+			Locations:   directive.Locations(),
+			Args:        b.existingArgs(directive.Args()),
+		})
+	}
+}
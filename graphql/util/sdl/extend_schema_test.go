@@ -0,0 +1,684 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package sdl_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/botobag/artemis/graphql"
+	"github.com/botobag/artemis/graphql/token"
+	"github.com/botobag/artemis/graphql/util/sdl"
+	"github.com/botobag/artemis/internal/util"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// graphql-js/src/utilities/__tests__/extendSchema-test.js@f529809
+var _ = Describe("ExtendSchema", func() {
+	type Dog struct {
+		Name string
+	}
+
+	type Cat struct {
+		Name  string
+		Meows bool
+	}
+
+	// Colors used as internal values of the Color enum.
+	const (
+		red = iota
+		green
+		blue
+	)
+
+	var (
+		schema    graphql.Schema
+		schemaSDL string
+	)
+
+	BeforeEach(func() {
+		dogType := &graphql.ObjectConfig{
+			Name: "Dog",
+			Fields: graphql.Fields{
+				"name": {
+					Type: graphql.T(graphql.String()),
+				},
+			},
+		}
+
+		catType := &graphql.ObjectConfig{
+			Name: "Cat",
+			Fields: graphql.Fields{
+				"name": {
+					Type: graphql.T(graphql.String()),
+				},
+				"meows": {
+					Type: graphql.T(graphql.Boolean()),
+				},
+			},
+		}
+
+		// The type resolver returns the Object types created with the configs above which are replaced
+		// with new ones in extended schema.
+		petTypeResolver := graphql.TypeResolverFunc(
+			func(ctx context.Context, value interface{}, info graphql.ResolveInfo) (graphql.Object, error) {
+				switch value.(type) {
+				case Dog:
+					return graphql.NewObject(dogType)
+				case Cat:
+					return graphql.NewObject(catType)
+				}
+				return nil, nil
+			})
+
+		petType := &graphql.InterfaceConfig{
+			Name:         "Pet",
+			TypeResolver: petTypeResolver,
+			Fields: graphql.Fields{
+				"name": {
+					Type: graphql.T(graphql.String()),
+				},
+			},
+		}
+		dogType.Interfaces = []graphql.InterfaceTypeDefinition{petType}
+		catType.Interfaces = []graphql.InterfaceTypeDefinition{petType}
+
+		colorType := &graphql.EnumConfig{
+			Name: "Color",
+			Values: graphql.EnumValueDefinitionMap{
+				"RED":   {Value: red},
+				"GREEN": {Value: green},
+				"BLUE":  {Value: blue},
+			},
+			ResultCoercerFactory: graphql.DefaultEnumResultCoercerFactory(
+				graphql.DefaultEnumResultCoercerLookupByValue),
+		}
+
+		filterType := &graphql.InputObjectConfig{
+			Name: "Filter",
+			Fields: graphql.InputFields{
+				"name": {
+					Type:         graphql.T(graphql.String()),
+					DefaultValue: "Odie",
+				},
+			},
+		}
+
+		schema = graphql.MustNewSchema(&graphql.SchemaConfig{
+			Query: graphql.MustNewObject(&graphql.ObjectConfig{
+				Name: "Query",
+				Fields: graphql.Fields{
+					"pets": {
+						Type: graphql.ListOf(petType),
+						Args: graphql.ArgumentConfigMap{
+							"filter": {
+								Type: filterType,
+							},
+						},
+						Resolver: graphql.FieldResolverFunc(
+							func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+								return []interface{}{
+									Dog{Name: "Odie"},
+									Cat{Name: "Garfield", Meows: false},
+								}, nil
+							}),
+					},
+					"favoriteColor": {
+						Type: colorType,
+						Resolver: graphql.FieldResolverFunc(
+							func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+								return blue, nil
+							}),
+					},
+				},
+			}),
+			Types: []graphql.Type{
+				graphql.MustNewObject(dogType),
+				graphql.MustNewObject(catType),
+			},
+		})
+
+		var err error
+		schemaSDL, err = sdl.PrintSchema(schema)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	extendSchema := func(source string, resolvers *sdl.Resolvers) (graphql.Schema, graphql.Errors) {
+		return sdl.ExtendSchema(schema, token.NewSource(source), resolvers)
+	}
+
+	mustExtendSchema := func(source string, resolvers *sdl.Resolvers) graphql.Schema {
+		extendedSchema, errs := extendSchema(source, resolvers)
+		Expect(errs).Should(Equal(graphql.NoErrors()))
+
+		// The original schema must not be modified.
+		Expect(sdl.PrintSchema(schema)).Should(Equal(schemaSDL))
+
+		return extendedSchema
+	}
+
+	It("returns the original schema when there are no type definitions", func() {
+		Expect(mustExtendSchema(`{ field }`, nil)).Should(Equal(schema))
+	})
+
+	It("extends objects by adding new fields with resolvers", func() {
+		extendedSchema := mustExtendSchema(`
+      extend type Query {
+        greeting(name: String = "World"): String
+      }
+    `, &sdl.Resolvers{
+			Fields: map[string]sdl.FieldResolvers{
+				"Query": {
+					"greeting": graphql.FieldResolverFunc(
+						func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+							return "Hello, " + info.Args().Get("name").(string), nil
+						}),
+				},
+			},
+		})
+
+		Expect(sdl.PrintSchema(extendedSchema)).Should(ContainSubstring(util.Dedent(`
+      type Query {
+        favoriteColor: Color
+        greeting(name: String = "World"): String
+        pets(filter: Filter): [Pet]
+      }
+    `)))
+
+		Expect(executeQuery(extendedSchema, `{
+			greeting
+			favoriteColor
+			pets {
+				name
+				... on Cat {
+					meows
+				}
+			}
+		}`, nil)).Should(MatchJSON(`{
+			"data": {
+				"greeting": "Hello, World",
+				"favoriteColor": "BLUE",
+				"pets": [
+					{ "name": "Odie" },
+					{ "name": "Garfield", "meows": false }
+				]
+			}
+		}`))
+	})
+
+	It("extends objects by adding new fields with subscribers", func() {
+		subscriber := graphql.FieldSubscriberFunc(
+			func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+				return make(chan interface{}), nil
+			})
+
+		extendedSchema := mustExtendSchema(`
+      type Subscription {
+        newPet: Pet
+      }
+
+      extend schema {
+        subscription: Subscription
+      }
+    `, &sdl.Resolvers{
+			Subscribers: map[string]sdl.FieldSubscribers{
+				"Subscription": {
+					"newPet": subscriber,
+				},
+			},
+		})
+
+		Expect(extendedSchema.Subscription()).ShouldNot(BeNil())
+		Expect(extendedSchema.Subscription().Fields()["newPet"].Subscriber()).ShouldNot(BeNil())
+	})
+
+	It("overrides resolvers for existing fields", func() {
+		extendedSchema := mustExtendSchema(`
+      extend type Query {
+        greeting: String
+      }
+    `, &sdl.Resolvers{
+			Fields: map[string]sdl.FieldResolvers{
+				"Query": {
+					"favoriteColor": graphql.FieldResolverFunc(
+						func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+							return red, nil
+						}),
+				},
+			},
+		})
+
+		Expect(executeQuery(extendedSchema, `{ favoriteColor }`, nil)).Should(MatchJSON(`{
+			"data": {
+				"favoriteColor": "RED"
+			}
+		}`))
+	})
+
+	It("extends objects with new types and interfaces", func() {
+		extendedSchema := mustExtendSchema(`
+      interface Named {
+        name: String
+      }
+
+      type Bird implements Pet & Named {
+        name: String
+        wingspan: Float
+      }
+
+      extend type Dog implements Named {
+        barks: Boolean
+      }
+    `, nil)
+
+		output, err := sdl.PrintSchema(extendedSchema)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(output).Should(ContainSubstring(util.Dedent(`
+      type Bird implements Pet & Named {
+        name: String
+        wingspan: Float
+      }
+    `)))
+		Expect(output).Should(ContainSubstring(util.Dedent(`
+      type Dog implements Pet & Named {
+        barks: Boolean
+        name: String
+      }
+    `)))
+		Expect(output).Should(ContainSubstring(util.Dedent(`
+      interface Named {
+        name: String
+      }
+    `)))
+	})
+
+	It("extends interfaces by adding new fields", func() {
+		extendedSchema := mustExtendSchema(`
+      extend interface Pet {
+        nickname: String
+      }
+
+      extend type Dog {
+        nickname: String
+      }
+
+      extend type Cat {
+        nickname: String
+      }
+    `, nil)
+
+		output, err := sdl.PrintSchema(extendedSchema)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(output).Should(ContainSubstring(util.Dedent(`
+      interface Pet {
+        name: String
+        nickname: String
+      }
+    `)))
+	})
+
+	It("extends interfaces by adding new interfaces", func() {
+		extendedSchema := mustExtendSchema(`
+      interface Named {
+        name: String
+      }
+
+      extend interface Pet implements Named
+
+      extend type Dog implements Named
+
+      extend type Cat implements Named
+    `, nil)
+
+		output, err := sdl.PrintSchema(extendedSchema)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(output).Should(ContainSubstring(util.Dedent(`
+      interface Pet implements Named {
+        name: String
+      }
+    `)))
+		Expect(output).Should(ContainSubstring(util.Dedent(`
+      type Dog implements Pet & Named {
+        name: String
+      }
+    `)))
+	})
+
+	It("extends unions by adding new types", func() {
+		extendedSchema := mustExtendSchema(`
+      union DogOrCat = Dog | Cat
+
+      type Fish {
+        name: String
+      }
+
+      extend union DogOrCat = Fish
+    `, nil)
+
+		Expect(sdl.PrintSchema(extendedSchema)).Should(ContainSubstring(
+			"union DogOrCat = Cat | Dog | Fish\n"))
+	})
+
+	It("extends enums by adding new values", func() {
+		const purple = 100
+
+		extendedSchema := mustExtendSchema(`
+      extend enum Color {
+        PURPLE
+      }
+
+      extend type Query {
+        newColor: Color
+      }
+    `, &sdl.Resolvers{
+			Fields: map[string]sdl.FieldResolvers{
+				"Query": {
+					"newColor": graphql.FieldResolverFunc(
+						func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+							return purple, nil
+						}),
+				},
+			},
+			Enums: map[string]sdl.EnumValues{
+				"Color": {
+					"PURPLE": purple,
+				},
+			},
+		})
+
+		Expect(sdl.PrintSchema(extendedSchema)).Should(ContainSubstring(util.Dedent(`
+      enum Color {
+        BLUE
+        GREEN
+        PURPLE
+        RED
+      }
+    `)))
+
+		Expect(executeQuery(extendedSchema, `{ favoriteColor newColor }`, nil)).Should(MatchJSON(`{
+			"data": {
+				"favoriteColor": "BLUE",
+				"newColor": "PURPLE"
+			}
+		}`))
+	})
+
+	It("extends input objects by adding new fields", func() {
+		extendedSchema := mustExtendSchema(`
+      extend input Filter {
+        meows: Boolean = true
+      }
+
+      extend type Query {
+        filter(filter: Filter = {}): String
+      }
+    `, &sdl.Resolvers{
+			Fields: map[string]sdl.FieldResolvers{
+				"Query": {
+					"filter": graphql.FieldResolverFunc(
+						func(ctx context.Context, source interface{}, info graphql.ResolveInfo) (interface{}, error) {
+							filter := info.Args().Get("filter").(map[string]interface{})
+							return fmt.Sprintf("%s %t", filter["name"], filter["meows"]), nil
+						}),
+				},
+			},
+		})
+
+		Expect(sdl.PrintSchema(extendedSchema)).Should(ContainSubstring(util.Dedent(`
+      input Filter {
+        meows: Boolean = true
+        name: String = "Odie"
+      }
+    `)))
+
+		Expect(executeQuery(extendedSchema, `{ filter }`, nil)).Should(MatchJSON(`{
+			"data": {
+				"filter": "Odie true"
+			}
+		}`))
+	})
+
+	It("extends input objects with @oneOf", func() {
+		extendedSchema := mustExtendSchema(`
+      input Lookup {
+        id: ID
+      }
+
+      extend input Lookup @oneOf {
+        name: String
+      }
+
+      extend type Query {
+        lookup(by: Lookup): String
+      }
+    `, nil)
+
+		lookup := extendedSchema.TypeMap().Lookup("Lookup").(graphql.InputObject)
+		Expect(lookup.IsOneOf()).Should(BeTrue())
+		Expect(sdl.PrintSchema(extendedSchema)).Should(ContainSubstring(util.Dedent(`
+      input Lookup @oneOf {
+        id: ID
+        name: String
+      }
+    `)))
+	})
+
+	It("extends scalars with @specifiedBy", func() {
+		extendedSchema := mustExtendSchema(`
+      scalar Foo
+
+      extend type Query {
+        foo: Foo
+      }
+    `, &sdl.Resolvers{
+			Scalars: map[string]sdl.ScalarCoercers{
+				"Foo": {
+					ResultCoercer: graphql.String(),
+				},
+			},
+		})
+		Expect(extendedSchema.TypeMap().Lookup("Foo").(graphql.Scalar).SpecifiedByURL()).Should(BeEmpty())
+
+		extendedSchema, errs := sdl.ExtendSchema(extendedSchema, token.NewSource(`
+      extend scalar Foo @specifiedBy(url: "https://example.com/foo_spec")
+    `), nil)
+		Expect(errs).Should(Equal(graphql.NoErrors()))
+
+		foo := extendedSchema.TypeMap().Lookup("Foo").(graphql.Scalar)
+		Expect(foo.SpecifiedByURL()).Should(Equal("https://example.com/foo_spec"))
+		Expect(graphql.DirectivesOf(foo)).Should(BeEmpty())
+
+		// The URL is kept when the schema is extended again.
+		extendedSchema, errs = sdl.ExtendSchema(extendedSchema, token.NewSource(`
+      directive @meta on SCALAR
+
+      extend scalar Foo @meta
+    `), nil)
+		Expect(errs).Should(Equal(graphql.NoErrors()))
+		Expect(extendedSchema.TypeMap().Lookup("Foo").(graphql.Scalar).SpecifiedByURL()).Should(
+			Equal("https://example.com/foo_spec"))
+	})
+
+	It("adds new directives and keeps existing ones", func() {
+		extendedSchema := mustExtendSchema(`
+      directive @neat(level: Int = 1) on FIELD_DEFINITION
+    `, nil)
+
+		Expect(extendedSchema.Directives().Lookup("neat")).ShouldNot(BeNil())
+		for _, directive := range graphql.StandardDirectives() {
+			Expect(extendedSchema.Directives().Lookup(directive.Name())).Should(Equal(directive))
+		}
+	})
+
+	It("applies directives in extensions and keeps existing ones", func() {
+		extendedSchema := mustExtendSchema(`
+      directive @meta(tag: String) on OBJECT | FIELD_DEFINITION | ENUM | INPUT_OBJECT
+
+      extend type Dog @meta(tag: "dog")
+
+      extend type Cat {
+        lives: Int @meta(tag: "nine")
+      }
+
+      extend enum Color @meta
+
+      extend input Filter @meta(tag: "filter")
+    `, nil)
+
+		Expect(graphql.DirectivesOf(extendedSchema.TypeMap().Lookup("Dog"))).Should(Equal(
+			graphql.AppliedDirectives{
+				{Name: "meta", Args: map[string]interface{}{"tag": "dog"}},
+			}))
+		Expect(graphql.DirectivesOf(extendedSchema.TypeMap().Lookup("Color"))).Should(Equal(
+			graphql.AppliedDirectives{
+				{Name: "meta"},
+			}))
+		Expect(graphql.DirectivesOf(extendedSchema.TypeMap().Lookup("Filter"))).Should(Equal(
+			graphql.AppliedDirectives{
+				{Name: "meta", Args: map[string]interface{}{"tag": "filter"}},
+			}))
+
+		// Extend the extended schema again.
+		extendedSchema, errs := sdl.ExtendSchema(extendedSchema, token.NewSource(`
+      extend type Cat @meta(tag: "cat")
+    `), nil)
+		Expect(errs).Should(Equal(graphql.NoErrors()))
+
+		catType := extendedSchema.TypeMap().Lookup("Cat").(graphql.Object)
+		Expect(graphql.DirectivesOf(catType)).Should(Equal(graphql.AppliedDirectives{
+			{Name: "meta", Args: map[string]interface{}{"tag": "cat"}},
+		}))
+		Expect(catType.Fields()["lives"].Directives()).Should(Equal(graphql.AppliedDirectives{
+			{Name: "meta", Args: map[string]interface{}{"tag": "nine"}},
+		}))
+		Expect(graphql.DirectivesOf(extendedSchema.TypeMap().Lookup("Dog"))).Should(Equal(
+			graphql.AppliedDirectives{
+				{Name: "meta", Args: map[string]interface{}{"tag": "dog"}},
+			}))
+	})
+
+	It("adds root operation types with schema extensions", func() {
+		extendedSchema := mustExtendSchema(`
+      extend schema {
+        mutation: Mutation
+      }
+
+      type Mutation {
+        adopt(name: String!): Pet
+      }
+    `, nil)
+
+		Expect(extendedSchema.Query().Name()).Should(Equal("Query"))
+		Expect(extendedSchema.Mutation().Name()).Should(Equal("Mutation"))
+		Expect(extendedSchema.Subscription()).Should(BeNil())
+	})
+
+	Describe("Failures", func() {
+		It("does not allow replacing an existing type", func() {
+			_, errs := extendSchema(`
+      type Dog {
+        name: String
+      }
+      `, nil)
+			Expect(errs).Should(Equal(graphql.ErrorsOf(
+				errorAt(`Type "Dog" already exists in the schema. It cannot also be defined in this type `+
+					`definition.`, 2, 12),
+			)))
+		})
+
+		It("does not allow replacing an existing field", func() {
+			_, errs := extendSchema(`
+      extend type Dog {
+        name: String
+      }
+
+      extend input Filter {
+        name: String
+      }
+      `, nil)
+			Expect(errs).Should(Equal(graphql.ErrorsOf(
+				errorAt(`Field "Dog.name" already exists in the schema. It cannot also be defined in this `+
+					`type extension.`, 3, 9),
+				errorAt(`Field "Filter.name" already exists in the schema. It cannot also be defined in this `+
+					`type extension.`, 7, 9),
+			)))
+		})
+
+		It("does not allow replacing an existing enum value", func() {
+			_, errs := extendSchema(`
+      extend enum Color {
+        RED
+      }
+      `, nil)
+			Expect(errs).Should(Equal(graphql.ErrorsOf(
+				errorAt(`Enum value "Color.RED" already exists in the schema. It cannot also be defined in `+
+					`this type extension.`, 3, 9),
+			)))
+		})
+
+		It("does not allow replacing an existing directive", func() {
+			_, errs := extendSchema(`
+      directive @include(if: Boolean!) on FIELD
+      `, nil)
+			Expect(errs).Should(Equal(graphql.ErrorsOf(
+				errorAt(`Directive "include" already exists in the schema. It cannot be redefined.`, 2, 18),
+			)))
+		})
+
+		It("does not allow extending an unknown type or a type with a different kind", func() {
+			_, errs := extendSchema(`
+      extend type Unknown {
+        name: String
+      }
+
+      extend interface Dog {
+        name: String
+      }
+      `, nil)
+			Expect(errs).Should(Equal(graphql.ErrorsOf(
+				graphql.NewError(`Cannot extend non-interface type "Dog".`, []graphql.ErrorLocation{
+					{Line: 6, Column: 7},
+				}),
+				errorAt(`Cannot extend type "Unknown" because it is not defined.`, 2, 19),
+			)))
+		})
+
+		It("does not allow defining a new schema", func() {
+			_, errs := extendSchema(`
+      schema {
+        mutation: Query
+      }
+      `, nil)
+			Expect(errs).Should(Equal(graphql.ErrorsOf(
+				errorAt("Cannot define a new schema within a schema extension.", 2, 7),
+			)))
+		})
+
+		It("does not allow adding a root operation type that is already defined", func() {
+			_, errs := extendSchema(`
+      extend schema {
+        query: Dog
+      }
+      `, nil)
+			Expect(errs).Should(Equal(graphql.ErrorsOf(
+				errorAt("There can be only one query type in schema.", 3, 9),
+			)))
+		})
+	})
+})
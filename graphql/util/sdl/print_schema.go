@@ -0,0 +1,683 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package sdl
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/botobag/artemis/graphql"
+	"github.com/botobag/artemis/graphql/ast"
+	"github.com/botobag/artemis/internal/util"
+	"github.com/botobag/artemis/iterator"
+)
+
+// This file implements printing a schema in SDL. Fields, arguments, enum values and union members
+// are printed in the order of their names because the order is not preserved by the graphql types.
+// Directives applied to the type system elements are printed in the order they are applied with
+// their arguments sorted by names.
+//
+// Reference: graphql-js/src/utilities/schemaPrinter.js
+
+// PrintSchema prints the types and directives defined in the schema in SDL. Built-in scalars,
+// introspection types and standard directives are omitted.
+func PrintSchema(schema graphql.Schema) (string, error) {
+	return printFilteredSchema(schema, func(directive graphql.Directive) bool {
+		return !isStandardDirective(directive)
+	}, isDefinedType)
+}
+
+// PrintIntrospectionSchema prints the introspection types and standard directives in SDL.
+func PrintIntrospectionSchema(schema graphql.Schema) (string, error) {
+	return printFilteredSchema(schema, isStandardDirective, isIntrospectionType)
+}
+
+// isStandardDirective returns true if the directive is one of the standard directives.
+func isStandardDirective(directive graphql.Directive) bool {
+	for _, standardDirective := range graphql.StandardDirectives() {
+		if standardDirective.Name() == directive.Name() {
+			return true
+		}
+	}
+	return false
+}
+
+// isIntrospectionType returns true if the type is provided by introspection system.
+func isIntrospectionType(t graphql.TypeWithName) bool {
+	return strings.HasPrefix(t.Name(), "__")
+}
+
+// isDefinedType returns true if the type is neither a built-in scalar or an introspection type.
+func isDefinedType(t graphql.TypeWithName) bool {
+	return standardScalar(t.Name()) == nil && !isIntrospectionType(t)
+}
+
+func printFilteredSchema(
+	schema graphql.Schema,
+	directiveFilter func(directive graphql.Directive) bool,
+	typeFilter func(t graphql.TypeWithName) bool) (string, error) {
+
+	var parts []string
+
+	if s := printSchemaDefinition(schema); len(s) > 0 {
+		parts = append(parts, s)
+	}
+
+	for _, directive := range schema.Directives() {
+		if !directiveFilter(directive) {
+			continue
+		}
+		s, err := printDirective(directive, schema.Directives())
+		if err != nil {
+			return "", err
+		}
+		parts = append(parts, s)
+	}
+
+	// Sort types by names.
+	var types []graphql.Type
+	iter := schema.TypeMap().Iterator()
+	for {
+		t, err := iter.Next()
+		if err == iterator.Done {
+			break
+		} else if err != nil {
+			return "", err
+		}
+
+		if typeFilter(t.(graphql.TypeWithName)) {
+			types = append(types, t.(graphql.Type))
+		}
+	}
+	sort.Slice(types, func(i, j int) bool {
+		return types[i].(graphql.TypeWithName).Name() < types[j].(graphql.TypeWithName).Name()
+	})
+
+	for _, t := range types {
+		s, err := printType(t, schema.Directives())
+		if err != nil {
+			return "", err
+		}
+		parts = append(parts, s)
+	}
+
+	return strings.Join(parts, "\n\n") + "\n", nil
+}
+
+func printSchemaDefinition(schema graphql.Schema) string {
+	if isSchemaOfCommonNames(schema) {
+		return ""
+	}
+
+	var operationTypes []string
+	if query := schema.Query(); query != nil {
+		operationTypes = append(operationTypes, "  query: "+query.Name())
+	}
+	if mutation := schema.Mutation(); mutation != nil {
+		operationTypes = append(operationTypes, "  mutation: "+mutation.Name())
+	}
+	if subscription := schema.Subscription(); subscription != nil {
+		operationTypes = append(operationTypes, "  subscription: "+subscription.Name())
+	}
+
+	return "schema {\n" + strings.Join(operationTypes, "\n") + "\n}"
+}
+
+// isSchemaOfCommonNames returns true if the root operation types in the schema are named with the
+// default names (i.e., Query, Mutation and Subscription). When using this naming convention, the
+// schema description can be omitted.
+func isSchemaOfCommonNames(schema graphql.Schema) bool {
+	if query := schema.Query(); query != nil && query.Name() != "Query" {
+		return false
+	}
+
+	if mutation := schema.Mutation(); mutation != nil && mutation.Name() != "Mutation" {
+		return false
+	}
+
+	if subscription := schema.Subscription(); subscription != nil && subscription.Name() != "Subscription" {
+		return false
+	}
+
+	return true
+}
+
+// PrintType prints the definition of the named type in SDL. Because the directive definitions are
+// not available without a schema, arguments of the directives applied to the type are printed in
+// the literals inferred from their Go values.
+func PrintType(t graphql.Type) (string, error) {
+	return printType(t, nil)
+}
+
+// printType prints the definition of the named type. The given directives provide the argument
+// types for printing the applied directives.
+func printType(t graphql.Type, directives graphql.DirectiveList) (string, error) {
+	switch t := t.(type) {
+	case graphql.Scalar:
+		return printScalar(t, directives)
+	case graphql.Object:
+		return printObject(t, directives)
+	case graphql.Interface:
+		return printInterface(t, directives)
+	case graphql.Union:
+		return printUnion(t, directives)
+	case graphql.Enum:
+		return printEnum(t, directives)
+	case graphql.InputObject:
+		return printInputObject(t, directives)
+	}
+	return "", graphql.NewError("Cannot print unnamed type " + graphql.Inspect(t))
+}
+
+func printScalar(t graphql.Scalar, directives graphql.DirectiveList) (string, error) {
+	specifiedBy, err := printSpecifiedByURL(t)
+	if err != nil {
+		return "", err
+	}
+
+	appliedDirectives, err := printAppliedDirectives(graphql.DirectivesOf(t), directives)
+	if err != nil {
+		return "", err
+	}
+
+	return printDescription(t.Description(), "", true) +
+		"scalar " + t.Name() + specifiedBy + appliedDirectives, nil
+}
+
+func printSpecifiedByURL(t graphql.Scalar) (string, error) {
+	url := t.SpecifiedByURL()
+	if len(url) == 0 {
+		return "", nil
+	}
+
+	urlAST, err := graphql.ASTFromValue(url, graphql.String())
+	if err != nil {
+		return "", err
+	}
+	return " @specifiedBy(url: " + ast.Print(urlAST) + ")", nil
+}
+
+func printObject(t graphql.Object, directives graphql.DirectiveList) (string, error) {
+	implementedInterfaces := printImplementedInterfaces(t.Interfaces())
+
+	appliedDirectives, err := printAppliedDirectives(graphql.DirectivesOf(t), directives)
+	if err != nil {
+		return "", err
+	}
+
+	fields, err := printFields(t.Fields(), directives)
+	if err != nil {
+		return "", err
+	}
+
+	return printDescription(t.Description(), "", true) +
+		"type " + t.Name() + implementedInterfaces + appliedDirectives + " {\n" +
+		fields + "\n" +
+		"}", nil
+}
+
+// printImplementedInterfaces prints the interfaces implemented by an Object or an Interface type.
+func printImplementedInterfaces(interfaces []graphql.Interface) string {
+	if len(interfaces) == 0 {
+		return ""
+	}
+
+	names := make([]string, len(interfaces))
+	for i, iface := range interfaces {
+		names[i] = iface.Name()
+	}
+	return " implements " + strings.Join(names, " & ")
+}
+
+func printInterface(t graphql.Interface, directives graphql.DirectiveList) (string, error) {
+	implementedInterfaces := printImplementedInterfaces(t.Interfaces())
+
+	appliedDirectives, err := printAppliedDirectives(graphql.DirectivesOf(t), directives)
+	if err != nil {
+		return "", err
+	}
+
+	fields, err := printFields(t.Fields(), directives)
+	if err != nil {
+		return "", err
+	}
+
+	return printDescription(t.Description(), "", true) +
+		"interface " + t.Name() + implementedInterfaces + appliedDirectives + " {\n" +
+		fields + "\n" +
+		"}", nil
+}
+
+func printUnion(t graphql.Union, directives graphql.DirectiveList) (string, error) {
+	appliedDirectives, err := printAppliedDirectives(graphql.DirectivesOf(t), directives)
+	if err != nil {
+		return "", err
+	}
+
+	var names []string
+	iter := t.PossibleTypes().Iterator()
+	for {
+		possibleType, err := iter.Next()
+		if err != nil {
+			break
+		}
+		names = append(names, possibleType.(graphql.Object).Name())
+	}
+	sort.Strings(names)
+
+	var possibleTypes string
+	if len(names) > 0 {
+		possibleTypes = " = " + strings.Join(names, " | ")
+	}
+
+	return printDescription(t.Description(), "", true) +
+		"union " + t.Name() + appliedDirectives + possibleTypes, nil
+}
+
+func printEnum(t graphql.Enum, directives graphql.DirectiveList) (string, error) {
+	appliedDirectives, err := printAppliedDirectives(graphql.DirectivesOf(t), directives)
+	if err != nil {
+		return "", err
+	}
+
+	enumValues := t.Values()
+	names := make([]string, 0, len(enumValues))
+	for name := range enumValues {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	values := make([]string, len(names))
+	for i, name := range names {
+		value := enumValues[name]
+		deprecated, err := printDeprecated(value.Deprecation())
+		if err != nil {
+			return "", err
+		}
+		valueDirectives, err := printAppliedDirectives(value.Directives(), directives)
+		if err != nil {
+			return "", err
+		}
+		values[i] = printDescription(value.Description(), "  ", i == 0) +
+			"  " + name + deprecated + valueDirectives
+	}
+
+	return printDescription(t.Description(), "", true) +
+		"enum " + t.Name() + appliedDirectives + " {\n" +
+		strings.Join(values, "\n") + "\n" +
+		"}", nil
+}
+
+func printInputObject(t graphql.InputObject, directives graphql.DirectiveList) (string, error) {
+	appliedDirectives, err := printAppliedDirectives(graphql.DirectivesOf(t), directives)
+	if err != nil {
+		return "", err
+	}
+
+	inputFields := t.Fields()
+	names := make([]string, 0, len(inputFields))
+	for name := range inputFields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fields := make([]string, len(names))
+	for i, name := range names {
+		field := inputFields[name]
+		inputValue, err := printInputValue(name, field.Type(), field.HasDefaultValue(), field.DefaultValue(),
+			field.Deprecation(), field.Directives(), directives)
+		if err != nil {
+			return "", err
+		}
+		fields[i] = printDescription(field.Description(), "  ", i == 0) + "  " + inputValue
+	}
+
+	var oneOf string
+	if t.IsOneOf() {
+		oneOf = " @oneOf"
+	}
+
+	return printDescription(t.Description(), "", true) +
+		"input " + t.Name() + oneOf + appliedDirectives + " {\n" +
+		strings.Join(fields, "\n") + "\n" +
+		"}", nil
+}
+
+func printFields(fieldMap graphql.FieldMap, directives graphql.DirectiveList) (string, error) {
+	names := make([]string, 0, len(fieldMap))
+	for name := range fieldMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fields := make([]string, len(names))
+	for i, name := range names {
+		field := fieldMap[name]
+
+		args, err := printArgs(field.Args(), "  ", directives)
+		if err != nil {
+			return "", err
+		}
+
+		deprecated, err := printDeprecated(field.Deprecation())
+		if err != nil {
+			return "", err
+		}
+
+		appliedDirectives, err := printAppliedDirectives(field.Directives(), directives)
+		if err != nil {
+			return "", err
+		}
+
+		fields[i] = printDescription(field.Description(), "  ", i == 0) +
+			"  " + name + args + ": " + graphql.Inspect(field.Type()) + deprecated + appliedDirectives
+	}
+
+	return strings.Join(fields, "\n"), nil
+}
+
+func printArgs(
+	args []graphql.Argument,
+	indentation string,
+	directives graphql.DirectiveList) (string, error) {
+
+	if len(args) == 0 {
+		return "", nil
+	}
+
+	// Sort arguments by names.
+	sortedArgs := make([]*graphql.Argument, len(args))
+	for i := range args {
+		sortedArgs[i] = &args[i]
+	}
+	sort.Slice(sortedArgs, func(i, j int) bool {
+		return sortedArgs[i].Name() < sortedArgs[j].Name()
+	})
+
+	// If every arg does not have a description, print them on one line.
+	hasDescription := false
+	inputValues := make([]string, len(sortedArgs))
+	for i, arg := range sortedArgs {
+		inputValue, err := printInputValue(arg.Name(), arg.Type(), arg.HasDefaultValue(), arg.DefaultValue(),
+			arg.Deprecation(), arg.Directives(), directives)
+		if err != nil {
+			return "", err
+		}
+		inputValues[i] = inputValue
+
+		if len(arg.Description()) > 0 {
+			hasDescription = true
+		}
+	}
+
+	if !hasDescription {
+		return "(" + strings.Join(inputValues, ", ") + ")", nil
+	}
+
+	var b util.StringBuilder
+	b.WriteString("(\n")
+	for i, arg := range sortedArgs {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString(printDescription(arg.Description(), "  "+indentation, i == 0))
+		b.WriteString("  " + indentation + inputValues[i])
+	}
+	b.WriteString("\n" + indentation + ")")
+
+	return b.String(), nil
+}
+
+func printInputValue(
+	name string,
+	t graphql.Type,
+	hasDefaultValue bool,
+	defaultValue interface{},
+	deprecation *graphql.Deprecation,
+	appliedDirectives graphql.AppliedDirectives,
+	directives graphql.DirectiveList) (string, error) {
+
+	inputValue := name + ": " + graphql.Inspect(t)
+	if hasDefaultValue {
+		defaultAST, err := graphql.ASTFromValue(defaultValue, t)
+		if err != nil {
+			return "", err
+		}
+		if defaultAST != nil {
+			inputValue += " = " + ast.Print(defaultAST)
+		}
+	}
+
+	deprecated, err := printDeprecated(deprecation)
+	if err != nil {
+		return "", err
+	}
+
+	s, err := printAppliedDirectives(appliedDirectives, directives)
+	if err != nil {
+		return "", err
+	}
+	return inputValue + deprecated + s, nil
+}
+
+func printDirective(directive graphql.Directive, directives graphql.DirectiveList) (string, error) {
+	args, err := printArgs(directive.Args(), "", directives)
+	if err != nil {
+		return "", err
+	}
+
+	locations := make([]string, len(directive.Locations()))
+	for i, location := range directive.Locations() {
+		locations[i] = string(location)
+	}
+
+	var repeatable string
+	if directive.IsRepeatable() {
+		repeatable = " repeatable"
+	}
+
+	return printDescription(directive.Description(), "", true) +
+		"directive @" + directive.Name() + args + repeatable +
+		" on " + strings.Join(locations, " | "), nil
+}
+
+func printDeprecated(deprecation *graphql.Deprecation) (string, error) {
+	if !deprecation.Defined() {
+		return "", nil
+	}
+
+	reason := deprecation.Reason
+	if len(reason) == 0 || reason == graphql.DefaultDeprecationReason {
+		return " @deprecated", nil
+	}
+
+	reasonAST, err := graphql.ASTFromValue(reason, graphql.String())
+	if err != nil {
+		return "", err
+	}
+	return " @deprecated(reason: " + ast.Print(reasonAST) + ")", nil
+}
+
+// printAppliedDirectives prints the directives applied to a type system element. Each of them is
+// preceded by a space. The given directives provide the argument types for printing argument
+// values.
+func printAppliedDirectives(
+	appliedDirectives graphql.AppliedDirectives,
+	directives graphql.DirectiveList) (string, error) {
+
+	var b util.StringBuilder
+	for _, appliedDirective := range appliedDirectives {
+		b.WriteString(" @" + appliedDirective.Name)
+		if len(appliedDirective.Args) == 0 {
+			continue
+		}
+
+		names := make([]string, 0, len(appliedDirective.Args))
+		for name := range appliedDirective.Args {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		// Find the argument types from the directive definition.
+		argTypes := map[string]graphql.Type{}
+		if directive := directives.Lookup(appliedDirective.Name); directive != nil {
+			for _, arg := range directive.Args() {
+				argTypes[arg.Name()] = arg.Type()
+			}
+		}
+
+		args := make([]string, len(names))
+		for i, name := range names {
+			value, err := printAppliedDirectiveArgValue(appliedDirective.Args[name], argTypes[name])
+			if err != nil {
+				return "", err
+			}
+			args[i] = name + ": " + value
+		}
+		b.WriteString("(" + strings.Join(args, ", ") + ")")
+	}
+
+	return b.String(), nil
+}
+
+// printAppliedDirectiveArgValue prints the value given to an argument of an applied directive. If
+// the argument type is unknown, the literal is inferred from the Go value.
+func printAppliedDirectiveArgValue(value interface{}, t graphql.Type) (string, error) {
+	if t == nil {
+		return printUntypedValue(value)
+	}
+
+	valueAST, err := graphql.ASTFromValue(value, t)
+	if err != nil {
+		return "", err
+	}
+	if valueAST == nil {
+		return "null", nil
+	}
+	return ast.Print(valueAST), nil
+}
+
+// printUntypedValue prints a Go value in GraphQL literal without knowing its type.
+func printUntypedValue(value interface{}) (string, error) {
+	switch value := value.(type) {
+	case nil:
+		return "null", nil
+
+	case bool:
+		return strconv.FormatBool(value), nil
+
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprint(value), nil
+
+	case float32, float64:
+		valueAST, err := graphql.ASTFromValue(value, graphql.Float())
+		if err != nil {
+			return "", err
+		}
+		return ast.Print(valueAST), nil
+
+	case string:
+		valueAST, err := graphql.ASTFromValue(value, graphql.String())
+		if err != nil {
+			return "", err
+		}
+		return ast.Print(valueAST), nil
+
+	case []interface{}:
+		items := make([]string, len(value))
+		for i, item := range value {
+			s, err := printUntypedValue(item)
+			if err != nil {
+				return "", err
+			}
+			items[i] = s
+		}
+		return "[" + strings.Join(items, ", ") + "]", nil
+
+	case map[string]interface{}:
+		names := make([]string, 0, len(value))
+		for name := range value {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		fields := make([]string, len(names))
+		for i, name := range names {
+			s, err := printUntypedValue(value[name])
+			if err != nil {
+				return "", err
+			}
+			fields[i] = name + ": " + s
+		}
+		return "{" + strings.Join(fields, ", ") + "}", nil
+	}
+
+	return "", graphql.NewError(fmt.Sprintf("Cannot print %s without knowing its type.", graphql.Inspect(value)))
+}
+
+// printDescription prints the description in a block string followed by a new line. Descriptions
+// of the items in a block (e.g., fields in an object) are indented by the given indentation and
+// separated from the previous item with an empty line.
+func printDescription(description string, indentation string, firstInBlock bool) string {
+	if len(description) == 0 {
+		return ""
+	}
+
+	preferMultipleLines := len(description) > 70
+	blockString := printBlockString(description, "", preferMultipleLines)
+
+	prefix := indentation
+	if len(indentation) > 0 && !firstInBlock {
+		prefix = "\n" + indentation
+	}
+
+	return prefix + strings.Replace(blockString, "\n", "\n"+indentation, -1) + "\n"
+}
+
+// printBlockString prints a block string in the indented block form by adding a leading and
+// trailing blank line. However, if a block string starts with whitespace and is a single-line,
+// adding a leading blank line would strip that whitespace.
+//
+// Reference: graphql-js/src/language/blockString.js
+func printBlockString(value string, indentation string, preferMultipleLines bool) string {
+	var (
+		isSingleLine         = !strings.ContainsRune(value, '\n')
+		hasLeadingSpace      = len(value) > 0 && (value[0] == ' ' || value[0] == '\t')
+		hasTrailingQuote     = len(value) > 0 && value[len(value)-1] == '"'
+		printAsMultipleLines = !isSingleLine || hasTrailingQuote || preferMultipleLines
+		b                    util.StringBuilder
+	)
+
+	// Format a multi-line block quote to account for leading space.
+	if printAsMultipleLines && !(isSingleLine && hasLeadingSpace) {
+		b.WriteString("\n" + indentation)
+	}
+
+	if len(indentation) > 0 {
+		b.WriteString(strings.Replace(value, "\n", "\n"+indentation, -1))
+	} else {
+		b.WriteString(value)
+	}
+
+	if printAsMultipleLines {
+		b.WriteString("\n")
+	}
+
+	return `"""` + strings.Replace(b.String(), `"""`, `\"""`, -1) + `"""`
+}
@@ -0,0 +1,1107 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package sdl_test
+
+import (
+	"strings"
+
+	"github.com/botobag/artemis/graphql"
+	"github.com/botobag/artemis/graphql/token"
+	"github.com/botobag/artemis/graphql/util/sdl"
+	"github.com/botobag/artemis/internal/util"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// graphql-js/src/utilities/__tests__/schemaPrinter-test.js@f529809
+var _ = Describe("PrintSchema", func() {
+	printSchema := func(schema graphql.Schema) string {
+		output, err := sdl.PrintSchema(schema)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		// Check the output can be built back to a schema that prints the same output.
+		resolvers := &sdl.Resolvers{}
+		if strings.Contains(output, "scalar Odd") {
+			resolvers.Scalars = map[string]sdl.ScalarCoercers{
+				"Odd": {
+					ResultCoercer: graphql.Int(),
+				},
+			}
+		}
+		builtSchema, errs := sdl.BuildSchema(token.NewSource(output), resolvers)
+		Expect(errs).Should(Equal(graphql.NoErrors()))
+		Expect(sdl.PrintSchema(builtSchema)).Should(Equal(output))
+
+		return output
+	}
+
+	printSingleFieldSchema := func(fieldConfig graphql.FieldConfig) string {
+		return printSchema(graphql.MustNewSchema(&graphql.SchemaConfig{
+			Query: graphql.MustNewObject(&graphql.ObjectConfig{
+				Name: "Query",
+				Fields: graphql.Fields{
+					"singleField": fieldConfig,
+				},
+			}),
+		}))
+	}
+
+	It("prints String field", func() {
+		Expect(printSingleFieldSchema(graphql.FieldConfig{
+			Type: graphql.T(graphql.String()),
+		})).Should(Equal(util.Dedent(`
+      type Query {
+        singleField: String
+      }
+    `)))
+	})
+
+	It("prints [String] field", func() {
+		Expect(printSingleFieldSchema(graphql.FieldConfig{
+			Type: graphql.ListOfType(graphql.String()),
+		})).Should(Equal(util.Dedent(`
+      type Query {
+        singleField: [String]
+      }
+    `)))
+	})
+
+	It("prints String! field", func() {
+		Expect(printSingleFieldSchema(graphql.FieldConfig{
+			Type: graphql.NonNullOfType(graphql.String()),
+		})).Should(Equal(util.Dedent(`
+      type Query {
+        singleField: String!
+      }
+    `)))
+	})
+
+	It("prints [String]! field", func() {
+		Expect(printSingleFieldSchema(graphql.FieldConfig{
+			Type: graphql.NonNullOf(graphql.ListOfType(graphql.String())),
+		})).Should(Equal(util.Dedent(`
+      type Query {
+        singleField: [String]!
+      }
+    `)))
+	})
+
+	It("prints [String!] field", func() {
+		Expect(printSingleFieldSchema(graphql.FieldConfig{
+			Type: graphql.ListOf(graphql.NonNullOfType(graphql.String())),
+		})).Should(Equal(util.Dedent(`
+      type Query {
+        singleField: [String!]
+      }
+    `)))
+	})
+
+	It("prints [String!]! field", func() {
+		Expect(printSingleFieldSchema(graphql.FieldConfig{
+			Type: graphql.NonNullOf(graphql.ListOf(graphql.NonNullOfType(graphql.String()))),
+		})).Should(Equal(util.Dedent(`
+      type Query {
+        singleField: [String!]!
+      }
+    `)))
+	})
+
+	It("prints Object field", func() {
+		fooType := &graphql.ObjectConfig{
+			Name: "Foo",
+			Fields: graphql.Fields{
+				"str": {
+					Type: graphql.T(graphql.String()),
+				},
+			},
+		}
+
+		schema := graphql.MustNewSchema(&graphql.SchemaConfig{
+			Query: graphql.MustNewObject(&graphql.ObjectConfig{
+				Name: "Query",
+				Fields: graphql.Fields{
+					"foo": {
+						Type: fooType,
+					},
+				},
+			}),
+		})
+
+		Expect(printSchema(schema)).Should(Equal(util.Dedent(`
+      type Foo {
+        str: String
+      }
+
+      type Query {
+        foo: Foo
+      }
+    `)))
+	})
+
+	It("prints String field with Int arg", func() {
+		Expect(printSingleFieldSchema(graphql.FieldConfig{
+			Type: graphql.T(graphql.String()),
+			Args: graphql.ArgumentConfigMap{
+				"argOne": {
+					Type: graphql.T(graphql.Int()),
+				},
+			},
+		})).Should(Equal(util.Dedent(`
+      type Query {
+        singleField(argOne: Int): String
+      }
+    `)))
+	})
+
+	It("prints String field with Int arg with default", func() {
+		Expect(printSingleFieldSchema(graphql.FieldConfig{
+			Type: graphql.T(graphql.String()),
+			Args: graphql.ArgumentConfigMap{
+				"argOne": {
+					Type:         graphql.T(graphql.Int()),
+					DefaultValue: 2,
+				},
+			},
+		})).Should(Equal(util.Dedent(`
+      type Query {
+        singleField(argOne: Int = 2): String
+      }
+    `)))
+	})
+
+	It("prints String field with String arg with default", func() {
+		Expect(printSingleFieldSchema(graphql.FieldConfig{
+			Type: graphql.T(graphql.String()),
+			Args: graphql.ArgumentConfigMap{
+				"argOne": {
+					Type:         graphql.T(graphql.String()),
+					DefaultValue: "tes\t de\fault",
+				},
+			},
+		})).Should(Equal(util.Dedent(`
+      type Query {
+        singleField(argOne: String = "tes\t de\u000cault"): String
+      }
+    `)))
+	})
+
+	It("prints String field with Int arg with default null", func() {
+		Expect(printSingleFieldSchema(graphql.FieldConfig{
+			Type: graphql.T(graphql.String()),
+			Args: graphql.ArgumentConfigMap{
+				"argOne": {
+					Type:         graphql.T(graphql.Int()),
+					DefaultValue: graphql.NilArgumentDefaultValue,
+				},
+			},
+		})).Should(Equal(util.Dedent(`
+      type Query {
+        singleField(argOne: Int = null): String
+      }
+    `)))
+	})
+
+	It("prints String field with Int! arg", func() {
+		Expect(printSingleFieldSchema(graphql.FieldConfig{
+			Type: graphql.T(graphql.String()),
+			Args: graphql.ArgumentConfigMap{
+				"argOne": {
+					Type: graphql.NonNullOfType(graphql.Int()),
+				},
+			},
+		})).Should(Equal(util.Dedent(`
+      type Query {
+        singleField(argOne: Int!): String
+      }
+    `)))
+	})
+
+	It("prints String field with multiple args", func() {
+		Expect(printSingleFieldSchema(graphql.FieldConfig{
+			Type: graphql.T(graphql.String()),
+			Args: graphql.ArgumentConfigMap{
+				"argOne": {
+					Type: graphql.T(graphql.Int()),
+				},
+				"argTwo": {
+					Type: graphql.T(graphql.String()),
+				},
+				"argThree": {
+					Type:         graphql.T(graphql.Boolean()),
+					DefaultValue: false,
+				},
+			},
+		})).Should(Equal(util.Dedent(`
+      type Query {
+        singleField(argOne: Int, argThree: Boolean = false, argTwo: String): String
+      }
+    `)))
+	})
+
+	It("prints custom query root type", func() {
+		schema := graphql.MustNewSchema(&graphql.SchemaConfig{
+			Query: graphql.MustNewObject(&graphql.ObjectConfig{
+				Name: "CustomQueryType",
+				Fields: graphql.Fields{
+					"bar": {
+						Type: graphql.T(graphql.String()),
+					},
+				},
+			}),
+		})
+
+		Expect(printSchema(schema)).Should(Equal(util.Dedent(`
+      schema {
+        query: CustomQueryType
+      }
+
+      type CustomQueryType {
+        bar: String
+      }
+    `)))
+	})
+
+	It("prints Interface", func() {
+		fooType := &graphql.InterfaceConfig{
+			Name: "Foo",
+			Fields: graphql.Fields{
+				"str": {
+					Type: graphql.T(graphql.String()),
+				},
+			},
+		}
+
+		barType := &graphql.ObjectConfig{
+			Name: "Bar",
+			Fields: graphql.Fields{
+				"str": {
+					Type: graphql.T(graphql.String()),
+				},
+			},
+			Interfaces: []graphql.InterfaceTypeDefinition{fooType},
+		}
+
+		schema := graphql.MustNewSchema(&graphql.SchemaConfig{
+			Query: graphql.MustNewObject(&graphql.ObjectConfig{
+				Name: "Query",
+				Fields: graphql.Fields{
+					"bar": {
+						Type: barType,
+					},
+				},
+			}),
+		})
+
+		Expect(printSchema(schema)).Should(Equal(util.Dedent(`
+      type Bar implements Foo {
+        str: String
+      }
+
+      interface Foo {
+        str: String
+      }
+
+      type Query {
+        bar: Bar
+      }
+    `)))
+	})
+
+	It("prints hierarchical interface", func() {
+		fooType := &graphql.InterfaceConfig{
+			Name: "Foo",
+			Fields: graphql.Fields{
+				"str": {
+					Type: graphql.T(graphql.String()),
+				},
+			},
+		}
+
+		baazType := &graphql.InterfaceConfig{
+			Name:       "Baaz",
+			Interfaces: []graphql.InterfaceTypeDefinition{fooType},
+			Fields: graphql.Fields{
+				"int": {
+					Type: graphql.T(graphql.Int()),
+				},
+				"str": {
+					Type: graphql.T(graphql.String()),
+				},
+			},
+		}
+
+		barType := &graphql.ObjectConfig{
+			Name: "Bar",
+			Fields: graphql.Fields{
+				"str": {
+					Type: graphql.T(graphql.String()),
+				},
+				"int": {
+					Type: graphql.T(graphql.Int()),
+				},
+			},
+			Interfaces: []graphql.InterfaceTypeDefinition{fooType, baazType},
+		}
+
+		schema := graphql.MustNewSchema(&graphql.SchemaConfig{
+			Query: graphql.MustNewObject(&graphql.ObjectConfig{
+				Name: "Query",
+				Fields: graphql.Fields{
+					"bar": {
+						Type: barType,
+					},
+				},
+			}),
+		})
+
+		Expect(printSchema(schema)).Should(Equal(util.Dedent(`
+      interface Baaz implements Foo {
+        int: Int
+        str: String
+      }
+
+      type Bar implements Foo & Baaz {
+        int: Int
+        str: String
+      }
+
+      interface Foo {
+        str: String
+      }
+
+      type Query {
+        bar: Bar
+      }
+    `)))
+	})
+
+	It("prints multiple interfaces", func() {
+		fooType := &graphql.InterfaceConfig{
+			Name: "Foo",
+			Fields: graphql.Fields{
+				"str": {
+					Type: graphql.T(graphql.String()),
+				},
+			},
+		}
+
+		baazType := &graphql.InterfaceConfig{
+			Name: "Baaz",
+			Fields: graphql.Fields{
+				"int": {
+					Type: graphql.T(graphql.Int()),
+				},
+			},
+		}
+
+		barType := &graphql.ObjectConfig{
+			Name: "Bar",
+			Fields: graphql.Fields{
+				"str": {
+					Type: graphql.T(graphql.String()),
+				},
+				"int": {
+					Type: graphql.T(graphql.Int()),
+				},
+			},
+			Interfaces: []graphql.InterfaceTypeDefinition{fooType, baazType},
+		}
+
+		schema := graphql.MustNewSchema(&graphql.SchemaConfig{
+			Query: graphql.MustNewObject(&graphql.ObjectConfig{
+				Name: "Query",
+				Fields: graphql.Fields{
+					"bar": {
+						Type: barType,
+					},
+				},
+			}),
+		})
+
+		Expect(printSchema(schema)).Should(Equal(util.Dedent(`
+      interface Baaz {
+        int: Int
+      }
+
+      type Bar implements Foo & Baaz {
+        int: Int
+        str: String
+      }
+
+      interface Foo {
+        str: String
+      }
+
+      type Query {
+        bar: Bar
+      }
+    `)))
+	})
+
+	It("prints Unions", func() {
+		fooType := &graphql.ObjectConfig{
+			Name: "Foo",
+			Fields: graphql.Fields{
+				"bool": {
+					Type: graphql.T(graphql.Boolean()),
+				},
+			},
+		}
+
+		barType := &graphql.ObjectConfig{
+			Name: "Bar",
+			Fields: graphql.Fields{
+				"str": {
+					Type: graphql.T(graphql.String()),
+				},
+			},
+		}
+
+		singleUnion := &graphql.UnionConfig{
+			Name:          "SingleUnion",
+			PossibleTypes: []graphql.ObjectTypeDefinition{fooType},
+		}
+
+		multipleUnion := &graphql.UnionConfig{
+			Name:          "MultipleUnion",
+			PossibleTypes: []graphql.ObjectTypeDefinition{fooType, barType},
+		}
+
+		schema := graphql.MustNewSchema(&graphql.SchemaConfig{
+			Query: graphql.MustNewObject(&graphql.ObjectConfig{
+				Name: "Query",
+				Fields: graphql.Fields{
+					"single": {
+						Type: singleUnion,
+					},
+					"multiple": {
+						Type: multipleUnion,
+					},
+				},
+			}),
+		})
+
+		Expect(printSchema(schema)).Should(Equal(util.Dedent(`
+      type Bar {
+        str: String
+      }
+
+      type Foo {
+        bool: Boolean
+      }
+
+      union MultipleUnion = Bar | Foo
+
+      type Query {
+        multiple: MultipleUnion
+        single: SingleUnion
+      }
+
+      union SingleUnion = Foo
+    `)))
+	})
+
+	It("prints Input Type", func() {
+		inputType := &graphql.InputObjectConfig{
+			Name: "InputType",
+			Fields: graphql.InputFields{
+				"int": {
+					Type: graphql.T(graphql.Int()),
+				},
+				"str": {
+					Type:         graphql.T(graphql.String()),
+					DefaultValue: "default",
+				},
+			},
+		}
+
+		schema := graphql.MustNewSchema(&graphql.SchemaConfig{
+			Query: graphql.MustNewObject(&graphql.ObjectConfig{
+				Name: "Query",
+				Fields: graphql.Fields{
+					"str": {
+						Type: graphql.T(graphql.String()),
+						Args: graphql.ArgumentConfigMap{
+							"argOne": {
+								Type: inputType,
+								DefaultValue: map[string]interface{}{
+									"str": "custom",
+								},
+							},
+						},
+					},
+				},
+			}),
+		})
+
+		Expect(printSchema(schema)).Should(Equal(util.Dedent(`
+      input InputType {
+        int: Int
+        str: String = "default"
+      }
+
+      type Query {
+        str(argOne: InputType = {str: "custom"}): String
+      }
+    `)))
+	})
+	It("prints OneOf Input Type", func() {
+		inputType := &graphql.InputObjectConfig{
+			Name: "InputType",
+			Fields: graphql.InputFields{
+				"int": {
+					Type: graphql.T(graphql.Int()),
+				},
+				"str": {
+					Type: graphql.T(graphql.String()),
+				},
+			},
+			IsOneOf: true,
+		}
+
+		schema := graphql.MustNewSchema(&graphql.SchemaConfig{
+			Query: graphql.MustNewObject(&graphql.ObjectConfig{
+				Name: "Query",
+				Fields: graphql.Fields{
+					"str": {
+						Type: graphql.T(graphql.String()),
+						Args: graphql.ArgumentConfigMap{
+							"argOne": {
+								Type: inputType,
+							},
+						},
+					},
+				},
+			}),
+		})
+
+		Expect(printSchema(schema)).Should(Equal(util.Dedent(`
+      input InputType @oneOf {
+        int: Int
+        str: String
+      }
+
+      type Query {
+        str(argOne: InputType): String
+      }
+    `)))
+	})
+
+	It("prints Custom Scalar", func() {
+		oddType := graphql.MustNewScalar(&graphql.ScalarConfig{
+			Name: "Odd",
+			ResultCoercer: graphql.ScalarResultCoercerFunc(func(value interface{}) (interface{}, error) {
+				return value, nil
+			}),
+		})
+
+		schema := graphql.MustNewSchema(&graphql.SchemaConfig{
+			Query: graphql.MustNewObject(&graphql.ObjectConfig{
+				Name: "Query",
+				Fields: graphql.Fields{
+					"odd": {
+						Type: graphql.T(oddType),
+					},
+				},
+			}),
+		})
+
+		Expect(printSchema(schema)).Should(Equal(util.Dedent(`
+      scalar Odd
+
+      type Query {
+        odd: Odd
+      }
+    `)))
+	})
+
+	It("prints Custom Scalar with specifiedByURL", func() {
+		oddType := graphql.MustNewScalar(&graphql.ScalarConfig{
+			Name:           "Odd",
+			SpecifiedByURL: "https://example.com/odd_spec",
+			ResultCoercer: graphql.ScalarResultCoercerFunc(func(value interface{}) (interface{}, error) {
+				return value, nil
+			}),
+		})
+
+		schema := graphql.MustNewSchema(&graphql.SchemaConfig{
+			Query: graphql.MustNewObject(&graphql.ObjectConfig{
+				Name: "Query",
+				Fields: graphql.Fields{
+					"odd": {
+						Type: graphql.T(oddType),
+					},
+				},
+			}),
+		})
+
+		Expect(printSchema(schema)).Should(Equal(util.Dedent(`
+      scalar Odd @specifiedBy(url: "https://example.com/odd_spec")
+
+      type Query {
+        odd: Odd
+      }
+    `)))
+	})
+
+	It("prints Enum", func() {
+		rgbType := &graphql.EnumConfig{
+			Name: "RGB",
+			Values: graphql.EnumValueDefinitionMap{
+				"RED":   {},
+				"GREEN": {},
+				"BLUE":  {},
+			},
+		}
+
+		schema := graphql.MustNewSchema(&graphql.SchemaConfig{
+			Query: graphql.MustNewObject(&graphql.ObjectConfig{
+				Name: "Query",
+				Fields: graphql.Fields{
+					"rgb": {
+						Type: rgbType,
+						Args: graphql.ArgumentConfigMap{
+							"default": {
+								Type:         rgbType,
+								DefaultValue: "RED",
+							},
+						},
+					},
+				},
+			}),
+		})
+
+		Expect(printSchema(schema)).Should(Equal(util.Dedent(`
+      type Query {
+        rgb(default: RGB = RED): RGB
+      }
+
+      enum RGB {
+        BLUE
+        GREEN
+        RED
+      }
+    `)))
+	})
+
+	It("prints custom directives", func() {
+		schema := graphql.MustNewSchema(&graphql.SchemaConfig{
+			Query: graphql.MustNewObject(&graphql.ObjectConfig{
+				Name: "Query",
+				Fields: graphql.Fields{
+					"field": {
+						Type: graphql.T(graphql.String()),
+					},
+				},
+			}),
+			Directives: graphql.DirectiveList{
+				graphql.MustNewDirective(&graphql.DirectiveConfig{
+					Name: "customDirective",
+					Locations: []graphql.DirectiveLocation{
+						graphql.DirectiveLocationField,
+					},
+				}),
+				graphql.MustNewDirective(&graphql.DirectiveConfig{
+					Name: "withArgs",
+					Locations: []graphql.DirectiveLocation{
+						graphql.DirectiveLocationFieldDefinition,
+						graphql.DirectiveLocationObject,
+					},
+					Args: graphql.ArgumentConfigMap{
+						"reason": {
+							Type:         graphql.T(graphql.String()),
+							DefaultValue: "because",
+						},
+					},
+				}),
+				graphql.MustNewDirective(&graphql.DirectiveConfig{
+					Name: "repeatableDirective",
+					Locations: []graphql.DirectiveLocation{
+						graphql.DirectiveLocationObject,
+					},
+					IsRepeatable: true,
+				}),
+			},
+		})
+
+		Expect(printSchema(schema)).Should(Equal(util.Dedent(`
+      directive @customDirective on FIELD
+
+      directive @withArgs(reason: String = "because") on FIELD_DEFINITION | OBJECT
+
+      directive @repeatableDirective repeatable on OBJECT
+
+      type Query {
+        field: String
+      }
+    `)))
+	})
+
+	It("prints deprecations", func() {
+		schema := graphql.MustNewSchema(&graphql.SchemaConfig{
+			Query: graphql.MustNewObject(&graphql.ObjectConfig{
+				Name: "Query",
+				Fields: graphql.Fields{
+					"old": {
+						Type:        graphql.T(graphql.String()),
+						Deprecation: &graphql.Deprecation{},
+					},
+					"older": {
+						Type: graphql.T(graphql.String()),
+						Deprecation: &graphql.Deprecation{
+							Reason: graphql.DefaultDeprecationReason,
+						},
+					},
+					"oldest": {
+						Type: graphql.T(graphql.String()),
+						Deprecation: &graphql.Deprecation{
+							Reason: "Use \"new\" instead",
+						},
+					},
+					"color": {
+						Type: &graphql.EnumConfig{
+							Name: "Color",
+							Values: graphql.EnumValueDefinitionMap{
+								"RED": {},
+								"CYAN": {
+									Deprecation: &graphql.Deprecation{
+										Reason: "Not a primary color",
+									},
+								},
+							},
+						},
+					},
+				},
+			}),
+		})
+
+		Expect(printSchema(schema)).Should(Equal(util.Dedent(`
+      enum Color {
+        CYAN @deprecated(reason: "Not a primary color")
+        RED
+      }
+
+      type Query {
+        color: Color
+        old: String @deprecated
+        older: String @deprecated
+        oldest: String @deprecated(reason: "Use \"new\" instead")
+      }
+    `)))
+	})
+
+	It("prints deprecated arguments and input fields", func() {
+		schema := graphql.MustNewSchema(&graphql.SchemaConfig{
+			Query: graphql.MustNewObject(&graphql.ObjectConfig{
+				Name: "Query",
+				Fields: graphql.Fields{
+					"field": {
+						Type: graphql.T(graphql.String()),
+						Args: graphql.ArgumentConfigMap{
+							"old": {
+								Type:        graphql.T(graphql.String()),
+								Deprecation: &graphql.Deprecation{},
+							},
+							"input": {
+								Type: &graphql.InputObjectConfig{
+									Name: "Input",
+									Fields: graphql.InputFields{
+										"new": {
+											Type: graphql.T(graphql.String()),
+										},
+										"old": {
+											Type:         graphql.T(graphql.String()),
+											DefaultValue: "default",
+											Deprecation: &graphql.Deprecation{
+												Reason: "Use \"new\" instead",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}),
+		})
+
+		Expect(printSchema(schema)).Should(Equal(util.Dedent(`
+      input Input {
+        new: String
+        old: String = "default" @deprecated(reason: "Use \"new\" instead")
+      }
+
+      type Query {
+        field(input: Input, old: String @deprecated): String
+      }
+    `)))
+	})
+
+	It("one-line prints a short description", func() {
+		description := "This field is awesome"
+		output := printSingleFieldSchema(graphql.FieldConfig{
+			Type:        graphql.T(graphql.String()),
+			Description: description,
+		})
+
+		Expect(output).Should(Equal(util.Dedent(`
+      type Query {
+        """This field is awesome"""
+        singleField: String
+      }
+    `)))
+
+		schema, errs := sdl.BuildSchema(token.NewSource(output), nil)
+		Expect(errs).Should(Equal(graphql.NoErrors()))
+		Expect(schema.Query().Fields()["singleField"].Description()).Should(Equal(description))
+	})
+
+	It("prints a long description with multiple lines", func() {
+		description := "This field is awesome\nwith multiple lines and a very long line that surely " +
+			"needs more than seventy characters"
+		output := printSingleFieldSchema(graphql.FieldConfig{
+			Type:        graphql.T(graphql.String()),
+			Description: description,
+		})
+
+		Expect(output).Should(Equal(util.Dedent(`
+      type Query {
+        """
+        This field is awesome
+        with multiple lines and a very long line that surely needs more than seventy characters
+        """
+        singleField: String
+      }
+    `)))
+
+		schema, errs := sdl.BuildSchema(token.NewSource(output), nil)
+		Expect(errs).Should(Equal(graphql.NoErrors()))
+		Expect(schema.Query().Fields()["singleField"].Description()).Should(Equal(description))
+	})
+
+	It("prints a description with leading space", func() {
+		description := "    This field is \"awesome\""
+		output := printSingleFieldSchema(graphql.FieldConfig{
+			Type:        graphql.T(graphql.String()),
+			Description: description,
+		})
+
+		Expect(output).Should(Equal(util.Dedent(`
+      type Query {
+        """    This field is "awesome"
+        """
+        singleField: String
+      }
+    `)))
+
+		schema, errs := sdl.BuildSchema(token.NewSource(output), nil)
+		Expect(errs).Should(Equal(graphql.NoErrors()))
+		Expect(schema.Query().Fields()["singleField"].Description()).Should(Equal(description))
+	})
+
+	It("prints descriptions of arguments and items in blocks", func() {
+		schema := graphql.MustNewSchema(&graphql.SchemaConfig{
+			Query: graphql.MustNewObject(&graphql.ObjectConfig{
+				Name:        "Query",
+				Description: "The query root",
+				Fields: graphql.Fields{
+					"first": {
+						Type:        graphql.T(graphql.String()),
+						Description: "The first field",
+					},
+					"second": {
+						Type:        graphql.T(graphql.String()),
+						Description: "The second field",
+						Args: graphql.ArgumentConfigMap{
+							"a": {
+								Type:        graphql.T(graphql.Int()),
+								Description: "The first arg",
+							},
+							"b": {
+								Type:        graphql.T(graphql.Int()),
+								Description: "The second arg",
+							},
+						},
+					},
+				},
+			}),
+		})
+
+		Expect(printSchema(schema)).Should(Equal(util.Dedent(`
+      """The query root"""
+      type Query {
+        """The first field"""
+        first: String
+
+        """The second field"""
+        second(
+          """The first arg"""
+          a: Int
+
+          """The second arg"""
+          b: Int
+        ): String
+      }
+    `)))
+	})
+
+	It("prints a schema built from SDL", func() {
+		source := util.Dedent(`
+      schema {
+        query: Root
+        mutation: Mutation
+      }
+
+      """Marks a field as cached."""
+      directive @cached(ttl: Int = 60) on FIELD_DEFINITION | OBJECT
+
+      type Mutation {
+        update(input: UpdateInput!): Node
+      }
+
+      interface Node {
+        id: ID!
+      }
+
+      type Root {
+        node(id: ID!): Node
+      }
+
+      input UpdateInput {
+        id: ID!
+        tags: [String!] = ["a", "b"]
+      }
+    `)
+
+		schema, errs := sdl.BuildSchema(token.NewSource(source), nil)
+		Expect(errs).Should(Equal(graphql.NoErrors()))
+		Expect(printSchema(schema)).Should(Equal(source))
+	})
+
+	It("prints applied directives", func() {
+		source := util.Dedent(`
+      directive @meta(tags: [String!], weight: Float) on SCALAR | OBJECT | FIELD_DEFINITION | ARGUMENT_DEFINITION | INTERFACE | UNION | ENUM | ENUM_VALUE | INPUT_OBJECT | INPUT_FIELD_DEFINITION
+
+      directive @role(name: Role!) on OBJECT | FIELD_DEFINITION
+
+      enum Color @meta {
+        BLUE @deprecated @meta(weight: 0.5)
+        RED
+      }
+
+      scalar Date @meta(tags: ["date", "time"])
+
+      input Filter @meta {
+        color: Color = RED @meta(tags: ["color"])
+      }
+
+      interface Node @meta {
+        id: ID! @meta
+      }
+
+      type Query @role(name: USER) @meta {
+        node(filter: Filter @meta(weight: 1), id: ID!): Node @role(name: ADMIN)
+        search: Result @deprecated(reason: "Use node.") @meta
+      }
+
+      union Result @meta = Query
+
+      enum Role {
+        ADMIN
+        USER
+      }
+    `)
+
+		schema, errs := sdl.BuildSchema(token.NewSource(source), &sdl.Resolvers{
+			Scalars: map[string]sdl.ScalarCoercers{
+				"Date": {
+					ResultCoercer: graphql.String(),
+				},
+			},
+		})
+		Expect(errs).Should(Equal(graphql.NoErrors()))
+
+		output, err := sdl.PrintSchema(schema)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(output).Should(Equal(source))
+	})
+
+	It("prints applied directives without a schema", func() {
+		queryType := graphql.MustNewObject(&graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"field": {
+					Type: graphql.T(graphql.String()),
+					Directives: graphql.AppliedDirectives{
+						{
+							Name: "meta",
+							Args: map[string]interface{}{
+								"tags":   []interface{}{"a", "b"},
+								"weight": 0.5,
+								"input": map[string]interface{}{
+									"enabled": true,
+									"limit":   10,
+								},
+							},
+						},
+					},
+				},
+			},
+			Directives: graphql.AppliedDirectives{
+				{Name: "internal"},
+			},
+		})
+
+		Expect(sdl.PrintType(queryType)).Should(Equal(util.Dedent(`
+      type Query @internal {
+        field: String @meta(input: {enabled: true, limit: 10}, tags: ["a", "b"], weight: 0.5)
+      }`)))
+	})
+
+	It("prints introspection schema", func() {
+		schema := graphql.MustNewSchema(&graphql.SchemaConfig{
+			Query: graphql.MustNewObject(&graphql.ObjectConfig{
+				Name: "Root",
+				Fields: graphql.Fields{
+					"onlyField": {
+						Type: graphql.T(graphql.String()),
+					},
+				},
+			}),
+		})
+
+		output, err := sdl.PrintIntrospectionSchema(schema)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(output).Should(HavePrefix(util.Dedent(`
+      schema {
+        query: Root
+      }
+
+      """
+      Directs the executor to skip this field or fragment when the ` + "`if`" + ` argument is true.
+      """
+      directive @skip(
+        """Skipped when true."""
+        if: Boolean!
+      ) on FIELD | FRAGMENT_SPREAD | INLINE_FRAGMENT
+    `)))
+		Expect(output).Should(ContainSubstring("\ntype __Schema {\n"))
+		Expect(output).Should(ContainSubstring("\nenum __TypeKind {\n"))
+		Expect(output).ShouldNot(ContainSubstring("type Root"))
+	})
+})
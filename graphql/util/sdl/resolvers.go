@@ -0,0 +1,64 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package sdl
+
+import (
+	"github.com/botobag/artemis/graphql"
+)
+
+// Resolvers provides the Go values that cannot be expressed in SDL and are attached to the types
+// defined in SDL when building a schema with BuildSchema.
+type Resolvers struct {
+	// Fields maps an Object type name to the resolvers for its fields. Fields that don't have a
+	// resolver are resolved with the default field resolver specified in execution.
+	Fields map[string]FieldResolvers
+
+	// Subscribers maps an Object type name (usually the subscription root type) to the subscribers
+	// for its fields. A field that is the root field of a subscription operation creates its source
+	// event stream with the subscriber. See graphql.FieldSubscriber.
+	Subscribers map[string]FieldSubscribers
+
+	// Types maps an Interface or a Union type name to the resolver that determines the concrete
+	// Object type at runtime.
+	Types map[string]graphql.TypeResolver
+
+	// Scalars maps a custom Scalar type name to its coercers. Every custom Scalar defined in SDL must
+	// have an entry in the map.
+	Scalars map[string]ScalarCoercers
+
+	// Enums maps an Enum type name to the internal values for its enum values (optional). Enum values
+	// that are not given an internal value use their names as internal values.
+	Enums map[string]EnumValues
+}
+
+// FieldResolvers maps field name to its resolver.
+type FieldResolvers map[string]graphql.FieldResolver
+
+// FieldSubscribers maps field name to its subscriber.
+type FieldSubscribers map[string]graphql.FieldSubscriber
+
+// ScalarCoercers contains coercers for a custom Scalar type.
+type ScalarCoercers struct {
+	// ResultCoercer serializes value for return in execution result
+	ResultCoercer graphql.ScalarResultCoercer
+
+	// InputCoercer parses input value given to the scalar field (optional)
+	InputCoercer graphql.ScalarInputCoercer
+}
+
+// EnumValues maps enum value name to its internal value.
+type EnumValues map[string]interface{}
@@ -0,0 +1,683 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package graphql
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// nameRegExp matches a valid name in GraphQL.
+//
+// Reference: https://graphql.github.io/graphql-spec/June2018/#Name
+var nameRegExp = regexp.MustCompile(`^[_a-zA-Z][_a-zA-Z0-9]*$`)
+
+// ValidateSchema implements the "Type Validation" sub-sections of the specification's "Type System"
+// section. It returns all the violations found in the schema.
+//
+// NewSchema validates the created schema with the same rules except that it accepts a schema
+// without Query root type. ValidateSchema is exported for validating Schemas that are created by
+// other means and for checking a schema is ready for execution.
+//
+// Reference: https://graphql.github.io/graphql-spec/June2018/#sec-Type-System
+func ValidateSchema(schema Schema) Errors {
+	return validateSchema(schema, true /* requireQuery */)
+}
+
+// validateSchema implements ValidateSchema. A missing Query root type is reported only if
+// requireQuery is true.
+func validateSchema(schema Schema, requireQuery bool) Errors {
+	ctx := &schemaValidationContext{
+		schema:       schema,
+		requireQuery: requireQuery,
+	}
+	ctx.validateRootTypes()
+	ctx.validateDirectives()
+	ctx.validateTypes()
+	ctx.validateAppliedDirectives()
+	return ctx.errs
+}
+
+// schemaValidationContext contains states for validating a schema.
+type schemaValidationContext struct {
+	schema       Schema
+	requireQuery bool
+	errs         Errors
+}
+
+func (ctx *schemaValidationContext) reportError(format string, a ...interface{}) {
+	ctx.errs.Emplace(fmt.Sprintf(format, a...))
+}
+
+func (ctx *schemaValidationContext) validateName(name string) {
+	if strings.HasPrefix(name, "__") {
+		ctx.reportError(`Name "%s" must not begin with "__", which is reserved by GraphQL introspection.`,
+			name)
+	} else if !nameRegExp.MatchString(name) {
+		ctx.reportError(`Names must match /^[_a-zA-Z][_a-zA-Z0-9]*$/ but "%s" does not.`, name)
+	}
+}
+
+// validateRootTypes validates root operation types.
+//
+// Reference: https://graphql.github.io/graphql-spec/June2018/#sec-Root-Operation-Types
+func (ctx *schemaValidationContext) validateRootTypes() {
+	if ctx.requireQuery && ctx.schema.Query() == nil {
+		ctx.reportError("Query root type must be provided.")
+	}
+}
+
+// validateDirectives validates directive definitions.
+//
+// Reference: https://graphql.github.io/graphql-spec/June2018/#sec-Type-System.Directives
+func (ctx *schemaValidationContext) validateDirectives() {
+	for _, directive := range ctx.schema.Directives() {
+		// Ensure they are named correctly.
+		ctx.validateName(directive.Name())
+
+		// Ensure the type is an input type.
+		for _, arg := range sortedArgs(directive.Args()) {
+			ctx.validateName(arg.Name())
+
+			if !IsInputType(arg.Type()) {
+				ctx.reportError("The type of @%s(%s:) must be Input Type but got: %s.",
+					directive.Name(), arg.Name(), Inspect(arg.Type()))
+			}
+
+			// Ensure a required argument is not deprecated.
+			if IsRequiredArgument(arg) && arg.Deprecation().Defined() {
+				ctx.reportError("Required argument @%s(%s:) cannot be deprecated.",
+					directive.Name(), arg.Name())
+			}
+		}
+	}
+}
+
+// isIntrospectionType returns true if the given type is one of the types used for introspection.
+func isIntrospectionType(t Type) bool {
+	switch t {
+	case _schema, _directive, _directiveLocation, _type, _field, _inputValue, _enumValue, _typeKind:
+		return true
+	}
+	return false
+}
+
+// validateTypes validates every named type in the schema.
+func (ctx *schemaValidationContext) validateTypes() {
+	typeMap := ctx.schema.TypeMap()
+
+	// Sort type names to report errors in a deterministic order.
+	typeNames := make([]string, 0, typeMap.Size())
+	for name := range typeMap.types {
+		typeNames = append(typeNames, name)
+	}
+	sort.Strings(typeNames)
+
+	// Keep track of Input Objects that have been visited when looking for cycles.
+	inputObjectCycleValidator := newInputObjectCycleValidator(ctx)
+
+	for _, name := range typeNames {
+		t := typeMap.types[name]
+
+		// Ensure they are named correctly.
+		if !isIntrospectionType(t) {
+			ctx.validateName(name)
+		}
+
+		switch t := t.(type) {
+		case Object:
+			// Ensure fields are valid.
+			ctx.validateFields(t, t.Fields())
+
+			// Ensure objects implement the interfaces they claim to.
+			ctx.validateInterfaces(t)
+
+		case Interface:
+			// Ensure fields are valid.
+			ctx.validateFields(t, t.Fields())
+
+			// Ensure interfaces implement the interfaces they claim to.
+			ctx.validateInterfaces(t)
+
+		case Union:
+			// Ensure Unions include valid member types.
+			if t.PossibleTypes().Empty() {
+				ctx.reportError("Union type %s must define one or more member types.", t.Name())
+			}
+
+		case Enum:
+			// Ensure Enums have valid values.
+			ctx.validateEnumValues(t)
+
+		case InputObject:
+			// Ensure Input Object fields are valid.
+			ctx.validateInputFields(t)
+
+			// Ensure Input Objects do not contain non-nullable circular references.
+			inputObjectCycleValidator.validate(t)
+		}
+	}
+}
+
+// sortedFieldNames returns names of the fields in the given map in sorted order.
+func sortedFieldNames(fields FieldMap) []string {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// sortedArgs returns a copy of the given arguments that is sorted by name.
+func sortedArgs(args []Argument) []*Argument {
+	result := make([]*Argument, len(args))
+	for i := range args {
+		result[i] = &args[i]
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name() < result[j].Name()
+	})
+	return result
+}
+
+// findArg finds the argument with the given name.
+func findArg(args []Argument, name string) *Argument {
+	for i := range args {
+		if args[i].Name() == name {
+			return &args[i]
+		}
+	}
+	return nil
+}
+
+func (ctx *schemaValidationContext) validateFields(t TypeWithName, fields FieldMap) {
+	// Objects and Interfaces both must define one or more fields.
+	if len(fields) == 0 {
+		ctx.reportError("Type %s must define one or more fields.", t.Name())
+		return
+	}
+
+	for _, fieldName := range sortedFieldNames(fields) {
+		field := fields[fieldName]
+
+		// Ensure they are named correctly.
+		ctx.validateName(fieldName)
+
+		// Ensure the type is an output type.
+		if !IsOutputType(field.Type()) {
+			ctx.reportError("The type of %s.%s must be Output Type but got: %s.",
+				t.Name(), fieldName, Inspect(field.Type()))
+		}
+
+		// Ensure the arguments are valid.
+		for _, arg := range sortedArgs(field.Args()) {
+			// Ensure they are named correctly.
+			ctx.validateName(arg.Name())
+
+			// Ensure the type is an input type.
+			if !IsInputType(arg.Type()) {
+				ctx.reportError("The type of %s.%s(%s:) must be Input Type but got: %s.",
+					t.Name(), fieldName, arg.Name(), Inspect(arg.Type()))
+			}
+
+			// Ensure a required argument is not deprecated.
+			if IsRequiredArgument(arg) && arg.Deprecation().Defined() {
+				ctx.reportError("Required argument %s.%s(%s:) cannot be deprecated.",
+					t.Name(), fieldName, arg.Name())
+			}
+		}
+	}
+}
+
+// implementingType is a type that can implement interfaces (i.e., an Object or an Interface).
+type implementingType interface {
+	TypeWithName
+	Fields() FieldMap
+	Interfaces() []Interface
+}
+
+func (ctx *schemaValidationContext) validateInterfaces(t implementingType) {
+	implementedTypeNames := map[string]bool{}
+	for _, iface := range t.Interfaces() {
+		if iface == t {
+			ctx.reportError("Type %s cannot implement itself because it would create a circular reference.",
+				t.Name())
+			continue
+		}
+
+		if implementedTypeNames[iface.Name()] {
+			ctx.reportError("Type %s can only implement %s once.", t.Name(), iface.Name())
+			continue
+		}
+		implementedTypeNames[iface.Name()] = true
+
+		ctx.validateTypeImplementsAncestors(t, iface)
+		ctx.validateTypeImplementsInterface(t, iface)
+	}
+}
+
+// validateTypeImplementsAncestors checks that t also implements all interfaces implemented by
+// iface.
+func (ctx *schemaValidationContext) validateTypeImplementsAncestors(t implementingType, iface Interface) {
+	for _, transitive := range iface.Interfaces() {
+		if transitive == t {
+			ctx.reportError("Type %s cannot implement %s because it would create a circular reference.",
+				t.Name(), iface.Name())
+			continue
+		}
+
+		implemented := false
+		for _, ifaceOfT := range t.Interfaces() {
+			if ifaceOfT == transitive {
+				implemented = true
+				break
+			}
+		}
+		if !implemented {
+			ctx.reportError("Type %s must implement %s because it is implemented by %s.",
+				t.Name(), transitive.Name(), iface.Name())
+		}
+	}
+}
+
+// validateTypeImplementsInterface checks that t (an Object or an Interface) is a valid
+// implementation of iface.
+//
+// Reference: https://graphql.github.io/graphql-spec/June2018/#sec-Objects (Type Validation)
+func (ctx *schemaValidationContext) validateTypeImplementsInterface(t implementingType, iface Interface) {
+	var (
+		objectFields = t.Fields()
+		ifaceFields  = iface.Fields()
+	)
+
+	kind := "Object"
+	if _, ok := t.(Interface); ok {
+		kind = "Interface"
+	}
+
+	// Assert each interface field is implemented.
+	for _, fieldName := range sortedFieldNames(ifaceFields) {
+		ifaceField := ifaceFields[fieldName]
+		objectField := objectFields[fieldName]
+
+		// Assert interface field exists on object.
+		if objectField == nil {
+			ctx.reportError("Interface field %s.%s expected but %s does not provide it.",
+				iface.Name(), fieldName, t.Name())
+			continue
+		}
+
+		// Assert interface field type is satisfied by object field type, by being a valid subtype
+		// (covariant).
+		if !IsTypeSubTypeOf(ctx.schema, objectField.Type(), ifaceField.Type()) {
+			ctx.reportError("Interface field %s.%s expects type %s but %s.%s is type %s.",
+				iface.Name(), fieldName, Inspect(ifaceField.Type()),
+				t.Name(), fieldName, Inspect(objectField.Type()))
+		}
+
+		// Assert each interface field arg is implemented.
+		objectArgs := objectField.Args()
+		for _, ifaceArg := range sortedArgs(ifaceField.Args()) {
+			argName := ifaceArg.Name()
+			objectArg := findArg(objectArgs, argName)
+
+			// Assert interface field arg exists on object field.
+			if objectArg == nil {
+				ctx.reportError("Interface field argument %s.%s(%s:) expected but %s.%s does not provide it.",
+					iface.Name(), fieldName, argName, t.Name(), fieldName)
+				continue
+			}
+
+			// Assert interface field arg type matches object field arg type (invariant).
+			if !isEqualType(ifaceArg.Type(), objectArg.Type()) {
+				ctx.reportError("Interface field argument %s.%s(%s:) expects type %s but %s.%s(%s:) is "+
+					"type %s.", iface.Name(), fieldName, argName, Inspect(ifaceArg.Type()),
+					t.Name(), fieldName, argName, Inspect(objectArg.Type()))
+			}
+		}
+
+		// Assert additional arguments must not be required.
+		ifaceArgs := ifaceField.Args()
+		for _, objectArg := range sortedArgs(objectArgs) {
+			argName := objectArg.Name()
+			if findArg(ifaceArgs, argName) == nil && IsRequiredArgument(objectArg) {
+				ctx.reportError("%s field %s.%s includes required argument %s that is missing from the "+
+					"Interface field %s.%s.", kind, t.Name(), fieldName, argName, iface.Name(), fieldName)
+			}
+		}
+	}
+}
+
+// isEqualType returns true if the two given types are equal.
+func isEqualType(typeA Type, typeB Type) bool {
+	for {
+		// Equivalent types are equal.
+		if typeA == typeB {
+			return true
+		}
+
+		switch a := typeA.(type) {
+		case NonNull:
+			// If either type is non-null, the other must also be non-null.
+			b, ok := typeB.(NonNull)
+			if !ok {
+				return false
+			}
+			typeA, typeB = a.InnerType(), b.InnerType()
+
+		case List:
+			// If either type is a list, the other must also be a list.
+			b, ok := typeB.(List)
+			if !ok {
+				return false
+			}
+			typeA, typeB = a.ElementType(), b.ElementType()
+
+		default:
+			// Otherwise the types are not equal.
+			return false
+		}
+	}
+}
+
+func (ctx *schemaValidationContext) validateEnumValues(enum Enum) {
+	values := enum.Values()
+	if len(values) == 0 {
+		ctx.reportError("Enum type %s must define one or more values.", enum.Name())
+		return
+	}
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		// Ensure valid name.
+		ctx.validateName(name)
+		if name == "true" || name == "false" || name == "null" {
+			ctx.reportError("Enum type %s cannot include value: %s.", enum.Name(), name)
+		}
+	}
+}
+
+func (ctx *schemaValidationContext) validateInputFields(inputObject InputObject) {
+	fields := inputObject.Fields()
+	if len(fields) == 0 {
+		ctx.reportError("Input Object type %s must define one or more fields.", inputObject.Name())
+		return
+	}
+
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// Ensure the arguments are valid.
+	for _, name := range names {
+		field := fields[name]
+
+		// Ensure they are named correctly.
+		ctx.validateName(name)
+
+		// Ensure the type is an input type.
+		if !IsInputType(field.Type()) {
+			ctx.reportError("The type of %s.%s must be Input Type but got: %s.",
+				inputObject.Name(), name, Inspect(field.Type()))
+		}
+
+		// Ensure a required input field is not deprecated.
+		if IsRequiredInputField(field) && field.Deprecation().Defined() {
+			ctx.reportError("Required input field %s.%s cannot be deprecated.", inputObject.Name(), name)
+		}
+
+		// Ensure the fields of a OneOf Input Object are nullable and have no default value.
+		if inputObject.IsOneOf() {
+			if IsNonNullType(field.Type()) {
+				ctx.reportError("OneOf input field %s.%s must be nullable.", inputObject.Name(), name)
+			}
+
+			if field.HasDefaultValue() {
+				ctx.reportError("OneOf input field %s.%s cannot have a default value.",
+					inputObject.Name(), name)
+			}
+		}
+	}
+}
+
+// inputObjectCycleValidator detects Input Objects that reference themselves through a series of
+// non-null fields. Such Input Objects cannot be provided with a finite value.
+type inputObjectCycleValidator struct {
+	ctx *schemaValidationContext
+
+	// Input Objects that have been visited. Cycles in them are already reported.
+	visitedTypes map[InputObject]bool
+
+	// Array of input fields used to produce meaningful errors
+	fieldPath []string
+
+	// Position in the type path
+	fieldPathIndexByType map[InputObject]int
+}
+
+func newInputObjectCycleValidator(ctx *schemaValidationContext) *inputObjectCycleValidator {
+	return &inputObjectCycleValidator{
+		ctx:                  ctx,
+		visitedTypes:         map[InputObject]bool{},
+		fieldPathIndexByType: map[InputObject]int{},
+	}
+}
+
+// validate detects cycles that start from the given Input Object with a DFS. Ideally, it would
+// find all the strongly connected components but it reports one cycle per Input Object in favor of
+// simplicity.
+func (validator *inputObjectCycleValidator) validate(inputObject InputObject) {
+	if validator.visitedTypes[inputObject] {
+		return
+	}
+
+	validator.visitedTypes[inputObject] = true
+	validator.fieldPathIndexByType[inputObject] = len(validator.fieldPath)
+
+	fields := inputObject.Fields()
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		nonNullType, ok := fields[name].Type().(NonNull)
+		if !ok {
+			continue
+		}
+
+		fieldType, ok := nonNullType.InnerType().(InputObject)
+		if !ok {
+			continue
+		}
+
+		cycleIndex, inPath := validator.fieldPathIndexByType[fieldType]
+		validator.fieldPath = append(validator.fieldPath, name)
+		if !inPath {
+			validator.validate(fieldType)
+		} else {
+			validator.ctx.reportError(`Cannot reference Input Object "%s" within itself through a series `+
+				`of non-null fields: "%s".`, fieldType.Name(), strings.Join(validator.fieldPath[cycleIndex:], "."))
+		}
+		validator.fieldPath = validator.fieldPath[:len(validator.fieldPath)-1]
+	}
+
+	delete(validator.fieldPathIndexByType, inputObject)
+}
+
+// validateAppliedDirectives validates the directives applied to the elements of every named type in
+// the schema.
+//
+// Reference: https://graphql.github.io/graphql-spec/June2018/#sec-Type-System.Directives
+func (ctx *schemaValidationContext) validateAppliedDirectives() {
+	typeMap := ctx.schema.TypeMap()
+
+	// Sort type names to report errors in a deterministic order.
+	typeNames := make([]string, 0, typeMap.Size())
+	for name := range typeMap.types {
+		typeNames = append(typeNames, name)
+	}
+	sort.Strings(typeNames)
+
+	for _, name := range typeNames {
+		t := typeMap.types[name]
+		if isIntrospectionType(t) {
+			continue
+		}
+
+		switch t := t.(type) {
+		case Scalar:
+			ctx.validateAppliedDirectivesAt(DirectivesOf(t), DirectiveLocationScalar, name)
+
+		case Object:
+			ctx.validateAppliedDirectivesAt(DirectivesOf(t), DirectiveLocationObject, name)
+			ctx.validateFieldAppliedDirectives(name, t.Fields())
+
+		case Interface:
+			ctx.validateAppliedDirectivesAt(DirectivesOf(t), DirectiveLocationInterface, name)
+			ctx.validateFieldAppliedDirectives(name, t.Fields())
+
+		case Union:
+			ctx.validateAppliedDirectivesAt(DirectivesOf(t), DirectiveLocationUnion, name)
+
+		case Enum:
+			ctx.validateAppliedDirectivesAt(DirectivesOf(t), DirectiveLocationEnum, name)
+
+			values := t.Values()
+			valueNames := make([]string, 0, len(values))
+			for valueName := range values {
+				valueNames = append(valueNames, valueName)
+			}
+			sort.Strings(valueNames)
+
+			for _, valueName := range valueNames {
+				ctx.validateAppliedDirectivesAt(values[valueName].Directives(), DirectiveLocationEnumValue,
+					name+"."+valueName)
+			}
+
+		case InputObject:
+			ctx.validateAppliedDirectivesAt(DirectivesOf(t), DirectiveLocationInputObject, name)
+
+			fields := t.Fields()
+			fieldNames := make([]string, 0, len(fields))
+			for fieldName := range fields {
+				fieldNames = append(fieldNames, fieldName)
+			}
+			sort.Strings(fieldNames)
+
+			for _, fieldName := range fieldNames {
+				ctx.validateAppliedDirectivesAt(fields[fieldName].Directives(),
+					DirectiveLocationInputFieldDefinition, name+"."+fieldName)
+			}
+		}
+	}
+}
+
+// validateFieldAppliedDirectives validates the directives applied to the fields and their arguments
+// in the Object or Interface type with the given name.
+func (ctx *schemaValidationContext) validateFieldAppliedDirectives(typeName string, fields FieldMap) {
+	for _, fieldName := range sortedFieldNames(fields) {
+		field := fields[fieldName]
+		coordinate := typeName + "." + fieldName
+		ctx.validateAppliedDirectivesAt(field.Directives(), DirectiveLocationFieldDefinition, coordinate)
+
+		for _, arg := range sortedArgs(field.Args()) {
+			ctx.validateAppliedDirectivesAt(arg.Directives(), DirectiveLocationArgumentDefinition,
+				fmt.Sprintf("%s(%s:)", coordinate, arg.Name()))
+		}
+	}
+}
+
+// validateAppliedDirectivesAt validates the directives applied to the element at the given location.
+// The element is specified by its coordinate (e.g., "Query.field") for reporting errors.
+func (ctx *schemaValidationContext) validateAppliedDirectivesAt(
+	appliedDirectives AppliedDirectives,
+	location DirectiveLocation,
+	coordinate string) {
+
+	applied := map[string]bool{}
+	for _, appliedDirective := range appliedDirectives {
+		name := appliedDirective.Name
+
+		directive := ctx.schema.Directives().Lookup(name)
+		if directive == nil {
+			ctx.reportError(`Unknown directive "@%s" applied to %s.`, name, coordinate)
+			continue
+		}
+
+		// Ensure the directive can be applied to the location.
+		allowed := false
+		for _, directiveLocation := range directive.Locations() {
+			if directiveLocation == location {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			ctx.reportError(`Directive "@%s" may not be used on %s (applied to %s).`, name, location, coordinate)
+		}
+
+		// Ensure the directive is applied at most once unless it is repeatable.
+		if applied[name] && !directive.IsRepeatable() {
+			ctx.reportError(`The directive "@%s" can only be applied to %s once.`, name, coordinate)
+		}
+		applied[name] = true
+
+		// Ensure arguments are known and valid.
+		argNames := make([]string, 0, len(appliedDirective.Args))
+		for argName := range appliedDirective.Args {
+			argNames = append(argNames, argName)
+		}
+		sort.Strings(argNames)
+
+		args := directive.Args()
+		for _, argName := range argNames {
+			arg := findArg(args, argName)
+			if arg == nil {
+				ctx.reportError(`Unknown argument "%s" on directive "@%s" applied to %s.`, argName, name,
+					coordinate)
+				continue
+			}
+
+			value := appliedDirective.Args[argName]
+			if valueAST, err := ASTFromValue(value, arg.Type()); err != nil ||
+				(valueAST == nil && IsNonNullType(arg.Type())) {
+				ctx.reportError(`Argument "%s" on directive "@%s" applied to %s has invalid value %s.`,
+					argName, name, coordinate, Inspect(value))
+			}
+		}
+
+		// Ensure required arguments are provided.
+		for _, arg := range sortedArgs(args) {
+			if _, exists := appliedDirective.Args[arg.Name()]; !exists && IsRequiredArgument(arg) {
+				ctx.reportError(`Argument "%s" of type "%s" on directive "@%s" applied to %s is required, but `+
+					`it was not provided.`, arg.Name(), Inspect(arg.Type()), name, coordinate)
+			}
+		}
+	}
+}
@@ -0,0 +1,1124 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package graphql_test
+
+import (
+	"strings"
+
+	"github.com/botobag/artemis/graphql"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// schemaWithoutQuery wraps a Schema and hides its query type.
+type schemaWithoutQuery struct {
+	graphql.Schema
+}
+
+func (schemaWithoutQuery) Query() graphql.Object {
+	return nil
+}
+
+// graphql-js/src/type/__tests__/validation-test.js@f529809
+var _ = Describe("Type System: Schema Validation", func() {
+	someScalarType := graphql.MustNewScalar(&graphql.ScalarConfig{
+		Name: "SomeScalar",
+		ResultCoercer: graphql.ScalarResultCoercerFunc(func(value interface{}) (interface{}, error) {
+			return value, nil
+		}),
+	})
+
+	someInterfaceType := &graphql.InterfaceConfig{
+		Name: "SomeInterface",
+		Fields: graphql.Fields{
+			"f": {
+				Type: graphql.T(graphql.String()),
+			},
+		},
+	}
+
+	someObjectType := &graphql.ObjectConfig{
+		Name:       "SomeObject",
+		Interfaces: []graphql.InterfaceTypeDefinition{someInterfaceType},
+		Fields: graphql.Fields{
+			"f": {
+				Type: graphql.T(graphql.String()),
+			},
+		},
+	}
+
+	someInputObjectType := &graphql.InputObjectConfig{
+		Name: "SomeInputObject",
+		Fields: graphql.InputFields{
+			"val": {
+				Type:         graphql.T(graphql.String()),
+				DefaultValue: "hello",
+			},
+		},
+	}
+
+	// schemaWithField creates a schema config with a query type that has a field with given config.
+	schemaWithField := func(field graphql.FieldConfig, types ...graphql.Type) *graphql.SchemaConfig {
+		return &graphql.SchemaConfig{
+			Query: graphql.MustNewObject(&graphql.ObjectConfig{
+				Name: "Query",
+				Fields: graphql.Fields{
+					"f": field,
+				},
+			}),
+			Types: types,
+		}
+	}
+
+	// expectErrors expects NewSchema to fail with a graphql.Errors which contains the errors with the
+	// given messages.
+	expectErrors := func(config *graphql.SchemaConfig, messages ...string) {
+		_, err := graphql.NewSchema(config)
+		if len(messages) == 0 {
+			Expect(err).ShouldNot(HaveOccurred())
+			return
+		}
+
+		Expect(err).Should(BeAssignableToTypeOf(graphql.Errors{}))
+		errs := err.(graphql.Errors)
+		actualMessages := make([]string, len(errs.Errors))
+		for i, e := range errs.Errors {
+			actualMessages[i] = e.Message
+		}
+		Expect(actualMessages).Should(Equal(messages))
+		Expect(err).Should(MatchError(strings.Join(messages, "\n\n")))
+	}
+
+	expectValid := func(config *graphql.SchemaConfig) {
+		expectErrors(config)
+	}
+
+	Describe("Type System: A Schema must have Object root types", func() {
+		It("accepts a Schema whose query type is an object type", func() {
+			expectValid(schemaWithField(graphql.FieldConfig{
+				Type: graphql.T(graphql.String()),
+			}))
+		})
+
+		It("rejects a Schema without a query type", func() {
+			// NewSchema accepts it for compatibility.
+			schema, err := graphql.NewSchema(&graphql.SchemaConfig{
+				Mutation: graphql.MustNewObject(&graphql.ObjectConfig{
+					Name: "Mutation",
+					Fields: graphql.Fields{
+						"test": {
+							Type: graphql.T(graphql.String()),
+						},
+					},
+				}),
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(graphql.ValidateSchema(schema)).Should(Equal(graphql.ErrorsOf(
+				"Query root type must be provided.",
+			)))
+		})
+
+		It("reports every violation in ValidateSchema", func() {
+			schema := graphql.MustNewSchema(schemaWithField(graphql.FieldConfig{
+				Type: graphql.T(graphql.String()),
+			}))
+			Expect(graphql.ValidateSchema(schema)).Should(Equal(graphql.NoErrors()))
+			Expect(graphql.ValidateSchema(schemaWithoutQuery{schema})).Should(Equal(graphql.ErrorsOf(
+				"Query root type must be provided.",
+			)))
+		})
+	})
+
+	Describe("Type System: Objects must have fields", func() {
+		It("accepts an Object type with fields object", func() {
+			expectValid(schemaWithField(graphql.FieldConfig{
+				Type: someObjectType,
+			}))
+		})
+
+		It("rejects an Object type with missing fields", func() {
+			expectErrors(schemaWithField(graphql.FieldConfig{
+				Type: &graphql.ObjectConfig{
+					Name: "SomeObject",
+				},
+			}), "Type SomeObject must define one or more fields.")
+		})
+
+		It("rejects an Object type with incorrectly named fields", func() {
+			expectErrors(schemaWithField(graphql.FieldConfig{
+				Type: &graphql.ObjectConfig{
+					Name: "SomeObject",
+					Fields: graphql.Fields{
+						"bad-name-with-dashes": {
+							Type: graphql.T(graphql.String()),
+						},
+					},
+				},
+			}), `Names must match /^[_a-zA-Z][_a-zA-Z0-9]*$/ but "bad-name-with-dashes" does not.`)
+		})
+
+		It("rejects a type with a name reserved for introspection", func() {
+			expectErrors(schemaWithField(graphql.FieldConfig{
+				Type: &graphql.ObjectConfig{
+					Name: "__SomeObject",
+					Fields: graphql.Fields{
+						"__f": {
+							Type: graphql.T(graphql.String()),
+						},
+					},
+				},
+			}),
+				`Name "__SomeObject" must not begin with "__", which is reserved by GraphQL introspection.`,
+				`Name "__f" must not begin with "__", which is reserved by GraphQL introspection.`)
+		})
+	})
+
+	Describe("Type System: Fields args must be properly named", func() {
+		It("accepts field args with valid names", func() {
+			expectValid(schemaWithField(graphql.FieldConfig{
+				Type: graphql.T(graphql.String()),
+				Args: graphql.ArgumentConfigMap{
+					"goodArg": {
+						Type: graphql.T(graphql.String()),
+					},
+				},
+			}))
+		})
+
+		It("rejects field arg with invalid names", func() {
+			expectErrors(schemaWithField(graphql.FieldConfig{
+				Type: graphql.T(graphql.String()),
+				Args: graphql.ArgumentConfigMap{
+					"bad-name-with-dashes": {
+						Type: graphql.T(graphql.String()),
+					},
+				},
+			}), `Names must match /^[_a-zA-Z][_a-zA-Z0-9]*$/ but "bad-name-with-dashes" does not.`)
+		})
+	})
+
+	Describe("Type System: Union types must be valid", func() {
+		It("accepts a Union type with member types", func() {
+			expectValid(schemaWithField(graphql.FieldConfig{
+				Type: &graphql.UnionConfig{
+					Name:          "GoodUnion",
+					PossibleTypes: []graphql.ObjectTypeDefinition{someObjectType},
+				},
+			}))
+		})
+
+		It("rejects a Union type with empty types", func() {
+			expectErrors(schemaWithField(graphql.FieldConfig{
+				Type: &graphql.UnionConfig{
+					Name: "BadUnion",
+				},
+			}), "Union type BadUnion must define one or more member types.")
+		})
+	})
+
+	Describe("Type System: Input Objects must have fields", func() {
+		schemaWithInputObject := func(inputObject graphql.TypeDefinition) *graphql.SchemaConfig {
+			return schemaWithField(graphql.FieldConfig{
+				Type: graphql.T(graphql.String()),
+				Args: graphql.ArgumentConfigMap{
+					"badArg": {
+						Type: inputObject,
+					},
+				},
+			})
+		}
+
+		It("accepts an Input Object type with fields", func() {
+			expectValid(schemaWithInputObject(someInputObjectType))
+		})
+
+		It("rejects an Input Object type with missing fields", func() {
+			expectErrors(schemaWithInputObject(&graphql.InputObjectConfig{
+				Name: "SomeInputObject",
+			}), "Input Object type SomeInputObject must define one or more fields.")
+		})
+
+		It("accepts an Input Object with breakable circular reference", func() {
+			someInputObject := &graphql.InputObjectConfig{
+				Name: "SomeInputObject",
+			}
+			someInputObject.Fields = graphql.InputFields{
+				"self": {
+					Type: someInputObject,
+				},
+				"arrayOfSelf": {
+					Type: graphql.ListOf(someInputObject),
+				},
+				"nonNullArrayOfSelf": {
+					Type: graphql.NonNullOf(graphql.ListOf(someInputObject)),
+				},
+				"nonNullArrayOfNonNullSelf": {
+					Type: graphql.NonNullOf(graphql.ListOf(graphql.NonNullOf(someInputObject))),
+				},
+				"intermediateSelf": {
+					Type: &graphql.InputObjectConfig{
+						Name: "AnotherInputObject",
+						Fields: graphql.InputFields{
+							"closeLoop": {
+								Type: someInputObject,
+							},
+						},
+					},
+				},
+			}
+			expectValid(schemaWithInputObject(someInputObject))
+		})
+
+		It("rejects an Input Object with non-breakable circular reference", func() {
+			someInputObject := &graphql.InputObjectConfig{
+				Name: "SomeInputObject",
+			}
+			someInputObject.Fields = graphql.InputFields{
+				"nonNullSelf": {
+					Type: graphql.NonNullOf(someInputObject),
+				},
+			}
+			expectErrors(schemaWithInputObject(someInputObject),
+				`Cannot reference Input Object "SomeInputObject" within itself through a series of `+
+					`non-null fields: "nonNullSelf".`)
+		})
+
+		It("rejects Input Objects with non-breakable circular reference spread across them", func() {
+			someInputObject := &graphql.InputObjectConfig{
+				Name: "SomeInputObject",
+			}
+			anotherInputObject := &graphql.InputObjectConfig{
+				Name: "AnotherInputObject",
+			}
+			yetAnotherInputObject := &graphql.InputObjectConfig{
+				Name: "YetAnotherInputObject",
+			}
+			someInputObject.Fields = graphql.InputFields{
+				"startLoop": {
+					Type: graphql.NonNullOf(anotherInputObject),
+				},
+			}
+			anotherInputObject.Fields = graphql.InputFields{
+				"nextInLoop": {
+					Type: graphql.NonNullOf(yetAnotherInputObject),
+				},
+			}
+			yetAnotherInputObject.Fields = graphql.InputFields{
+				"closeLoop": {
+					Type: graphql.NonNullOf(someInputObject),
+				},
+			}
+			expectErrors(schemaWithInputObject(someInputObject),
+				`Cannot reference Input Object "AnotherInputObject" within itself through a series of `+
+					`non-null fields: "nextInLoop.closeLoop.startLoop".`)
+		})
+
+		It("rejects an Input Object type with incorrectly typed fields", func() {
+			expectErrors(schemaWithInputObject(&graphql.InputObjectConfig{
+				Name: "AnotherInputObject",
+				Fields: graphql.InputFields{
+					"badObject": {
+						Type: someObjectType,
+					},
+					"badUnion": {
+						Type: &graphql.UnionConfig{
+							Name:          "SomeUnion",
+							PossibleTypes: []graphql.ObjectTypeDefinition{someObjectType},
+						},
+					},
+					"goodInputObject": {
+						Type: someInputObjectType,
+					},
+				},
+			}),
+				"The type of AnotherInputObject.badObject must be Input Type but got: SomeObject.",
+				"The type of AnotherInputObject.badUnion must be Input Type but got: SomeUnion.")
+		})
+	})
+
+	Describe("Type System: OneOf Input Object fields must be nullable", func() {
+		schemaWithInputObject := func(inputObject graphql.TypeDefinition) *graphql.SchemaConfig {
+			return schemaWithField(graphql.FieldConfig{
+				Type: graphql.T(graphql.String()),
+				Args: graphql.ArgumentConfigMap{
+					"arg": {
+						Type: inputObject,
+					},
+				},
+			})
+		}
+
+		It("accepts a OneOf Input Object with nullable fields", func() {
+			expectValid(schemaWithInputObject(&graphql.InputObjectConfig{
+				Name: "SomeInputObject",
+				Fields: graphql.InputFields{
+					"a": {
+						Type: graphql.T(graphql.String()),
+					},
+					"b": {
+						Type: graphql.T(graphql.Int()),
+					},
+				},
+				IsOneOf: true,
+			}))
+		})
+
+		It("rejects a OneOf Input Object with non-nullable fields", func() {
+			expectErrors(schemaWithInputObject(&graphql.InputObjectConfig{
+				Name: "SomeInputObject",
+				Fields: graphql.InputFields{
+					"a": {
+						Type: graphql.T(graphql.String()),
+					},
+					"b": {
+						Type: graphql.NonNullOfType(graphql.Int()),
+					},
+				},
+				IsOneOf: true,
+			}), "OneOf input field SomeInputObject.b must be nullable.")
+		})
+
+		It("rejects a OneOf Input Object with fields having default values", func() {
+			expectErrors(schemaWithInputObject(&graphql.InputObjectConfig{
+				Name: "SomeInputObject",
+				Fields: graphql.InputFields{
+					"a": {
+						Type:         graphql.T(graphql.String()),
+						DefaultValue: "foo",
+					},
+					"b": {
+						Type: graphql.T(graphql.Int()),
+					},
+				},
+				IsOneOf: true,
+			}), "OneOf input field SomeInputObject.a cannot have a default value.")
+		})
+	})
+
+	Describe("Type System: Enum types must be well defined", func() {
+		schemaWithEnum := func(values graphql.EnumValueDefinitionMap) *graphql.SchemaConfig {
+			return schemaWithField(graphql.FieldConfig{
+				Type: &graphql.EnumConfig{
+					Name:   "SomeEnum",
+					Values: values,
+				},
+			})
+		}
+
+		It("rejects an Enum type without values", func() {
+			expectErrors(schemaWithEnum(nil), "Enum type SomeEnum must define one or more values.")
+		})
+
+		It("rejects an Enum type with incorrectly named values", func() {
+			expectErrors(schemaWithEnum(graphql.EnumValueDefinitionMap{
+				"#value": {},
+			}), `Names must match /^[_a-zA-Z][_a-zA-Z0-9]*$/ but "#value" does not.`)
+
+			expectErrors(schemaWithEnum(graphql.EnumValueDefinitionMap{
+				"__badName": {},
+			}), `Name "__badName" must not begin with "__", which is reserved by GraphQL introspection.`)
+
+			expectErrors(schemaWithEnum(graphql.EnumValueDefinitionMap{
+				"true": {},
+			}), "Enum type SomeEnum cannot include value: true.")
+
+			expectErrors(schemaWithEnum(graphql.EnumValueDefinitionMap{
+				"false": {},
+			}), "Enum type SomeEnum cannot include value: false.")
+
+			expectErrors(schemaWithEnum(graphql.EnumValueDefinitionMap{
+				"null": {},
+			}), "Enum type SomeEnum cannot include value: null.")
+		})
+	})
+
+	Describe("Type System: Object fields must have output types", func() {
+		It("rejects an output field with input type", func() {
+			expectErrors(schemaWithField(graphql.FieldConfig{
+				Type: someInputObjectType,
+			}), "The type of Query.f must be Output Type but got: SomeInputObject.")
+		})
+
+		It("rejects a field arg with output type", func() {
+			expectErrors(schemaWithField(graphql.FieldConfig{
+				Type: graphql.T(graphql.String()),
+				Args: graphql.ArgumentConfigMap{
+					"badArg": {
+						Type: graphql.ListOf(someObjectType),
+					},
+				},
+			}), "The type of Query.f(badArg:) must be Input Type but got: [SomeObject].")
+		})
+
+		It("accepts leaf types as field types and field arg types", func() {
+			expectValid(schemaWithField(graphql.FieldConfig{
+				Type: graphql.T(someScalarType),
+				Args: graphql.ArgumentConfigMap{
+					"scalarArg": {
+						Type: graphql.T(someScalarType),
+					},
+					"inputArg": {
+						Type: someInputObjectType,
+					},
+				},
+			}))
+		})
+	})
+
+	Describe("Type System: Objects can only implement unique interfaces", func() {
+		It("rejects an Object implementing the same interface twice", func() {
+			expectErrors(schemaWithField(graphql.FieldConfig{
+				Type: &graphql.ObjectConfig{
+					Name:       "AnotherObject",
+					Interfaces: []graphql.InterfaceTypeDefinition{someInterfaceType, someInterfaceType},
+					Fields: graphql.Fields{
+						"f": {
+							Type: graphql.T(graphql.String()),
+						},
+					},
+				},
+			}), "Type AnotherObject can only implement SomeInterface once.")
+		})
+	})
+
+	Describe("Type System: Objects must adhere to Interface they implement", func() {
+		// schemaWithImplementation creates a schema with an Object that implements an Interface where
+		// the field in the Object and the Interface are specified by the given configs.
+		schemaWithImplementation := func(
+			interfaceField graphql.FieldConfig,
+			objectField graphql.FieldConfig) *graphql.SchemaConfig {
+
+			anotherInterface := &graphql.InterfaceConfig{
+				Name: "AnotherInterface",
+				Fields: graphql.Fields{
+					"field": interfaceField,
+				},
+			}
+
+			anotherObject := graphql.MustNewObject(&graphql.ObjectConfig{
+				Name:       "AnotherObject",
+				Interfaces: []graphql.InterfaceTypeDefinition{anotherInterface},
+				Fields: graphql.Fields{
+					"field": objectField,
+				},
+			})
+
+			return schemaWithField(graphql.FieldConfig{
+				Type: anotherInterface,
+			}, anotherObject)
+		}
+
+		It("accepts an Object which implements an Interface", func() {
+			expectValid(schemaWithImplementation(graphql.FieldConfig{
+				Type: graphql.T(graphql.String()),
+				Args: graphql.ArgumentConfigMap{
+					"input": {
+						Type: graphql.T(graphql.String()),
+					},
+				},
+			}, graphql.FieldConfig{
+				Type: graphql.T(graphql.String()),
+				Args: graphql.ArgumentConfigMap{
+					"input": {
+						Type: graphql.T(graphql.String()),
+					},
+				},
+			}))
+		})
+
+		It("accepts an Object which implements an Interface along with more fields and optional arguments", func() {
+			expectValid(schemaWithImplementation(graphql.FieldConfig{
+				Type: graphql.T(graphql.String()),
+			}, graphql.FieldConfig{
+				Type: graphql.T(graphql.String()),
+				Args: graphql.ArgumentConfigMap{
+					"anotherInput": {
+						Type: graphql.T(graphql.String()),
+					},
+				},
+			}))
+		})
+
+		It("rejects an Object missing an Interface field", func() {
+			anotherInterface := &graphql.InterfaceConfig{
+				Name: "AnotherInterface",
+				Fields: graphql.Fields{
+					"field": {
+						Type: graphql.T(graphql.String()),
+					},
+				},
+			}
+
+			anotherObject := graphql.MustNewObject(&graphql.ObjectConfig{
+				Name:       "AnotherObject",
+				Interfaces: []graphql.InterfaceTypeDefinition{anotherInterface},
+				Fields: graphql.Fields{
+					"anotherField": {
+						Type: graphql.T(graphql.String()),
+					},
+				},
+			})
+
+			expectErrors(schemaWithField(graphql.FieldConfig{
+				Type: anotherInterface,
+			}, anotherObject),
+				"Interface field AnotherInterface.field expected but AnotherObject does not provide it.")
+		})
+
+		It("rejects an Object with an incorrectly typed Interface field", func() {
+			expectErrors(schemaWithImplementation(graphql.FieldConfig{
+				Type: graphql.T(graphql.String()),
+			}, graphql.FieldConfig{
+				Type: graphql.T(graphql.Int()),
+			}), "Interface field AnotherInterface.field expects type String but AnotherObject.field is "+
+				"type Int.")
+		})
+
+		It("accepts an Object with a subtyped Interface field (interface)", func() {
+			expectValid(schemaWithImplementation(graphql.FieldConfig{
+				Type: someInterfaceType,
+			}, graphql.FieldConfig{
+				Type: someObjectType,
+			}))
+		})
+
+		It("accepts an Object with a subtyped Interface field (union)", func() {
+			expectValid(schemaWithImplementation(graphql.FieldConfig{
+				Type: &graphql.UnionConfig{
+					Name:          "SomeUnion",
+					PossibleTypes: []graphql.ObjectTypeDefinition{someObjectType},
+				},
+			}, graphql.FieldConfig{
+				Type: someObjectType,
+			}))
+		})
+
+		It("accepts an Object with an equivalently wrapped Interface field type", func() {
+			expectValid(schemaWithImplementation(graphql.FieldConfig{
+				Type: graphql.NonNullOf(graphql.ListOf(graphql.NonNullOfType(graphql.String()))),
+			}, graphql.FieldConfig{
+				Type: graphql.NonNullOf(graphql.ListOf(graphql.NonNullOfType(graphql.String()))),
+			}))
+		})
+
+		It("rejects an Object with a non-list Interface field list type", func() {
+			expectErrors(schemaWithImplementation(graphql.FieldConfig{
+				Type: graphql.ListOfType(graphql.String()),
+			}, graphql.FieldConfig{
+				Type: graphql.T(graphql.String()),
+			}), "Interface field AnotherInterface.field expects type [String] but AnotherObject.field is "+
+				"type String.")
+		})
+
+		It("accepts an Object with a subset non-null Interface field type", func() {
+			expectValid(schemaWithImplementation(graphql.FieldConfig{
+				Type: graphql.T(graphql.String()),
+			}, graphql.FieldConfig{
+				Type: graphql.NonNullOfType(graphql.String()),
+			}))
+		})
+
+		It("rejects an Object with a superset nullable Interface field type", func() {
+			expectErrors(schemaWithImplementation(graphql.FieldConfig{
+				Type: graphql.NonNullOfType(graphql.String()),
+			}, graphql.FieldConfig{
+				Type: graphql.T(graphql.String()),
+			}), "Interface field AnotherInterface.field expects type String! but AnotherObject.field is "+
+				"type String.")
+		})
+
+		It("rejects an Object missing an Interface argument", func() {
+			expectErrors(schemaWithImplementation(graphql.FieldConfig{
+				Type: graphql.T(graphql.String()),
+				Args: graphql.ArgumentConfigMap{
+					"input": {
+						Type: graphql.T(graphql.String()),
+					},
+				},
+			}, graphql.FieldConfig{
+				Type: graphql.T(graphql.String()),
+			}), "Interface field argument AnotherInterface.field(input:) expected but AnotherObject.field "+
+				"does not provide it.")
+		})
+
+		It("rejects an Object with an incorrectly typed Interface argument", func() {
+			expectErrors(schemaWithImplementation(graphql.FieldConfig{
+				Type: graphql.T(graphql.String()),
+				Args: graphql.ArgumentConfigMap{
+					"input": {
+						Type: graphql.T(graphql.String()),
+					},
+				},
+			}, graphql.FieldConfig{
+				Type: graphql.T(graphql.String()),
+				Args: graphql.ArgumentConfigMap{
+					"input": {
+						Type: graphql.NonNullOfType(graphql.String()),
+					},
+				},
+			}), "Interface field argument AnotherInterface.field(input:) expects type String but "+
+				"AnotherObject.field(input:) is type String!.")
+		})
+
+		It("rejects an Object with an additional required argument", func() {
+			expectErrors(schemaWithImplementation(graphql.FieldConfig{
+				Type: graphql.T(graphql.String()),
+			}, graphql.FieldConfig{
+				Type: graphql.T(graphql.String()),
+				Args: graphql.ArgumentConfigMap{
+					"requiredArg": {
+						Type: graphql.NonNullOfType(graphql.String()),
+					},
+				},
+			}), "Object field AnotherObject.field includes required argument requiredArg that is missing "+
+				"from the Interface field AnotherInterface.field.")
+		})
+	})
+
+	Describe("Type System: Interfaces must adhere to Interface they implement", func() {
+		// schemaWithInterfaceImplementation creates a schema with an Interface that implements another
+		// Interface where the field in the two Interfaces are specified by the given configs.
+		schemaWithInterfaceImplementation := func(
+			parentField graphql.FieldConfig,
+			childField graphql.FieldConfig) *graphql.SchemaConfig {
+
+			parentInterface := &graphql.InterfaceConfig{
+				Name: "ParentInterface",
+				Fields: graphql.Fields{
+					"field": parentField,
+				},
+			}
+
+			childInterface := &graphql.InterfaceConfig{
+				Name:       "ChildInterface",
+				Interfaces: []graphql.InterfaceTypeDefinition{parentInterface},
+				Fields: graphql.Fields{
+					"field": childField,
+				},
+			}
+
+			return schemaWithField(graphql.FieldConfig{
+				Type: childInterface,
+			})
+		}
+
+		It("accepts an Interface which implements an Interface", func() {
+			expectValid(schemaWithInterfaceImplementation(graphql.FieldConfig{
+				Type: graphql.T(graphql.String()),
+				Args: graphql.ArgumentConfigMap{
+					"input": {
+						Type: graphql.T(graphql.String()),
+					},
+				},
+			}, graphql.FieldConfig{
+				Type: graphql.T(graphql.String()),
+				Args: graphql.ArgumentConfigMap{
+					"input": {
+						Type: graphql.T(graphql.String()),
+					},
+				},
+			}))
+		})
+
+		It("rejects an Interface with an incorrectly typed Interface field", func() {
+			expectErrors(schemaWithInterfaceImplementation(graphql.FieldConfig{
+				Type: graphql.T(graphql.String()),
+			}, graphql.FieldConfig{
+				Type: graphql.T(graphql.Int()),
+			}), "Interface field ParentInterface.field expects type String but ChildInterface.field is "+
+				"type Int.")
+		})
+
+		It("rejects an Interface with an additional required argument", func() {
+			expectErrors(schemaWithInterfaceImplementation(graphql.FieldConfig{
+				Type: graphql.T(graphql.String()),
+			}, graphql.FieldConfig{
+				Type: graphql.T(graphql.String()),
+				Args: graphql.ArgumentConfigMap{
+					"requiredArg": {
+						Type: graphql.NonNullOfType(graphql.String()),
+					},
+				},
+			}), "Interface field ChildInterface.field includes required argument requiredArg that is "+
+				"missing from the Interface field ParentInterface.field.")
+		})
+
+		It("rejects an Interface implementing the same interface twice", func() {
+			expectErrors(schemaWithField(graphql.FieldConfig{
+				Type: &graphql.InterfaceConfig{
+					Name:       "AnotherInterface",
+					Interfaces: []graphql.InterfaceTypeDefinition{someInterfaceType, someInterfaceType},
+					Fields: graphql.Fields{
+						"f": {
+							Type: graphql.T(graphql.String()),
+						},
+					},
+				},
+			}), "Type AnotherInterface can only implement SomeInterface once.")
+		})
+
+		It("rejects an Interface implementing itself", func() {
+			badInterface := &graphql.InterfaceConfig{
+				Name: "BadInterface",
+				Fields: graphql.Fields{
+					"f": {
+						Type: graphql.T(graphql.String()),
+					},
+				},
+			}
+			badInterface.Interfaces = []graphql.InterfaceTypeDefinition{badInterface}
+
+			expectErrors(schemaWithField(graphql.FieldConfig{
+				Type: badInterface,
+			}), "Type BadInterface cannot implement itself because it would create a circular reference.")
+		})
+
+		It("rejects Interfaces implementing each other", func() {
+			interfaceA := &graphql.InterfaceConfig{
+				Name: "InterfaceA",
+				Fields: graphql.Fields{
+					"f": {
+						Type: graphql.T(graphql.String()),
+					},
+				},
+			}
+			interfaceB := &graphql.InterfaceConfig{
+				Name:       "InterfaceB",
+				Interfaces: []graphql.InterfaceTypeDefinition{interfaceA},
+				Fields: graphql.Fields{
+					"f": {
+						Type: graphql.T(graphql.String()),
+					},
+				},
+			}
+			interfaceA.Interfaces = []graphql.InterfaceTypeDefinition{interfaceB}
+
+			expectErrors(schemaWithField(graphql.FieldConfig{
+				Type: interfaceA,
+			}),
+				"Type InterfaceA cannot implement InterfaceB because it would create a circular reference.",
+				"Type InterfaceB cannot implement InterfaceA because it would create a circular reference.")
+		})
+
+		It("rejects an Object that does not implement the interfaces of its Interfaces", func() {
+			childInterface := &graphql.InterfaceConfig{
+				Name:       "ChildInterface",
+				Interfaces: []graphql.InterfaceTypeDefinition{someInterfaceType},
+				Fields: graphql.Fields{
+					"f": {
+						Type: graphql.T(graphql.String()),
+					},
+				},
+			}
+
+			anotherObject := graphql.MustNewObject(&graphql.ObjectConfig{
+				Name:       "AnotherObject",
+				Interfaces: []graphql.InterfaceTypeDefinition{childInterface},
+				Fields: graphql.Fields{
+					"f": {
+						Type: graphql.T(graphql.String()),
+					},
+				},
+			})
+
+			expectErrors(schemaWithField(graphql.FieldConfig{
+				Type: childInterface,
+			}, anotherObject),
+				"Type AnotherObject must implement SomeInterface because it is implemented by "+
+					"ChildInterface.")
+		})
+
+		It("rejects an Interface that does not implement the interfaces of its Interfaces", func() {
+			childInterface := &graphql.InterfaceConfig{
+				Name:       "ChildInterface",
+				Interfaces: []graphql.InterfaceTypeDefinition{someInterfaceType},
+				Fields: graphql.Fields{
+					"f": {
+						Type: graphql.T(graphql.String()),
+					},
+				},
+			}
+
+			grandchildInterface := &graphql.InterfaceConfig{
+				Name:       "GrandchildInterface",
+				Interfaces: []graphql.InterfaceTypeDefinition{childInterface},
+				Fields: graphql.Fields{
+					"f": {
+						Type: graphql.T(graphql.String()),
+					},
+				},
+			}
+
+			expectErrors(schemaWithField(graphql.FieldConfig{
+				Type: grandchildInterface,
+			}),
+				"Type GrandchildInterface must implement SomeInterface because it is implemented by "+
+					"ChildInterface.")
+		})
+	})
+
+	Describe("Type System: Directives must be valid", func() {
+		It("rejects a directive with invalid argument type", func() {
+			config := schemaWithField(graphql.FieldConfig{
+				Type: graphql.T(graphql.String()),
+			})
+			config.Directives = graphql.DirectiveList{
+				graphql.MustNewDirective(&graphql.DirectiveConfig{
+					Name: "badDirective",
+					Locations: []graphql.DirectiveLocation{
+						graphql.DirectiveLocationQuery,
+					},
+					Args: graphql.ArgumentConfigMap{
+						"badArg": {
+							Type: someObjectType,
+						},
+					},
+				}),
+			}
+			expectErrors(config, "The type of @badDirective(badArg:) must be Input Type but got: SomeObject.")
+		})
+
+		It("rejects a directive with invalid name", func() {
+			config := schemaWithField(graphql.FieldConfig{
+				Type: graphql.T(graphql.String()),
+			})
+			config.Directives = graphql.DirectiveList{
+				graphql.MustNewDirective(&graphql.DirectiveConfig{
+					Name: "__badDirective",
+					Locations: []graphql.DirectiveLocation{
+						graphql.DirectiveLocationQuery,
+					},
+				}),
+			}
+			expectErrors(config,
+				`Name "__badDirective" must not begin with "__", which is reserved by GraphQL introspection.`)
+		})
+	})
+
+	Describe("Type System: Arguments and input fields can be deprecated if optional", func() {
+		deprecation := &graphql.Deprecation{
+			Reason: "Some reason.",
+		}
+
+		It("accepts deprecated optional arguments and input fields", func() {
+			config := schemaWithField(graphql.FieldConfig{
+				Type: graphql.T(graphql.String()),
+				Args: graphql.ArgumentConfigMap{
+					"optionalArg": {
+						Type:        graphql.T(graphql.String()),
+						Deprecation: deprecation,
+					},
+					"defaultedArg": {
+						Type:         graphql.NonNullOfType(graphql.String()),
+						DefaultValue: "default",
+						Deprecation:  deprecation,
+					},
+					"input": {
+						Type: &graphql.InputObjectConfig{
+							Name: "SomeInputObject",
+							Fields: graphql.InputFields{
+								"optionalField": {
+									Type:        graphql.T(graphql.String()),
+									Deprecation: deprecation,
+								},
+							},
+						},
+					},
+				},
+			})
+			config.Directives = graphql.DirectiveList{
+				graphql.MustNewDirective(&graphql.DirectiveConfig{
+					Name: "someDirective",
+					Locations: []graphql.DirectiveLocation{
+						graphql.DirectiveLocationQuery,
+					},
+					Args: graphql.ArgumentConfigMap{
+						"optionalArg": {
+							Type:        graphql.T(graphql.String()),
+							Deprecation: deprecation,
+						},
+					},
+				}),
+			}
+			expectValid(config)
+		})
+
+		It("rejects deprecated required arguments and input fields", func() {
+			config := schemaWithField(graphql.FieldConfig{
+				Type: graphql.T(graphql.String()),
+				Args: graphql.ArgumentConfigMap{
+					"requiredArg": {
+						Type:        graphql.NonNullOfType(graphql.String()),
+						Deprecation: deprecation,
+					},
+					"input": {
+						Type: &graphql.InputObjectConfig{
+							Name: "SomeInputObject",
+							Fields: graphql.InputFields{
+								"requiredField": {
+									Type:        graphql.NonNullOfType(graphql.String()),
+									Deprecation: deprecation,
+								},
+							},
+						},
+					},
+				},
+			})
+			config.Directives = graphql.DirectiveList{
+				graphql.MustNewDirective(&graphql.DirectiveConfig{
+					Name: "someDirective",
+					Locations: []graphql.DirectiveLocation{
+						graphql.DirectiveLocationQuery,
+					},
+					Args: graphql.ArgumentConfigMap{
+						"requiredArg": {
+							Type:        graphql.NonNullOfType(graphql.String()),
+							Deprecation: deprecation,
+						},
+					},
+				}),
+			}
+			expectErrors(config,
+				"Required argument @someDirective(requiredArg:) cannot be deprecated.",
+				"Required argument Query.f(requiredArg:) cannot be deprecated.",
+				"Required input field SomeInputObject.requiredField cannot be deprecated.",
+			)
+		})
+	})
+
+	Describe("Type System: Applied directives must be valid", func() {
+		authDirective := graphql.MustNewDirective(&graphql.DirectiveConfig{
+			Name: "auth",
+			Locations: []graphql.DirectiveLocation{
+				graphql.DirectiveLocationObject,
+				graphql.DirectiveLocationFieldDefinition,
+			},
+			Args: graphql.ArgumentConfigMap{
+				"requires": {
+					Type: graphql.NonNullOfType(graphql.String()),
+				},
+			},
+		})
+
+		schemaWithAppliedDirectives := func(directives graphql.AppliedDirectives) *graphql.SchemaConfig {
+			config := schemaWithField(graphql.FieldConfig{
+				Type:       graphql.T(graphql.String()),
+				Directives: directives,
+			})
+			config.Directives = graphql.DirectiveList{authDirective}
+			return config
+		}
+
+		It("accepts a known directive applied to a field", func() {
+			expectValid(schemaWithAppliedDirectives(graphql.AppliedDirectives{
+				{
+					Name: "auth",
+					Args: map[string]interface{}{
+						"requires": "ADMIN",
+					},
+				},
+			}))
+		})
+
+		It("rejects an unknown directive", func() {
+			expectErrors(schemaWithAppliedDirectives(graphql.AppliedDirectives{
+				{Name: "unknown"},
+			}), `Unknown directive "@unknown" applied to Query.f.`)
+		})
+
+		It("rejects a directive applied to an invalid location", func() {
+			config := schemaWithField(graphql.FieldConfig{
+				Type: graphql.T(graphql.String()),
+				Args: graphql.ArgumentConfigMap{
+					"arg": {
+						Type: graphql.T(graphql.String()),
+						Directives: graphql.AppliedDirectives{
+							{
+								Name: "auth",
+								Args: map[string]interface{}{
+									"requires": "ADMIN",
+								},
+							},
+						},
+					},
+				},
+			})
+			config.Directives = graphql.DirectiveList{authDirective}
+			expectErrors(config,
+				`Directive "@auth" may not be used on ARGUMENT_DEFINITION (applied to Query.f(arg:)).`)
+		})
+
+		It("rejects a directive applied more than once", func() {
+			directive := &graphql.AppliedDirective{
+				Name: "auth",
+				Args: map[string]interface{}{
+					"requires": "ADMIN",
+				},
+			}
+			expectErrors(schemaWithAppliedDirectives(graphql.AppliedDirectives{directive, directive}),
+				`The directive "@auth" can only be applied to Query.f once.`)
+		})
+
+		It("accepts a repeatable directive applied more than once", func() {
+			config := schemaWithField(graphql.FieldConfig{
+				Type: graphql.T(graphql.String()),
+				Directives: graphql.AppliedDirectives{
+					{Name: "tag", Args: map[string]interface{}{"name": "a"}},
+					{Name: "tag", Args: map[string]interface{}{"name": "b"}},
+				},
+			})
+			config.Directives = graphql.DirectiveList{
+				graphql.MustNewDirective(&graphql.DirectiveConfig{
+					Name: "tag",
+					Locations: []graphql.DirectiveLocation{
+						graphql.DirectiveLocationFieldDefinition,
+					},
+					Args: graphql.ArgumentConfigMap{
+						"name": {
+							Type: graphql.NonNullOfType(graphql.String()),
+						},
+					},
+					IsRepeatable: true,
+				}),
+			}
+			expectValid(config)
+		})
+
+		It("rejects unknown and invalid arguments", func() {
+			expectErrors(schemaWithAppliedDirectives(graphql.AppliedDirectives{
+				{
+					Name: "auth",
+					Args: map[string]interface{}{
+						"requires": nil,
+						"unknown":  true,
+					},
+				},
+			}),
+				`Argument "requires" on directive "@auth" applied to Query.f has invalid value null.`,
+				`Unknown argument "unknown" on directive "@auth" applied to Query.f.`)
+		})
+
+		It("rejects a directive missing required arguments", func() {
+			expectErrors(schemaWithAppliedDirectives(graphql.AppliedDirectives{
+				{Name: "auth"},
+			}), `Argument "requires" of type "String!" on directive "@auth" applied to Query.f is required, `+
+				`but it was not provided.`)
+		})
+	})
+})
@@ -42,6 +42,12 @@ const (
 	StopCheck
 )
 
+// DocumentRule validates a Document. It is run once at the beginning of validation before any
+// other rules.
+type DocumentRule interface {
+	CheckDocument(ctx *ValidationContext, document ast.Document) NextCheckAction
+}
+
 // OperationRule validates an OperationDefinition.
 type OperationRule interface {
 	CheckOperation(ctx *ValidationContext, operation *ast.OperationDefinition) NextCheckAction
@@ -0,0 +1,72 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package rules
+
+import (
+	"github.com/botobag/artemis/graphql"
+	"github.com/botobag/artemis/graphql/ast"
+	messages "github.com/botobag/artemis/graphql/internal/validator"
+	"github.com/botobag/artemis/graphql/validator"
+)
+
+// DeferStreamDirectiveLabel implements the validation rule which ensures that the label arguments
+// given to @defer and @stream are static strings and are unique in the document. The label is used
+// to identify the subsequent payloads delivered for the directives.
+type DeferStreamDirectiveLabel struct{}
+
+// CheckDirective implements validator.DirectiveRule.
+func (rule DeferStreamDirectiveLabel) CheckDirective(
+	ctx *validator.ValidationContext,
+	directive *validator.DirectiveInfo) validator.NextCheckAction {
+
+	directiveName := directive.Name()
+	if directiveName != graphql.DeferDirective().Name() &&
+		directiveName != graphql.StreamDirective().Name() {
+		return validator.ContinueCheck
+	}
+
+	node := directive.Node()
+	for _, arg := range node.Arguments {
+		if arg.Name.Value() != "label" {
+			continue
+		}
+
+		label, ok := arg.Value.(ast.StringValue)
+		if !ok {
+			ctx.ReportError(
+				messages.NonStaticDeferStreamLabelMessage(directiveName),
+				graphql.ErrorLocationOfASTNode(node),
+			)
+			break
+		}
+
+		if prevNode, exists := ctx.KnownDeferStreamLabels[label.Value()]; exists {
+			ctx.ReportError(
+				messages.DuplicateDeferStreamLabelMessage(),
+				[]graphql.ErrorLocation{
+					graphql.ErrorLocationOfASTNode(prevNode),
+					graphql.ErrorLocationOfASTNode(node),
+				},
+			)
+		} else {
+			ctx.KnownDeferStreamLabels[label.Value()] = node
+		}
+		break
+	}
+
+	return validator.ContinueCheck
+}
@@ -0,0 +1,192 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package rules_test
+
+import (
+	"github.com/botobag/artemis/graphql"
+	"github.com/botobag/artemis/graphql/internal/validator"
+	"github.com/botobag/artemis/graphql/validator/rules"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// graphql-js/src/validation/__tests__/DeferStreamDirectiveLabelRule-test.ts
+var _ = Describe("Validate: Defer/Stream directive labels", func() {
+	expectErrors := func(queryStr string) GomegaAssertion {
+		return expectValidationErrors(rules.DeferStreamDirectiveLabel{}, queryStr)
+	}
+
+	expectValid := func(queryStr string) {
+		expectErrors(queryStr).Should(Equal(graphql.NoErrors()))
+	}
+
+	duplicateLabel := func(l1 uint, c1 uint, l2 uint, c2 uint) error {
+		return graphql.NewError(validator.DuplicateDeferStreamLabelMessage(), []graphql.ErrorLocation{
+			{Line: l1, Column: c1},
+			{Line: l2, Column: c2},
+		})
+	}
+
+	It("defer fragments with no label", func() {
+		expectValid(`
+      {
+        dog {
+          ...dogFragmentA @defer
+          ...dogFragmentB @defer
+        }
+      }
+      fragment dogFragmentA on Dog {
+        name
+      }
+      fragment dogFragmentB on Dog {
+        nickname
+      }
+    `)
+	})
+
+	It("defer fragments, one with label, one without", func() {
+		expectValid(`
+      {
+        dog {
+          ...dogFragmentA @defer(label: "fragA")
+          ...dogFragmentB @defer
+        }
+      }
+      fragment dogFragmentA on Dog {
+        name
+      }
+      fragment dogFragmentB on Dog {
+        nickname
+      }
+    `)
+	})
+
+	It("defer fragment with variable label", func() {
+		expectErrors(`
+      query($label: String) {
+        dog {
+          ...dogFragmentA @defer(label: $label)
+        }
+      }
+      fragment dogFragmentA on Dog {
+        name
+      }
+    `).Should(Equal(graphql.ErrorsOf(
+			graphql.NewError(validator.NonStaticDeferStreamLabelMessage("defer"), []graphql.ErrorLocation{
+				{Line: 4, Column: 27},
+			}))))
+	})
+
+	It("defer fragments with different labels", func() {
+		expectValid(`
+      {
+        dog {
+          ...dogFragmentA @defer(label: "fragB")
+          ...dogFragmentB @defer(label: "fragA")
+        }
+      }
+      fragment dogFragmentA on Dog {
+        name
+      }
+      fragment dogFragmentB on Dog {
+        nickname
+      }
+    `)
+	})
+
+	It("defer fragments with same label", func() {
+		expectErrors(`
+      {
+        dog {
+          ...dogFragmentA @defer(label: "fragA")
+          ...dogFragmentB @defer(label: "fragA")
+        }
+      }
+      fragment dogFragmentA on Dog {
+        name
+      }
+      fragment dogFragmentB on Dog {
+        nickname
+      }
+    `).Should(Equal(graphql.ErrorsOf(duplicateLabel(4, 27, 5, 27))))
+	})
+
+	It("defer and stream with same label", func() {
+		expectErrors(`
+      {
+        dog {
+          ...dogFragment @defer(label: "MyLabel")
+        }
+        human {
+          pets @stream(label: "MyLabel") {
+            name
+          }
+        }
+      }
+      fragment dogFragment on Dog {
+        name
+      }
+    `).Should(Equal(graphql.ErrorsOf(duplicateLabel(4, 26, 7, 16))))
+	})
+
+	It("defer and stream with different labels", func() {
+		expectValid(`
+      {
+        dog {
+          ...dogFragment @defer(label: "MyLabel")
+        }
+        human {
+          pets @stream(label: "MyOtherLabel") {
+            name
+          }
+        }
+      }
+      fragment dogFragment on Dog {
+        name
+      }
+    `)
+	})
+
+	It("no defer or stream directive with variable and duplicate label", func() {
+		expectValid(`
+      query($label: Boolean) {
+        dog @skip(if: $label) {
+          name
+        }
+        human @skip(if: $label) {
+          name
+        }
+      }
+    `)
+	})
+
+	It("stream with variable label", func() {
+		expectErrors(`
+      query($label: String!) {
+        human {
+          pets @stream(label: $label) {
+            name
+          }
+        }
+      }
+    `).Should(Equal(graphql.ErrorsOf(
+			graphql.NewError(validator.NonStaticDeferStreamLabelMessage("stream"), []graphql.ErrorLocation{
+				{Line: 4, Column: 16},
+			}))))
+	})
+})
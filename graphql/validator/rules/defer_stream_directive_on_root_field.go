@@ -0,0 +1,119 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package rules
+
+import (
+	"github.com/botobag/artemis/graphql"
+	"github.com/botobag/artemis/graphql/ast"
+	messages "github.com/botobag/artemis/graphql/internal/validator"
+	"github.com/botobag/artemis/graphql/validator"
+)
+
+// DeferStreamDirectiveOnRootField implements the validation rule which ensures that @defer and
+// @stream are not used on the root fields of mutation and subscription where the fields are
+// required to be executed serially or to produce a single result for each event.
+type DeferStreamDirectiveOnRootField struct{}
+
+// CheckField implements validator.FieldRule.
+func (rule DeferStreamDirectiveOnRootField) CheckField(
+	ctx *validator.ValidationContext,
+	field *validator.FieldInfo) validator.NextCheckAction {
+
+	rule.checkDirectives(
+		ctx,
+		field.ParentType(),
+		field.Node().Directives,
+		graphql.StreamDirective().Name(),
+		messages.StreamOnRootMutationTypeMessage,
+		messages.StreamOnRootSubscriptionTypeMessage)
+
+	return validator.ContinueCheck
+}
+
+// CheckInlineFragment implements validator.InlineFragmentRule.
+func (rule DeferStreamDirectiveOnRootField) CheckInlineFragment(
+	ctx *validator.ValidationContext,
+	parentType graphql.Type,
+	typeCondition graphql.Type,
+	fragment *ast.InlineFragment) validator.NextCheckAction {
+
+	rule.checkDirectives(
+		ctx,
+		parentType,
+		fragment.Directives,
+		graphql.DeferDirective().Name(),
+		messages.DeferOnRootMutationTypeMessage,
+		messages.DeferOnRootSubscriptionTypeMessage)
+
+	return validator.ContinueCheck
+}
+
+// CheckFragmentSpread implements validator.FragmentSpreadRule.
+func (rule DeferStreamDirectiveOnRootField) CheckFragmentSpread(
+	ctx *validator.ValidationContext,
+	parentType graphql.Type,
+	fragmentInfo *validator.FragmentInfo,
+	fragmentSpread *ast.FragmentSpread) validator.NextCheckAction {
+
+	rule.checkDirectives(
+		ctx,
+		parentType,
+		fragmentSpread.Directives,
+		graphql.DeferDirective().Name(),
+		messages.DeferOnRootMutationTypeMessage,
+		messages.DeferOnRootSubscriptionTypeMessage)
+
+	return validator.ContinueCheck
+}
+
+// checkDirectives reports an error for each directive with the given name in directives if
+// parentType is the root mutation or subscription type.
+func (rule DeferStreamDirectiveOnRootField) checkDirectives(
+	ctx *validator.ValidationContext,
+	parentType graphql.Type,
+	directives ast.Directives,
+	directiveName string,
+	mutationMessage func(typeName string) string,
+	subscriptionMessage func(typeName string) string) {
+
+	if parentType == nil {
+		return
+	}
+
+	var (
+		schema  = ctx.Schema()
+		message func(typeName string) string
+	)
+
+	if mutationType := schema.Mutation(); mutationType != nil && parentType == mutationType {
+		message = mutationMessage
+	} else if subscriptionType := schema.Subscription(); subscriptionType != nil &&
+		parentType == subscriptionType {
+		message = subscriptionMessage
+	} else {
+		return
+	}
+
+	for _, directive := range directives {
+		if directive.Name.Value() == directiveName {
+			ctx.ReportError(
+				message(parentType.(graphql.TypeWithName).Name()),
+				graphql.ErrorLocationOfASTNode(directive),
+			)
+		}
+	}
+}
@@ -0,0 +1,257 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package rules_test
+
+import (
+	"github.com/botobag/artemis/graphql"
+	"github.com/botobag/artemis/graphql/internal/validator"
+	"github.com/botobag/artemis/graphql/validator/rules"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// graphql-js/src/validation/__tests__/DeferStreamDirectiveOnRootFieldRule-test.ts
+var _ = Describe("Validate: Defer/Stream directive on root field", func() {
+	message := graphql.MustNewObject(&graphql.ObjectConfig{
+		Name: "Message",
+		Fields: graphql.Fields{
+			"body": {
+				Type: graphql.T(graphql.String()),
+			},
+			"sender": {
+				Type: graphql.T(graphql.String()),
+			},
+		},
+	})
+
+	schema := graphql.MustNewSchema(&graphql.SchemaConfig{
+		Query: graphql.MustNewObject(&graphql.ObjectConfig{
+			Name: "QueryRoot",
+			Fields: graphql.Fields{
+				"message": {
+					Type: graphql.T(message),
+				},
+				"messages": {
+					Type: graphql.ListOfType(message),
+				},
+			},
+		}),
+		Mutation: graphql.MustNewObject(&graphql.ObjectConfig{
+			Name: "MutationRoot",
+			Fields: graphql.Fields{
+				"mutationField": {
+					Type: graphql.T(message),
+				},
+				"mutationListField": {
+					Type: graphql.ListOfType(message),
+				},
+			},
+		}),
+		Subscription: graphql.MustNewObject(&graphql.ObjectConfig{
+			Name: "SubscriptionRoot",
+			Fields: graphql.Fields{
+				"subscriptionField": {
+					Type: graphql.T(message),
+				},
+				"subscriptionListField": {
+					Type: graphql.ListOfType(message),
+				},
+			},
+		}),
+		Directives: graphql.DirectiveList{
+			graphql.DeferDirective(),
+			graphql.StreamDirective(),
+		},
+	})
+
+	expectErrors := func(queryStr string) GomegaAssertion {
+		return expectValidationErrorsWithSchema(schema, rules.DeferStreamDirectiveOnRootField{}, queryStr)
+	}
+
+	expectValid := func(queryStr string) {
+		expectErrors(queryStr).Should(Equal(graphql.NoErrors()))
+	}
+
+	errorAt := func(message string, line uint, column uint) error {
+		return graphql.NewError(message, []graphql.ErrorLocation{
+			{Line: line, Column: column},
+		})
+	}
+
+	It("Defer fragment spread on root query field", func() {
+		expectValid(`
+      {
+        ...rootQueryFragment @defer
+      }
+      fragment rootQueryFragment on QueryRoot {
+        message {
+          body
+        }
+      }
+    `)
+	})
+
+	It("Defer inline fragment spread on root query field", func() {
+		expectValid(`
+      {
+        ... @defer {
+          message {
+            body
+          }
+        }
+      }
+    `)
+	})
+
+	It("Defer fragment spread on root mutation field", func() {
+		expectErrors(`
+      mutation {
+        ...rootFragment @defer
+      }
+      fragment rootFragment on MutationRoot {
+        mutationField {
+          body
+        }
+      }
+    `).Should(Equal(graphql.ErrorsOf(
+			errorAt(validator.DeferOnRootMutationTypeMessage("MutationRoot"), 3, 25))))
+	})
+
+	It("Defer inline fragment spread on root mutation field", func() {
+		expectErrors(`
+      mutation {
+        ... @defer {
+          mutationField {
+            body
+          }
+        }
+      }
+    `).Should(Equal(graphql.ErrorsOf(
+			errorAt(validator.DeferOnRootMutationTypeMessage("MutationRoot"), 3, 13))))
+	})
+
+	It("Defer fragment spread on nested mutation field", func() {
+		expectValid(`
+      mutation {
+        mutationField {
+          ... @defer {
+            body
+          }
+        }
+      }
+    `)
+	})
+
+	It("Defer fragment spread on root subscription field", func() {
+		expectErrors(`
+      subscription {
+        ...rootFragment @defer
+      }
+      fragment rootFragment on SubscriptionRoot {
+        subscriptionField {
+          body
+        }
+      }
+    `).Should(Equal(graphql.ErrorsOf(
+			errorAt(validator.DeferOnRootSubscriptionTypeMessage("SubscriptionRoot"), 3, 25))))
+	})
+
+	It("Defer inline fragment spread on root subscription field", func() {
+		expectErrors(`
+      subscription {
+        ... @defer {
+          subscriptionField {
+            body
+          }
+        }
+      }
+    `).Should(Equal(graphql.ErrorsOf(
+			errorAt(validator.DeferOnRootSubscriptionTypeMessage("SubscriptionRoot"), 3, 13))))
+	})
+
+	It("Defer fragment spread on nested subscription field", func() {
+		expectValid(`
+      subscription {
+        subscriptionField {
+          ...nestedFragment @defer
+        }
+      }
+      fragment nestedFragment on Message {
+        body
+      }
+    `)
+	})
+
+	It("Stream field on root query field", func() {
+		expectValid(`
+      {
+        messages @stream {
+          name
+        }
+      }
+    `)
+	})
+
+	It("Stream field on root mutation field", func() {
+		expectErrors(`
+      mutation {
+        mutationListField @stream {
+          name
+        }
+      }
+    `).Should(Equal(graphql.ErrorsOf(
+			errorAt(validator.StreamOnRootMutationTypeMessage("MutationRoot"), 3, 27))))
+	})
+
+	It("Stream field on fragment on root mutation field", func() {
+		expectErrors(`
+      mutation {
+        ...rootFragment
+      }
+      fragment rootFragment on MutationRoot {
+        mutationListField @stream {
+          name
+        }
+      }
+    `).Should(Equal(graphql.ErrorsOf(
+			errorAt(validator.StreamOnRootMutationTypeMessage("MutationRoot"), 6, 27))))
+	})
+
+	It("Stream field on root subscription field", func() {
+		expectErrors(`
+      subscription {
+        subscriptionListField @stream {
+          name
+        }
+      }
+    `).Should(Equal(graphql.ErrorsOf(
+			errorAt(validator.StreamOnRootSubscriptionTypeMessage("SubscriptionRoot"), 3, 31))))
+	})
+
+	It("Stream field on nested subscription field", func() {
+		expectValid(`
+      subscription {
+        subscriptionField {
+          ... on Message @defer {
+            body
+          }
+        }
+      }
+    `)
+	})
+})
@@ -0,0 +1,59 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package rules
+
+import (
+	"github.com/botobag/artemis/graphql"
+	"github.com/botobag/artemis/graphql/ast"
+	messages "github.com/botobag/artemis/graphql/internal/validator"
+	"github.com/botobag/artemis/graphql/validator"
+)
+
+// ExecutableDefinitions implements the "Executable Definitions" validation rule.
+//
+// See https://graphql.github.io/graphql-spec/June2018/#sec-Executable-Definitions.
+type ExecutableDefinitions struct{}
+
+// CheckDocument implements validator.DocumentRule.
+func (rule ExecutableDefinitions) CheckDocument(ctx *validator.ValidationContext, document ast.Document) validator.NextCheckAction {
+	// A GraphQL document is only valid for execution if all definitions are either operation or
+	// fragment definitions.
+	for _, definition := range document.Definitions {
+		if _, ok := definition.(ast.ExecutableDefinition); ok {
+			continue
+		}
+
+		var defName string
+		switch definition := definition.(type) {
+		case *ast.SchemaDefinition, *ast.SchemaExtension:
+			defName = "schema"
+		case ast.TypeDefinition:
+			defName = definition.GetName().Value()
+		case ast.TypeExtension:
+			defName = definition.GetName().Value()
+		case *ast.DirectiveDefinition:
+			defName = definition.Name.Value()
+		}
+
+		ctx.ReportError(
+			messages.NonExecutableDefinitionMessage(defName),
+			graphql.ErrorLocationOfASTNode(definition),
+		)
+	}
+
+	return validator.StopCheck
+}
@@ -0,0 +1,116 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package rules_test
+
+import (
+	"github.com/botobag/artemis/graphql"
+	"github.com/botobag/artemis/graphql/internal/validator"
+	"github.com/botobag/artemis/graphql/validator/rules"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// graphql-js/src/validation/__tests__/ExecutableDefinitions-test.js@f529809
+var _ = Describe("Validate: Executable definitions", func() {
+	expectErrors := func(queryStr string) GomegaAssertion {
+		return expectValidationErrors(rules.ExecutableDefinitions{}, queryStr)
+	}
+
+	expectValid := func(queryStr string) {
+		expectErrors(queryStr).Should(Equal(graphql.NoErrors()))
+	}
+
+	nonExecutableDefinition := func(defName string, line, column uint) error {
+		return graphql.NewError(validator.NonExecutableDefinitionMessage(defName), []graphql.ErrorLocation{
+			{Line: line, Column: column},
+		})
+	}
+
+	It("with only operation", func() {
+		expectValid(`
+      query Foo {
+        dog {
+          name
+        }
+      }
+    `)
+	})
+
+	It("with operation and fragment", func() {
+		expectValid(`
+      query Foo {
+        dog {
+          name
+          ...Frag
+        }
+      }
+
+      fragment Frag on Dog {
+        name
+      }
+    `)
+	})
+
+	It("with type definition", func() {
+		expectErrors(`
+      query Foo {
+        dog {
+          name
+        }
+      }
+
+      type Cow {
+        name: String
+      }
+
+      extend type Dog {
+        color: String
+      }
+
+      "Description"
+      interface Animal {
+        name: String
+      }
+    `).Should(Equal(graphql.ErrorsOf(
+			nonExecutableDefinition("Cow", 8, 7),
+			nonExecutableDefinition("Dog", 12, 7),
+			nonExecutableDefinition("Animal", 16, 7),
+		)))
+	})
+
+	It("with schema definition", func() {
+		expectErrors(`
+      schema {
+        query: Query
+      }
+
+      type Query {
+        test: String
+      }
+
+      extend schema @directive
+
+      directive @directive on FIELD
+    `).Should(Equal(graphql.ErrorsOf(
+			nonExecutableDefinition("schema", 2, 7),
+			nonExecutableDefinition("Query", 6, 7),
+			nonExecutableDefinition("schema", 10, 7),
+			nonExecutableDefinition("directive", 12, 7),
+		)))
+	})
+})
@@ -26,6 +26,7 @@ func init() {
 	// The order of the rules in this list has been adjusted to lead to the most clear output when
 	// encountering multiple validation errors.
 	validator.InitStandardRules(
+		ExecutableDefinitions{},
 		UniqueOperationNames{},
 		LoneAnonymousOperation{},
 		SingleFieldSubscriptions{},
@@ -45,6 +46,9 @@ func init() {
 		KnownDirectives{},
 		DirectivesInValidLocations{},
 		UniqueDirectivesPerLocation{},
+		DeferStreamDirectiveOnRootField{},
+		DeferStreamDirectiveLabel{},
+		StreamDirectiveOnListField{},
 		KnownArgumentNames{},
 		UniqueArgumentNames{},
 		ValuesOfCorrectType{},
@@ -234,4 +234,116 @@ var _ = Describe("Validate: Possible fragment spreads", func() {
       fragment petFragment on Pet { name }
     `).Should(Equal(graphql.ErrorsOf(errorSpread("petFragment", "HumanOrAlien", "Pet", 2, 62))))
 	})
+
+	Describe("with interfaces implementing interfaces", func() {
+		nodeType := &graphql.InterfaceConfig{
+			Name: "Node",
+			Fields: graphql.Fields{
+				"id": {
+					Type: graphql.T(graphql.ID()),
+				},
+			},
+		}
+
+		resourceType := &graphql.InterfaceConfig{
+			Name:       "Resource",
+			Interfaces: []graphql.InterfaceTypeDefinition{nodeType},
+			Fields: graphql.Fields{
+				"id": {
+					Type: graphql.T(graphql.ID()),
+				},
+				"url": {
+					Type: graphql.T(graphql.String()),
+				},
+			},
+		}
+
+		namedType := &graphql.InterfaceConfig{
+			Name: "Named",
+			Fields: graphql.Fields{
+				"name": {
+					Type: graphql.T(graphql.String()),
+				},
+			},
+		}
+
+		schema := graphql.MustNewSchema(&graphql.SchemaConfig{
+			Query: graphql.MustNewObject(&graphql.ObjectConfig{
+				Name: "Query",
+				Fields: graphql.Fields{
+					"node": {
+						Type: nodeType,
+					},
+					"resource": {
+						Type: resourceType,
+					},
+					"named": {
+						Type: namedType,
+					},
+				},
+			}),
+			Types: []graphql.Type{
+				graphql.MustNewObject(&graphql.ObjectConfig{
+					Name:       "Image",
+					Interfaces: []graphql.InterfaceTypeDefinition{resourceType, nodeType},
+					Fields: graphql.Fields{
+						"id": {
+							Type: graphql.T(graphql.ID()),
+						},
+						"url": {
+							Type: graphql.T(graphql.String()),
+						},
+					},
+				}),
+				graphql.MustNewObject(&graphql.ObjectConfig{
+					Name:       "Person",
+					Interfaces: []graphql.InterfaceTypeDefinition{namedType},
+					Fields: graphql.Fields{
+						"name": {
+							Type: graphql.T(graphql.String()),
+						},
+					},
+				}),
+			},
+		})
+
+		expectErrors := func(queryStr string) GomegaAssertion {
+			return expectValidationErrorsWithSchema(schema, rules.PossibleFragmentSpreads{}, queryStr)
+		}
+
+		expectValid := func(queryStr string) {
+			expectErrors(queryStr).Should(Equal(graphql.NoErrors()))
+		}
+
+		It("interface into implemented interface", func() {
+			expectValid(`
+      {
+        resource {
+          ...on Node { id }
+        }
+      }
+    `)
+		})
+
+		It("interface into implementing interface", func() {
+			expectValid(`
+      {
+        node {
+          ...resourceFragment
+        }
+      }
+      fragment resourceFragment on Resource { url }
+    `)
+		})
+
+		It("interface into non overlapping interface", func() {
+			expectErrors(`
+      {
+        resource {
+          ...on Named { name }
+        }
+      }
+    `).Should(Equal(graphql.ErrorsOf(errorAnon("Resource", "Named", 4, 11))))
+		})
+	})
 })
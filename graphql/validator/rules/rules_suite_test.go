@@ -293,6 +293,19 @@ var ComplexInput = &graphql.InputObjectConfig{
 	},
 }
 
+var OneOfInput = &graphql.InputObjectConfig{
+	Name: "OneOfInput",
+	Fields: graphql.InputFields{
+		"stringField": {
+			Type: graphql.T(graphql.String()),
+		},
+		"intField": {
+			Type: graphql.T(graphql.Int()),
+		},
+	},
+	IsOneOf: true,
+}
+
 var ComplicatedArgs = &graphql.ObjectConfig{
 	Name: "ComplicatedArgs",
 	Fields: graphql.Fields{
@@ -376,6 +389,14 @@ var ComplicatedArgs = &graphql.ObjectConfig{
 				},
 			},
 		},
+		"oneOfArgField": {
+			Type: graphql.T(graphql.String()),
+			Args: graphql.ArgumentConfigMap{
+				"oneOfArg": {
+					Type: OneOfInput,
+				},
+			},
+		},
 		"multipleReqs": {
 			Type: graphql.T(graphql.String()),
 			Args: graphql.ArgumentConfigMap{
@@ -561,6 +582,8 @@ func init() {
 		Directives: graphql.DirectiveList{
 			graphql.IncludeDirective(),
 			graphql.SkipDirective(),
+			graphql.DeferDirective(),
+			graphql.StreamDirective(),
 			graphql.MustNewDirective(&graphql.DirectiveConfig{
 				Name:      "onQuery",
 				Locations: []graphql.DirectiveLocation{graphql.DirectiveLocationQuery},
@@ -593,6 +616,14 @@ func init() {
 				Name:      "onVariableDefinition",
 				Locations: []graphql.DirectiveLocation{graphql.DirectiveLocationVariableDefinition},
 			}),
+			graphql.MustNewDirective(&graphql.DirectiveConfig{
+				Name: "repeatable",
+				Locations: []graphql.DirectiveLocation{
+					graphql.DirectiveLocationField,
+					graphql.DirectiveLocationFragmentDefinition,
+				},
+				IsRepeatable: true,
+			}),
 		},
 	})
 }
@@ -0,0 +1,61 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package rules
+
+import (
+	"github.com/botobag/artemis/graphql"
+	messages "github.com/botobag/artemis/graphql/internal/validator"
+	"github.com/botobag/artemis/graphql/validator"
+)
+
+// StreamDirectiveOnListField implements the validation rule which ensures that @stream is only
+// applied to the fields of List type.
+type StreamDirectiveOnListField struct{}
+
+// CheckField implements validator.FieldRule.
+func (rule StreamDirectiveOnListField) CheckField(
+	ctx *validator.ValidationContext,
+	field *validator.FieldInfo) validator.NextCheckAction {
+
+	var (
+		fieldDef   = field.Def()
+		parentType = field.ParentType()
+	)
+
+	// Skip the check if we're unable to resolve field and parent type statically.
+	if fieldDef == nil || parentType == nil {
+		return validator.ContinueCheck
+	}
+
+	if graphql.IsListType(graphql.NullableTypeOf(fieldDef.Type())) {
+		return validator.ContinueCheck
+	}
+
+	streamDirectiveName := graphql.StreamDirective().Name()
+	for _, directive := range field.Node().Directives {
+		if directive.Name.Value() == streamDirectiveName {
+			ctx.ReportError(
+				messages.StreamOnNonListFieldMessage(
+					fieldDef.Name(),
+					parentType.(graphql.TypeWithName).Name()),
+				graphql.ErrorLocationOfASTNode(directive),
+			)
+		}
+	}
+
+	return validator.ContinueCheck
+}
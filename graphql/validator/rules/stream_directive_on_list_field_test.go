@@ -0,0 +1,100 @@
+/**
+ * Copyright (c) 2019, The Artemis Authors.
+ *
+ * Permission to use, copy, modify, and/or distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package rules_test
+
+import (
+	"github.com/botobag/artemis/graphql"
+	"github.com/botobag/artemis/graphql/internal/validator"
+	"github.com/botobag/artemis/graphql/validator/rules"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// graphql-js/src/validation/__tests__/StreamDirectiveOnListFieldRule-test.ts
+var _ = Describe("Validate: Stream directive on list field", func() {
+	expectErrors := func(queryStr string) GomegaAssertion {
+		return expectValidationErrors(rules.StreamDirectiveOnListField{}, queryStr)
+	}
+
+	expectValid := func(queryStr string) {
+		expectErrors(queryStr).Should(Equal(graphql.NoErrors()))
+	}
+
+	It("Stream on list field", func() {
+		expectValid(`
+      fragment objectFieldSelection on Human {
+        pets @stream(initialCount: 0) {
+          name
+        }
+      }
+    `)
+	})
+
+	It("Stream on list field in inline fragment", func() {
+		expectValid(`
+      fragment objectFieldSelection on Human {
+        ... on Human {
+          relatives @stream {
+            name
+          }
+        }
+      }
+    `)
+	})
+
+	It("Does not validate other directives on list fields", func() {
+		expectValid(`
+      fragment objectFieldSelection on Human {
+        pets @include(if: true) {
+          name
+        }
+      }
+    `)
+	})
+
+	It("Does not validate other directives on non-list fields", func() {
+		expectValid(`
+      fragment objectFieldSelection on Human {
+        pets {
+          name @include(if: true)
+        }
+      }
+    `)
+	})
+
+	It("Does not validate misplaced stream directives", func() {
+		expectValid(`
+      fragment objectFieldSelection on Human {
+        ... @stream(initialCount: 0) {
+          name
+        }
+      }
+    `)
+	})
+
+	It("Reports errors when stream is used on non-list field", func() {
+		expectErrors(`
+      fragment objectFieldSelection on Human {
+        name @stream(initialCount: 0)
+      }
+    `).Should(Equal(graphql.ErrorsOf(
+			graphql.NewError(validator.StreamOnNonListFieldMessage("name", "Human"), []graphql.ErrorLocation{
+				{Line: 3, Column: 14},
+			}))))
+	})
+})
@@ -34,11 +34,18 @@ func (rule UniqueDirectivesPerLocation) CheckDirectives(
 	directives ast.Directives,
 	location graphql.DirectiveLocation) validator.NextCheckAction {
 
-	// A GraphQL document is only valid if all directives at a given location are uniquely named.
+	// A GraphQL document is only valid if all non-repeatable directives at a given location are
+	// uniquely named.
 
+	directiveDefs := ctx.Schema().Directives()
 	knownDirectives := make(map[string]*ast.Directive, len(directives))
 	for _, directive := range directives {
 		directiveName := directive.Name.Value()
+		if directiveDef := directiveDefs.Lookup(directiveName); directiveDef != nil &&
+			directiveDef.IsRepeatable() {
+			continue
+		}
+
 		prevDirective, exists := knownDirectives[directiveName]
 		if !exists {
 			knownDirectives[directiveName] = directive
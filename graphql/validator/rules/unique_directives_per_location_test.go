@@ -90,6 +90,14 @@ var _ = Describe("Validate: Directives Are Unique Per Location", func() {
     `)
 	})
 
+	It("repeatable directives in same location", func() {
+		expectValid(`
+      fragment Test on Type @repeatable @repeatable {
+        field @repeatable @repeatable
+      }
+    `)
+	})
+
 	It("duplicate directives in one location", func() {
 		expectErrors(`
       fragment Test on Type {
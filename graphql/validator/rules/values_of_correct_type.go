@@ -121,6 +121,21 @@ func (rule ValuesOfCorrectType) CheckValue(
 			}
 		}
 
+		// Ensure exactly one non-null field is given to a OneOf Input Object.
+		if objectType.IsOneOf() {
+			if len(fieldNodes) != 1 {
+				ctx.ReportError(
+					messages.OneOfInputObjectKeyCountMessage(objectType.Name()),
+					graphql.ErrorLocationOfASTNode(value),
+				)
+			} else if _, isNullValue := fieldNodes[0].Value.(ast.NullValue); isNullValue {
+				ctx.ReportError(
+					messages.OneOfInputObjectNullFieldMessage(objectType.Name(), fieldNodes[0].Name.Value()),
+					graphql.ErrorLocationOfASTNode(value),
+				)
+			}
+		}
+
 	case ast.EnumValue:
 		enumType, ok := graphql.NamedTypeOf(valueType).(graphql.Enum)
 		if !ok {
@@ -880,6 +880,93 @@ var _ = Describe("Validate: Values of correct type", func() {
 		})
 	})
 
+	Describe("Valid oneOf input object value", func() {
+		It("Exactly one field", func() {
+			expectValid(`
+        {
+          complicatedArgs {
+            oneOfArgField(oneOfArg: { stringField: "abc" })
+          }
+        }
+      `)
+		})
+
+		It("Exactly one non-nullable variable", func() {
+			expectValid(`
+        query ($string: String!) {
+          complicatedArgs {
+            oneOfArgField(oneOfArg: { stringField: $string })
+          }
+        }
+      `)
+		})
+	})
+
+	Describe("Invalid oneOf input object value", func() {
+		It("Invalid field type", func() {
+			expectErrors(`
+        {
+          complicatedArgs {
+            oneOfArgField(oneOfArg: { stringField: 2 })
+          }
+        }
+      `).Should(Equal(graphql.ErrorsOf(
+				badValue("String", "2", nil, 4, 52),
+			)))
+		})
+
+		It("Exactly one null field", func() {
+			expectErrors(`
+        {
+          complicatedArgs {
+            oneOfArgField(oneOfArg: { stringField: null })
+          }
+        }
+      `).Should(Equal(graphql.ErrorsOf(
+				graphql.NewError(
+					validator.OneOfInputObjectNullFieldMessage("OneOfInput", "stringField"),
+					[]graphql.ErrorLocation{
+						{Line: 4, Column: 37},
+					},
+				),
+			)))
+		})
+
+		It("More than one field", func() {
+			expectErrors(`
+        {
+          complicatedArgs {
+            oneOfArgField(oneOfArg: { stringField: "abc", intField: 123 })
+          }
+        }
+      `).Should(Equal(graphql.ErrorsOf(
+				graphql.NewError(
+					validator.OneOfInputObjectKeyCountMessage("OneOfInput"),
+					[]graphql.ErrorLocation{
+						{Line: 4, Column: 37},
+					},
+				),
+			)))
+		})
+
+		It("No field", func() {
+			expectErrors(`
+        {
+          complicatedArgs {
+            oneOfArgField(oneOfArg: {})
+          }
+        }
+      `).Should(Equal(graphql.ErrorsOf(
+				graphql.NewError(
+					validator.OneOfInputObjectKeyCountMessage("OneOfInput"),
+					[]graphql.ErrorLocation{
+						{Line: 4, Column: 37},
+					},
+				),
+			)))
+		})
+	})
+
 	Describe("Directive arguments", func() {
 		It("with directives of valid types", func() {
 			expectValid(`
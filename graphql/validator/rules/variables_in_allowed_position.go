@@ -96,3 +96,42 @@ func (rule VariablesInAllowedPosition) allowedVariableUsage(
 
 	return graphql.IsTypeSubTypeOf(schema, varType, locationType)
 }
+
+// CheckValue implements validator.ValueRule.
+func (rule VariablesInAllowedPosition) CheckValue(
+	ctx *validator.ValidationContext,
+	valueType graphql.Type,
+	value ast.Value) validator.NextCheckAction {
+
+	// The field of a OneOf Input Object cannot be given with a variable that is nullable, because the
+	// variable could supply a null value at runtime.
+	objectValue, ok := value.(ast.ObjectValue)
+	if !ok || ctx.CurrentOperation() == nil {
+		return validator.ContinueCheck
+	}
+
+	objectType, ok := graphql.NamedTypeOf(valueType).(graphql.InputObject)
+	if !ok || !objectType.IsOneOf() {
+		return validator.ContinueCheck
+	}
+
+	fieldNodes := objectValue.Fields()
+	if len(fieldNodes) != 1 {
+		return validator.ContinueCheck
+	}
+
+	variable, ok := fieldNodes[0].Value.(ast.Variable)
+	if !ok {
+		return validator.ContinueCheck
+	}
+
+	info := ctx.VariableInfo(variable.Name.Value())
+	if info != nil && info.TypeDef() != nil && !graphql.IsNonNullType(info.TypeDef()) {
+		ctx.ReportError(
+			messages.NullableVarForOneOfMessage(info.Name(), objectType.Name()),
+			graphql.ErrorLocationOfASTNode(variable),
+		)
+	}
+
+	return validator.ContinueCheck
+}
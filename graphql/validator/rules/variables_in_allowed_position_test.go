@@ -370,4 +370,52 @@ var _ = Describe("Validate: Variables are in allowed positions", func() {
         }`)
 		})
 	})
+
+	Describe("Validates OneOf Input Objects", func() {
+		It("String! => OneOf Input Object field", func() {
+			expectValid(`
+        query Query($stringVar: String!) {
+          complicatedArgs {
+            oneOfArgField(oneOfArg: { stringField: $stringVar })
+          }
+        }`)
+		})
+
+		It("String => OneOf Input Object field", func() {
+			expectErrors(`
+        query Query($stringVar: String) {
+          complicatedArgs {
+            oneOfArgField(oneOfArg: { stringField: $stringVar })
+          }
+        }
+      `).Should(Equal(graphql.ErrorsOf(
+				graphql.NewError(
+					validator.NullableVarForOneOfMessage("stringVar", "OneOfInput"),
+					[]graphql.ErrorLocation{
+						{Line: 4, Column: 52},
+					},
+				),
+			)))
+		})
+
+		It("String => OneOf Input Object field within fragment", func() {
+			expectErrors(`
+        query Query($stringVar: String) {
+          complicatedArgs {
+            ...oneOfFrag
+          }
+        }
+        fragment oneOfFrag on ComplicatedArgs {
+          oneOfArgField(oneOfArg: { stringField: $stringVar })
+        }
+      `).Should(Equal(graphql.ErrorsOf(
+				graphql.NewError(
+					validator.NullableVarForOneOfMessage("stringVar", "OneOfInput"),
+					[]graphql.ErrorLocation{
+						{Line: 8, Column: 50},
+					},
+				),
+			)))
+		})
+	})
 })
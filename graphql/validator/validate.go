@@ -36,6 +36,7 @@ func Validate(schema graphql.Schema, document ast.Document) graphql.Errors {
 // ValidateWithRules runs a list of specific validation rules on the given document. Every rule in
 // rs must implement at least one of the following interfaces:
 //
+//  DocumentRule
 //  OperationRule
 //  VariableRule
 //  FragmentRule
@@ -143,8 +143,9 @@ type ValidationContext struct {
 
 	// The rules set that are only applied when visiting the selection sets referenced via fragment
 	// spreads in an Operation. It is a subset of ctx.rules which currently contains only
-	// VariableUsageRule's. It is initialized on creation of a ValidationContext and is used
-	// repeatedly in walkFragmentSpread to save allocation.
+	// VariableUsageRule's and the ValueRule's that are also VariableUsageRule's. It is initialized on
+	// creation of a ValidationContext and is used repeatedly in walkFragmentSpread to save
+	// allocation.
 	rulesForFragmentSpreads *rules
 
 	// Map VariableInfo's in current operation from their names. This is only available when we're
@@ -201,6 +202,9 @@ type ValidationContext struct {
 	// UniqueFragmentNames
 	KnownFragmentNames map[string]ast.Name
 
+	// DeferStreamDirectiveLabel
+	KnownDeferStreamLabels map[string]*ast.Directive
+
 	// KnownTypeNames
 
 	// existingTypeNames caches all type names occurred in the schema; This is lazily initialized at
@@ -216,6 +220,7 @@ func newValidationContext(schema graphql.Schema, document ast.Document, r *rules
 		document: document,
 		rules:    r,
 		rulesForFragmentSpreads: &rules{
+			valueRules:         r.valueRules.variableUsageRules(),
 			variableUsageRules: r.variableUsageRules,
 		},
 
@@ -227,6 +232,8 @@ func newValidationContext(schema graphql.Schema, document ast.Document, r *rules
 		FieldsAndFragmentNamesCache: internal.NewFieldsAndFragmentNamesCache(),
 
 		KnownFragmentNames: map[string]ast.Name{},
+
+		KnownDeferStreamLabels: map[string]*ast.Directive{},
 	}
 }
 
@@ -27,6 +27,7 @@ import (
 // rules contains a collection of actions to be performed on nodes for validation.
 type rules struct {
 	size                   int
+	documentRules          documentRules
 	operationRules         operationRules
 	variableRules          variableRules
 	fragmentRules          fragmentRules
@@ -48,6 +49,13 @@ func buildRules(rs ...interface{}) *rules {
 	}
 	for i, rule := range rs {
 		isRule := false
+		if r, ok := rule.(DocumentRule); ok {
+			documentRules := &rules.documentRules
+			documentRules.indices = append(documentRules.indices, i)
+			documentRules.rules = append(documentRules.rules, r)
+			isRule = true
+		}
+
 		if r, ok := rule.(OperationRule); ok {
 			operationRules := &rules.operationRules
 			operationRules.indices = append(operationRules.indices, i)
@@ -163,6 +171,23 @@ func setSkipping(ctx *ValidationContext, ruleIndex int, node ast.Node, nextCheck
 	}
 }
 
+type documentRules struct {
+	indices []int
+	rules   []DocumentRule
+}
+
+func (r *documentRules) Run(ctx *ValidationContext, document ast.Document) {
+	indices := r.indices
+	for i, rule := range r.rules {
+		index := indices[i]
+		// See whether we can run the rule.
+		if !shouldSkipRule(ctx, index) {
+			// Run the rule and set skipping state.
+			setSkipping(ctx, index, document, rule.CheckDocument(ctx, document))
+		}
+	}
+}
+
 type operationRules struct {
 	indices []int
 	rules   []OperationRule
@@ -309,6 +334,20 @@ type valueRules struct {
 	rules   []ValueRule
 }
 
+// variableUsageRules returns the subset of r that also implements VariableUsageRule. These rules
+// check values against the variables defined in an Operation and therefore should also be applied
+// to the values in the fragments referenced by the Operation.
+func (r *valueRules) variableUsageRules() valueRules {
+	var result valueRules
+	for i, rule := range r.rules {
+		if _, ok := rule.(VariableUsageRule); ok {
+			result.indices = append(result.indices, r.indices[i])
+			result.rules = append(result.rules, rule)
+		}
+	}
+	return result
+}
+
 func (r *valueRules) Run(ctx *ValidationContext, valueType graphql.Type, value ast.Value) {
 	indices := r.indices
 	for i, rule := range r.rules {
@@ -402,6 +441,8 @@ func (r *directiveArgumentRules) Run(
 }
 
 func walk(ctx *ValidationContext) {
+	ctx.rules.documentRules.Run(ctx, ctx.Document())
+
 	for _, definitions := range ctx.Document().Definitions {
 		if operation, ok := definitions.(*ast.OperationDefinition); ok {
 			walkOperationDefinition(ctx, operation)
@@ -84,3 +84,47 @@ func CamelCase(s string) string {
 
 	return buf.String()
 }
+
+func toLowerCamelCaseLower(b byte) byte {
+	if b >= 'A' && b <= 'Z' {
+		return b - 'A' + 'a'
+	}
+	return b
+}
+
+func isLowerCamelCaseUpper(b byte) bool {
+	return b >= 'A' && b <= 'Z'
+}
+
+// LowerCamelCase converts an exported Go identifier into lower camel case by lowering its leading
+// upper case letters. An initialism at the beginning is lowered as a whole. For example, it returns
+// "lowerCamelCase" for "LowerCamelCase", "id" for "ID" and "urlPath" for "URLPath".
+func LowerCamelCase(s string) string {
+	sLen := len(s)
+
+	// Count the number of leading upper case letters.
+	n := 0
+	for n < sLen && isLowerCamelCaseUpper(s[n]) {
+		n++
+	}
+
+	if n == 0 {
+		return s
+	} else if n > 1 && n < sLen {
+		// The last upper case letter begins the next word (e.g., "P" in "URLPath") unless it is followed
+		// by a digit or an underscore.
+		c := s[n]
+		if c >= 'a' && c <= 'z' {
+			n--
+		}
+	}
+
+	var buf StringBuilder
+	buf.Grow(sLen)
+	for i := 0; i < n; i++ {
+		buf.WriteByte(toLowerCamelCaseLower(s[i]))
+	}
+	buf.WriteString(s[n:])
+
+	return buf.String()
+}
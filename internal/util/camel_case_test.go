@@ -48,3 +48,25 @@ var _ = Describe("CamelCase", func() {
 		}
 	})
 })
+
+var _ = Describe("LowerCamelCase", func() {
+	It("converts strng to lowerCamelCase", func() {
+		testcases := map[string]string{
+			"":               "",
+			"a":              "a",
+			"A":              "a",
+			"foo":            "foo",
+			"Foo":            "foo",
+			"ID":             "id",
+			"UserID":         "userID",
+			"URLPath":        "urlPath",
+			"HTTP2Server":    "http2Server",
+			"LowerCamelCase": "lowerCamelCase",
+			"Foo_Bar":        "foo_Bar",
+		}
+
+		for s, expected := range testcases {
+			Expect(util.LowerCamelCase(s)).Should(Equal(expected), "%s", s)
+		}
+	})
+})